@@ -355,7 +355,7 @@ func generateNAccounts(t *testing.T, N int, firstRound, lastRound basics.Round,
 			panic(err)
 		}
 		allocatedAccessors = append(allocatedAccessors, access)
-		part, err := account.FillDBWithParticipationKeys(access, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+		part, err := account.FillDBWithParticipationKeys(access, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 		if err != nil {
 			panic(err)
 		}
@@ -18,6 +18,7 @@ package catchup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -402,6 +403,10 @@ func (cs *CatchpointCatchupService) processStageLastestBlockDownload() (err erro
 			if cs.ctx.Err() != nil {
 				return cs.stopOrAbort()
 			}
+			if errors.Is(err, ledger.ErrCatchpointBoxesNotSupported) {
+				// no other peer would serve a better block
+				return cs.abort(fmt.Errorf("processStageLastestBlockDownload failed when calling VerifyCatchpoint : %v", err))
+			}
 			if attemptsCount <= cs.config.CatchupBlockDownloadRetryAttempts {
 				// try again.
 				blk = nil
@@ -116,7 +116,7 @@ func benchenv(t testing.TB, numAccounts, numBlocks int) (ledger, emptyLedger *da
 		}
 		accesssors = append(accesssors, access)
 		part, err := account.FillDBWithParticipationKeys(access, root.Address(), 0, basics.Round(numBlocks),
-			config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+			config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 		if err != nil {
 			panic(err)
 		}
@@ -43,6 +43,9 @@ const blockQueryPeerLimit = 10
 // this should be at least the number of relays
 const catchupRetryLimit = 500
 
+// ErrSyncRoundInvalid is returned when the sync round requested is behind the current ledger round
+var ErrSyncRoundInvalid = errors.New("requested sync round cannot be less than the latest round")
+
 // PendingUnmatchedCertificate is a single certificate that is being waited upon to have its corresponding block fetched.
 type PendingUnmatchedCertificate struct {
 	Cert         agreement.Certificate
@@ -64,7 +67,8 @@ type Ledger interface {
 
 // Service represents the catchup service. Once started and until it is stopped, it ensures that the ledger is up to date with network.
 type Service struct {
-	syncStartNS         int64 // at top of struct to keep 64 bit aligned for atomic.* ops
+	syncStartNS         int64  // at top of struct to keep 64 bit aligned for atomic.* ops
+	syncRound           uint64 // the last round the service fetches, or 0 if unset; accessed with atomic.* ops
 	cfg                 config.Local
 	ledger              Ledger
 	ctx                 context.Context
@@ -77,6 +81,10 @@ type Service struct {
 	deadlineTimeout     time.Duration
 	blockValidationPool execpool.BacklogPool
 
+	// syncNow wakes up periodicSync when the sync round moves, so that fetching
+	// resumes without waiting for the deadline timeout.
+	syncNow chan struct{}
+
 	// suspendForCatchpointWriting defines whether we've ran into a state where the ledger is currently busy writing the
 	// catchpoint file. If so, we want to suspend the catchup process until the catchpoint file writing is complete,
 	// and resume from there without stopping the catchup timer.
@@ -116,6 +124,7 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.parallelBlocks = config.CatchupParallelBlocks
 	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
+	s.syncNow = make(chan struct{}, 1)
 
 	return s
 }
@@ -156,6 +165,42 @@ func (s *Service) SynchronizingTime() time.Duration {
 	return time.Duration(timeInNS - startNS)
 }
 
+// SetSyncRound sets the last round the catchup service fetches from the network. Rounds past it are not
+// fetched until the sync round is moved forward or unset.
+func (s *Service) SetSyncRound(rnd basics.Round) error {
+	if rnd < s.ledger.LastRound() {
+		return ErrSyncRoundInvalid
+	}
+	atomic.StoreUint64(&s.syncRound, uint64(rnd))
+	s.triggerSync()
+	return nil
+}
+
+// UnsetSyncRound removes the sync round limit, allowing the catchup service to fetch any round.
+func (s *Service) UnsetSyncRound() {
+	atomic.StoreUint64(&s.syncRound, 0)
+	s.triggerSync()
+}
+
+// triggerSync wakes up periodicSync unless it has a pending wake up already.
+func (s *Service) triggerSync() {
+	select {
+	case s.syncNow <- struct{}{}:
+	default:
+	}
+}
+
+// GetSyncRound returns the current sync round, or 0 if it is not set.
+func (s *Service) GetSyncRound() basics.Round {
+	return basics.Round(atomic.LoadUint64(&s.syncRound))
+}
+
+// roundIsBeyondSyncRound returns true if the sync round is set and the given round is past it.
+func (s *Service) roundIsBeyondSyncRound(r basics.Round) bool {
+	syncRound := s.GetSyncRound()
+	return syncRound != 0 && r > syncRound
+}
+
 // errLedgerAlreadyHasBlock is returned by innerFetch in case the local ledger already has the requested block.
 var errLedgerAlreadyHasBlock = errors.New("ledger already has block")
 
@@ -453,8 +498,16 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 	}
 
 	from := s.ledger.NextRound()
+	if s.roundIsBeyondSyncRound(from) {
+		return
+	}
 	nextRound := from
 	for ; nextRound < from+basics.Round(parallelRequests); nextRound++ {
+		// do not fetch rounds past the sync round
+		if s.roundIsBeyondSyncRound(nextRound) {
+			break
+		}
+
 		// If the next round is not supported
 		if s.nextRoundIsNotSupported(nextRound) {
 			// We may get here when (1) The service starts
@@ -499,8 +552,16 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 				return
 			}
 			completedRounds[round] = true
+			// stop once the ledger has reached the sync round; ledger writes are in order, so every
+			// round that was scheduled has been written by then.
+			if s.roundIsBeyondSyncRound(s.ledger.NextRound()) {
+				return
+			}
 			// fetch rounds we can validate
 			for completedRounds[nextRound-basics.Round(parallelRequests)] {
+				if s.roundIsBeyondSyncRound(nextRound) {
+					break
+				}
 				// If the next round is not supported
 				if s.nextRoundIsNotSupported(nextRound) {
 					s.handleUnsupportedRound(nextRound)
@@ -558,9 +619,24 @@ func (s *Service) periodicSync() {
 				// keep the existing sleep duration and try again later.
 				continue
 			}
+			// the ledger is not expected to advance while it is held at the sync round.
+			if s.roundIsBeyondSyncRound(s.ledger.NextRound()) {
+				continue
+			}
 			s.suspendForCatchpointWriting = false
 			s.log.Info("It's been too long since our ledger advanced; resyncing")
 			s.sync()
+		case <-s.syncNow:
+			// the sync round moved, so fetch up to it right away.
+			if s.parallelBlocks == 0 || s.cfg.DisableNetworking || s.ledger.IsWritingCatchpointFile() {
+				continue
+			}
+			if s.roundIsBeyondSyncRound(s.ledger.NextRound()) {
+				continue
+			}
+			s.suspendForCatchpointWriting = false
+			s.log.Infof("Sync round moved to %d; resyncing", s.GetSyncRound())
+			s.sync()
 		case cert := <-s.unmatchedPendingCertificates:
 			// the agreement service has a valid certificate for a block, but not the block itself.
 			if s.cfg.DisableNetworking {
@@ -420,6 +420,103 @@ func TestServiceFetchBlocksMultiBlocks(t *testing.T) {
 	}
 }
 
+func TestSyncRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Make Ledger
+	numberOfBlocks := basics.Round(20)
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, bookkeeping.Block{})
+
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, remote, blk, int(numberOfBlocks)-1)
+
+	// Create a network and block service
+	blockServiceConfig := config.GetDefaultLocal()
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, remote, net, "test genesisID")
+
+	nodeA := basicRPCNode{}
+	nodeA.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, ls)
+	nodeA.start()
+	defer nodeA.stop()
+	rootURL := nodeA.rootURL()
+	net.addPeer(rootURL)
+
+	// Make Service
+	syncer := MakeService(logging.Base(), defaultConfig, net, local, &mockedAuthenticator{errorRound: -1}, nil, nil)
+	syncer.testStart()
+	require.Equal(t, basics.Round(0), syncer.GetSyncRound())
+
+	// the ledger does not advance beyond the sync round
+	syncRound := basics.Round(5)
+	require.NoError(t, syncer.SetSyncRound(syncRound))
+	require.Equal(t, syncRound, syncer.GetSyncRound())
+	syncer.sync()
+	require.Equal(t, syncRound, local.LastRound())
+
+	// the sync round cannot be moved behind the ledger
+	require.Equal(t, ErrSyncRoundInvalid, syncer.SetSyncRound(syncRound-1))
+	require.Equal(t, syncRound, syncer.GetSyncRound())
+
+	// moving the sync round forward lets the ledger advance up to it
+	syncRound = basics.Round(12)
+	require.NoError(t, syncer.SetSyncRound(syncRound))
+	syncer.sync()
+	require.Equal(t, syncRound, local.LastRound())
+
+	// once unset, the ledger catches up with the network
+	syncer.UnsetSyncRound()
+	require.Equal(t, basics.Round(0), syncer.GetSyncRound())
+	syncer.sync()
+	require.Equal(t, numberOfBlocks, local.LastRound())
+}
+
+func TestSyncRoundWakesService(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Make Ledger
+	numberOfBlocks := basics.Round(20)
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, bookkeeping.Block{})
+
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, remote, blk, int(numberOfBlocks)-1)
+
+	// Create a network and block service
+	blockServiceConfig := config.GetDefaultLocal()
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, remote, net, "test genesisID")
+
+	nodeA := basicRPCNode{}
+	nodeA.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, ls)
+	nodeA.start()
+	defer nodeA.stop()
+	rootURL := nodeA.rootURL()
+	net.addPeer(rootURL)
+
+	// Make Service, which would only resync on its own after a minute
+	syncer := MakeService(logging.Base(), defaultConfig, net, local, &mockedAuthenticator{errorRound: -1}, nil, nil)
+	syncer.deadlineTimeout = time.Minute
+	require.NoError(t, syncer.SetSyncRound(5))
+	syncer.Start()
+	defer syncer.Stop()
+
+	// the initial sync stops at the sync round
+	require.Eventually(t, func() bool { return local.LastRound() == 5 }, 10*time.Second, 10*time.Millisecond)
+
+	// moving the sync round forward resumes fetching well before the deadline
+	for _, syncRound := range []basics.Round{6, 7, 12} {
+		require.NoError(t, syncer.SetSyncRound(syncRound))
+		require.Eventually(t, func() bool { return local.LastRound() == syncRound }, 10*time.Second, 10*time.Millisecond)
+	}
+
+	syncer.UnsetSyncRound()
+	require.Eventually(t, func() bool { return local.LastRound() == numberOfBlocks }, 10*time.Second, 10*time.Millisecond)
+}
+
 func TestServiceFetchBlocksMalformed(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+// This tool verifies a compact certificate independently of a node.
+//
+// It takes the block headers from a voters round to the round certified
+// by a compact certificate, and the compact certificate transaction, all as
+// fetched from the algod REST API in either msgpack or JSON format:
+//
+//   GET /v2/blocks/{round}/header   a block header
+//   GET /v2/blocks/{round}          a block, either for its header or for
+//                                   the compact cert transaction it contains
+//
+// The transaction may also be given on its own, as a signed transaction.
+// The tool checks that the headers form a hash chain and that the
+// certificate is signed by the voters committed to in the first header, and
+// prints the weight the certificate proves and the rounds it attests to.
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var txnFile = flag.String("t", "", "File with the compact cert transaction, or with the block containing it")
+
+// response holds the parts of the REST API responses that carry headers
+// and transactions.
+type response struct {
+	Header *bookkeeping.BlockHeader `codec:"header"`
+	Block  *bookkeeping.Block       `codec:"block"`
+
+	// Cert is the agreement certificate of msgpack blocks, which is not needed here.
+	Cert interface{} `codec:"cert"`
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s -t txnfile headerfile...\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "The header files hold the headers (or blocks) from the voters round to the certified round, in order.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *txnFile == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	headers := make([]bookkeeping.BlockHeader, flag.NArg())
+	for i, fn := range flag.Args() {
+		hdr, err := readHeader(fn)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read header from %s: %v\n", fn, err)
+			os.Exit(1)
+		}
+		headers[i] = hdr
+	}
+
+	certRound := headers[len(headers)-1].Round
+	txn, err := readCertTxn(*txnFile, certRound)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot read compact cert transaction from %s: %v\n", *txnFile, err)
+		os.Exit(1)
+	}
+
+	res, err := verify.CompactCert(headers, txn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Compact cert for round %d does not verify: %v\n", certRound, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Voters round:    %d\n", res.VotersRound)
+	fmt.Printf("Certified round: %d\n", res.CertRound)
+	fmt.Printf("Attested rounds: %d - %d\n", res.FirstAttested, res.LastAttested)
+	fmt.Printf("Signed weight:   %d\n", res.SignedWeight)
+	fmt.Printf("Proven weight:   %d\n", res.ProvenWeight)
+	fmt.Printf("Total weight:    %d\n", res.TotalWeight)
+}
+
+// decodeFile decodes the msgpack or JSON encoded object in fn
+func decodeFile(fn string, obj interface{}) error {
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return err
+	}
+	// msgpack maps never start with '{'
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return protocol.DecodeJSON(data, obj)
+	}
+	return protocol.DecodeReflect(data, obj)
+}
+
+func readHeader(fn string) (bookkeeping.BlockHeader, error) {
+	var resp response
+	err := decodeFile(fn, &resp)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, err
+	}
+	if resp.Header != nil {
+		return *resp.Header, nil
+	}
+	if resp.Block != nil {
+		return resp.Block.BlockHeader, nil
+	}
+	return bookkeeping.BlockHeader{}, fmt.Errorf("no header or block found")
+}
+
+func readCertTxn(fn string, certRound basics.Round) (transactions.Transaction, error) {
+	var resp response
+	err := decodeFile(fn, &resp)
+	if err == nil && resp.Block != nil {
+		for _, stib := range resp.Block.Payset {
+			if stib.Txn.Type == protocol.CompactCertTx && stib.Txn.CertRound == certRound {
+				return stib.Txn, nil
+			}
+		}
+		return transactions.Transaction{}, fmt.Errorf("block %d has no compact cert for round %d", resp.Block.Round(), certRound)
+	}
+
+	// not a block, so it should be a transaction on its own
+	var stib transactions.SignedTxnInBlock
+	err = decodeFile(fn, &stib)
+	if err != nil {
+		return transactions.Transaction{}, err
+	}
+	return stib.Txn, nil
+}
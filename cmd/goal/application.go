@@ -17,8 +17,6 @@
 package main
 
 import (
-	"bytes"
-	"crypto/sha512"
 	"encoding/base32"
 	"encoding/base64"
 	"encoding/binary"
@@ -1055,9 +1053,8 @@ var infoAppCmd = &cobra.Command{
 }
 
 // populateMethodCallTxnArgs parses and loads transactions from the files indicated by the values
-// slice. An error will occur if the transaction does not matched the expected type, it has a nonzero
-// group ID, or if it is signed by a normal signature or Msig signature (but not Lsig signature)
-func populateMethodCallTxnArgs(types []string, values []string) ([]transactions.SignedTxn, error) {
+// slice. They are checked against the method argument types when the call group is assembled.
+func populateMethodCallTxnArgs(values []string) ([]transactions.SignedTxn, error) {
 	loadedTxns := make([]transactions.SignedTxn, len(values))
 
 	for i, txFilename := range values {
@@ -1072,101 +1069,12 @@ func populateMethodCallTxnArgs(types []string, values []string) ([]transactions.
 			return nil, fmt.Errorf(txDecodeError, txFilename, err)
 		}
 
-		if !txn.Sig.Blank() || !txn.Msig.Blank() {
-			return nil, fmt.Errorf("Transaction from %s has already been signed", txFilename)
-		}
-
-		if !txn.Txn.Group.IsZero() {
-			return nil, fmt.Errorf("Transaction from %s already has a group ID: %s", txFilename, txn.Txn.Group)
-		}
-
-		expectedType := types[i]
-		if expectedType != abi.AnyTransactionType && txn.Txn.Type != protocol.TxType(expectedType) {
-			return nil, fmt.Errorf("Transaction from %s does not match method argument type. Expected %s, got %s", txFilename, expectedType, txn.Txn.Type)
-		}
-
 		loadedTxns[i] = txn
 	}
 
 	return loadedTxns, nil
 }
 
-// populateMethodCallReferenceArgs parses reference argument types and resolves them to an index
-// into the appropriate foreign array. Their placement will be as compact as possible, which means
-// values will be deduplicated and any value that is the sender or the current app will not be added
-// to the foreign array.
-func populateMethodCallReferenceArgs(sender string, currentApp uint64, types []string, values []string, accounts *[]string, apps *[]uint64, assets *[]uint64) ([]int, error) {
-	resolvedIndexes := make([]int, len(types))
-
-	for i, value := range values {
-		var resolved int
-
-		switch types[i] {
-		case abi.AccountReferenceType:
-			if value == sender {
-				resolved = 0
-			} else {
-				duplicate := false
-				for j, account := range *accounts {
-					if value == account {
-						resolved = j + 1 // + 1 because 0 is the sender
-						duplicate = true
-						break
-					}
-				}
-				if !duplicate {
-					resolved = len(*accounts) + 1
-					*accounts = append(*accounts, value)
-				}
-			}
-		case abi.ApplicationReferenceType:
-			appID, err := strconv.ParseUint(value, 10, 64)
-			if err != nil {
-				return nil, fmt.Errorf("Unable to parse application ID '%s': %s", value, err)
-			}
-			if appID == currentApp {
-				resolved = 0
-			} else {
-				duplicate := false
-				for j, app := range *apps {
-					if appID == app {
-						resolved = j + 1 // + 1 because 0 is the current app
-						duplicate = true
-						break
-					}
-				}
-				if !duplicate {
-					resolved = len(*apps) + 1
-					*apps = append(*apps, appID)
-				}
-			}
-		case abi.AssetReferenceType:
-			assetID, err := strconv.ParseUint(value, 10, 64)
-			if err != nil {
-				return nil, fmt.Errorf("Unable to parse asset ID '%s': %s", value, err)
-			}
-			duplicate := false
-			for j, asset := range *assets {
-				if assetID == asset {
-					resolved = j
-					duplicate = true
-					break
-				}
-			}
-			if !duplicate {
-				resolved = len(*assets)
-				*assets = append(*assets, assetID)
-			}
-		default:
-			return nil, fmt.Errorf("Unknown reference type: %s", types[i])
-		}
-
-		resolvedIndexes[i] = resolved
-	}
-
-	return resolvedIndexes, nil
-}
-
 var methodAppCmd = &cobra.Command{
 	Use:   "method",
 	Short: "Invoke an ABI method",
@@ -1222,82 +1130,52 @@ var methodAppCmd = &cobra.Command{
 			approvalProg, clearProg = mustParseProgArgs()
 		}
 
-		var applicationArgs [][]byte
-
-		// insert the method selector hash
-		hash := sha512.Sum512_256([]byte(method))
-		applicationArgs = append(applicationArgs, hash[0:4])
-
-		// parse down the ABI type from method signature
-		_, argTypes, retTypeStr, err := abi.ParseMethodSignature(method)
+		abiMethod, err := abi.MethodFromSignature(method)
 		if err != nil {
 			reportErrorf("cannot parse method signature: %v", err)
 		}
 
-		var retType *abi.Type
-		if retTypeStr != "void" {
-			theRetType, err := abi.TypeOf(retTypeStr)
-			if err != nil {
-				reportErrorf("cannot cast %s to abi type: %v", retTypeStr, err)
-			}
-			retType = &theRetType
+		retType, hasReturn, err := abiMethod.GetReturnType()
+		if err != nil {
+			reportErrorf("cannot cast %s to abi type: %v", abiMethod.Returns.Type, err)
 		}
 
-		if len(methodArgs) != len(argTypes) {
-			reportErrorf("incorrect number of arguments, method expected %d but got %d", len(argTypes), len(methodArgs))
+		if len(methodArgs) != len(abiMethod.Args) {
+			reportErrorf("incorrect number of arguments, method expected %d but got %d", len(abiMethod.Args), len(methodArgs))
 		}
 
-		var txnArgTypes []string
 		var txnArgValues []string
-		var basicArgTypes []string
 		var basicArgValues []string
-		var refArgTypes []string
-		var refArgValues []string
-		refArgIndexToBasicArgIndex := make(map[int]int)
-		for i, argType := range argTypes {
-			argValue := methodArgs[i]
-			if abi.IsTransactionType(argType) {
-				txnArgTypes = append(txnArgTypes, argType)
-				txnArgValues = append(txnArgValues, argValue)
+		for i, arg := range abiMethod.Args {
+			if abi.IsTransactionType(arg.Type) {
+				txnArgValues = append(txnArgValues, methodArgs[i])
 			} else {
-				if abi.IsReferenceType(argType) {
-					refArgIndexToBasicArgIndex[len(refArgTypes)] = len(basicArgTypes)
-					refArgTypes = append(refArgTypes, argType)
-					refArgValues = append(refArgValues, argValue)
-					// treat the reference as a uint8 for encoding purposes
-					argType = "uint8"
-				}
-				basicArgTypes = append(basicArgTypes, argType)
-				basicArgValues = append(basicArgValues, argValue)
+				basicArgValues = append(basicArgValues, methodArgs[i])
 			}
 		}
 
-		refArgsResolved, err := populateMethodCallReferenceArgs(account, appIdx, refArgTypes, refArgValues, &appAccounts, &foreignApps, &foreignAssets)
+		txnArgs, err := populateMethodCallTxnArgs(txnArgValues)
 		if err != nil {
-			reportErrorf("error populating reference arguments: %v", err)
-		}
-		for i, resolved := range refArgsResolved {
-			basicArgIndex := refArgIndexToBasicArgIndex[i]
-			// use the foreign array index as the encoded argument value
-			basicArgValues[basicArgIndex] = strconv.Itoa(resolved)
+			reportErrorf("error populating transaction arguments: %v", err)
 		}
 
-		err = abi.ParseArgJSONtoByteSlice(basicArgTypes, basicArgValues, &applicationArgs)
+		appCallTxn, err := client.MakeUnsignedApplicationCallTx(
+			appIdx, nil, appAccounts, foreignApps, foreignAssets,
+			onCompletionEnum, approvalProg, clearProg, globalSchema, localSchema, extraPages)
+
 		if err != nil {
-			reportErrorf("cannot parse arguments to ABI encoding: %v", err)
+			reportErrorf("Cannot create application txn: %v", err)
 		}
 
-		txnArgs, err := populateMethodCallTxnArgs(txnArgTypes, txnArgValues)
+		// Reference arguments are resolved against the sender, so set it before encoding
+		appCallTxn.Sender, err = basics.UnmarshalChecksumAddress(account)
 		if err != nil {
-			reportErrorf("error populating transaction arguments: %v", err)
+			reportErrorf("Cannot parse address %s: %v", account, err)
 		}
 
-		appCallTxn, err := client.MakeUnsignedApplicationCallTx(
-			appIdx, applicationArgs, appAccounts, foreignApps, foreignAssets,
-			onCompletionEnum, approvalProg, clearProg, globalSchema, localSchema, extraPages)
-
+		err = abiMethod.EncodeAppCall(&appCallTxn, basicArgValues)
 		if err != nil {
-			reportErrorf("Cannot create application txn: %v", err)
+			reportErrorf("cannot parse arguments to ABI encoding: %v", err)
 		}
 
 		// Fill in note and lease
@@ -1320,41 +1198,21 @@ var methodAppCmd = &cobra.Command{
 		}
 
 		// Compile group
-		var txnGroup []transactions.Transaction
-		for i := range txnArgs {
-			txnGroup = append(txnGroup, txnArgs[i].Txn)
-		}
-		txnGroup = append(txnGroup, appCallTxn)
-		if len(txnGroup) > 1 {
-			// Only if transaction arguments are present, assign group ID
-			groupID, err := client.GroupID(txnGroup)
-			if err != nil {
-				reportErrorf("Cannot assign transaction group ID: %s", err)
-			}
-			for i := range txnGroup {
-				txnGroup[i].Group = groupID
-			}
+		txnGroup, err := abi.MakeMethodCallGroup(abiMethod, appCallTxn, txnArgs)
+		if err != nil {
+			reportErrorf("Cannot assemble method call group: %s", err)
 		}
 
 		// Sign transactions
 		var signedTxnGroup []transactions.SignedTxn
 		shouldSign := sign || outFilename == ""
-		for i, unsignedTxn := range txnGroup {
-			txnFromArgs := transactions.SignedTxn{}
-			if i < len(txnArgs) {
-				txnFromArgs = txnArgs[i]
-			}
-
-			if !txnFromArgs.Lsig.Blank() {
-				signedTxnGroup = append(signedTxnGroup, transactions.SignedTxn{
-					Lsig:     txnFromArgs.Lsig,
-					AuthAddr: txnFromArgs.AuthAddr,
-					Txn:      unsignedTxn,
-				})
+		for _, groupTxn := range txnGroup {
+			if !groupTxn.Lsig.Blank() {
+				signedTxnGroup = append(signedTxnGroup, groupTxn)
 				continue
 			}
 
-			signedTxn, err := createSignedTransaction(client, shouldSign, dataDir, walletName, unsignedTxn, txnFromArgs.AuthAddr)
+			signedTxn, err := createSignedTransaction(client, shouldSign, dataDir, walletName, groupTxn.Txn, groupTxn.AuthAddr)
 			if err != nil {
 				reportErrorf(errorSigningTX, err)
 			}
@@ -1412,26 +1270,15 @@ var methodAppCmd = &cobra.Command{
 				reportInfof("Created app with app index %d", *resp.ApplicationIndex)
 			}
 
-			if retType == nil {
+			if !hasReturn {
 				reportInfof("method %s succeeded", method)
 				return
 			}
 
-			// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
-			var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
-
-			if resp.Logs == nil || len(*resp.Logs) == 0 {
-				reportErrorf("method %s succeed but did not log a return value", method)
-			}
-
-			lastLog := (*resp.Logs)[len(*resp.Logs)-1]
-			if !bytes.HasPrefix(lastLog, abiReturnHash) {
+			decoded, rawReturnValue, err := libgoal.ABIMethodReturn(resp, retType)
+			if err == libgoal.ErrNoABIReturn {
 				reportErrorf("method %s succeed but did not log a return value", method)
-			}
-
-			rawReturnValue := lastLog[len(abiReturnHash):]
-			decoded, err := retType.Decode(rawReturnValue)
-			if err != nil {
+			} else if err != nil {
 				reportErrorf("method %s succeed but its return value could not be decoded.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
 			}
 
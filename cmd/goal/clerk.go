@@ -18,6 +18,7 @@ package main
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -49,6 +50,7 @@ var (
 	rejectsFilename string
 	closeToAddress  string
 	noProgramOutput bool
+	writeSourceMap  bool
 	signProgram     bool
 	programSource   string
 	argB64Strings   []string
@@ -62,6 +64,9 @@ var (
 	rekeyToAddress  string
 	signerAddress   string
 	rawOutput       bool
+	targetVersion   uint64
+	lintJSON        bool
+	traceFilename   string
 )
 
 func init() {
@@ -72,6 +77,7 @@ func init() {
 	clerkCmd.AddCommand(groupCmd)
 	clerkCmd.AddCommand(splitCmd)
 	clerkCmd.AddCommand(compileCmd)
+	clerkCmd.AddCommand(lintCmd)
 	clerkCmd.AddCommand(dryrunCmd)
 	clerkCmd.AddCommand(dryrunRemoteCmd)
 
@@ -124,15 +130,20 @@ func init() {
 	compileCmd.Flags().BoolVarP(&disassemble, "disassemble", "D", false, "disassemble a compiled program")
 	compileCmd.Flags().BoolVarP(&noProgramOutput, "no-out", "n", false, "don't write contract program binary")
 	compileCmd.Flags().BoolVarP(&signProgram, "sign", "s", false, "sign program, output is a binary signed LogicSig record")
+	compileCmd.Flags().BoolVarP(&writeSourceMap, "map", "m", false, "write out the source map of the program next to the output file, with a .map extension")
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
 
+	lintCmd.Flags().Uint64VarP(&targetVersion, "target-version", "V", 0, "TEAL version to check opcode and field availability against (default: the program version)")
+	lintCmd.Flags().BoolVar(&lintJSON, "json", false, "print the analysis as JSON")
+
 	dryrunCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "transaction or transaction-group to test")
 	dryrunCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "consensus protocol version id string")
 	dryrunCmd.Flags().BoolVar(&dumpForDryrun, "dryrun-dump", false, "Dump in dryrun format acceptable by dryrun REST api instead of running")
 	dryrunCmd.Flags().Var(&dumpForDryrunFormat, "dryrun-dump-format", "Dryrun dump format: "+dumpForDryrunFormat.AllowedString())
 	dryrunCmd.Flags().StringSliceVar(&dumpForDryrunAccts, "dryrun-accounts", nil, "additional accounts to include into dryrun request obj")
 	dryrunCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing dryrun state object")
+	dryrunCmd.Flags().StringVar(&traceFilename, "trace-file", "", "Filename for writing an execution trace of the programs as JSON lines")
 	dryrunCmd.MarkFlagRequired("txfile")
 
 	dryrunRemoteCmd.Flags().StringVarP(&txFilename, "dryrun-state", "D", "", "dryrun request object to run")
@@ -926,11 +937,15 @@ func mustReadFile(fname string) []byte {
 }
 
 func assembleFile(fname string) (program []byte) {
+	return assembleFileImpl(fname).Program
+}
+
+func assembleFileImpl(fname string) *logic.OpStream {
 	text, err := readFile(fname)
 	if err != nil {
 		reportErrorf("%s: %s", fname, err)
 	}
-	ops, err := logic.AssembleString(string(text))
+	ops, err := logic.AssembleStringWithIncludes(fname, string(text), includeFile)
 	if err != nil {
 		ops.ReportProblems(fname, os.Stderr)
 		reportErrorf("%s: %s", fname, err)
@@ -946,7 +961,42 @@ func assembleFile(fname string) (program []byte) {
 		}
 	}
 
-	return ops.Program
+	return ops
+}
+
+// includeFile resolves the #include directives of TEAL sources relative to
+// the directory of the including source.
+func includeFile(from string, name string) (string, []byte, error) {
+	fname := name
+	if !filepath.IsAbs(fname) {
+		fname = filepath.Join(filepath.Dir(from), fname)
+	}
+	text, err := readFile(fname)
+	return fname, text, err
+}
+
+// writeSourceMapFile writes the source map of the program assembled from
+// fname next to its output file outname.
+func writeSourceMapFile(ops *logic.OpStream, fname, outname string) {
+	mapname := outname + ".map"
+	sourceMap := ops.GetSourceMap(fname)
+	sourceMap.File = filepath.Base(outname)
+	// sources are resolved relative to the location of the map
+	for i, source := range sourceMap.Sources {
+		rel, err := filepath.Rel(filepath.Dir(mapname), source)
+		if err == nil {
+			source = rel
+		}
+		sourceMap.Sources[i] = filepath.ToSlash(source)
+	}
+	data, err := json.Marshal(&sourceMap)
+	if err != nil {
+		reportErrorf("%s: %s", mapname, err)
+	}
+	err = writeFile(mapname, data, 0666)
+	if err != nil {
+		reportErrorf("%s: %s", mapname, err)
+	}
 }
 
 func disassembleFile(fname, outname string) {
@@ -995,7 +1045,8 @@ var compileCmd = &cobra.Command{
 				disassembleFile(fname, outFilename)
 				continue
 			}
-			program := assembleFile(fname)
+			ops := assembleFileImpl(fname)
+			program := ops.Program
 			outblob := program
 			outname := outFilename
 			if outname == "" {
@@ -1005,6 +1056,12 @@ var compileCmd = &cobra.Command{
 					outname = fmt.Sprintf("%s.tok", fname)
 				}
 			}
+			if writeSourceMap {
+				if outname == stdoutFilenameValue {
+					reportErrorf("%s: the source map is only written next to an output file", fname)
+				}
+				writeSourceMapFile(ops, fname, outname)
+			}
 			if signProgram {
 				dataDir := ensureSingleDataDir()
 				accountList := makeAccountsList(dataDir)
@@ -1043,6 +1100,118 @@ var compileCmd = &cobra.Command{
 	},
 }
 
+var lintCmd = &cobra.Command{
+	Use:   "lint [input file 1] [input file 2]...",
+	Short: "Analyze a contract program",
+	Long: "Assembles TEAL contract programs and reports the worst-case opcode cost and maximum stack depth of the program and its subroutines, " +
+		"along with unreachable code, scratch slots that are stored but never loaded, and opcodes or fields unavailable in the target version. " +
+		"Exits with an error if anything but costs and stack depths is reported.",
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var reports []lintReport
+		findings := false
+		for _, fname := range args {
+			ops := assembleFileImpl(fname)
+			analysis, err := logic.AnalyzeProgram(ops.Program, targetVersion)
+			if err != nil {
+				reportErrorf("%s: %s", fname, err)
+			}
+			findings = findings || len(analysis.Unreachable) > 0 || len(analysis.UnusedScratch) > 0 ||
+				len(analysis.Unavailable) > 0 || len(analysis.Warnings) > 0
+			if lintJSON {
+				reports = append(reports, makeLintReport(fname, ops, analysis))
+			} else {
+				printLintReport(fname, ops, analysis)
+			}
+		}
+		if lintJSON {
+			out, err := json.MarshalIndent(reports, "", "  ")
+			if err != nil {
+				reportErrorf("cannot encode analysis: %s", err)
+			}
+			fmt.Println(string(out))
+		}
+		if findings {
+			os.Exit(1)
+		}
+	},
+}
+
+// lintReport is the JSON output of goal clerk lint for one program
+type lintReport struct {
+	File string `json:"file"`
+	*logic.ProgramAnalysis
+	// Locations maps the pcs the analysis refers to to their source lines
+	Locations map[int]string `json:"locations"`
+}
+
+// sourceLocation returns the file:line an instruction was assembled from
+func sourceLocation(fname string, ops *logic.OpStream, pc int) string {
+	source := fname
+	if index := ops.OffsetToSource[pc]; index > 0 {
+		source = ops.IncludedSources[index-1]
+	}
+	return fmt.Sprintf("%s:%d", source, ops.OffsetToLine[pc]+1)
+}
+
+func makeLintReport(fname string, ops *logic.OpStream, analysis *logic.ProgramAnalysis) lintReport {
+	report := lintReport{File: fname, ProgramAnalysis: analysis, Locations: make(map[int]string)}
+	locate := func(pc int) {
+		report.Locations[pc] = sourceLocation(fname, ops, pc)
+	}
+	for _, sub := range analysis.Subroutines {
+		locate(sub.Entry)
+	}
+	for _, r := range analysis.Unreachable {
+		locate(r.Start)
+	}
+	for _, slot := range analysis.UnusedScratch {
+		for _, pc := range slot.PCs {
+			locate(pc)
+		}
+	}
+	for _, op := range analysis.Unavailable {
+		locate(op.PC)
+	}
+	for _, w := range analysis.Warnings {
+		locate(w.PC)
+	}
+	return report
+}
+
+func printLintReport(fname string, ops *logic.OpStream, analysis *logic.ProgramAnalysis) {
+	fmt.Printf("%s: TEAL version %d, checked against version %d\n", fname, analysis.Version, analysis.TargetVersion)
+	for i, sub := range analysis.Subroutines {
+		name := "main program"
+		if i > 0 {
+			name = "subroutine at " + sourceLocation(fname, ops, sub.Entry)
+		}
+		cost := fmt.Sprintf("%d", sub.Cost)
+		if !sub.CostBounded {
+			cost = fmt.Sprintf("unbounded (%d without repeating loops or recursion)", sub.Cost)
+		}
+		depth := "unknown"
+		if sub.StackDepthKnown {
+			depth = fmt.Sprintf("%d", sub.MaxStackDepth)
+		}
+		fmt.Printf("  %s: worst-case cost %s, max stack depth %s\n", name, cost, depth)
+	}
+	for _, r := range analysis.Unreachable {
+		fmt.Printf("%s: unreachable code (%d bytes)\n", sourceLocation(fname, ops, r.Start), r.End-r.Start)
+	}
+	for _, slot := range analysis.UnusedScratch {
+		for _, pc := range slot.PCs {
+			fmt.Printf("%s: scratch slot %d is stored but never loaded, unless by a later app call of the group with gload\n", sourceLocation(fname, ops, pc), slot.Slot)
+		}
+	}
+	for _, op := range analysis.Unavailable {
+		fmt.Printf("%s: %s needs TEAL version %d\n", sourceLocation(fname, ops, op.PC), op.Op, op.Version)
+	}
+	for _, w := range analysis.Warnings {
+		fmt.Printf("%s: %s\n", sourceLocation(fname, ops, w.PC), w.Message)
+	}
+}
+
 var dryrunCmd = &cobra.Command{
 	Use:   "dryrun",
 	Short: "Test a program offline",
@@ -1089,6 +1258,15 @@ var dryrunCmd = &cobra.Command{
 		if timeStamp <= 0 {
 			timeStamp = time.Now().Unix()
 		}
+		var recorder *logic.TraceRecorder
+		if traceFilename != "" {
+			f, err := os.Create(traceFilename)
+			if err != nil {
+				reportErrorf(fileWriteError, traceFilename, err)
+			}
+			defer f.Close()
+			recorder = logic.MakeTraceRecorder(f)
+		}
 		for i, txn := range txgroup {
 			if txn.Lsig.Blank() {
 				continue
@@ -1109,6 +1287,9 @@ var dryrunCmd = &cobra.Command{
 				Trace:      &sb,
 				TxnGroup:   txgroup,
 			}
+			if recorder != nil {
+				ep.Debugger = recorder
+			}
 			pass, err := logic.Eval(txn.Lsig.Logic, ep)
 			// TODO: optionally include `inspect` output here?
 			fmt.Fprintf(os.Stdout, "tx[%d] trace:\n%s\n", i, sb.String())
@@ -1121,6 +1302,9 @@ var dryrunCmd = &cobra.Command{
 				fmt.Fprintf(os.Stdout, "ERROR: %s\n", err.Error())
 			}
 		}
+		if recorder != nil && recorder.Err() != nil {
+			reportErrorf(fileWriteError, traceFilename, recorder.Err())
+		}
 
 	},
 }
@@ -1175,6 +1359,9 @@ var dryrunRemoteCmd = &cobra.Command{
 				if txnResult.Cost != nil {
 					fmt.Fprintf(os.Stdout, "tx[%d] cost: %d\n", i, *txnResult.Cost)
 				}
+				if txnResult.BudgetRemaining != nil {
+					fmt.Fprintf(os.Stdout, "tx[%d] budget remaining: %d\n", i, *txnResult.BudgetRemaining)
+				}
 
 				fmt.Fprintf(os.Stdout, "tx[%d] messages:\n", i)
 				for _, msg := range msgs {
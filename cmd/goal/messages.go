@@ -82,6 +82,10 @@ const (
 	infoNodeWroteToken                = "Successfully wrote new API token: %s"
 	infoNodePendingTxnsDescription    = "Pending Transactions (Truncated max=%d, Total in pool=%d): "
 	infoNodeNoPendingTxnsDescription  = "None"
+	infoNodeTxPoolFees                = "Minimum fee per byte: %d (congestion multiplier %d, whole blocks pending %d)"
+	infoNodeTxPoolGroupsDescription   = "Pending Transaction Groups (Truncated max=%d, Total in pool=%d): "
+	infoNodeTxPoolEvicted             = "Evicted %d transactions from the transaction pool"
+	errorTxPoolEvictArgs              = "At least one of --txid or --sender is required"
 	infoDataDir                       = "[Data Directory: %s]"
 	errLoadingConfig                  = "Error loading Config file from '%s': %v"
 	errorNodeFailedToShutdown         = "Unable to shut down node: %v"
@@ -200,4 +204,5 @@ const (
 	errParsingRoundNumber  = "Error parsing round number: %s"
 	errBadBlockArgs        = "Cannot combine --b32=true or --strict=true with --raw"
 	errEncodingBlockAsJSON = "Error encoding block as json: %s"
+	errEncodingDeltaAsJSON = "Error encoding state delta as json: %s"
 )
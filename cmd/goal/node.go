@@ -17,6 +17,7 @@
 package main
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -24,6 +25,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -36,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/libgoal"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/nodecontrol"
+	"github.com/algorand/go-algorand/protocol/transcode"
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/tokens"
 )
@@ -55,6 +58,11 @@ var newNodeIndexer bool
 var newNodeRelay string
 var watchMillisecond uint64
 var abortCatchup bool
+var deltasFilename string
+var rawDeltas bool
+var maxTxPoolGroups uint64
+var evictTxids []string
+var evictSender string
 
 func init() {
 	nodeCmd.AddCommand(startCmd)
@@ -68,6 +76,9 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(deltasCmd)
+	nodeCmd.AddCommand(txPoolCmd)
+	txPoolCmd.AddCommand(txPoolEvictCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -101,6 +112,15 @@ func init() {
 
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
 
+	deltasCmd.Flags().StringVarP(&deltasFilename, "out", "o", stdoutFilenameValue, "The filename to dump the state delta to (if not set, use stdout)")
+	deltasCmd.Flags().BoolVarP(&rawDeltas, "raw", "r", false, "Format state delta as msgpack")
+	deltasCmd.Flags().BoolVar(&base32Encoding, "b32", false, "Encode binary blobs using base32 instead of base64")
+	deltasCmd.Flags().BoolVar(&strictJSON, "strict", false, "Strict JSON decode: turn all keys into strings")
+
+	txPoolCmd.Flags().Uint64VarP(&maxTxPoolGroups, "max", "m", 0, "Cap the number of transaction groups to fetch")
+	txPoolEvictCmd.Flags().StringSliceVarP(&evictTxids, "txid", "t", nil, "Evict the group holding this transaction (can be repeated)")
+	txPoolEvictCmd.Flags().StringVarP(&evictSender, "sender", "s", "", "Evict the groups holding a transaction sent by this account")
+
 }
 
 var nodeCmd = &cobra.Command{
@@ -507,6 +527,97 @@ var pendingTxnsCmd = &cobra.Command{
 	},
 }
 
+var deltasCmd = &cobra.Command{
+	Use:   "deltas [round number]",
+	Short: "Dump the ledger state delta of a round to a file or stdout",
+	Long:  "Dump the ledger state delta produced by the block of the given round to a file or stdout. Only the most recent rounds are kept by the node. Default behavior is to attempt to decode the raw bytes returned from algod to JSON.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		round, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			reportErrorf(errParsingRoundNumber, err)
+		}
+
+		dataDir := ensureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		response, err := client.RawLedgerStateDelta(round)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		// Unless the user asked for the raw delta,
+		// print the delta encoded as JSON
+		if !rawDeltas {
+			in := bytes.NewBuffer(response)
+			out := bytes.NewBuffer(nil)
+			err = transcode.Transcode(true, base32Encoding, strictJSON, in, out)
+			if err != nil {
+				reportErrorf(errEncodingDeltaAsJSON, err)
+			}
+			response = out.Bytes()
+		} else {
+			if base32Encoding || strictJSON {
+				reportErrorf(errBadBlockArgs)
+			}
+		}
+
+		// If deltasFilename flag was not set, the default value '-' will write to stdout
+		err = writeFile(deltasFilename, response, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, deltasFilename, err)
+		}
+	},
+}
+
+var txPoolCmd = &cobra.Command{
+	Use:   "txpool",
+	Short: "Show the fee levels and pending transaction groups of the node's transaction pool",
+	Long:  `Show the minimum fee per byte required by the node's transaction pool, the congestion multiplier it derives from, and the pending transaction groups sorted by decreasing fee per byte, cut off at MAX groups (-m), default 0. If MAX=0, fetches all the pending groups. Requires the admin API token.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			txPool, err := client.TransactionPool(maxTxPoolGroups)
+			if err != nil {
+				reportErrorf(errorNodeStatus, err)
+			}
+
+			reportInfof(infoNodeTxPoolFees, txPool.FeePerByte, txPool.FeeMultiplier, txPool.PendingWholeBlocks)
+			reportInfof(infoNodeTxPoolGroupsDescription, maxTxPoolGroups, txPool.TotalGroups)
+			if len(txPool.Groups) == 0 {
+				reportInfof(infoNodeNoPendingTxnsDescription)
+				return
+			}
+			for _, group := range txPool.Groups {
+				fmt.Printf("%d microAlgos per byte:\n", group.FeePerByte)
+				for _, txn := range group.Transactions {
+					fmt.Printf("  %s  sender %s  fee %d  valid [%d, %d]  size %d\n", txn.Txid, txn.Sender, txn.Fee, txn.FirstValid, txn.LastValid, txn.Size)
+				}
+			}
+		})
+	},
+}
+
+var txPoolEvictCmd = &cobra.Command{
+	Use:   "evict",
+	Short: "Evict transactions from the node's transaction pool",
+	Long:  `Remove from the node's transaction pool the groups holding any of the given transactions (-t), or any transaction sent by the given account (-s). A group is only valid as a whole, so the entire group of a matching transaction is evicted. Requires the admin API token.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		if len(evictTxids) == 0 && evictSender == "" {
+			reportErrorf(errorTxPoolEvictArgs)
+		}
+
+		dataDir := ensureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		evicted, err := client.EvictPendingTransactions(evictTxids, evictSender)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		reportInfof(infoNodeTxPoolEvicted, evicted)
+	},
+}
+
 var waitCmd = &cobra.Command{
 	Use:   "wait",
 	Short: "Waits for the node to make progress",
@@ -118,6 +118,11 @@ func appParamsFieldsMarkdown(out io.Writer) {
 	fieldTableMarkdown(out, logic.AppParamsFieldNames, logic.AppParamsFieldTypes, logic.AppParamsFieldDocs())
 }
 
+func acctParamsFieldsMarkdown(out io.Writer) {
+	fmt.Fprintf(out, "\n`acct_params_get` Fields:\n\n")
+	fieldTableMarkdown(out, logic.AcctParamsFieldNames, logic.AcctParamsFieldTypes, logic.AcctParamsFieldDocs())
+}
+
 func ecDsaCurvesMarkdown(out io.Writer) {
 	fmt.Fprintf(out, "\n`ECDSA` Curves:\n\n")
 	fieldTableMarkdown(out, logic.EcdsaCurveNames, nil, logic.EcdsaCurveDocs)
@@ -203,6 +208,8 @@ func opToMarkdown(out io.Writer, op *logic.OpSpec) (err error) {
 		assetParamsFieldsMarkdown(out)
 	} else if op.Name == "app_params_get" {
 		appParamsFieldsMarkdown(out)
+	} else if op.Name == "acct_params_get" {
+		acctParamsFieldsMarkdown(out)
 	} else if strings.HasPrefix(op.Name, "ecdsa") {
 		ecDsaCurvesMarkdown(out)
 	}
@@ -269,6 +276,9 @@ func argEnum(name string) []string {
 	if name == "app_params_get" {
 		return logic.AppParamsFieldNames
 	}
+	if name == "acct_params_get" {
+		return logic.AcctParamsFieldNames
+	}
 	return nil
 }
 
@@ -313,6 +323,9 @@ func argEnumTypes(name string) string {
 	if name == "app_params_get" {
 		return typeString(logic.AppParamsFieldTypes)
 	}
+	if name == "acct_params_get" {
+		return typeString(logic.AcctParamsFieldTypes)
+	}
 
 	return ""
 }
@@ -380,6 +393,10 @@ func main() {
 	fieldTableMarkdown(appparams, logic.AppParamsFieldNames, logic.AppParamsFieldTypes, logic.AppParamsFieldDocs())
 	appparams.Close()
 
+	acctparams, _ := os.Create("acct_params_fields.md")
+	fieldTableMarkdown(acctparams, logic.AcctParamsFieldNames, logic.AcctParamsFieldTypes, logic.AcctParamsFieldDocs())
+	acctparams.Close()
+
 	langspecjs, _ := os.Create("langspec.json")
 	enc := json.NewEncoder(langspecjs)
 	enc.Encode(buildLanguageSpec(opGroups))
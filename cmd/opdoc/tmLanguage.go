@@ -89,7 +89,7 @@ func buildSyntaxHighlight() *tmLanguage {
 	}
 	tm.Repository["pragmas"] = pattern{
 		Name:  "support.function.teal",
-		Match: "^#pragma\\b.*$",
+		Match: "^#(pragma|define|include)\\b.*$",
 	}
 	tm.Repository["labels"] = pattern{
 		Patterns: []pattern{
@@ -167,7 +167,7 @@ func buildSyntaxHighlight() *tmLanguage {
 				Name:  "keyword.other.teal",
 				Match: fmt.Sprintf("^(%s)\\b", strings.Join(loading, "|")),
 			})
-		case "State Access":
+		case "State Access", "Box Access":
 			keywords.Patterns = append(keywords.Patterns, pattern{
 				Name:  "keyword.other.unit.teal",
 				Match: fmt.Sprintf("^(%s)\\b", strings.Join(names, "|")),
@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dap
+
+import "encoding/json"
+
+// definitions of the subset of the Debug Adapter Protocol used by tealdbg
+// https://microsoft.github.io/debug-adapter-protocol/specification
+
+// ProtocolMessage is the base of requests, responses and events
+type ProtocolMessage struct {
+	Seq  int    `json:"seq"`
+	Type string `json:"type"` // "request", "response" or "event"
+}
+
+// Request is a client or debug adapter initiated request
+type Request struct {
+	ProtocolMessage
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// Response to a request
+type Response struct {
+	ProtocolMessage
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+// Event is a debug adapter initiated event
+type Event struct {
+	ProtocolMessage
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+// InitializeArguments type
+type InitializeArguments struct {
+	ClientID      string `json:"clientID,omitempty"`
+	AdapterID     string `json:"adapterID"`
+	LinesStartAt1 *bool  `json:"linesStartAt1,omitempty"` // defaults to true when missing
+}
+
+// Capabilities of the debug adapter, returned from initialize
+type Capabilities struct {
+	SupportsConfigurationDoneRequest bool `json:"supportsConfigurationDoneRequest,omitempty"`
+}
+
+// LaunchArguments are the arguments of launch and attach. The programs to
+// debug are given to tealdbg on its command line, so only execution
+// control is configured here.
+type LaunchArguments struct {
+	StopOnEntry bool `json:"stopOnEntry,omitempty"`
+}
+
+// Source describes a program. Disassembled programs have no path and are
+// retrieved with the source request.
+type Source struct {
+	Name            string `json:"name,omitempty"`
+	Path            string `json:"path,omitempty"`
+	SourceReference int    `json:"sourceReference,omitempty"`
+}
+
+// SourceBreakpoint is a breakpoint requested by the client
+type SourceBreakpoint struct {
+	Line int `json:"line"`
+}
+
+// SetBreakpointsArguments replace all breakpoints of a source
+type SetBreakpointsArguments struct {
+	Source      Source             `json:"source"`
+	Breakpoints []SourceBreakpoint `json:"breakpoints"`
+}
+
+// Breakpoint is the state of a requested breakpoint
+type Breakpoint struct {
+	Verified bool   `json:"verified"`
+	Line     int    `json:"line,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// SetBreakpointsResponseBody type
+type SetBreakpointsResponseBody struct {
+	Breakpoints []Breakpoint `json:"breakpoints"`
+}
+
+// Thread is a single program evaluation
+type Thread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ThreadsResponseBody type
+type ThreadsResponseBody struct {
+	Threads []Thread `json:"threads"`
+}
+
+// ThreadArguments are the arguments of the execution control requests:
+// continue, next, stepIn and stepOut
+type ThreadArguments struct {
+	ThreadID int `json:"threadId"`
+}
+
+// ContinueResponseBody type
+type ContinueResponseBody struct {
+	AllThreadsContinued bool `json:"allThreadsContinued"`
+}
+
+// StackTraceArguments type
+type StackTraceArguments struct {
+	ThreadID int `json:"threadId"`
+}
+
+// StackFrame is the main program or an active subroutine
+type StackFrame struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Source *Source `json:"source,omitempty"`
+	Line   int     `json:"line"`
+	Column int     `json:"column"`
+}
+
+// StackTraceResponseBody type
+type StackTraceResponseBody struct {
+	StackFrames []StackFrame `json:"stackFrames"`
+	TotalFrames int          `json:"totalFrames"`
+}
+
+// ScopesArguments type
+type ScopesArguments struct {
+	FrameID int `json:"frameId"`
+}
+
+// Scope is a named container of variables
+type Scope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+// ScopesResponseBody type
+type ScopesResponseBody struct {
+	Scopes []Scope `json:"scopes"`
+}
+
+// VariablesArguments type
+type VariablesArguments struct {
+	VariablesReference int `json:"variablesReference"`
+}
+
+// Variable is a value shown by the client. Variables with a non-zero
+// VariablesReference can be expanded.
+type Variable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+// VariablesResponseBody type
+type VariablesResponseBody struct {
+	Variables []Variable `json:"variables"`
+}
+
+// SourceArguments type
+type SourceArguments struct {
+	Source          *Source `json:"source,omitempty"`
+	SourceReference int     `json:"sourceReference"`
+}
+
+// SourceResponseBody type
+type SourceResponseBody struct {
+	Content  string `json:"content"`
+	MimeType string `json:"mimeType,omitempty"`
+}
+
+// StoppedEventBody type
+type StoppedEventBody struct {
+	Reason            string `json:"reason"` // "step", "breakpoint", "exception" or "entry"
+	Description       string `json:"description,omitempty"`
+	ThreadID          int    `json:"threadId"`
+	Text              string `json:"text,omitempty"`
+	AllThreadsStopped bool   `json:"allThreadsStopped"`
+}
+
+// ThreadEventBody type
+type ThreadEventBody struct {
+	Reason   string `json:"reason"` // "started" or "exited"
+	ThreadID int    `json:"threadId"`
+}
+
+// OutputEventBody type
+type OutputEventBody struct {
+	Category string `json:"category,omitempty"` // "console", "stdout" or "stderr"
+	Output   string `json:"output"`
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// maxContentLength bounds the size of a single message
+const maxContentLength = 1 << 20
+
+const contentLengthHeader = "Content-Length"
+
+// ReadMessage reads the JSON content of a base protocol message: a header
+// part with the Content-Length, followed by an empty line and the content.
+func ReadMessage(r *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid header %q", line)
+		}
+		// other headers are allowed, and ignored
+		if strings.TrimSpace(parts[0]) != contentLengthHeader {
+			continue
+		}
+		length, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", contentLengthHeader, err)
+		}
+	}
+	if length < 0 {
+		return nil, fmt.Errorf("missing %s header", contentLengthHeader)
+	}
+	if length > maxContentLength {
+		return nil, fmt.Errorf("%s %d exceeds %d", contentLengthHeader, length, maxContentLength)
+	}
+	content := make([]byte, length)
+	_, err := io.ReadFull(r, content)
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// WriteMessage encodes msg to JSON and writes it with its header part
+func WriteMessage(w io.Writer, msg interface{}) error {
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s: %d\r\n\r\n%s", contentLengthHeader, len(content), content)
+	return err
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dap
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestMessageRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var buf bytes.Buffer
+	event := Event{
+		ProtocolMessage: ProtocolMessage{Seq: 7, Type: "event"},
+		Event:           "stopped",
+		Body:            StoppedEventBody{Reason: "step", ThreadID: 2},
+	}
+	require.NoError(t, WriteMessage(&buf, &event))
+	require.NoError(t, WriteMessage(&buf, &Response{
+		ProtocolMessage: ProtocolMessage{Seq: 8, Type: "response"},
+		RequestSeq:      1,
+		Success:         true,
+		Command:         "threads",
+	}))
+	require.True(t, strings.HasPrefix(buf.String(), "Content-Length: "))
+
+	r := bufio.NewReader(&buf)
+	content, err := ReadMessage(r)
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(content, &decoded))
+	require.Equal(t, "stopped", decoded["event"])
+	require.Equal(t, float64(7), decoded["seq"])
+	require.Equal(t, float64(2), decoded["body"].(map[string]interface{})["threadId"])
+
+	content, err = ReadMessage(r)
+	require.NoError(t, err)
+	var resp Response
+	require.NoError(t, json.Unmarshal(content, &resp))
+	require.Equal(t, "threads", resp.Command)
+	require.Equal(t, 1, resp.RequestSeq)
+
+	_, err = ReadMessage(r)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReadMessageHeaders(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// unknown headers are ignored
+	r := bufio.NewReader(strings.NewReader("Content-Type: json\r\nContent-Length: 2\r\n\r\n{}"))
+	content, err := ReadMessage(r)
+	require.NoError(t, err)
+	require.Equal(t, "{}", string(content))
+
+	r = bufio.NewReader(strings.NewReader("Content-Type: json\r\n\r\n{}"))
+	_, err = ReadMessage(r)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing Content-Length")
+
+	r = bufio.NewReader(strings.NewReader("Content-Length: x\r\n\r\n{}"))
+	_, err = ReadMessage(r)
+	require.Error(t, err)
+
+	r = bufio.NewReader(strings.NewReader("Content-Length: 99999999\r\n\r\n{}"))
+	_, err = ReadMessage(r)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exceeds")
+
+	r = bufio.NewReader(strings.NewReader("Content-Length: 10\r\n\r\n{}"))
+	_, err = ReadMessage(r)
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
@@ -0,0 +1,350 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+type stepMode int
+
+const (
+	// stepNone runs until the next breakpoint
+	stepNone stepMode = iota
+	stepIn
+	stepOver
+	stepOut
+)
+
+var stepModes = map[string]stepMode{
+	"continue": stepNone,
+	"stepIn":   stepIn,
+	"next":     stepOver,
+	"stepOut":  stepOut,
+}
+
+// frame and variables references are prefixed by a thread id
+const frameIDBase = 1000
+const variablesRefThread = 100000
+const variablesRefKind = 1000
+
+const (
+	scopeStack = iota + 1
+	scopeScratch
+	scopeGlobals
+	scopeGroup
+	scopeTxn
+)
+
+func encodeVariablesRef(threadID int, kind int, index int) int {
+	return threadID*variablesRefThread + kind*variablesRefKind + index
+}
+
+func decodeVariablesRef(ref int) (threadID int, kind int, index int) {
+	return ref / variablesRefThread, (ref % variablesRefThread) / variablesRefKind, ref % variablesRefKind
+}
+
+type dapSession struct {
+	frontend      *DapFrontend
+	sid           string
+	threadID      int
+	source        dap.Source
+	debugger      Control
+	notifications chan Notification
+	done          chan struct{}
+	exited        chan struct{}
+
+	mu          deadlock.Mutex
+	state       logic.DebugState
+	breakpoints map[int]bool
+	stopped     bool
+	completed   bool
+	mode        stepMode
+	depth       int
+}
+
+func makeDapSession(a *DapFrontend, sid string, threadID int, debugger Control, ch chan Notification) *dapSession {
+	return &dapSession{
+		frontend:      a,
+		sid:           sid,
+		threadID:      threadID,
+		debugger:      debugger,
+		notifications: ch,
+		done:          make(chan struct{}),
+		exited:        make(chan struct{}, 1),
+		breakpoints:   make(map[int]bool),
+	}
+}
+
+func (s *dapSession) name() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fmt.Sprintf("txn %d: %s", s.state.GroupIndex, s.source.Name)
+}
+
+// run processes debugger notifications until the program completes
+func (s *dapSession) run() {
+	a := s.frontend
+	defer close(s.done)
+	for notification := range s.notifications {
+		state := notification.DebugState
+		switch notification.Event {
+		case "registered":
+			s.mu.Lock()
+			s.state = state
+			s.mu.Unlock()
+			a.sessionStarted(s, state.Disassembly)
+
+			// the client is not ready for events until configured
+			a.waitConfigured()
+			if a.detached.IsSet() {
+				s.debugger.SetBreakpointsActive(false)
+				s.debugger.Resume()
+				continue
+			}
+			a.sendEvent("thread", dap.ThreadEventBody{Reason: "started", ThreadID: s.threadID})
+			if a.entryStop.IsSet() {
+				s.stop("entry", "")
+			} else {
+				s.debugger.Resume()
+			}
+		case "updated":
+			if a.detached.IsSet() {
+				s.debugger.Resume()
+				continue
+			}
+			if reason, stop := s.update(state); stop {
+				s.stop(reason, "")
+			} else {
+				s.debugger.Step()
+			}
+		case "completed":
+			s.mu.Lock()
+			s.state = state
+			s.completed = true
+			s.mu.Unlock()
+
+			if len(state.Error) > 0 {
+				a.sendEvent("output", dap.OutputEventBody{
+					Category: "stderr",
+					Output:   fmt.Sprintf("%s failed: %s\n", s.name(), state.Error),
+				})
+				if !a.detached.IsSet() {
+					// let the user inspect the failed program
+					s.stop("exception", state.Error)
+					<-s.exited
+				}
+			} else {
+				a.sendEvent("output", dap.OutputEventBody{
+					Category: "console",
+					Output:   fmt.Sprintf("%s completed\n", s.name()),
+				})
+			}
+			a.sendEvent("thread", dap.ThreadEventBody{Reason: "exited", ThreadID: s.threadID})
+			return
+		}
+	}
+}
+
+// update stores a new state and checks if the execution must be stopped
+func (s *dapSession) update(state logic.DebugState) (reason string, stop bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+
+	if s.breakpoints[state.Line] {
+		return "breakpoint", true
+	}
+	depth := len(state.CallStack)
+	switch s.mode {
+	case stepNone:
+		// debugger notifies only on breakpoints while running
+		return "breakpoint", true
+	case stepIn:
+		return "step", true
+	case stepOver:
+		return "step", depth <= s.depth
+	case stepOut:
+		return "step", depth < s.depth
+	}
+	return "", false
+}
+
+func (s *dapSession) stop(reason string, text string) {
+	s.mu.Lock()
+	s.stopped = true
+	s.mu.Unlock()
+
+	s.frontend.sendEvent("stopped", dap.StoppedEventBody{
+		Reason:   reason,
+		ThreadID: s.threadID,
+		Text:     text,
+	})
+}
+
+// continueExecution prepares a stopped session to run in the given mode.
+// The execution continues with proceed.
+func (s *dapSession) continueExecution(mode stepMode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.stopped {
+		return fmt.Errorf("thread %d is not stopped", s.threadID)
+	}
+	s.stopped = false
+	s.depth = len(s.state.CallStack)
+	if mode == stepOut && s.depth == 0 {
+		mode = stepNone
+	}
+	s.mode = mode
+	return nil
+}
+
+func (s *dapSession) proceed() {
+	s.mu.Lock()
+	completed, mode := s.completed, s.mode
+	s.mu.Unlock()
+
+	switch {
+	case completed:
+		select {
+		case s.exited <- struct{}{}:
+		default:
+		}
+	case mode == stepNone:
+		s.debugger.Resume()
+	default:
+		s.debugger.Step()
+	}
+}
+
+// setBreakpoints replaces the session breakpoints and reports which lines are valid
+func (s *dapSession) setBreakpoints(lines []int) []bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for line := range s.breakpoints {
+		s.debugger.RemoveBreakpoint(line)
+	}
+	s.breakpoints = make(map[int]bool, len(lines))
+	verified := make([]bool, len(lines))
+	for i, line := range lines {
+		if line < 0 {
+			continue
+		}
+		if err := s.debugger.SetBreakpoint(line); err == nil {
+			s.breakpoints[line] = true
+			verified[i] = true
+		}
+	}
+	return verified
+}
+
+// stackFrames returns the current line and active subroutine calls, innermost first
+func (s *dapSession) stackFrames() []dap.StackFrame {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	calls := s.state.CallStack
+	frameName := func(depth int) string {
+		if depth == 0 {
+			return "main"
+		}
+		return calls[depth-1].LabelName
+	}
+
+	source := s.source
+	frames := make([]dap.StackFrame, 0, len(calls)+1)
+	line := s.state.Line
+	for depth := len(calls); depth >= 0; depth-- {
+		frames = append(frames, dap.StackFrame{
+			ID:     s.threadID*frameIDBase + depth,
+			Name:   frameName(depth),
+			Source: &source,
+			Line:   s.frontend.toClientLine(line),
+			Column: 1,
+		})
+		if depth > 0 {
+			line = calls[depth-1].FrameLine
+		}
+	}
+	return frames
+}
+
+// scopes are the same for all frames since TEAL has a single stack and scratch space
+func (s *dapSession) scopes() []dap.Scope {
+	return []dap.Scope{
+		{Name: "Stack", VariablesReference: encodeVariablesRef(s.threadID, scopeStack, 0)},
+		{Name: "Scratch", VariablesReference: encodeVariablesRef(s.threadID, scopeScratch, 0)},
+		{Name: "Globals", VariablesReference: encodeVariablesRef(s.threadID, scopeGlobals, 0), Expensive: true},
+		{Name: "Transaction Group", VariablesReference: encodeVariablesRef(s.threadID, scopeGroup, 0), Expensive: true},
+	}
+}
+
+func (s *dapSession) variables(kind int, index int) ([]dap.Variable, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var fields []fieldDesc
+	switch kind {
+	case scopeStack:
+		fields = prepareArray(s.state.Stack)
+	case scopeScratch:
+		// only show scratch slots that were written
+		for i, tv := range s.state.Scratch {
+			if tv.Type == basics.TealUintType && tv.Uint == 0 {
+				continue
+			}
+			fields = append(fields, tealValueToFieldDesc(strconv.Itoa(i), tv))
+		}
+	case scopeGlobals:
+		fields = prepareGlobals(s.state.Globals)
+	case scopeGroup:
+		vars := make([]dap.Variable, len(s.state.TxnGroup))
+		for i, stxn := range s.state.TxnGroup {
+			value := string(stxn.Txn.Type)
+			if i == s.state.GroupIndex {
+				value += " (current)"
+			}
+			vars[i] = dap.Variable{
+				Name:               strconv.Itoa(i),
+				Value:              value,
+				VariablesReference: encodeVariablesRef(s.threadID, scopeTxn, i),
+			}
+		}
+		return vars, nil
+	case scopeTxn:
+		if index >= len(s.state.TxnGroup) {
+			return nil, fmt.Errorf("no transaction %d in group of %d", index, len(s.state.TxnGroup))
+		}
+		fields = prepareTxn(&s.state.TxnGroup[index].Txn, index)
+	default:
+		return nil, fmt.Errorf("unknown variables kind %d", kind)
+	}
+
+	vars := make([]dap.Variable, len(fields))
+	for i, field := range fields {
+		vars[i] = dap.Variable{Name: field.Name, Value: field.Value, Type: field.Type}
+	}
+	return vars, nil
+}
@@ -0,0 +1,502 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+)
+
+// DapFrontend is Debug Adapter Protocol frontend.
+// It serves a single client connected over TCP or stdin/stdout,
+// and presents every program evaluation as a separate thread.
+type DapFrontend struct {
+	mu          deadlock.Mutex
+	sessions    map[string]*dapSession
+	threads     map[int]*dapSession
+	lastThread  int
+	sourceRefs  map[string]int
+	sources     map[int]string
+	breakpoints map[string][]int
+	url         string
+	verbose     bool
+
+	// client connection
+	wmu       deadlock.Mutex
+	w         io.Writer
+	seq       int
+	lineBase  atomicInt
+	entryStop atomicBool
+	detached  atomicBool
+
+	configured     chan struct{}
+	configuredOnce sync.Once
+	connected      chan struct{}
+	disconnected   chan struct{}
+	detachOnce     sync.Once
+}
+
+// DapFrontendParams for Setup
+type DapFrontendParams struct {
+	address string // TCP address to listen on, stdin/stdout are used if empty
+	verbose bool
+}
+
+type stdio struct {
+	io.Reader
+	io.Writer
+}
+
+// MakeDapFrontend creates new DapFrontend and starts waiting for a client
+func MakeDapFrontend(params *DapFrontendParams) (a *DapFrontend) {
+	a = makeDapFrontend(params.verbose)
+
+	if len(params.address) == 0 {
+		a.url = "stdio"
+		go a.serve(stdio{os.Stdin, os.Stdout})
+		return a
+	}
+
+	ln, err := net.Listen("tcp", params.address)
+	if err != nil {
+		log.Panicf("failed to listen: %v", err)
+	}
+	a.url = "tcp://" + ln.Addr().String()
+	log.Printf("DAP server listening on %s\n", ln.Addr())
+	go func() {
+		conn, err := ln.Accept()
+		ln.Close()
+		if err != nil {
+			log.Printf("DAP accept error: %v\n", err)
+			a.detach()
+			return
+		}
+		defer conn.Close()
+		a.serve(conn)
+	}()
+	return a
+}
+
+func makeDapFrontend(verbose bool) (a *DapFrontend) {
+	a = new(DapFrontend)
+	a.sessions = make(map[string]*dapSession)
+	a.threads = make(map[int]*dapSession)
+	a.sourceRefs = make(map[string]int)
+	a.sources = make(map[int]string)
+	a.breakpoints = make(map[string][]int)
+	a.verbose = verbose
+	a.lineBase.Store(1)
+	a.configured = make(chan struct{})
+	a.connected = make(chan struct{})
+	a.disconnected = make(chan struct{})
+	return a
+}
+
+// SessionStarted registers new session
+func (a *DapFrontend) SessionStarted(sid string, debugger Control, ch chan Notification) {
+	name, _ := debugger.GetSource()
+	if len(name) == 0 {
+		name = sid[:8]
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ref, ok := a.sourceRefs[name]
+	if !ok {
+		ref = len(a.sourceRefs) + 1
+		a.sourceRefs[name] = ref
+	}
+	a.lastThread++
+	s := makeDapSession(a, sid, a.lastThread, debugger, ch)
+	s.source = dap.Source{Name: name, SourceReference: ref}
+	a.sessions[sid] = s
+	a.threads[s.threadID] = s
+
+	go s.run()
+}
+
+// SessionEnded removes the session
+func (a *DapFrontend) SessionEnded(sid string) {
+	go func() {
+		a.mu.Lock()
+		s, ok := a.sessions[sid]
+		a.mu.Unlock()
+		if !ok {
+			return
+		}
+
+		<-s.done
+
+		a.mu.Lock()
+		delete(a.sessions, sid)
+		delete(a.threads, s.threadID)
+		a.mu.Unlock()
+		log.Printf("DAP session %s closed\n", sid)
+	}()
+}
+
+// URL returns the address the frontend accepts a client on
+func (a *DapFrontend) URL() string {
+	return a.url
+}
+
+// WaitForCompletion returns when no active sessions left and the client disconnected
+func (a *DapFrontend) WaitForCompletion() {
+	for {
+		a.mu.Lock()
+		active := len(a.sessions)
+		a.mu.Unlock()
+		if active == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	select {
+	case <-a.connected:
+	default:
+		return
+	}
+	a.sendEvent("terminated", nil)
+	<-a.disconnected
+}
+
+// serve processes client requests until disconnect
+func (a *DapFrontend) serve(rw io.ReadWriter) {
+	a.wmu.Lock()
+	a.w = rw
+	a.wmu.Unlock()
+	close(a.connected)
+	defer a.detach()
+
+	r := bufio.NewReader(rw)
+	for {
+		content, err := dap.ReadMessage(r)
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("DAP read error: %v\n", err)
+			}
+			return
+		}
+		if a.verbose {
+			log.Printf("DAP request: %s\n", string(content))
+		}
+
+		var req dap.Request
+		if err = json.Unmarshal(content, &req); err != nil {
+			log.Printf("DAP decode error: %v\n", err)
+			continue
+		}
+		if req.Type != "request" {
+			continue
+		}
+
+		body, action, err := a.handleRequest(&req)
+		a.respond(&req, body, err)
+		if action != nil {
+			action()
+		}
+		if req.Command == "disconnect" {
+			return
+		}
+	}
+}
+
+// detach releases all sessions and lets them run to completion
+func (a *DapFrontend) detach() {
+	a.detachOnce.Do(func() {
+		a.detached.SetTo(true)
+		a.configuredOnce.Do(func() { close(a.configured) })
+
+		a.wmu.Lock()
+		a.w = nil
+		a.wmu.Unlock()
+
+		a.mu.Lock()
+		sessions := make([]*dapSession, 0, len(a.sessions))
+		for _, s := range a.sessions {
+			sessions = append(sessions, s)
+		}
+		a.mu.Unlock()
+		for _, s := range sessions {
+			s.debugger.SetBreakpointsActive(false)
+			if s.continueExecution(stepNone) == nil {
+				s.proceed()
+			}
+		}
+		close(a.disconnected)
+	})
+}
+
+func (a *DapFrontend) waitConfigured() {
+	<-a.configured
+}
+
+func (a *DapFrontend) write(msg interface{}) {
+	a.wmu.Lock()
+	defer a.wmu.Unlock()
+	if a.w == nil {
+		return
+	}
+
+	a.seq++
+	switch m := msg.(type) {
+	case *dap.Response:
+		m.Seq = a.seq
+	case *dap.Event:
+		m.Seq = a.seq
+	}
+	if err := dap.WriteMessage(a.w, msg); err != nil && a.verbose {
+		log.Printf("DAP write error: %v\n", err)
+	}
+}
+
+func (a *DapFrontend) respond(req *dap.Request, body interface{}, err error) {
+	resp := dap.Response{
+		ProtocolMessage: dap.ProtocolMessage{Type: "response"},
+		RequestSeq:      req.Seq,
+		Success:         err == nil,
+		Command:         req.Command,
+		Body:            body,
+	}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	a.write(&resp)
+}
+
+func (a *DapFrontend) sendEvent(event string, body interface{}) {
+	a.write(&dap.Event{
+		ProtocolMessage: dap.ProtocolMessage{Type: "event"},
+		Event:           event,
+		Body:            body,
+	})
+}
+
+func (a *DapFrontend) getThread(threadID int) (*dapSession, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	s, ok := a.threads[threadID]
+	if !ok {
+		return nil, fmt.Errorf("thread %d not found", threadID)
+	}
+	return s, nil
+}
+
+// toClientLine and fromClientLine convert between 0-based disassembly lines and client lines
+func (a *DapFrontend) toClientLine(line int) int {
+	return line + a.lineBase.Load()
+}
+
+func (a *DapFrontend) fromClientLine(line int) int {
+	return line - a.lineBase.Load()
+}
+
+// handleRequest returns a response body and an optional action
+// to perform after the response is sent
+func (a *DapFrontend) handleRequest(req *dap.Request) (body interface{}, action func(), err error) {
+	parse := func(args interface{}) error {
+		if len(req.Arguments) == 0 {
+			return nil
+		}
+		return json.Unmarshal(req.Arguments, args)
+	}
+
+	switch req.Command {
+	case "initialize":
+		var args dap.InitializeArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		if args.LinesStartAt1 != nil && !*args.LinesStartAt1 {
+			a.lineBase.Store(0)
+		}
+		body = dap.Capabilities{SupportsConfigurationDoneRequest: true}
+		action = func() { a.sendEvent("initialized", nil) }
+	case "launch", "attach":
+		var args dap.LaunchArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		a.entryStop.SetTo(args.StopOnEntry)
+	case "setBreakpoints":
+		var args dap.SetBreakpointsArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		body = a.setBreakpoints(&args)
+	case "setExceptionBreakpoints":
+		body = dap.SetBreakpointsResponseBody{Breakpoints: []dap.Breakpoint{}}
+	case "configurationDone":
+		a.configuredOnce.Do(func() { close(a.configured) })
+	case "threads":
+		body = a.listThreads()
+	case "continue", "next", "stepIn", "stepOut":
+		var args dap.ThreadArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		var s *dapSession
+		if s, err = a.getThread(args.ThreadID); err != nil {
+			return
+		}
+		if err = s.continueExecution(stepModes[req.Command]); err != nil {
+			return
+		}
+		if req.Command == "continue" {
+			body = dap.ContinueResponseBody{AllThreadsContinued: false}
+		}
+		action = s.proceed
+	case "pause":
+		err = fmt.Errorf("pause is not supported, set a breakpoint instead")
+	case "stackTrace":
+		var args dap.StackTraceArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		var s *dapSession
+		if s, err = a.getThread(args.ThreadID); err != nil {
+			return
+		}
+		frames := s.stackFrames()
+		body = dap.StackTraceResponseBody{StackFrames: frames, TotalFrames: len(frames)}
+	case "scopes":
+		var args dap.ScopesArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		var s *dapSession
+		if s, err = a.getThread(args.FrameID / frameIDBase); err != nil {
+			return
+		}
+		body = dap.ScopesResponseBody{Scopes: s.scopes()}
+	case "variables":
+		var args dap.VariablesArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		threadID, kind, index := decodeVariablesRef(args.VariablesReference)
+		var s *dapSession
+		if s, err = a.getThread(threadID); err != nil {
+			return
+		}
+		var vars []dap.Variable
+		if vars, err = s.variables(kind, index); err != nil {
+			return
+		}
+		body = dap.VariablesResponseBody{Variables: vars}
+	case "source":
+		var args dap.SourceArguments
+		if err = parse(&args); err != nil {
+			return
+		}
+		ref := args.SourceReference
+		if args.Source != nil && args.Source.SourceReference != 0 {
+			ref = args.Source.SourceReference
+		}
+		a.mu.Lock()
+		content, ok := a.sources[ref]
+		a.mu.Unlock()
+		if !ok {
+			err = fmt.Errorf("source %d not found", ref)
+			return
+		}
+		body = dap.SourceResponseBody{Content: content, MimeType: "text/x-teal"}
+	case "disconnect":
+	default:
+		err = fmt.Errorf("unsupported command %s", req.Command)
+	}
+	return
+}
+
+func (a *DapFrontend) listThreads() dap.ThreadsResponseBody {
+	a.mu.Lock()
+	sessions := make([]*dapSession, 0, len(a.threads))
+	for _, s := range a.threads {
+		sessions = append(sessions, s)
+	}
+	a.mu.Unlock()
+
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].threadID < sessions[j].threadID })
+	threads := make([]dap.Thread, 0, len(sessions))
+	for _, s := range sessions {
+		threads = append(threads, dap.Thread{ID: s.threadID, Name: s.name()})
+	}
+	return dap.ThreadsResponseBody{Threads: threads}
+}
+
+// setBreakpoints replaces breakpoints of a source. Breakpoints are kept by source name
+// and applied to all current and future sessions of the same program.
+func (a *DapFrontend) setBreakpoints(args *dap.SetBreakpointsArguments) dap.SetBreakpointsResponseBody {
+	a.mu.Lock()
+	name := args.Source.Name
+	for n, ref := range a.sourceRefs {
+		if args.Source.SourceReference != 0 && ref == args.Source.SourceReference {
+			name = n
+		}
+	}
+	lines := make([]int, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		lines[i] = a.fromClientLine(bp.Line)
+	}
+	a.breakpoints[name] = lines
+	var sessions []*dapSession
+	for _, s := range a.sessions {
+		if s.source.Name == name {
+			sessions = append(sessions, s)
+		}
+	}
+	a.mu.Unlock()
+
+	result := make([]dap.Breakpoint, len(lines))
+	for i, bp := range args.Breakpoints {
+		result[i] = dap.Breakpoint{Line: bp.Line, Message: "program is not loaded"}
+	}
+	for _, s := range sessions {
+		for i, ok := range s.setBreakpoints(lines) {
+			result[i].Verified = ok
+			result[i].Message = ""
+			if !ok {
+				result[i].Message = fmt.Sprintf("no line %d in the disassembly", args.Breakpoints[i].Line)
+			}
+		}
+	}
+	return dap.SetBreakpointsResponseBody{Breakpoints: result}
+}
+
+// sessionStarted publishes the session source and applies requested breakpoints
+func (a *DapFrontend) sessionStarted(s *dapSession, disassembly string) {
+	a.mu.Lock()
+	a.sources[s.source.SourceReference] = disassembly
+	lines := a.breakpoints[s.source.Name]
+	a.mu.Unlock()
+
+	s.setBreakpoints(lines)
+}
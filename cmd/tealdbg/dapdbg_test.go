@@ -0,0 +1,376 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type dapTestMessage struct {
+	Type       string          `json:"type"`
+	Command    string          `json:"command"`
+	Event      string          `json:"event"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Message    string          `json:"message"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type dapTestClient struct {
+	t        *testing.T
+	conn     net.Conn
+	seq      int
+	messages chan dapTestMessage
+	events   []dapTestMessage
+}
+
+func makeDapTestClient(t *testing.T, conn net.Conn) *dapTestClient {
+	c := &dapTestClient{t: t, conn: conn, messages: make(chan dapTestMessage, 100)}
+	go func() {
+		r := bufio.NewReader(conn)
+		for {
+			content, err := dap.ReadMessage(r)
+			if err != nil {
+				close(c.messages)
+				return
+			}
+			var msg dapTestMessage
+			require.NoError(t, json.Unmarshal(content, &msg))
+			c.messages <- msg
+		}
+	}()
+	return c
+}
+
+func (c *dapTestClient) next() dapTestMessage {
+	select {
+	case msg, ok := <-c.messages:
+		require.True(c.t, ok, "connection closed")
+		return msg
+	case <-time.After(10 * time.Second):
+		require.FailNow(c.t, "timeout waiting for a message")
+	}
+	return dapTestMessage{}
+}
+
+func (c *dapTestClient) request(command string, args interface{}, body interface{}) dapTestMessage {
+	c.seq++
+	req := map[string]interface{}{"seq": c.seq, "type": "request", "command": command}
+	if args != nil {
+		req["arguments"] = args
+	}
+	require.NoError(c.t, dap.WriteMessage(c.conn, req))
+	for {
+		msg := c.next()
+		if msg.Type == "event" {
+			c.events = append(c.events, msg)
+			continue
+		}
+		require.Equal(c.t, "response", msg.Type)
+		require.Equal(c.t, c.seq, msg.RequestSeq)
+		require.Equal(c.t, command, msg.Command)
+		if body != nil && msg.Success {
+			require.NoError(c.t, json.Unmarshal(msg.Body, body))
+		}
+		return msg
+	}
+}
+
+func (c *dapTestClient) expectEvent(event string, body interface{}) {
+	var msg dapTestMessage
+	if len(c.events) > 0 {
+		msg, c.events = c.events[0], c.events[1:]
+	} else {
+		msg = c.next()
+	}
+	require.Equal(c.t, "event", msg.Type)
+	require.Equal(c.t, event, msg.Event)
+	if body != nil {
+		require.NoError(c.t, json.Unmarshal(msg.Body, body))
+	}
+}
+
+func (c *dapTestClient) expectStopped(reason string) {
+	var stopped dap.StoppedEventBody
+	c.expectEvent("stopped", &stopped)
+	require.Equal(c.t, reason, stopped.Reason)
+	require.Equal(c.t, 1, stopped.ThreadID)
+}
+
+func (c *dapTestClient) stackTrace() []dap.StackFrame {
+	var trace dap.StackTraceResponseBody
+	resp := c.request("stackTrace", dap.StackTraceArguments{ThreadID: 1}, &trace)
+	require.True(c.t, resp.Success, resp.Message)
+	return trace.StackFrames
+}
+
+func TestDapFrontend(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source := `#pragma version 4
+int 1
+callsub double
+int 2
+==
+return
+double:
+dup
++
+retsub
+`
+	ops, err := logic.AssembleStringWithVersion(source, 4)
+	require.NoError(t, err)
+
+	a := makeDapFrontend(false)
+	server, client := net.Pipe()
+	defer client.Close()
+	go a.serve(server)
+	c := makeDapTestClient(t, client)
+
+	debugger := MakeDebugger()
+	debugger.AddAdapter(a)
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	txgroup := []transactions.SignedTxn{
+		{Txn: transactions.Transaction{Type: protocol.ApplicationCallTx}},
+		{Txn: transactions.Transaction{Type: protocol.PaymentTx}},
+	}
+	ep := logic.EvalParams{
+		Proto:      &proto,
+		Debugger:   debugger,
+		Txn:        &txgroup[0],
+		TxnGroup:   txgroup,
+		GroupIndex: 0,
+	}
+	type evalResult struct {
+		pass bool
+		err  error
+	}
+	evalDone := make(chan evalResult, 1)
+	go func() {
+		pass, err := logic.Eval(ops.Program, ep)
+		evalDone <- evalResult{pass, err}
+	}()
+
+	var caps dap.Capabilities
+	resp := c.request("initialize", dap.InitializeArguments{AdapterID: "teal"}, &caps)
+	require.True(t, resp.Success)
+	require.True(t, caps.SupportsConfigurationDoneRequest)
+	c.expectEvent("initialized", nil)
+
+	resp = c.request("launch", dap.LaunchArguments{StopOnEntry: true}, nil)
+	require.True(t, resp.Success)
+	resp = c.request("configurationDone", nil, nil)
+	require.True(t, resp.Success)
+
+	var thread dap.ThreadEventBody
+	c.expectEvent("thread", &thread)
+	require.Equal(t, dap.ThreadEventBody{Reason: "started", ThreadID: 1}, thread)
+	c.expectStopped("entry")
+
+	var threads dap.ThreadsResponseBody
+	c.request("threads", nil, &threads)
+	require.Len(t, threads.Threads, 1)
+	require.Equal(t, 1, threads.Threads[0].ID)
+	require.True(t, strings.HasPrefix(threads.Threads[0].Name, "txn 0: "))
+
+	frames := c.stackTrace()
+	require.Len(t, frames, 1)
+	require.Equal(t, "main", frames[0].Name)
+	src := frames[0].Source
+	require.NotNil(t, src)
+
+	var content dap.SourceResponseBody
+	resp = c.request("source", dap.SourceArguments{SourceReference: src.SourceReference}, &content)
+	require.True(t, resp.Success)
+	lines := strings.Split(content.Content, "\n")
+	lineOf := func(text string) int {
+		for i, line := range lines {
+			if line == text {
+				return i + 1
+			}
+		}
+		require.FailNow(t, "line not found", text)
+		return 0
+	}
+
+	var bps dap.SetBreakpointsResponseBody
+	resp = c.request("setBreakpoints", dap.SetBreakpointsArguments{
+		Source:      *src,
+		Breakpoints: []dap.SourceBreakpoint{{Line: lineOf("+")}, {Line: 1000}},
+	}, &bps)
+	require.True(t, resp.Success)
+	require.Len(t, bps.Breakpoints, 2)
+	require.True(t, bps.Breakpoints[0].Verified)
+	require.False(t, bps.Breakpoints[1].Verified)
+
+	resp = c.request("pause", dap.ThreadArguments{ThreadID: 1}, nil)
+	require.False(t, resp.Success)
+
+	// run to the breakpoint in the subroutine
+	resp = c.request("continue", dap.ThreadArguments{ThreadID: 1}, nil)
+	require.True(t, resp.Success)
+	c.expectStopped("breakpoint")
+	frames = c.stackTrace()
+	require.Len(t, frames, 2)
+	require.Equal(t, "label1", frames[0].Name)
+	require.Equal(t, lineOf("+"), frames[0].Line)
+	require.Equal(t, "main", frames[1].Name)
+	require.Equal(t, lineOf("callsub label1"), frames[1].Line)
+
+	resp = c.request("continue", dap.ThreadArguments{ThreadID: 2}, nil)
+	require.False(t, resp.Success)
+
+	var scopes dap.ScopesResponseBody
+	c.request("scopes", dap.ScopesArguments{FrameID: frames[0].ID}, &scopes)
+	require.Len(t, scopes.Scopes, 4)
+	require.Equal(t, "Stack", scopes.Scopes[0].Name)
+	var vars dap.VariablesResponseBody
+	c.request("variables", dap.VariablesArguments{VariablesReference: scopes.Scopes[0].VariablesReference}, &vars)
+	require.Len(t, vars.Variables, 2)
+	require.Equal(t, "1", vars.Variables[1].Value)
+
+	require.Equal(t, "Transaction Group", scopes.Scopes[3].Name)
+	c.request("variables", dap.VariablesArguments{VariablesReference: scopes.Scopes[3].VariablesReference}, &vars)
+	require.Len(t, vars.Variables, 2)
+	require.Equal(t, "appl (current)", vars.Variables[0].Value)
+	require.Equal(t, "pay", vars.Variables[1].Value)
+	c.request("variables", dap.VariablesArguments{VariablesReference: vars.Variables[1].VariablesReference}, &vars)
+	require.NotEmpty(t, vars.Variables)
+
+	// step out of the subroutine and over the next line
+	resp = c.request("stepOut", dap.ThreadArguments{ThreadID: 1}, nil)
+	require.True(t, resp.Success)
+	c.expectStopped("step")
+	frames = c.stackTrace()
+	require.Len(t, frames, 1)
+	require.Equal(t, lineOf("pushint 2"), frames[0].Line)
+
+	resp = c.request("next", dap.ThreadArguments{ThreadID: 1}, nil)
+	require.True(t, resp.Success)
+	c.expectStopped("step")
+	frames = c.stackTrace()
+	require.Equal(t, lineOf("=="), frames[0].Line)
+
+	resp = c.request("continue", dap.ThreadArguments{ThreadID: 1}, nil)
+	require.True(t, resp.Success)
+	var output dap.OutputEventBody
+	c.expectEvent("output", &output)
+	require.Equal(t, "console", output.Category)
+	c.expectEvent("thread", &thread)
+	require.Equal(t, dap.ThreadEventBody{Reason: "exited", ThreadID: 1}, thread)
+
+	result := <-evalDone
+	require.NoError(t, result.err)
+	require.True(t, result.pass)
+
+	completed := make(chan struct{})
+	go func() {
+		a.WaitForCompletion()
+		close(completed)
+	}()
+	c.expectEvent("terminated", nil)
+	resp = c.request("disconnect", nil, nil)
+	require.True(t, resp.Success)
+	<-completed
+}
+
+func TestDapFrontendStepping(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source := `#pragma version 4
+int 1
+callsub sub
+return
+sub:
+int 2
+pop
+retsub
+`
+	ops, err := logic.AssembleStringWithVersion(source, 4)
+	require.NoError(t, err)
+	dis, err := logic.Disassemble(ops.Program)
+	require.NoError(t, err)
+	lines := strings.Split(dis, "\n")
+	lineOf := func(text string) int {
+		for i, line := range lines {
+			if line == text {
+				return i + 1
+			}
+		}
+		require.FailNow(t, "line not found", text)
+		return 0
+	}
+
+	a := makeDapFrontend(false)
+	server, client := net.Pipe()
+	defer client.Close()
+	go a.serve(server)
+	c := makeDapTestClient(t, client)
+
+	debugger := MakeDebugger()
+	debugger.AddAdapter(a)
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	ep := logic.EvalParams{
+		Proto:    &proto,
+		Debugger: debugger,
+		Txn:      &transactions.SignedTxn{},
+	}
+	go logic.Eval(ops.Program, ep)
+
+	c.request("initialize", nil, nil)
+	c.expectEvent("initialized", nil)
+	c.request("attach", dap.LaunchArguments{StopOnEntry: true}, nil)
+	c.request("configurationDone", nil, nil)
+	c.expectEvent("thread", nil)
+	c.expectStopped("entry")
+
+	step := func(command string, line int, depth int) {
+		resp := c.request(command, dap.ThreadArguments{ThreadID: 1}, nil)
+		require.True(t, resp.Success, resp.Message)
+		c.expectStopped("step")
+		frames := c.stackTrace()
+		require.Len(t, frames, depth)
+		require.Equal(t, line, frames[0].Line)
+	}
+
+	// step into the subroutine, then step over the rest of it
+	step("stepIn", lineOf("pushint 1"), 1)
+	step("stepIn", lineOf("callsub label1"), 1)
+	step("stepIn", lineOf("pushint 2"), 2)
+	step("next", lineOf("pop"), 2)
+	step("next", lineOf("retsub"), 2)
+	step("next", lineOf("return"), 1)
+
+	// client disconnect lets the program run to completion
+	resp := c.request("disconnect", nil, nil)
+	require.True(t, resp.Success)
+	a.WaitForCompletion()
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
@@ -233,6 +234,7 @@ type LocalRunner struct {
 	protoName string
 	txnGroup  []transactions.SignedTxn
 	runs      []evaluation
+	traceFile string
 }
 
 func makeAppState() (states AppState) {
@@ -272,6 +274,8 @@ func determineEvalMode(program []byte, modeIn string) (mode modeType, err error)
 }
 
 // Setup validates input params and resolves inputs into canonical balance record structures.
+// A transaction to replay is resolved into a transaction group and balance records first.
+// Executions are recorded into the trace file if one is set.
 // Programs for execution are discovered in the following way:
 // - Sources from command line file names.
 // - Programs mentioned in transaction group txnGroup.
@@ -285,6 +289,13 @@ func determineEvalMode(program []byte, modeIn string) (mode modeType, err error)
 //    In this case Accounts data is used as a base for balance records creation,
 //    and Apps supply updates to AppParams field.
 func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
+	r.traceFile = dp.TraceFile
+	if len(dp.ReplayTxID) != 0 {
+		if err = replayFromAlgod(dp); err != nil {
+			return
+		}
+	}
+
 	ddr, err := ddrFromParams(dp)
 	if err != nil {
 		return
@@ -408,7 +419,7 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 				}
 
 				b, states, err = makeBalancesAdapter(
-					balances, r.txnGroup, dp.GroupIndex,
+					balances, dp.Boxes, r.txnGroup, dp.GroupIndex,
 					r.protoName, dp.Round, dp.LatestTimestamp, appIdx,
 					dp.Painless, dp.IndexerURL, dp.IndexerToken,
 				)
@@ -443,7 +454,7 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 				if len(stxn.Txn.ApprovalProgram) > 0 {
 					appIdx = basics.AppIndex(dp.AppID)
 					b, states, err = makeBalancesAdapter(
-						balances, r.txnGroup, gi,
+						balances, dp.Boxes, r.txnGroup, gi,
 						r.protoName, dp.Round, dp.LatestTimestamp,
 						appIdx, dp.Painless, dp.IndexerURL, dp.IndexerToken,
 					)
@@ -479,7 +490,7 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 								return
 							}
 							b, states, err = makeBalancesAdapter(
-								balances, r.txnGroup, gi,
+								balances, dp.Boxes, r.txnGroup, gi,
 								r.protoName, dp.Round, dp.LatestTimestamp,
 								appIdx, dp.Painless, dp.IndexerURL, dp.IndexerToken,
 							)
@@ -522,6 +533,19 @@ func (r *LocalRunner) RunAll() error {
 		return fmt.Errorf("no program to debug")
 	}
 
+	var hook logic.DebuggerHook = r.debugger
+	var recorder *logic.TraceRecorder
+	if len(r.traceFile) != 0 {
+		f, err := os.Create(r.traceFile)
+		if err != nil {
+			return fmt.Errorf("trace file error: %w", err)
+		}
+		defer f.Close()
+		recorder = logic.MakeTraceRecorder(f)
+		recorder.Next = r.debugger
+		hook = recorder
+	}
+
 	failed := 0
 	start := time.Now()
 	pooledApplicationBudget := uint64(0)
@@ -539,7 +563,7 @@ func (r *LocalRunner) RunAll() error {
 		}
 		ep := logic.EvalParams{
 			Proto:                   &r.proto,
-			Debugger:                r.debugger,
+			Debugger:                hook,
 			Txn:                     &r.txnGroup[run.groupIndex],
 			TxnGroup:                r.txnGroup,
 			GroupIndex:              run.groupIndex,
@@ -560,6 +584,9 @@ func (r *LocalRunner) RunAll() error {
 			failed++
 		}
 	}
+	if recorder != nil && recorder.Err() != nil {
+		return fmt.Errorf("trace write error: %w", recorder.Err())
+	}
 	elapsed := time.Since(start)
 	if failed == len(r.runs) && elapsed < time.Second {
 		return fmt.Errorf("all %d program(s) failed in less than a second, invocation error?", failed)
@@ -18,6 +18,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -58,8 +59,13 @@ type ApplicationIndexerResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// errBoxesNotSupported is returned on box access when no boxes were given,
+// rather than reporting every box as missing
+var errBoxesNotSupported = errors.New("boxes are only supported when replaying a transaction from algod")
+
 type localLedger struct {
 	balances        map[basics.Address]basics.AccountData
+	boxes           map[string][]byte
 	txnGroup        []transactions.SignedTxn
 	groupIndex      int
 	round           uint64
@@ -68,7 +74,7 @@ type localLedger struct {
 }
 
 func makeBalancesAdapter(
-	balances map[basics.Address]basics.AccountData, txnGroup []transactions.SignedTxn,
+	balances map[basics.Address]basics.AccountData, boxes map[string][]byte, txnGroup []transactions.SignedTxn,
 	groupIndex int, proto string, round uint64, latestTimestamp int64,
 	appIdx basics.AppIndex, painless bool, indexerURL string, indexerToken string,
 ) (apply.Balances, AppState, error) {
@@ -110,6 +116,7 @@ func makeBalancesAdapter(
 
 	ll := &localLedger{
 		balances:   balances,
+		boxes:      boxes,
 		txnGroup:   txnGroup,
 		groupIndex: groupIndex,
 		round:      round,
@@ -313,3 +320,10 @@ func (l *localLedger) GetCreatorForRound(rnd basics.Round, cidx basics.Creatable
 	}
 	return basics.Address{}, false, fmt.Errorf("unknown creatable type %d", ctype)
 }
+
+func (l *localLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	if l.boxes == nil {
+		return nil, errBoxesNotSupported
+	}
+	return l.boxes[key], nil
+}
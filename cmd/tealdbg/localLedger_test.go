@@ -25,6 +25,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -103,7 +104,7 @@ int 2
 	}
 
 	ba, _, err := makeBalancesAdapter(
-		balances, []transactions.SignedTxn{txn}, 0, string(protocol.ConsensusCurrentVersion),
+		balances, nil, []transactions.SignedTxn{txn}, 0, string(protocol.ConsensusCurrentVersion),
 		100, 102030, appIdx, false, "", "",
 	)
 	a.NoError(err)
@@ -128,3 +129,24 @@ int 2
 	a.Equal(basics.SetUintAction, delta.LocalDeltas[0]["lkeyint"].Action)
 	a.Equal(uint64(2), delta.LocalDeltas[0]["lkeyint"].Uint)
 }
+
+func TestLocalLedgerBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	key := ledgercore.MakeBoxKey(1, "box")
+
+	// without boxes, box access fails rather than finding no box
+	l := localLedger{}
+	_, err := l.LookupKv(0, key)
+	a.ErrorIs(err, errBoxesNotSupported)
+
+	l.boxes = map[string][]byte{key: []byte("value")}
+	value, err := l.LookupKv(0, key)
+	a.NoError(err)
+	a.Equal([]byte("value"), value)
+
+	value, err = l.LookupKv(0, ledgercore.MakeBoxKey(1, "other"))
+	a.NoError(err)
+	a.Nil(value)
+}
@@ -38,7 +38,7 @@ var rootCmd = &cobra.Command{
 	Use:   "tealdbg",
 	Short: "Algorand TEAL Debugger",
 	Long: `Debug a local or remote TEAL code in controlled environment
-with Web or Chrome DevTools frontends`,
+with Web, Chrome DevTools or Debug Adapter Protocol frontends`,
 	Run: func(cmd *cobra.Command, args []string) {
 		//If no arguments passed, we should fallback to help
 		cmd.HelpFunc()(cmd, args)
@@ -111,6 +111,9 @@ func (f *frontendValue) Make(router *mux.Router, appAddress string) (da DebugAda
 	case "web":
 		wa := MakeWebPageFrontend(&WebPageFrontendParams{router, appAddress})
 		return wa
+	case "dap":
+		da := MakeDapFrontend(&DapFrontendParams{dapAddress, verbose})
+		return da
 	case "cdt":
 		fallthrough
 	default:
@@ -123,7 +126,7 @@ type runModeValue struct {
 	*cobraStringValue
 }
 
-var frontend frontendValue = frontendValue{makeCobraStringValue("cdt", []string{"web"})}
+var frontend frontendValue = frontendValue{makeCobraStringValue("cdt", []string{"web", "dap"})}
 var proto string
 var txnFile string
 var groupIndex int
@@ -136,6 +139,7 @@ var timestamp int64
 var runMode runModeValue = runModeValue{makeCobraStringValue("auto", []string{"signature", "application"})}
 var port int
 var iface string
+var dapAddress string
 var noFirstRun bool
 var noBrowserCheck bool
 var noSourceMap bool
@@ -143,11 +147,17 @@ var verbose bool
 var painless bool
 var appID uint64
 var listenForDrReq bool
+var replayTxID string
+var algodURL string
+var algodToken string
+var traceFile string
+var replayTraceFile string
 
 func init() {
 	rootCmd.PersistentFlags().VarP(&frontend, "frontend", "f", "Frontend to use: "+frontend.AllowedString())
 	rootCmd.PersistentFlags().IntVar(&port, "remote-debugging-port", 9392, "Port to listen on")
 	rootCmd.PersistentFlags().StringVar(&iface, "listen", "127.0.0.1", "Network interface to listen on")
+	rootCmd.PersistentFlags().StringVar(&dapAddress, "dap-listen", "", "Address to accept a DAP client on, stdin/stdout are used if empty (dap frontend only)")
 	rootCmd.PersistentFlags().BoolVar(&noFirstRun, "no-first-run", false, "")
 	rootCmd.PersistentFlags().MarkHidden("no-first-run")
 	rootCmd.PersistentFlags().BoolVar(&noBrowserCheck, "no-default-browser-check", false, "")
@@ -161,13 +171,18 @@ func init() {
 	debugCmd.Flags().StringVarP(&balanceFile, "balance", "b", "", "Balance records to evaluate stateful TEAL on in form of json or msgpack file")
 	debugCmd.Flags().StringVarP(&ddrFile, "dryrun-req", "d", "", "Program(s) and state(s) in dryrun REST request format")
 	debugCmd.Flags().Uint64VarP(&appID, "app-id", "a", 1380011588, "Application ID for stateful TEAL if not set in transaction(s)")
-	debugCmd.Flags().Uint64VarP(&roundNumber, "round", "r", 0, "Ledger round number to evaluate stateful TEAL on")
+	debugCmd.Flags().Uint64VarP(&roundNumber, "round", "r", 0, "Ledger round number to evaluate stateful TEAL on, or the round of the replayed transaction")
 	debugCmd.Flags().Int64VarP(&timestamp, "latest-timestamp", "l", 0, "Latest confirmed timestamp to evaluate stateful TEAL on")
 	debugCmd.Flags().VarP(&runMode, "mode", "m", "TEAL evaluation mode: "+runMode.AllowedString())
 	debugCmd.Flags().BoolVar(&painless, "painless", false, "Automatically create balance record for all accounts and applications")
 	debugCmd.Flags().StringVarP(&indexerURL, "indexer-url", "i", "", "URL for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().StringVarP(&indexerToken, "indexer-token", "", "", "API token for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().BoolVarP(&listenForDrReq, "listen-dr-req", "q", false, "Listen for upcoming debugging dryrun request objects instead of taking program(s) from command line")
+	debugCmd.Flags().StringVar(&replayTxID, "replay", "", "ID of a confirmed transaction to debug its transaction group as evaluated on chain")
+	debugCmd.Flags().StringVar(&algodURL, "algod", "", "URL for algod to fetch the replayed transaction group and balance records from")
+	debugCmd.Flags().StringVar(&algodToken, "algod-token", "", "API token for algod to fetch the replayed transaction group and balance records from")
+	debugCmd.Flags().StringVar(&traceFile, "trace", "", "File to write an execution trace of all programs to, as JSON lines")
+	debugCmd.Flags().StringVar(&replayTraceFile, "replay-trace", "", "Execution trace written with --trace to step through instead of evaluating programs")
 
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(remoteCmd)
@@ -195,7 +210,22 @@ func debugLocal(args []string) {
 		log.Fatalln("Can not combine listening for Dryrun Requests and program(s), or transaction(s), or dryrun-req object")
 	}
 
-	if !listenForDrReq {
+	if len(replayTxID) != 0 {
+		if len(algodURL) == 0 {
+			log.Fatalln("Error: replay requires algod URL")
+		}
+		if listenForDrReq || len(args) != 0 || len(txnFile) != 0 || len(ddrFile) != 0 || len(balanceFile) != 0 {
+			log.Fatalln("Error: replay can not be combined with program(s), transaction(s), balance records or dryrun-req object")
+		}
+	}
+
+	if len(replayTraceFile) != 0 {
+		if listenForDrReq || len(replayTxID) != 0 || len(args) != 0 || len(txnFile) != 0 || len(ddrFile) != 0 || len(balanceFile) != 0 || len(traceFile) != 0 {
+			log.Fatalln("Error: replay-trace can not be combined with program(s), transaction(s), balance records, dryrun-req object, replay or trace")
+		}
+	}
+
+	if !listenForDrReq && len(replayTxID) == 0 && len(replayTraceFile) == 0 {
 		// program can be set either directly
 		// or with SignedTxn.Lsig.Logic,
 		// or with BalanceRecord.AppParams.ApprovalProgram
@@ -277,6 +307,11 @@ func debugLocal(args []string) {
 		AppID:            appID,
 		Painless:         painless,
 		ListenForDrReq:   listenForDrReq,
+		ReplayTxID:       replayTxID,
+		AlgodURL:         algodURL,
+		AlgodToken:       algodToken,
+		TraceFile:        traceFile,
+		ReplayTraceFile:  replayTraceFile,
 	}
 
 	ds := makeDebugServer(iface, port, &frontend, &dp)
@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// replayRefs collects everything the evaluation of a transaction group
+// might read from the ledger, including its inner transactions
+type replayRefs struct {
+	accounts map[basics.Address]bool
+	apps     map[basics.AppIndex]bool
+	assets   map[basics.AssetIndex]bool
+	boxes    map[basics.AppIndex]map[string]bool
+}
+
+func makeReplayRefs() replayRefs {
+	return replayRefs{
+		accounts: make(map[basics.Address]bool),
+		apps:     make(map[basics.AppIndex]bool),
+		assets:   make(map[basics.AssetIndex]bool),
+		boxes:    make(map[basics.AppIndex]map[string]bool),
+	}
+}
+
+func (r *replayRefs) addAccount(addr basics.Address) {
+	if !addr.IsZero() {
+		r.accounts[addr] = true
+	}
+}
+
+func (r *replayRefs) addApp(aidx basics.AppIndex) {
+	if aidx != 0 {
+		r.apps[aidx] = true
+		// app account pays for inner transactions
+		r.addAccount(aidx.Address())
+	}
+}
+
+func (r *replayRefs) addAsset(aidx basics.AssetIndex) {
+	if aidx != 0 {
+		r.assets[aidx] = true
+	}
+}
+
+func (r *replayRefs) addBox(aidx basics.AppIndex, name string) {
+	// boxes of an app created by the group do not exist yet
+	if aidx == 0 {
+		return
+	}
+	if r.boxes[aidx] == nil {
+		r.boxes[aidx] = make(map[string]bool)
+	}
+	r.boxes[aidx][name] = true
+}
+
+func (r *replayRefs) addTxn(stxn *transactions.SignedTxnWithAD) {
+	txn := &stxn.Txn
+	r.addAccount(txn.Sender)
+	r.addAccount(txn.Receiver)
+	r.addAccount(txn.CloseRemainderTo)
+	r.addAccount(txn.AssetSender)
+	r.addAccount(txn.AssetReceiver)
+	r.addAccount(txn.AssetCloseTo)
+	r.addAccount(txn.FreezeAccount)
+	for _, addr := range txn.Accounts {
+		r.addAccount(addr)
+	}
+	r.addApp(txn.ApplicationID)
+	for _, aidx := range txn.ForeignApps {
+		r.addApp(aidx)
+	}
+	r.addAsset(txn.XferAsset)
+	r.addAsset(txn.ConfigAsset)
+	r.addAsset(txn.FreezeAsset)
+	for _, aidx := range txn.ForeignAssets {
+		r.addAsset(aidx)
+	}
+	for _, br := range txn.Boxes {
+		aidx := txn.ApplicationID
+		if br.Index > 0 {
+			if br.Index > uint64(len(txn.ForeignApps)) {
+				continue
+			}
+			aidx = txn.ForeignApps[br.Index-1]
+		}
+		r.addBox(aidx, string(br.Name))
+	}
+	for i := range stxn.EvalDelta.InnerTxns {
+		r.addTxn(&stxn.EvalDelta.InnerTxns[i])
+	}
+}
+
+func fetchBlock(algod client.RestClient, round uint64) (block bookkeeping.Block, err error) {
+	raw, err := algod.RawBlock(round)
+	if err != nil {
+		return bookkeeping.Block{}, fmt.Errorf("block %d request error: %w", round, err)
+	}
+	var encoded struct {
+		Block bookkeeping.Block `codec:"block"`
+	}
+	err = protocol.DecodeReflect(raw, &encoded)
+	if err != nil {
+		return bookkeeping.Block{}, fmt.Errorf("block %d decode error: %w", round, err)
+	}
+	return encoded.Block, nil
+}
+
+// fetchBoxes returns the values of the boxes in refs that exist, by their
+// ledger key. Algod only serves the boxes of its latest round.
+func fetchBoxes(algod client.RestClient, refs *replayRefs) (map[string][]byte, error) {
+	boxes := make(map[string][]byte)
+	for aidx, names := range refs.boxes {
+		resp, err := algod.ApplicationBoxes(uint64(aidx), 0)
+		if err != nil {
+			log.Printf("Skipping boxes of app %d: %s", aidx, err.Error())
+			continue
+		}
+		for _, desc := range resp.Boxes {
+			if !names[string(desc.Name)] {
+				continue
+			}
+			box, err := algod.GetApplicationBoxByName(uint64(aidx), "b64:"+base64.StdEncoding.EncodeToString(desc.Name))
+			if err != nil {
+				return nil, fmt.Errorf("app %d box %q request error: %w", aidx, desc.Name, err)
+			}
+			boxes[ledgercore.MakeBoxKey(aidx, string(desc.Name))] = box.Value
+		}
+	}
+	return boxes, nil
+}
+
+// findTxnGroup returns the group of the transaction txid confirmed in block
+func findTxnGroup(block *bookkeeping.Block, txid string) ([]transactions.SignedTxnWithAD, int, error) {
+	groups, err := block.DecodePaysetGroups()
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, group := range groups {
+		for gi := range group {
+			if group[gi].ID().String() == txid {
+				return group, gi, nil
+			}
+		}
+	}
+	return nil, 0, fmt.Errorf("transaction %s not found in block %d", txid, block.Round())
+}
+
+// replayFromAlgod fetches the transaction group of dp.ReplayTxID and the ledger state
+// it was evaluated on from algod, and sets up DebugParams to debug all programs of the group.
+func replayFromAlgod(dp *DebugParams) error {
+	u, err := url.Parse(dp.AlgodURL)
+	if err != nil {
+		return fmt.Errorf("invalid algod URL %s: %w", dp.AlgodURL, err)
+	}
+	algod := client.MakeRestClient(*u, dp.AlgodToken)
+	algod.SetAPIVersionAffinity(client.APIVersionV2)
+
+	round := dp.Round
+	if round == 0 {
+		info, err := algod.PendingTransactionInformationV2(dp.ReplayTxID)
+		if err != nil || info.ConfirmedRound == nil || *info.ConfirmedRound == 0 {
+			return fmt.Errorf("transaction %s is not known to the node as confirmed, set the round of its block", dp.ReplayTxID)
+		}
+		round = *info.ConfirmedRound
+	}
+
+	block, err := fetchBlock(algod, round)
+	if err != nil {
+		return err
+	}
+	prev, err := fetchBlock(algod, round-1)
+	if err != nil {
+		return err
+	}
+	group, gi, err := findTxnGroup(&block, dp.ReplayTxID)
+	if err != nil {
+		return err
+	}
+	log.Printf("Replaying group of %d txn(s) from round %d, txn %s at group index %d", len(group), round, dp.ReplayTxID, gi)
+
+	refs := makeReplayRefs()
+	txnGroup := make([]transactions.SignedTxn, len(group))
+	for i := range group {
+		txnGroup[i] = group[i].SignedTxn
+		refs.addTxn(&group[i])
+		if group[i].Txn.Type == protocol.ApplicationCallTx && group[i].Txn.ApplicationID == 0 {
+			// run app creation with the same id as on chain
+			dp.AppID = uint64(group[i].ApplicationID)
+		}
+	}
+
+	// creators hold app and asset params
+	for aidx := range refs.apps {
+		app, err := algod.ApplicationInformation(uint64(aidx))
+		if err != nil {
+			log.Printf("Skipping app %d: %s", aidx, err.Error())
+			continue
+		}
+		creator, err := basics.UnmarshalChecksumAddress(app.Params.Creator)
+		if err != nil {
+			return fmt.Errorf("app %d creator error: %w", aidx, err)
+		}
+		refs.addAccount(creator)
+	}
+	for aidx := range refs.assets {
+		asset, err := algod.AssetInformationV2(uint64(aidx))
+		if err != nil {
+			log.Printf("Skipping asset %d: %s", aidx, err.Error())
+			continue
+		}
+		creator, err := basics.UnmarshalChecksumAddress(asset.Params.Creator)
+		if err != nil {
+			return fmt.Errorf("asset %d creator error: %w", aidx, err)
+		}
+		refs.addAccount(creator)
+	}
+
+	records := make([]basics.BalanceRecord, 0, len(refs.accounts))
+	for addr := range refs.accounts {
+		raw, err := algod.RawAccountInformationV2AtRound(addr.String(), round-1)
+		if err != nil {
+			return fmt.Errorf("account %s request error: %w", addr.String(), err)
+		}
+		record := basics.BalanceRecord{Addr: addr}
+		err = protocol.Decode(raw, &record.AccountData)
+		if err != nil {
+			return fmt.Errorf("account %s decode error: %w", addr.String(), err)
+		}
+		records = append(records, record)
+	}
+
+	boxes, err := fetchBoxes(algod, &refs)
+	if err != nil {
+		return err
+	}
+	if len(refs.boxes) > 0 {
+		log.Printf("Using the boxes of the latest round, as algod does not serve boxes of past rounds")
+	}
+
+	dp.TxnBlob = protocol.EncodeJSON(txnGroup)
+	dp.BalanceBlob = protocol.EncodeJSON(records)
+	dp.Boxes = boxes
+	dp.Proto = string(block.CurrentProtocol)
+	dp.Round = round
+	dp.LatestTimestamp = prev.TimeStamp
+	return nil
+}
@@ -0,0 +1,413 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// replayDbgAdapter resumes all sessions and reports their final states
+type replayDbgAdapter struct {
+	completed chan logic.DebugState
+}
+
+func (d *replayDbgAdapter) SessionStarted(sid string, debugger Control, ch chan Notification) {
+	go func() {
+		for n := range ch {
+			if n.Event == "completed" {
+				d.completed <- n.DebugState
+				return
+			}
+			debugger.Resume()
+		}
+	}()
+}
+
+func (d *replayDbgAdapter) SessionEnded(sid string) {}
+
+func (d *replayDbgAdapter) WaitForCompletion() {}
+
+func (d *replayDbgAdapter) URL() string {
+	return ""
+}
+
+type replayAlgod struct {
+	blocks   map[uint64]bookkeeping.Block
+	balances map[basics.Address]basics.AccountData
+	creators map[uint64]basics.Address
+	boxes    map[uint64]map[string][]byte
+	txid     string
+	round    uint64
+}
+
+func (a *replayAlgod) router(t *testing.T) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/v2/transactions/pending/{txid}", func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["txid"] != a.txid {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(generated.PendingTransactionResponse{ConfirmedRound: &a.round})
+	})
+	router.HandleFunc("/v2/blocks/{round}", func(w http.ResponseWriter, r *http.Request) {
+		round, err := strconv.ParseUint(mux.Vars(r)["round"], 10, 64)
+		require.NoError(t, err)
+		block, ok := a.blocks[round]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.Equal(t, "msgpack", r.URL.Query().Get("format"))
+		w.Write(protocol.EncodeReflect(struct {
+			Block bookkeeping.Block `codec:"block"`
+		}{block}))
+	})
+	router.HandleFunc("/v2/accounts/{addr}", func(w http.ResponseWriter, r *http.Request) {
+		addr, err := basics.UnmarshalChecksumAddress(mux.Vars(r)["addr"])
+		require.NoError(t, err)
+		require.Equal(t, strconv.FormatUint(a.round-1, 10), r.URL.Query().Get("round"))
+		ad := a.balances[addr]
+		w.Write(protocol.Encode(&ad))
+	})
+	creator := func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		require.NoError(t, err)
+		addr, ok := a.creators[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Path[len("/v2/a")] == 'p' {
+			json.NewEncoder(w).Encode(generated.Application{Id: id, Params: generated.ApplicationParams{Creator: addr.String()}})
+		} else {
+			json.NewEncoder(w).Encode(generated.Asset{Index: id, Params: generated.AssetParams{Creator: addr.String()}})
+		}
+	}
+	router.HandleFunc("/v2/applications/{id}", creator)
+	router.HandleFunc("/v2/assets/{id}", creator)
+	router.HandleFunc("/v2/applications/{id}/boxes", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		require.NoError(t, err)
+		response := generated.BoxesResponse{Boxes: []generated.BoxDescriptor{}}
+		for name := range a.boxes[id] {
+			response.Boxes = append(response.Boxes, generated.BoxDescriptor{Name: []byte(name)})
+		}
+		json.NewEncoder(w).Encode(response)
+	})
+	router.HandleFunc("/v2/applications/{id}/box", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		require.NoError(t, err)
+		encoded := r.URL.Query().Get("name")
+		require.True(t, strings.HasPrefix(encoded, "b64:"))
+		name, err := base64.StdEncoding.DecodeString(encoded[len("b64:"):])
+		require.NoError(t, err)
+		value, ok := a.boxes[id][string(name)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(generated.BoxResponse{Round: a.round, Name: name, Value: value})
+	})
+	return router
+}
+
+func TestReplayFromAlgod(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	caller, err := logic.AssembleStringWithVersion(`#pragma version 6
+itxn_begin
+int appl
+itxn_field TypeEnum
+int 1002
+itxn_field ApplicationID
+itxn_submit
+int 1
+`, 6)
+	require.NoError(t, err)
+	callee, err := logic.AssembleStringWithVersion("#pragma version 6\nint 1", 6)
+	require.NoError(t, err)
+
+	var sender, receiver, creator basics.Address
+	crypto.RandBytes(sender[:])
+	crypto.RandBytes(receiver[:])
+	crypto.RandBytes(creator[:])
+	callerApp := basics.AppIndex(1001)
+	calleeApp := basics.AppIndex(1002)
+
+	balances := map[basics.Address]basics.AccountData{
+		sender: {MicroAlgos: basics.MicroAlgos{Raw: 10_000_000}},
+		creator: {
+			MicroAlgos: basics.MicroAlgos{Raw: 10_000_000},
+			AppParams: map[basics.AppIndex]basics.AppParams{
+				callerApp: {ApprovalProgram: caller.Program, ClearStateProgram: callee.Program},
+				calleeApp: {ApprovalProgram: callee.Program, ClearStateProgram: callee.Program},
+			},
+		},
+		callerApp.Address(): {MicroAlgos: basics.MicroAlgos{Raw: 1_000_000}},
+	}
+
+	var genesisHash crypto.Digest
+	crypto.RandBytes(genesisHash[:])
+	prev := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+		Round:        9,
+		TimeStamp:    1_600_000_000,
+		GenesisHash:  genesisHash,
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusFuture},
+	}}
+	block := bookkeeping.Block{BlockHeader: prev.BlockHeader}
+	block.BlockHeader.Round = 10
+	block.TimeStamp += 5
+
+	header := transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 2000}, GenesisHash: genesisHash, LastValid: 100}
+	pay := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: header,
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: 1000},
+		},
+	}}
+	appl := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: header,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID: callerApp,
+			ForeignApps:   []basics.AppIndex{calleeApp},
+		},
+	}}
+	var group transactions.TxGroup
+	group.TxGroupHashes = []crypto.Digest{crypto.Digest(pay.ID()), crypto.Digest(appl.ID())}
+	pay.Txn.Group = crypto.HashObj(group)
+	appl.Txn.Group = pay.Txn.Group
+
+	inner := transactions.SignedTxnWithAD{SignedTxn: transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: transactions.Header{Sender: callerApp.Address()},
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID: calleeApp,
+		},
+	}}}
+	applyData := transactions.ApplyData{EvalDelta: transactions.EvalDelta{InnerTxns: []transactions.SignedTxnWithAD{inner}}}
+	for _, txn := range []struct {
+		stxn transactions.SignedTxn
+		ad   transactions.ApplyData
+	}{{pay, transactions.ApplyData{}}, {appl, applyData}} {
+		stib, err := block.EncodeSignedTxn(txn.stxn, txn.ad)
+		require.NoError(t, err)
+		block.Payset = append(block.Payset, stib)
+	}
+
+	algod := replayAlgod{
+		blocks:   map[uint64]bookkeeping.Block{9: prev, 10: block},
+		balances: balances,
+		creators: map[uint64]basics.Address{uint64(callerApp): creator, uint64(calleeApp): creator},
+		txid:     pay.ID().String(),
+		round:    10,
+	}
+	server := httptest.NewServer(algod.router(t))
+	defer server.Close()
+
+	// the group is found by any of its transactions
+	traceFile := filepath.Join(t.TempDir(), "trace.jsonl")
+	dp := DebugParams{ReplayTxID: pay.ID().String(), AlgodURL: server.URL, RunMode: "auto", TraceFile: traceFile}
+	debugger := MakeDebugger()
+	da := replayDbgAdapter{completed: make(chan logic.DebugState, 2)}
+	debugger.AddAdapter(&da)
+	local := MakeLocalRunner(debugger)
+	err = local.Setup(&dp)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(10), dp.Round)
+	require.Equal(t, prev.TimeStamp, dp.LatestTimestamp)
+	require.Equal(t, string(protocol.ConsensusFuture), local.protoName)
+	require.Len(t, local.txnGroup, 2)
+	require.Equal(t, pay.ID(), local.txnGroup[0].ID())
+	require.Equal(t, appl.ID(), local.txnGroup[1].ID())
+	require.Len(t, local.runs, 1)
+	require.Equal(t, callerApp, local.runs[0].aidx)
+	require.Equal(t, uint64(1), local.runs[0].groupIndex)
+
+	err = local.RunAll()
+	require.NoError(t, err)
+	require.NoError(t, local.runs[0].result.err)
+	require.True(t, local.runs[0].result.pass)
+
+	// both the app and its inner app call were debugged
+	innerState := <-da.completed
+	require.Equal(t, 1, innerState.CallDepth)
+	require.Equal(t, logic.GetProgramID(callee.Program)+"-1", innerState.ExecID)
+	outerState := <-da.completed
+	require.Equal(t, 0, outerState.CallDepth)
+	require.Equal(t, logic.GetProgramID(caller.Program), outerState.ExecID)
+	require.Equal(t, []logic.ProgramContext{
+		{ExecID: outerState.ExecID, GroupIndex: 1, Depth: 0},
+		{ExecID: innerState.ExecID, GroupIndex: 0, Depth: 1},
+	}, innerState.Contexts)
+
+	// the trace nests the inner program in the outer one
+	trace, err := ioutil.ReadFile(traceFile)
+	require.NoError(t, err)
+	var starts []logic.TraceEvent
+	for _, line := range strings.Split(strings.TrimSpace(string(trace)), "\n") {
+		var ev logic.TraceEvent
+		require.NoError(t, protocol.DecodeJSON([]byte(line), &ev))
+		if ev.Event == logic.TraceStart {
+			starts = append(starts, ev)
+		}
+	}
+	require.Len(t, starts, 2)
+	require.Equal(t, outerState.ExecID, starts[0].ExecID)
+	require.Equal(t, innerState.ExecID, starts[1].ExecID)
+	require.Equal(t, outerState.ExecID, starts[1].Caller)
+	require.Equal(t, 1, starts[1].Depth)
+
+	// replaying the trace goes through the same executions again
+	replayDebugger := MakeDebugger()
+	replayed := replayDbgAdapter{completed: make(chan logic.DebugState, 2)}
+	replayDebugger.AddAdapter(&replayed)
+	err = logic.ReplayTrace(bytes.NewReader(trace), replayDebugger)
+	require.NoError(t, err)
+	replayedInner := <-replayed.completed
+	require.Equal(t, innerState.ExecID, replayedInner.ExecID)
+	require.Equal(t, innerState.PC, replayedInner.PC)
+	require.Equal(t, innerState.Stack, replayedInner.Stack)
+	replayedOuter := <-replayed.completed
+	require.Equal(t, outerState.ExecID, replayedOuter.ExecID)
+	require.Equal(t, outerState.PC, replayedOuter.PC)
+	require.Equal(t, outerState.Stack, replayedOuter.Stack)
+
+	// unknown transactions require the round
+	dp = DebugParams{ReplayTxID: appl.ID().String(), AlgodURL: server.URL}
+	err = replayFromAlgod(&dp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "set the round")
+
+	dp = DebugParams{ReplayTxID: appl.ID().String(), AlgodURL: server.URL, Round: 10}
+	err = replayFromAlgod(&dp)
+	require.NoError(t, err)
+
+	dp = DebugParams{ReplayTxID: "unknown", AlgodURL: server.URL, Round: 10}
+	err = replayFromAlgod(&dp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not found in block 10")
+}
+
+func TestReplayBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	approval, err := logic.AssembleStringWithVersion(`#pragma version 7
+byte "box"
+box_get
+assert
+byte "value"
+==
+assert
+byte "missing"
+box_len
+swap
+pop
+!
+`, 7)
+	require.NoError(t, err)
+	clear, err := logic.AssembleStringWithVersion("#pragma version 7\nint 1", 7)
+	require.NoError(t, err)
+
+	var sender, creator basics.Address
+	crypto.RandBytes(sender[:])
+	crypto.RandBytes(creator[:])
+	app := basics.AppIndex(1001)
+
+	var genesisHash crypto.Digest
+	crypto.RandBytes(genesisHash[:])
+	prev := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+		Round:        9,
+		GenesisHash:  genesisHash,
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusFuture},
+	}}
+	block := bookkeeping.Block{BlockHeader: prev.BlockHeader}
+	block.BlockHeader.Round = 10
+
+	appl := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 1000}, GenesisHash: genesisHash, LastValid: 100},
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID: app,
+			Boxes:         []transactions.BoxRef{{Name: []byte("box")}, {Name: []byte("missing")}},
+		},
+	}}
+	stib, err := block.EncodeSignedTxn(appl, transactions.ApplyData{})
+	require.NoError(t, err)
+	block.Payset = append(block.Payset, stib)
+
+	algod := replayAlgod{
+		blocks: map[uint64]bookkeeping.Block{9: prev, 10: block},
+		balances: map[basics.Address]basics.AccountData{
+			sender: {MicroAlgos: basics.MicroAlgos{Raw: 10_000_000}},
+			creator: {
+				MicroAlgos: basics.MicroAlgos{Raw: 10_000_000},
+				AppParams: map[basics.AppIndex]basics.AppParams{
+					app: {ApprovalProgram: approval.Program, ClearStateProgram: clear.Program},
+				},
+			},
+		},
+		creators: map[uint64]basics.Address{uint64(app): creator},
+		// only the referenced boxes are fetched
+		boxes: map[uint64]map[string][]byte{uint64(app): {"box": []byte("value"), "other": nil}},
+		txid:  appl.ID().String(),
+		round: 10,
+	}
+	server := httptest.NewServer(algod.router(t))
+	defer server.Close()
+
+	dp := DebugParams{ReplayTxID: appl.ID().String(), AlgodURL: server.URL}
+	err = replayFromAlgod(&dp)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{ledgercore.MakeBoxKey(app, "box"): []byte("value")}, dp.Boxes)
+
+	dp.RunMode = "auto"
+	debugger := MakeDebugger()
+	da := replayDbgAdapter{completed: make(chan logic.DebugState, 1)}
+	debugger.AddAdapter(&da)
+	local := MakeLocalRunner(debugger)
+	require.NoError(t, local.Setup(&dp))
+	require.NoError(t, local.RunAll())
+	<-da.completed
+	require.NoError(t, local.runs[0].result.err)
+	require.True(t, local.runs[0].result.pass)
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -88,6 +89,7 @@ type DebugParams struct {
 	GroupIndex       int
 	PastSideEffects  []logic.EvalSideEffects
 	BalanceBlob      []byte
+	Boxes            map[string][]byte
 	DdrBlob          []byte
 	IndexerURL       string
 	IndexerToken     string
@@ -98,6 +100,11 @@ type DebugParams struct {
 	AppID            uint64
 	Painless         bool
 	ListenForDrReq   bool
+	ReplayTxID       string
+	AlgodURL         string
+	AlgodToken       string
+	TraceFile        string
+	ReplayTraceFile  string
 }
 
 // FrontendFactory interface for attaching debug frontends
@@ -149,6 +156,10 @@ func (ds *DebugServer) startRemote() error {
 // So that for ListenForDrReq case a new endpoint is created and incoming data is await first.
 // Then execution is set up and program(s) run with stage-by-stage sync with ListenForDrReq's handler.
 func (ds *DebugServer) startDebug() (err error) {
+	if len(ds.params.ReplayTraceFile) != 0 {
+		return ds.startReplayTrace()
+	}
+
 	local := MakeLocalRunner(ds.debugger)
 
 	if ds.params.ListenForDrReq {
@@ -216,6 +227,30 @@ func (ds *DebugServer) startDebug() (err error) {
 	return
 }
 
+// startReplayTrace feeds the states of a recorded execution trace to the frontend
+// instead of evaluating programs
+func (ds *DebugServer) startReplayTrace() error {
+	f, err := os.Open(ds.params.ReplayTraceFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	go func() {
+		err := ds.server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Panicf("failed to listen: %v", err)
+		}
+	}()
+	defer ds.server.Shutdown(context.Background())
+
+	if err = logic.ReplayTrace(f, ds.debugger); err != nil {
+		return err
+	}
+	ds.frontend.WaitForCompletion()
+	return nil
+}
+
 func (ds *DebugServer) dryrunReqHander(w http.ResponseWriter, r *http.Request) {
 	blob := make([]byte, 0, 4096)
 	buf := make([]byte, 1024)
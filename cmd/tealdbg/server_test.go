@@ -18,7 +18,9 @@ package main
 
 import (
 	"context"
+	"io/ioutil"
 	"math/rand"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -142,3 +144,28 @@ func TestServerLocal(t *testing.T) {
 
 	serverTestImpl(t, tryStartingServerDebug, &dp)
 }
+
+func TestServerReplayTrace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	traceFile := filepath.Join(t.TempDir(), "trace.jsonl")
+	txnBlob := []byte("[" + strings.Join([]string{string(txnSample), txnSample}, ",") + "]")
+	dp := DebugParams{
+		ProgramNames: []string{"test"},
+		ProgramBlobs: [][]byte{{2, 0x20, 1, 1, 0x22}}, // version, intcb, int 1
+		TxnBlob:      txnBlob,
+		GroupIndex:   0,
+		RunMode:      "signature",
+		TraceFile:    traceFile,
+	}
+	serverTestImpl(t, tryStartingServerDebug, &dp)
+
+	trace, err := ioutil.ReadFile(traceFile)
+	require.NoError(t, err)
+	require.NotEmpty(t, trace)
+
+	serverTestImpl(t, tryStartingServerDebug, &DebugParams{ReplayTraceFile: traceFile})
+
+	// a missing trace is an error
+	ds := makeDebugServer("127.0.0.1", port, &mockFactory{}, &DebugParams{ReplayTraceFile: filepath.Join(t.TempDir(), "none")})
+	require.Error(t, ds.startDebug())
+}
@@ -206,7 +206,8 @@ func newPartKey(t testing.TB, parent basics.Address) account.Participation {
 	partDB, err := db.MakeAccessor(fn, false, true)
 	require.NoError(t, err)
 
-	part, err := account.FillDBWithParticipationKeys(partDB, parent, 0, 1024*1024, config.Consensus[protocol.ConsensusFuture].DefaultKeyDilution)
+	// the worker signs with the voting keys, so skip generating state proof keys
+	part, err := account.FillDBWithParticipationKeys(partDB, parent, 0, 1024*1024, config.Consensus[protocol.ConsensusFuture].DefaultKeyDilution, 0)
 	require.NoError(t, err)
 	part.Close()
 	return part.Participation
@@ -285,6 +285,32 @@ type ConsensusParams struct {
 	// maximum number of inner transactions that can be created by an app call
 	MaxInnerTransactions int
 
+	// maximum depth of application calls made through inner transactions,
+	// counting the top-level app call. Zero disallows inner app calls.
+	MaxAppCallDepth int
+
+	// programs with a lower version than this may not be invoked by an
+	// inner app call
+	MinInnerApplVersion uint64
+
+	// EnableBoxes allows applications to keep named byte arrays ("boxes")
+	// in the ledger, outside of their global state schema
+	EnableBoxes bool
+
+	// maximum size of a single box
+	MaxBoxSize uint64
+
+	// maximum number of box references that can be attached to an app
+	// call. Box references also count towards MaxAppTotalTxnReferences
+	MaxAppBoxReferences int
+
+	// flat MinBalance requirement for each box, charged to the account
+	// of the application that created it
+	BoxFlatMinBalance uint64
+
+	// MinBalance requirement per byte of box name and contents
+	BoxByteMinBalance uint64
+
 	// maximum number of applications a single account can create and store
 	// AppParams for at once
 	MaxAppsCreated int
@@ -1064,6 +1090,18 @@ func initConsensusProtocols() {
 
 	vFuture.RewardsCalculationFix = true
 
+	// Allow apps to call other apps with inner transactions
+	vFuture.MaxAppCallDepth = 8
+	vFuture.MinInnerApplVersion = 4
+
+	// Enable TEAL 7 and application boxes
+	vFuture.LogicSigVersion = 7
+	vFuture.EnableBoxes = true
+	vFuture.MaxBoxSize = 32 * 1024
+	vFuture.MaxAppBoxReferences = 8
+	vFuture.BoxFlatMinBalance = 2500
+	vFuture.BoxByteMinBalance = 400
+
 	Consensus[protocol.ConsensusFuture] = vFuture
 }
 
@@ -41,7 +41,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23"`
 
 	// environmental (may be overridden)
 	// When enabled, stores blocks indefinitally, otherwise, only the most recents blocks
@@ -430,6 +430,24 @@ type Local struct {
 	// The http server does not accept new connections as long we have this many
 	// (hard limit) connections already.
 	RestConnectionsHardLimit uint64 `version[20]:"2048"`
+
+	// EnableFollowMode launches the node in "follower" mode. The node does not participate in the
+	// agreement and does not relay or accept transactions; instead it follows the network through
+	// the catchup service, and the REST API can hold the ledger at a given sync round so that the
+	// ledger data of every round can be consumed before the node moves on.
+	EnableFollowMode bool `version[21]:"false"`
+
+	// EnableAccountHistory makes an archival node persist the state of every account modified in each
+	// round, so that accounts can be looked up at any round since the history was enabled.
+	// It has no effect on non-archival nodes.
+	EnableAccountHistory bool `version[22]:"false"`
+
+	// TxnStreamMaxSubscriptions limits the number of concurrent subscriptions to the transaction
+	// event stream of the REST API.
+	TxnStreamMaxSubscriptions uint64 `version[23]:"64"`
+	// TxnStreamMaxSubscriptionsPerClient limits the number of concurrent subscriptions to the
+	// transaction event stream from a single client address, so that one client cannot take all of them.
+	TxnStreamMaxSubscriptionsPerClient uint64 `version[23]:"4"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    20,
+	Version:                                    23,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AnnounceParticipationKey:                   true,
@@ -48,6 +48,7 @@ var defaultLocal = Local{
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	EnableAccountHistory:                       false,
 	EnableAccountUpdatesStats:                  false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
@@ -56,6 +57,7 @@ var defaultLocal = Local{
 	EnableBlockServiceFallbackToArchiver:       true,
 	EnableCatchupFromArchiveServers:            false,
 	EnableDeveloperAPI:                         false,
+	EnableFollowMode:                           false,
 	EnableGossipBlockService:                   true,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
@@ -116,6 +118,8 @@ var defaultLocal = Local{
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
 	TxSyncTimeoutSeconds:                       30,
+	TxnStreamMaxSubscriptions:                  64,
+	TxnStreamMaxSubscriptionsPerClient:         4,
 	UseXForwardedForAddressField:               "",
 	VerifiedTranscationsCacheSize:              30000,
 }
@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+// maxCompressedCoeff bounds the absolute values of compressed coefficients
+const maxCompressedCoeff = 2047
+
+// encodePublicKey packs the coefficients of h in 14 bits each, after the header byte
+func encodePublicKey(h []int64) PublicKey {
+	var pk PublicKey
+	pk[0] = pkHeader
+	var acc uint32
+	accBits := 0
+	pos := 1
+	for _, c := range h {
+		acc = acc<<14 | uint32(c)
+		accBits += 14
+		for accBits >= 8 {
+			accBits -= 8
+			pk[pos] = byte(acc >> uint(accBits))
+			pos++
+		}
+	}
+	return pk
+}
+
+func decodePublicKey(pk *PublicKey) ([]int64, bool) {
+	if pk[0] != pkHeader {
+		return nil, false
+	}
+	h := make([]int64, 0, n)
+	var acc uint32
+	accBits := 0
+	for _, b := range pk[1:] {
+		acc = acc<<8 | uint32(b)
+		accBits += 8
+		if accBits >= 14 {
+			accBits -= 14
+			c := int64(acc>>uint(accBits)) & (1<<14 - 1)
+			if c >= q {
+				return nil, false
+			}
+			h = append(h, c)
+		}
+	}
+	return h, true
+}
+
+// bitWriter appends bits to a byte slice, most significant bit first
+//msgp:ignore bitWriter
+type bitWriter struct {
+	out     []byte
+	acc     uint32
+	accBits int
+}
+
+func (w *bitWriter) write(v uint32, bits int) {
+	w.acc = w.acc<<uint(bits) | v
+	w.accBits += bits
+	for w.accBits >= 8 {
+		w.accBits -= 8
+		w.out = append(w.out, byte(w.acc>>uint(w.accBits)))
+	}
+}
+
+// compress encodes every coefficient as its sign, its 7 low bits and its high
+// bits in unary, within maxSize bytes
+func compress(s []int64, maxSize int) ([]byte, bool) {
+	w := bitWriter{out: make([]byte, 0, maxSize)}
+	for _, c := range s {
+		sign := uint32(0)
+		if c < 0 {
+			sign = 1
+			c = -c
+		}
+		if c > maxCompressedCoeff {
+			return nil, false
+		}
+		w.write(sign<<7|uint32(c&0x7f), 8)
+		for high := c >> 7; high > 0; high-- {
+			w.write(0, 1)
+		}
+		w.write(1, 1)
+		if len(w.out) > maxSize {
+			return nil, false
+		}
+	}
+	if w.accBits > 0 {
+		w.write(0, 8-w.accBits)
+	}
+	if len(w.out) > maxSize {
+		return nil, false
+	}
+	return w.out, true
+}
+
+// decompress decodes the n coefficients of a compressed encoding, which must be
+// canonical: no negative zero and no non-zero padding bits
+func decompress(enc []byte) ([]int64, bool) {
+	s := make([]int64, n)
+	pos := 0
+	bit := func() (uint32, bool) {
+		if pos >= 8*len(enc) {
+			return 0, false
+		}
+		b := uint32(enc[pos/8]>>uint(7-pos%8)) & 1
+		pos++
+		return b, true
+	}
+
+	for i := range s {
+		var low uint32
+		for j := 0; j < 8; j++ {
+			b, ok := bit()
+			if !ok {
+				return nil, false
+			}
+			low = low<<1 | b
+		}
+		high := int64(0)
+		for {
+			b, ok := bit()
+			if !ok {
+				return nil, false
+			}
+			if b == 1 {
+				break
+			}
+			high++
+			if high > maxCompressedCoeff>>7 {
+				return nil, false
+			}
+		}
+		c := high<<7 | int64(low&0x7f)
+		if low&0x80 != 0 {
+			if c == 0 {
+				return nil, false
+			}
+			c = -c
+		}
+		s[i] = c
+	}
+
+	// the padding is the rest of the last byte, and must be zero
+	if (pos+7)/8 != len(enc) {
+		return nil, false
+	}
+	for ; pos < 8*len(enc); pos++ {
+		if enc[pos/8]>>uint(7-pos%8)&1 != 0 {
+			return nil, false
+		}
+	}
+	return s, true
+}
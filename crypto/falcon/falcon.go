@@ -0,0 +1,278 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package falcon is a pure Go implementation of the Falcon-512 lattice-based
+// signature scheme, made deterministic: keys are generated from a seed, and
+// the nonce and sampling randomness of a signature are derived from the
+// private key and the message, so signing a message twice yields the same
+// signature.
+//
+// Public keys and signatures use the encodings of the Falcon specification,
+// and Verify accepts the signatures of any implementation. The randomness of
+// key generation and signing is derived from the seed by this package, so its
+// keys and signatures differ from those of the reference implementation.
+package falcon
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	logn = 9
+	n    = 1 << logn
+	q    = 12289
+
+	// sigma is the standard deviation of the signatures
+	sigma = 165.7366171829776
+	// sigmin is the smallest standard deviation of the sampler
+	sigmin = 1.2778336969128337
+	// sigBound is the maximal squared norm of a signature
+	sigBound = 34034726
+
+	// nonceSize is the size of the nonce hashed with the message
+	nonceSize = 40
+	// maxCompressedSize bounds the encoding of the second half of a signature
+	maxCompressedSize = MaxSignatureSize - 1 - nonceSize
+
+	pkHeader  = 0x00 + logn
+	sigHeader = 0x30 + logn
+)
+
+const (
+	// SeedSize is the size of the seed a key is generated from
+	SeedSize = 48
+	// PublicKeySize is the size of an encoded public key
+	PublicKeySize = 1 + n*14/8
+	// MaxSignatureSize bounds the size of a compressed signature
+	MaxSignatureSize = 666
+)
+
+// Errors returned by Verify
+var (
+	ErrInvalidPublicKey = errors.New("falcon: invalid public key encoding")
+	ErrInvalidSignature = errors.New("falcon: invalid signature encoding")
+	ErrVerifyFailed     = errors.New("falcon: signature does not verify")
+)
+
+// domain separators of the randomness derived from the seed of a key
+var (
+	keyGenDomain = []byte("falcon-keygen")
+	signDomain   = []byte("falcon-sign")
+)
+
+type (
+	// PublicKey is the encoding of the public polynomial h = g/f mod q
+	PublicKey [PublicKeySize]byte
+
+	// CompressedSignature is a signature in the compressed format: a header
+	// byte, the nonce and the compressed second half of the signature
+	//msgp:allocbound CompressedSignature MaxSignatureSize
+	CompressedSignature []byte
+
+	// PrivateKey is the NTRU basis of a key, and the tree used to sample
+	// short vectors of its lattice. It is regenerated from its seed rather
+	// than stored.
+	//msgp:ignore PrivateKey
+	PrivateKey struct {
+		signSeed [SeedSize]byte
+		f, g     []int64
+		// b0 is the basis [[g, -f], [G, -F]] in FFT representation
+		b0   [2][2]fftPoly
+		tree *ldlTree
+	}
+)
+
+// GenerateKey deterministically derives a key pair from seed
+func GenerateKey(seed [SeedSize]byte) (PublicKey, *PrivateKey) {
+	rng := newShakeRNG(keyGenDomain, seed[:])
+	f, g, F, G := ntruGen(rng)
+
+	sk := &PrivateKey{f: f, g: g}
+	rng.read(sk.signSeed[:])
+
+	sk.b0 = [2][2]fftPoly{
+		{fftInt(g), fftInt(neg(f))},
+		{fftInt(G), fftInt(neg(F))},
+	}
+	sk.tree = ffLDL(gram(sk.b0))
+	sk.tree.normalize(sigma)
+
+	return sk.PublicKey(), sk
+}
+
+// PublicKey computes the public key of sk
+func (sk *PrivateKey) PublicKey() PublicKey {
+	// f is invertible mod q, which ntruGen checked
+	h := nttMul(modQ(sk.g), nttInv(modQ(sk.f)))
+	return encodePublicKey(h)
+}
+
+// SignCompressed signs msg. The signature only depends on the key and msg.
+func (sk *PrivateKey) SignCompressed(msg []byte) CompressedSignature {
+	rng := newShakeRNG(signDomain, sk.signSeed[:], msg)
+	var nonce [nonceSize]byte
+	rng.read(nonce[:])
+	c := hashToPoint(nonce[:], msg)
+
+	for {
+		s0, s1 := sk.samplePreimage(c, rng)
+		var norm int64
+		for i := range s0 {
+			norm += s0[i]*s0[i] + s1[i]*s1[i]
+		}
+		if norm > sigBound {
+			continue
+		}
+		enc, ok := compress(s1, maxCompressedSize)
+		if !ok {
+			continue
+		}
+		sig := make(CompressedSignature, 0, 1+nonceSize+len(enc))
+		sig = append(sig, sigHeader)
+		sig = append(sig, nonce[:]...)
+		return append(sig, enc...)
+	}
+}
+
+// samplePreimage samples a short vector (s0, s1) with s0 + s1*h = c mod q
+func (sk *PrivateKey) samplePreimage(c []int64, rng *shakeRNG) (s0, s1 []int64) {
+	a, b := sk.b0[0][0], sk.b0[0][1]
+	cc, d := sk.b0[1][0], sk.b0[1][1]
+
+	point := fftInt(c)
+	t0 := make(fftPoly, n)
+	t1 := make(fftPoly, n)
+	for i := range point {
+		t0[i] = cscale(cmul(point[i], d[i]), 1.0/q)
+		t1[i] = cscale(cmul(-point[i], b[i]), 1.0/q)
+	}
+
+	z0, z1 := sk.tree.sample(t0, t1, rng)
+	v0 := make(fftPoly, n)
+	v1 := make(fftPoly, n)
+	for i := range z0 {
+		v0[i] = cmul(z0[i], a[i]) + cmul(z1[i], cc[i])
+		v1[i] = cmul(z0[i], b[i]) + cmul(z1[i], d[i])
+	}
+
+	s0 = make([]int64, n)
+	s1 = make([]int64, n)
+	for i, v := range ifft(v0) {
+		s0[i] = c[i] - int64(math.Round(v))
+	}
+	for i, v := range ifft(v1) {
+		s1[i] = -int64(math.Round(v))
+	}
+	return s0, s1
+}
+
+// Verify checks that sig is a signature of msg by the key pk
+func (pk *PublicKey) Verify(sig CompressedSignature, msg []byte) error {
+	h, ok := decodePublicKey(pk)
+	if !ok {
+		return ErrInvalidPublicKey
+	}
+	if len(sig) < 1+nonceSize || len(sig) > MaxSignatureSize || sig[0] != sigHeader {
+		return ErrInvalidSignature
+	}
+	s1, ok := decompress(sig[1+nonceSize:])
+	if !ok {
+		return ErrInvalidSignature
+	}
+
+	c := hashToPoint(sig[1:1+nonceSize], msg)
+	s1h := nttMul(modQ(s1), h)
+	var norm int64
+	for i := range c {
+		// center s0 = c - s1*h around 0
+		s0 := (c[i]-s1h[i]+q+q/2)%q - q/2
+		norm += s0*s0 + s1[i]*s1[i]
+	}
+	if norm > sigBound {
+		return ErrVerifyFailed
+	}
+	return nil
+}
+
+// hashToPoint hashes the nonce and msg to a polynomial mod q
+func hashToPoint(nonce []byte, msg []byte) []int64 {
+	shake := sha3.NewShake256()
+	shake.Write(nonce)
+	shake.Write(msg)
+
+	c := make([]int64, 0, n)
+	var buf [2]byte
+	for len(c) < n {
+		shake.Read(buf[:])
+		t := int64(buf[0])<<8 | int64(buf[1])
+		// rejecting values above 5q keeps the coefficients uniform
+		if t < 5*q {
+			c = append(c, t%q)
+		}
+	}
+	return c
+}
+
+func neg(a []int64) []int64 {
+	r := make([]int64, len(a))
+	for i := range a {
+		r[i] = -a[i]
+	}
+	return r
+}
+
+// shakeRNG is the randomness source of key generation and signing
+//msgp:ignore shakeRNG
+type shakeRNG struct {
+	shake sha3.ShakeHash
+	buf   [512]byte
+	pos   int
+}
+
+func newShakeRNG(inputs ...[]byte) *shakeRNG {
+	r := &shakeRNG{shake: sha3.NewShake256()}
+	for _, in := range inputs {
+		// prefix every input with its length, so that they cannot collide
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(in)))
+		r.shake.Write(l[:])
+		r.shake.Write(in)
+	}
+	r.pos = len(r.buf)
+	return r
+}
+
+func (r *shakeRNG) read(out []byte) {
+	for len(out) > 0 {
+		if r.pos == len(r.buf) {
+			r.shake.Read(r.buf[:])
+			r.pos = 0
+		}
+		c := copy(out, r.buf[r.pos:])
+		r.pos += c
+		out = out[c:]
+	}
+}
+
+func (r *shakeRNG) byte() byte {
+	var b [1]byte
+	r.read(b[:])
+	return b[0]
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSignVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed [SeedSize]byte
+	seed[0] = 1
+	pk, sk := GenerateKey(seed)
+	require.Equal(t, pk, sk.PublicKey())
+
+	msg := []byte("state proof")
+	sig := sk.SignCompressed(msg)
+	require.LessOrEqual(t, len(sig), MaxSignatureSize)
+	require.NoError(t, pk.Verify(sig, msg))
+	require.ErrorIs(t, pk.Verify(sig, []byte("other")), ErrVerifyFailed)
+
+	// keys and signatures are deterministic
+	pk2, sk2 := GenerateKey(seed)
+	require.Equal(t, pk, pk2)
+	require.Equal(t, sig, sk2.SignCompressed(msg))
+
+	seed[0] = 2
+	otherPK, _ := GenerateKey(seed)
+	require.NotEqual(t, pk, otherPK)
+	require.ErrorIs(t, otherPK.Verify(sig, msg), ErrVerifyFailed)
+
+	// encodings are checked
+	require.ErrorIs(t, pk.Verify(sig[:len(sig)-1], msg), ErrInvalidSignature)
+	require.ErrorIs(t, pk.Verify(append(sig[:len(sig):len(sig)], 0), msg), ErrInvalidSignature)
+	bad := append(CompressedSignature(nil), sig...)
+	bad[0]++
+	require.ErrorIs(t, pk.Verify(bad, msg), ErrInvalidSignature)
+	badPK := pk
+	badPK[0]++
+	require.ErrorIs(t, badPK.Verify(sig, msg), ErrInvalidPublicKey)
+}
+
+// TestKnownAnswer pins the key and signature of a seed, which must not depend
+// on the architecture or on the floating point instructions of the compiler
+func TestKnownAnswer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed [SeedSize]byte
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	pk, sk := GenerateKey(seed)
+	pkHash := sha256.Sum256(pk[:])
+	require.Equal(t, "716777d92babdbcbd0f8f75e18208715664c1f6348481c0335d9f92411140f14", hex.EncodeToString(pkHash[:]))
+
+	sig := sk.SignCompressed([]byte("state proof"))
+	sigHash := sha256.Sum256(sig)
+	require.Equal(t, "6dc9672bdfae9100d0efeae8308f7c167eb9049238abf5b886490a7d9ede5370", hex.EncodeToString(sigHash[:]))
+}
+
+func TestNTRUEquation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed [SeedSize]byte
+	rng := newShakeRNG(keyGenDomain, seed[:])
+	f, g, F, G := ntruGen(rng)
+
+	// f*G - g*F = q modulo x^n+1
+	fG := mulBig(bigPoly(f), bigPoly(G))
+	gF := mulBig(bigPoly(g), bigPoly(F))
+	for i := range fG {
+		expected := int64(0)
+		if i == 0 {
+			expected = q
+		}
+		require.Equal(t, expected, fG[i].Sub(fG[i], gF[i]).Int64())
+	}
+}
+
+func TestFFT(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := make([]int64, n)
+	b := make([]int64, n)
+	for i := range a {
+		a[i] = int64(i%7) - 3
+		b[i] = int64(i%5) - 2
+	}
+
+	// the FFT and NTT multiply modulo x^n+1, like the schoolbook product
+	expected := mulBig(bigPoly(a), bigPoly(b))
+	product := ifft(fftInt(a).mul(fftInt(b)))
+	for i := range product {
+		require.Equal(t, expected[i].Int64(), int64(math.Round(product[i])))
+	}
+	require.Equal(t, modQ(smallInts(expected)), nttMul(modQ(a), modQ(b)))
+
+	inv := nttInv(modQ(a))
+	one := make([]int64, n)
+	one[0] = 1
+	require.Equal(t, one, nttMul(modQ(a), inv))
+}
+
+func TestCompress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s := make([]int64, n)
+	for i := range s {
+		s[i] = int64(i*37%500) - 250
+	}
+	s[0] = maxCompressedCoeff
+	s[1] = -maxCompressedCoeff
+	enc, ok := compress(s, 2*n)
+	require.True(t, ok)
+	dec, ok := decompress(enc)
+	require.True(t, ok)
+	require.Equal(t, s, dec)
+
+	s[2] = maxCompressedCoeff + 1
+	_, ok = compress(s, 2*n)
+	require.False(t, ok)
+
+	// negative zero is not canonical
+	zero := make([]int64, n)
+	enc, ok = compress(zero, 2*n)
+	require.True(t, ok)
+	enc[0] |= 0x80
+	_, ok = decompress(enc)
+	require.False(t, ok)
+}
+
+func TestSamplerZ(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rng := newShakeRNG([]byte("sampler"))
+	const samples = 20000
+	const mu, sig = 0.3, 1.5
+	var sum, sumSq float64
+	for i := 0; i < samples; i++ {
+		z := float64(samplerZ(mu, sig, rng))
+		sum += z
+		sumSq += z * z
+	}
+	mean := sum / samples
+	variance := sumSq/samples - mean*mean
+	require.InDelta(t, mu, mean, 0.05)
+	require.InDelta(t, sig*sig, variance, 0.1)
+}
+
+func BenchmarkGenerateKey(b *testing.B) {
+	var seed [SeedSize]byte
+	for i := 0; i < b.N; i++ {
+		seed[0] = byte(i)
+		GenerateKey(seed)
+	}
+}
+
+func BenchmarkSign(b *testing.B) {
+	var seed [SeedSize]byte
+	_, sk := GenerateKey(seed)
+	msg := []byte("state proof")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		msg[0] = byte(i)
+		sk.SignCompressed(msg)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	var seed [SeedSize]byte
+	pk, sk := GenerateKey(seed)
+	msg := []byte("state proof")
+	sig := sk.SignCompressed(msg)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pk.Verify(sig, msg)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"math"
+)
+
+// The key of a seed must not depend on the architecture, so the floating point
+// arithmetic of this package is spelled out on real numbers: the compiler may
+// fuse a product and a sum into one FMA instruction, whose rounding differs,
+// unless the product is explicitly converted to float64, and the complex
+// division of the runtime has architecture-dependent code paths. Additions,
+// products, divisions and square roots are then correctly rounded IEEE 754
+// operations, whose results are the same everywhere.
+
+// fftPoly holds the evaluations of a real polynomial modulo x^m+1 at the
+// roots of x^m+1. The roots of x^2m+1 are ordered as pairs +z, -z of square
+// roots of the roots of x^m+1, so that a polynomial f(x) = f0(x^2) + x f1(x^2)
+// splits into the evaluations of f0 and f1 in linear time.
+//msgp:ignore fftPoly
+type fftPoly []complex128
+
+// fftRoots[k] are the square roots z chosen for the roots of x^(2^k)+1
+var fftRoots [logn][]complex128
+
+func init() {
+	roots := []complex128{-1}
+	for k := 0; k < logn; k++ {
+		fftRoots[k] = make([]complex128, len(roots))
+		next := make([]complex128, 0, 2*len(roots))
+		for i, r := range roots {
+			z := rootSqrt(r)
+			fftRoots[k][i] = z
+			next = append(next, z, -z)
+		}
+		roots = next
+	}
+}
+
+// rootSqrt is the square root with a non-negative real part of the root of
+// unity z, computed with the half-angle formulas. The formula of the component that
+// does not cancel out is used, for precision.
+func rootSqrt(z complex128) complex128 {
+	c, s := real(z), imag(z)
+	if c == -1 {
+		return complex(0, 1)
+	}
+	if c >= 0 {
+		rc := math.Sqrt((1 + c) / 2)
+		return complex(rc, s/(2*rc))
+	}
+	rs := math.Copysign(math.Sqrt((1-c)/2), s)
+	return complex(s/(2*rs), rs)
+}
+
+// cmul multiplies complex numbers, rounding every product
+func cmul(a, b complex128) complex128 {
+	ar, ai, br, bi := real(a), imag(a), real(b), imag(b)
+	return complex(float64(ar*br)-float64(ai*bi), float64(ar*bi)+float64(ai*br))
+}
+
+// cdiv divides complex numbers, rounding every product
+func cdiv(a, b complex128) complex128 {
+	ar, ai, br, bi := real(a), imag(a), real(b), imag(b)
+	d := float64(br*br) + float64(bi*bi)
+	return complex((float64(ar*br)+float64(ai*bi))/d, (float64(ai*br)-float64(ar*bi))/d)
+}
+
+// cscale multiplies a complex number by a real one
+func cscale(a complex128, s float64) complex128 {
+	return complex(float64(real(a)*s), float64(imag(a)*s))
+}
+
+// conj is the complex conjugate
+func conj(a complex128) complex128 {
+	return complex(real(a), -imag(a))
+}
+
+// level is the base 2 logarithm of the power of two m
+func level(m int) int {
+	k := 0
+	for 1<<k < m {
+		k++
+	}
+	return k
+}
+
+// mergeFFT computes the evaluations of f0(x^2) + x f1(x^2)
+func mergeFFT(f0, f1 fftPoly) fftPoly {
+	m := len(f0)
+	roots := fftRoots[level(m)]
+	f := make(fftPoly, 2*m)
+	for i := 0; i < m; i++ {
+		t := cmul(roots[i], f1[i])
+		f[2*i] = f0[i] + t
+		f[2*i+1] = f0[i] - t
+	}
+	return f
+}
+
+// splitFFT is the inverse of mergeFFT
+func splitFFT(f fftPoly) (f0, f1 fftPoly) {
+	m := len(f) / 2
+	roots := fftRoots[level(m)]
+	f0 = make(fftPoly, m)
+	f1 = make(fftPoly, m)
+	for i := 0; i < m; i++ {
+		// the roots have modulus 1, so dividing by a root multiplies by its conjugate
+		f0[i] = cscale(f[2*i]+f[2*i+1], 0.5)
+		f1[i] = cscale(cmul(f[2*i]-f[2*i+1], conj(roots[i])), 0.5)
+	}
+	return f0, f1
+}
+
+// fft evaluates the polynomial with coefficients a
+func fft(a []float64) fftPoly {
+	if len(a) == 1 {
+		return fftPoly{complex(a[0], 0)}
+	}
+	m := len(a) / 2
+	a0 := make([]float64, m)
+	a1 := make([]float64, m)
+	for i := 0; i < m; i++ {
+		a0[i] = a[2*i]
+		a1[i] = a[2*i+1]
+	}
+	return mergeFFT(fft(a0), fft(a1))
+}
+
+func fftInt(a []int64) fftPoly {
+	f := make([]float64, len(a))
+	for i := range a {
+		f[i] = float64(a[i])
+	}
+	return fft(f)
+}
+
+// ifft interpolates the coefficients of the polynomial with evaluations f
+func ifft(f fftPoly) []float64 {
+	if len(f) == 1 {
+		return []float64{real(f[0])}
+	}
+	f0, f1 := splitFFT(f)
+	a0, a1 := ifft(f0), ifft(f1)
+	a := make([]float64, len(f))
+	for i := range a0 {
+		a[2*i] = a0[i]
+		a[2*i+1] = a1[i]
+	}
+	return a
+}
+
+// adj is the adjoint f(1/x) of f, which evaluates to the conjugates of f
+func (f fftPoly) adj() fftPoly {
+	r := make(fftPoly, len(f))
+	for i := range f {
+		r[i] = conj(f[i])
+	}
+	return r
+}
+
+func (f fftPoly) add(g fftPoly) fftPoly {
+	r := make(fftPoly, len(f))
+	for i := range f {
+		r[i] = f[i] + g[i]
+	}
+	return r
+}
+
+func (f fftPoly) sub(g fftPoly) fftPoly {
+	r := make(fftPoly, len(f))
+	for i := range f {
+		r[i] = f[i] - g[i]
+	}
+	return r
+}
+
+func (f fftPoly) mul(g fftPoly) fftPoly {
+	r := make(fftPoly, len(f))
+	for i := range f {
+		r[i] = cmul(f[i], g[i])
+	}
+	return r
+}
+
+func (f fftPoly) div(g fftPoly) fftPoly {
+	r := make(fftPoly, len(f))
+	for i := range f {
+		r[i] = cdiv(f[i], g[i])
+	}
+	return r
+}
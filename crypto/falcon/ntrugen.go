@@ -0,0 +1,366 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+const (
+	// sigmaFG is the standard deviation of the samples summed into
+	// the coefficients of f and g
+	sigmaFG = 1.43300980528773
+	// maxFGNorm is the bound on the squared Gram-Schmidt norm of the basis
+	maxFGNorm = 1.17 * 1.17 * q
+	// maxFG bounds the coefficients of F and G
+	maxFG = 127
+)
+
+// ntruGen samples short polynomials f, g and solves the NTRU equation
+// f*G - g*F = q mod x^n+1 for short F, G
+func ntruGen(rng *shakeRNG) (f, g, F, G []int64) {
+	for {
+		f = genPoly(rng)
+		g = genPoly(rng)
+		if gsNorm(f, g) > maxFGNorm || !nttInvertible(modQ(f)) {
+			continue
+		}
+
+		bF, bG, ok := ntruSolve(bigPoly(f), bigPoly(g))
+		if !ok {
+			continue
+		}
+		F, ok = smallPoly(bF)
+		if !ok {
+			continue
+		}
+		G, ok = smallPoly(bG)
+		if !ok {
+			continue
+		}
+		return f, g, F, G
+	}
+}
+
+// genPoly samples a polynomial whose coefficients are sums of Gaussian samples
+func genPoly(rng *shakeRNG) []int64 {
+	const samples = 4096 / n
+	f := make([]int64, n)
+	for i := range f {
+		for j := 0; j < samples; j++ {
+			f[i] += samplerZ(0, sigmaFG, rng)
+		}
+	}
+	return f
+}
+
+// gsNorm is the squared Gram-Schmidt norm of the NTRU basis of f and g
+func gsNorm(f, g []int64) float64 {
+	var normFG float64
+	for i := range f {
+		normFG += float64(f[i]*f[i] + g[i]*g[i])
+	}
+
+	ff, gf := fftInt(f), fftInt(g)
+	ffgg := ff.mul(ff.adj()).add(gf.mul(gf.adj()))
+	var normFt float64
+	for _, c := range ifft(gf.adj().div(ffgg)) {
+		normFt += float64(c * c)
+	}
+	for _, c := range ifft(ff.adj().div(ffgg)) {
+		normFt += float64(c * c)
+	}
+	return math.Max(normFG, float64(q*q*normFt))
+}
+
+func bigPoly(a []int64) []*big.Int {
+	r := make([]*big.Int, len(a))
+	for i := range a {
+		r[i] = big.NewInt(a[i])
+	}
+	return r
+}
+
+func smallPoly(a []*big.Int) ([]int64, bool) {
+	r := make([]int64, len(a))
+	for i := range a {
+		if !a[i].IsInt64() {
+			return nil, false
+		}
+		r[i] = a[i].Int64()
+		if r[i] < -maxFG || r[i] > maxFG {
+			return nil, false
+		}
+	}
+	return r, true
+}
+
+// ntruSolve solves f*G - g*F = q by recursion on the field norms of f and g
+func ntruSolve(f, g []*big.Int) (F, G []*big.Int, ok bool) {
+	if len(f) == 1 {
+		u, v := new(big.Int), new(big.Int)
+		d := new(big.Int).GCD(u, v, f[0], g[0])
+		if d.Cmp(big.NewInt(1)) != 0 {
+			return nil, nil, false
+		}
+		bq := big.NewInt(q)
+		F = []*big.Int{v.Mul(v, bq).Neg(v)}
+		G = []*big.Int{u.Mul(u, bq)}
+		return F, G, true
+	}
+
+	Fp, Gp, ok := ntruSolve(fieldNorm(f), fieldNorm(g))
+	if !ok {
+		return nil, nil, false
+	}
+	F = mulBig(lift(Fp), galoisConjugate(g))
+	G = mulBig(lift(Gp), galoisConjugate(f))
+	reduce(f, g, F, G)
+	return F, G, true
+}
+
+// mulBig multiplies a and b modulo x^m+1
+func mulBig(a, b []*big.Int) []*big.Int {
+	m := len(a)
+	if r, ok := mulSmall(a, b); ok {
+		return r
+	}
+	r := make([]*big.Int, m)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	t := new(big.Int)
+	for i := range a {
+		if a[i].Sign() == 0 {
+			continue
+		}
+		for j := range b {
+			t.Mul(a[i], b[j])
+			if k := i + j; k < m {
+				r[k].Add(r[k], t)
+			} else {
+				r[k-m].Sub(r[k-m], t)
+			}
+		}
+	}
+	return r
+}
+
+// mulSmall is mulBig for coefficients small enough to accumulate their products
+// in 128 bits, which is the case in the largest degrees of ntruSolve
+func mulSmall(a, b []*big.Int) ([]*big.Int, bool) {
+	m := len(a)
+	bitsA, bitsB := maxBitLen(a), maxBitLen(b)
+	if bitsA > 63 || bitsB > 63 || bitsA+bitsB+level(m)+1 > 127 {
+		return nil, false
+	}
+	sa, sb := smallInts(a), smallInts(b)
+
+	acc := make([]int128, m)
+	for i := range sa {
+		if sa[i] == 0 {
+			continue
+		}
+		for j := range sb {
+			p := mul128(sa[i], sb[j])
+			if k := i + j; k < m {
+				acc[k] = acc[k].add(p)
+			} else {
+				acc[k-m] = acc[k-m].sub(p)
+			}
+		}
+	}
+
+	r := make([]*big.Int, m)
+	for i := range acc {
+		r[i] = acc[i].big()
+	}
+	return r, true
+}
+
+func maxBitLen(a []*big.Int) int {
+	max := 0
+	for _, c := range a {
+		if b := c.BitLen(); b > max {
+			max = b
+		}
+	}
+	return max
+}
+
+func smallInts(a []*big.Int) []int64 {
+	r := make([]int64, len(a))
+	for i := range a {
+		r[i] = a[i].Int64()
+	}
+	return r
+}
+
+// int128 is a two's complement 128-bit integer
+//msgp:ignore int128
+type int128 struct {
+	hi, lo uint64
+}
+
+func mul128(a, b int64) int128 {
+	ua, ub := uint64(a), uint64(b)
+	if a < 0 {
+		ua = -ua
+	}
+	if b < 0 {
+		ub = -ub
+	}
+	hi, lo := bits.Mul64(ua, ub)
+	r := int128{hi, lo}
+	if (a < 0) != (b < 0) {
+		return r.neg()
+	}
+	return r
+}
+
+func (x int128) neg() int128 {
+	lo, borrow := bits.Sub64(0, x.lo, 0)
+	hi, _ := bits.Sub64(0, x.hi, borrow)
+	return int128{hi, lo}
+}
+
+func (x int128) add(y int128) int128 {
+	lo, carry := bits.Add64(x.lo, y.lo, 0)
+	hi, _ := bits.Add64(x.hi, y.hi, carry)
+	return int128{hi, lo}
+}
+
+func (x int128) sub(y int128) int128 {
+	return x.add(y.neg())
+}
+
+func (x int128) big() *big.Int {
+	negative := int64(x.hi) < 0
+	if negative {
+		x = x.neg()
+	}
+	r := new(big.Int).SetUint64(x.hi)
+	r.Lsh(r, 64).Or(r, new(big.Int).SetUint64(x.lo))
+	if negative {
+		r.Neg(r)
+	}
+	return r
+}
+
+// fieldNorm maps a(x) = a0(x^2) + x a1(x^2) to a0^2 - x a1^2 modulo x^(m/2)+1
+func fieldNorm(a []*big.Int) []*big.Int {
+	m := len(a) / 2
+	a0 := make([]*big.Int, m)
+	a1 := make([]*big.Int, m)
+	for i := 0; i < m; i++ {
+		a0[i] = a[2*i]
+		a1[i] = a[2*i+1]
+	}
+	r := mulBig(a0, a0)
+	a1sq := mulBig(a1, a1)
+	r[0].Add(r[0], a1sq[m-1])
+	for i := 1; i < m; i++ {
+		r[i].Sub(r[i], a1sq[i-1])
+	}
+	return r
+}
+
+// galoisConjugate maps a(x) to a(-x)
+func galoisConjugate(a []*big.Int) []*big.Int {
+	r := make([]*big.Int, len(a))
+	for i := range a {
+		r[i] = new(big.Int).Set(a[i])
+		if i%2 == 1 {
+			r[i].Neg(r[i])
+		}
+	}
+	return r
+}
+
+// lift maps a(x) to a(x^2) modulo x^2m+1
+func lift(a []*big.Int) []*big.Int {
+	r := make([]*big.Int, 2*len(a))
+	for i := range a {
+		r[2*i] = a[i]
+		r[2*i+1] = new(big.Int)
+	}
+	return r
+}
+
+// bitSize is the size of the largest coefficient of the polynomials, rounded
+// up to a multiple of 8 and at least 53, the precision of a float64
+func bitSize(polys ...[]*big.Int) uint {
+	size := 53
+	for _, p := range polys {
+		for _, c := range p {
+			if b := (c.BitLen() + 7) / 8 * 8; b > size {
+				size = b
+			}
+		}
+	}
+	return uint(size)
+}
+
+// fftShifted computes the FFT of a scaled down by 2^shift
+func fftShifted(a []*big.Int, shift uint) fftPoly {
+	f := make([]float64, len(a))
+	t := new(big.Int)
+	for i := range a {
+		f[i] = float64(t.Rsh(a[i], shift).Int64())
+	}
+	return fft(f)
+}
+
+// reduce makes F and G short by subtracting multiples of f and g, using Babai's
+// round-off on floating point approximations of the polynomials
+func reduce(f, g, F, G []*big.Int) {
+	size := bitSize(f, g)
+	fa := fftShifted(f, size-53)
+	ga := fftShifted(g, size-53)
+	den := fa.mul(fa.adj()).add(ga.mul(ga.adj()))
+
+	for {
+		Size := bitSize(F, G)
+		if Size < size {
+			return
+		}
+		Fa := fftShifted(F, Size-53)
+		Ga := fftShifted(G, Size-53)
+		num := Fa.mul(fa.adj()).add(Ga.mul(ga.adj()))
+
+		k := make([]*big.Int, len(f))
+		zero := true
+		for i, c := range ifft(num.div(den)) {
+			k[i] = big.NewInt(int64(math.Round(c)))
+			if k[i].Sign() != 0 {
+				zero = false
+			}
+		}
+		if zero {
+			return
+		}
+
+		fk := mulBig(f, k)
+		gk := mulBig(g, k)
+		for i := range F {
+			F[i].Sub(F[i], fk[i].Lsh(fk[i], Size-size))
+			G[i].Sub(G[i], gk[i].Lsh(gk[i], Size-size))
+		}
+	}
+}
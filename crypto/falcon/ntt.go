@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+// The NTT evaluates polynomials modulo x^n+1 and q at the roots of x^n+1 in
+// Z_q, which all exist since 2n divides q-1. The roots are ordered like the
+// ones of the FFT.
+
+// nttRoots[k] are the square roots z chosen for the roots of x^(2^k)+1 mod q,
+// and nttRootsInv[k] their inverses
+var nttRoots, nttRootsInv [logn][]int64
+
+// inv2 is the inverse of 2 mod q
+const inv2 = (q + 1) / 2
+
+func init() {
+	var sqrt [q]int64
+	for x := int64(q - 1); x >= 0; x-- {
+		sqrt[x*x%q] = x
+	}
+
+	roots := []int64{q - 1}
+	for k := 0; k < logn; k++ {
+		nttRoots[k] = make([]int64, len(roots))
+		nttRootsInv[k] = make([]int64, len(roots))
+		next := make([]int64, 0, 2*len(roots))
+		for i, r := range roots {
+			z := sqrt[r]
+			nttRoots[k][i] = z
+			nttRootsInv[k][i] = powQ(z, q-2)
+			next = append(next, z, (q-z)%q)
+		}
+		roots = next
+	}
+}
+
+func modQ(a []int64) []int64 {
+	r := make([]int64, len(a))
+	for i := range a {
+		r[i] = (a[i]%q + q) % q
+	}
+	return r
+}
+
+func powQ(x, e int64) int64 {
+	r := int64(1)
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = r * x % q
+		}
+		x = x * x % q
+	}
+	return r
+}
+
+// ntt evaluates the polynomial with coefficients a, which are reduced mod q
+func ntt(a []int64) []int64 {
+	if len(a) == 1 {
+		return []int64{a[0]}
+	}
+	m := len(a) / 2
+	a0 := make([]int64, m)
+	a1 := make([]int64, m)
+	for i := 0; i < m; i++ {
+		a0[i] = a[2*i]
+		a1[i] = a[2*i+1]
+	}
+	f0, f1 := ntt(a0), ntt(a1)
+	roots := nttRoots[level(m)]
+	f := make([]int64, 2*m)
+	for i := 0; i < m; i++ {
+		t := roots[i] * f1[i] % q
+		f[2*i] = (f0[i] + t) % q
+		f[2*i+1] = (f0[i] - t + q) % q
+	}
+	return f
+}
+
+// intt interpolates the coefficients of the polynomial with evaluations f
+func intt(f []int64) []int64 {
+	if len(f) == 1 {
+		return []int64{f[0]}
+	}
+	m := len(f) / 2
+	rootsInv := nttRootsInv[level(m)]
+	f0 := make([]int64, m)
+	f1 := make([]int64, m)
+	for i := 0; i < m; i++ {
+		f0[i] = (f[2*i] + f[2*i+1]) * inv2 % q
+		f1[i] = (f[2*i] - f[2*i+1] + q) * inv2 % q * rootsInv[i] % q
+	}
+	a0, a1 := intt(f0), intt(f1)
+	a := make([]int64, len(f))
+	for i := range a0 {
+		a[2*i] = a0[i]
+		a[2*i+1] = a1[i]
+	}
+	return a
+}
+
+// nttMul multiplies a and b modulo x^n+1 and q
+func nttMul(a, b []int64) []int64 {
+	fa, fb := ntt(a), ntt(b)
+	for i := range fa {
+		fa[i] = fa[i] * fb[i] % q
+	}
+	return intt(fa)
+}
+
+// nttInvertible checks that a is invertible modulo x^n+1 and q
+func nttInvertible(a []int64) bool {
+	for _, v := range ntt(a) {
+		if v == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// nttInv inverts a modulo x^n+1 and q, which must be possible
+func nttInv(a []int64) []int64 {
+	f := ntt(a)
+	for i := range f {
+		f[i] = powQ(f[i], q-2)
+	}
+	return intt(f)
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"math"
+	"math/bits"
+)
+
+// The sampler follows the timing discipline of the reference implementation of
+// Falcon. The table lookup of baseSampler, the clamping in berExp and the floor
+// in samplerZ are computed without branches on their data. The floating point
+// operations take the same time for all the values they get, which are normal
+// numbers. What remains variable is public or random: berExp stops at the first
+// random byte that decides its result, and samplerZ rejects a number of samples
+// that, thanks to the scaling by sigmin/sig, does not depend on the key.
+
+// maxSigma bounds the standard deviations passed to samplerZ
+const maxSigma = 1.8205
+
+// rcdt is the reverse cumulative distribution table of the half-Gaussian of
+// standard deviation maxSigma, scaled by 2^72. Each entry is split in its high
+// 8 bits and low 64 bits.
+var rcdt = [18][2]uint64{
+	{163, 17866957108348000258},
+	{84, 15216282288489618306},
+	{34, 9065130955956142591},
+	{10, 15093043907930966756},
+	{2, 10773855707238178671},
+	{0, 8595902006365044063},
+	{0, 1163297957344668388},
+	{0, 117656387352093658},
+	{0, 8867391802663976},
+	{0, 496969357462633},
+	{0, 20680885154299},
+	{0, 638331848991},
+	{0, 14602316184},
+	{0, 247426747},
+	{0, 3104126},
+	{0, 28824},
+	{0, 198},
+	{0, 1},
+}
+
+// expmCoeffs approximate exp(-x) on [0, ln 2], scaled by 2^63, from the
+// coefficient of the highest degree down
+var expmCoeffs = [13]uint64{
+	0x00000004741183A3,
+	0x00000036548CFC06,
+	0x0000024FDCBF140A,
+	0x0000171D939DE045,
+	0x0000D00CF58F6F84,
+	0x000680681CF796E3,
+	0x002D82D8305B0FEA,
+	0x011111110E066FD0,
+	0x0555555555070F00,
+	0x155555555581FF00,
+	0x400000000002B400,
+	0x7FFFFFFFFFFF4800,
+	0x8000000000000000,
+}
+
+// baseSampler samples the half-Gaussian of standard deviation maxSigma
+func baseSampler(rng *shakeRNG) int {
+	var buf [9]byte
+	rng.read(buf[:])
+	hi := uint64(buf[8])
+	lo := uint64(buf[0]) | uint64(buf[1])<<8 | uint64(buf[2])<<16 | uint64(buf[3])<<24 |
+		uint64(buf[4])<<32 | uint64(buf[5])<<40 | uint64(buf[6])<<48 | uint64(buf[7])<<56
+
+	// (hi, lo) is below an entry when subtracting the entry borrows
+	z := 0
+	for _, e := range rcdt {
+		_, borrow := bits.Sub64(lo, e[1], 0)
+		_, borrow = bits.Sub64(hi, e[0], borrow)
+		z += int(borrow)
+	}
+	return z
+}
+
+// mul63 is (a*b) >> 63
+func mul63(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return hi<<1 | lo>>63
+}
+
+// approxExp computes 2^64 * ccs * exp(-x) for x in [0, ln 2]
+func approxExp(x float64, ccs float64) uint64 {
+	z := uint64(float64(x * (1 << 63)))
+	y := expmCoeffs[0]
+	for _, c := range expmCoeffs[1:] {
+		y = c - mul63(z, y)
+	}
+	return mul63(uint64(float64(ccs*(1<<63)))<<1, y)
+}
+
+// berExp returns true with probability ccs * exp(-x)
+func berExp(x float64, ccs float64, rng *shakeRNG) bool {
+	s := uint64(x / math.Ln2)
+	r := x - float64(float64(s)*math.Ln2)
+	// s = min(s, 63)
+	s ^= (s ^ 63) & -((63 - s) >> 63)
+	z := (approxExp(r, ccs) - 1) >> s
+
+	var w int
+	for i := 56; i >= 0; i -= 8 {
+		w = int(rng.byte()) - int((z>>uint(i))&0xff)
+		if w != 0 {
+			break
+		}
+	}
+	return w < 0
+}
+
+// samplerZ samples the discrete Gaussian over the integers of center mu and
+// standard deviation sig, which must be between sigmin and maxSigma
+func samplerZ(mu float64, sig float64, rng *shakeRNG) int64 {
+	// s is the floor of mu, computed from its truncation. It is -1 for mu = -0,
+	// which is fine since the sampler only needs r in [0, 1].
+	si := int64(mu)
+	si -= int64(math.Float64bits(mu-float64(si)) >> 63)
+	s := float64(si)
+	r := mu - s
+	dss := 1 / (2 * sig * sig)
+	ccs := sigmin / sig
+	for {
+		z0 := baseSampler(rng)
+		b := int(rng.byte() & 1)
+		z := float64(b + (2*b-1)*z0)
+		x := float64(float64((z-r)*(z-r))*dss) - float64(z0*z0)/(2*maxSigma*maxSigma)
+		if berExp(x, ccs, rng) {
+			return int64(z + s)
+		}
+	}
+}
+
+// ldlTree is the LDL* decomposition of the Gram matrix of a basis, split
+// recursively into the decompositions of the halves of its diagonal
+//msgp:ignore ldlTree
+type ldlTree struct {
+	l10         fftPoly
+	left, right *ldlTree
+
+	// sigma is the standard deviation used at the leaves
+	sigma float64
+}
+
+// gram computes b * b^*
+func gram(b [2][2]fftPoly) [2][2]fftPoly {
+	var g [2][2]fftPoly
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			g[i][j] = b[i][0].mul(b[j][0].adj()).add(b[i][1].mul(b[j][1].adj()))
+		}
+	}
+	return g
+}
+
+// ffLDL computes the LDL tree of the self-adjoint matrix g
+func ffLDL(g [2][2]fftPoly) *ldlTree {
+	d00 := g[0][0]
+	l10 := g[1][0].div(d00)
+	d11 := g[1][1].sub(l10.mul(l10.adj()).mul(d00))
+
+	t := &ldlTree{l10: l10}
+	if len(d00) == 2 {
+		// the diagonal of a 2x2 self-adjoint matrix is real and constant
+		t.left = &ldlTree{sigma: real(d00[0])}
+		t.right = &ldlTree{sigma: real(d11[0])}
+		return t
+	}
+	t.left = ffLDL(splitGram(d00))
+	t.right = ffLDL(splitGram(d11))
+	return t
+}
+
+// splitGram returns the Gram matrix of half the size of the self-adjoint d
+func splitGram(d fftPoly) [2][2]fftPoly {
+	d0, d1 := splitFFT(d)
+	return [2][2]fftPoly{{d0, d1}, {d1.adj(), d0}}
+}
+
+// normalize sets the standard deviations of the leaves for signatures of
+// standard deviation sig
+func (t *ldlTree) normalize(sig float64) {
+	if t.l10 == nil {
+		t.sigma = sig / math.Sqrt(t.sigma)
+		return
+	}
+	t.left.normalize(sig)
+	t.right.normalize(sig)
+}
+
+// sample samples a short lattice vector close to the target (t0, t1)
+func (t *ldlTree) sample(t0, t1 fftPoly, rng *shakeRNG) (z0, z1 fftPoly) {
+	if len(t0) == 1 {
+		// the children of a node of size 2 are leaves
+		z0 = fftPoly{complex(float64(samplerZ(real(t0[0]), t.sigma, rng)), 0)}
+		z1 = fftPoly{complex(float64(samplerZ(real(t1[0]), t.sigma, rng)), 0)}
+		return z0, z1
+	}
+
+	t10, t11 := splitFFT(t1)
+	z1 = mergeFFT(t.right.sample(t10, t11, rng))
+	t0b := t0.add(t1.sub(z1).mul(t.l10))
+	t00, t01 := splitFFT(t0b)
+	z0 = mergeFFT(t.left.sample(t00, t01, rng))
+	return z0, z1
+}
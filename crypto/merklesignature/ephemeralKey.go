@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merklesignature
+
+import (
+	"encoding/binary"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/falcon"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The ephemeral keys are deterministic Falcon keys, whose security relies on
+// lattice problems that remain hard for quantum adversaries. Only their seeds
+// are stored: generating a key from its seed takes a fraction of a second, and
+// the seed is much smaller than the key.
+
+// ephemeralSeed generates the Falcon key of a round
+type ephemeralSeed [falcon.SeedSize]byte
+
+// ephemeralKey binds the public key of a round to the round, and it is
+// the leaf of the merkle tree for the key
+//msgp:ignore ephemeralKey
+type ephemeralKey struct {
+	round uint64
+	pk    *falcon.PublicKey
+}
+
+// ToBeHashed implements the crypto.Hashable interface
+func (k *ephemeralKey) ToBeHashed() (protocol.HashID, []byte) {
+	data := make([]byte, 8, 8+falcon.PublicKeySize)
+	binary.BigEndian.PutUint64(data, k.round)
+	return protocol.MerkleSigKey, append(data, k.pk[:]...)
+}
+
+func keyLeaf(round uint64, pk *falcon.PublicKey) crypto.Digest {
+	return crypto.HashObj(&ephemeralKey{round: round, pk: pk})
+}
+
+// leaf computes the merkle tree leaf of the ephemeral key of round
+func (seed *ephemeralSeed) leaf(round uint64) crypto.Digest {
+	pk, _ := falcon.GenerateKey(*seed)
+	return keyLeaf(round, &pk)
+}
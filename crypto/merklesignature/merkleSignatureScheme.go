@@ -0,0 +1,324 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merklesignature
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/falcon"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+)
+
+// maxKeys is a bound on the number of ephemeral keys of Secrets
+const maxKeys = 1 << 20
+
+// maxProofDigests is a bound on the length of a merkle proof, which
+// is the height of the tree over the ephemeral keys
+const maxProofDigests = 20
+
+// Errors for the merkle signature scheme
+var (
+	ErrKeyLifetimeIsZero       = errors.New("the key lifetime must be positive")
+	ErrStartBiggerThanEndRound = errors.New("the first valid round is after the last valid round")
+	ErrTooManyKeys             = fmt.Errorf("the validity range requires more than %d keys", maxKeys)
+	ErrNoStateProofKey         = errors.New("no state proof key exists for the round")
+)
+
+type (
+	// Verifier is the commitment to all ephemeral keys of Secrets: the root
+	// of the merkle tree over them.
+	Verifier crypto.Digest
+
+	// Secrets are the ephemeral keys of an account for signing state proofs
+	// in a range of rounds. Each key signs the messages of a single round and
+	// is erased once the round has passed, so that a later compromise of the
+	// account cannot sign state proofs of past rounds.
+	Secrets struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+		// FirstRound is the round of the first key, and the keys are for
+		// the rounds that follow it every KeyLifetime rounds
+		FirstRound  uint64 `codec:"rnd"`
+		KeyLifetime uint64 `codec:"kl"`
+
+		// Leaves are the leaves of all the keys, which are stored so that
+		// the tree is built without generating the keys again. Seeds are the
+		// seeds of the last keys: the seeds of the first keys are erased once
+		// their rounds passed.
+		Leaves []crypto.Digest  `codec:"lvs,allocbound=maxKeys"`
+		Seeds  []ephemeralSeed `codec:"sds,allocbound=maxKeys"`
+
+		// mu protects the seeds and tree, which is built from the leaves
+		// on first use
+		mu   deadlock.Mutex
+		tree *merklearray.Tree
+	}
+
+	// Signer signs the message of a single round. It holds the ephemeral key of
+	// the round and its proof, so it can be stored on its own and deleted once used.
+	Signer struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+		Round uint64          `codec:"rnd"`
+		Index uint64          `codec:"idx"`
+		Seed  ephemeralSeed   `codec:"sd"`
+		Proof []crypto.Digest `codec:"prf,allocbound=maxProofDigests"`
+	}
+
+	// Signature is a Falcon signature of a message, together with the public
+	// key that verifies it, the position of the key in the merkle tree and the
+	// proof of the key.
+	Signature struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+		Signature    falcon.CompressedSignature `codec:"sig"`
+		VerifyingKey falcon.PublicKey           `codec:"vk"`
+		Index        uint64                     `codec:"idx"`
+		Proof        []crypto.Digest            `codec:"prf,allocbound=maxProofDigests"`
+	}
+)
+
+// leaves are the hashes of the ephemeral keys
+//msgp:ignore leaves
+type leaves []crypto.Digest
+
+func (l leaves) Length() uint64 {
+	return uint64(len(l))
+}
+
+func (l leaves) GetHash(pos uint64) (crypto.Digest, error) {
+	if pos >= uint64(len(l)) {
+		return crypto.Digest{}, fmt.Errorf("pos %d larger than length %d", pos, len(l))
+	}
+	return l[pos], nil
+}
+
+// New generates the ephemeral keys for the rounds between firstValid and lastValid
+// that are multiples of keyLifetime, using the system-wide randomness source.
+// Generating a key takes about a tenth of a second.
+func New(firstValid, lastValid, keyLifetime uint64) (*Secrets, error) {
+	return NewRNG(firstValid, lastValid, keyLifetime, crypto.SystemRNG)
+}
+
+// NewRNG is a version of New that takes the randomness source of the keys
+func NewRNG(firstValid, lastValid, keyLifetime uint64, rng crypto.RNG) (*Secrets, error) {
+	if keyLifetime == 0 {
+		return nil, ErrKeyLifetimeIsZero
+	}
+	if firstValid > lastValid {
+		return nil, ErrStartBiggerThanEndRound
+	}
+
+	firstRound := firstValid
+	if firstRound%keyLifetime != 0 {
+		firstRound += keyLifetime - firstRound%keyLifetime
+	}
+	numKeys := uint64(0)
+	if firstRound <= lastValid {
+		numKeys = (lastValid-firstRound)/keyLifetime + 1
+	}
+	if numKeys > maxKeys {
+		return nil, ErrTooManyKeys
+	}
+
+	s := &Secrets{
+		FirstRound:  firstRound,
+		KeyLifetime: keyLifetime,
+		Leaves:      make([]crypto.Digest, numKeys),
+		Seeds:       make([]ephemeralSeed, numKeys),
+	}
+	for i := range s.Seeds {
+		rng.RandBytes(s.Seeds[i][:])
+	}
+
+	// generating the keys takes most of the time, spread it on all cores
+	workers := runtime.NumCPU()
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(s.Seeds); i += workers {
+				s.Leaves[i] = s.Seeds[i].leaf(s.roundOf(uint64(i)))
+			}
+		}(w)
+	}
+	wg.Wait()
+	s.tree = s.buildTree()
+	return s, nil
+}
+
+// buildTree commits to the leaves of the keys
+func (s *Secrets) buildTree() *merklearray.Tree {
+	// leaves never fail to return their hashes
+	tree, _ := merklearray.Build(leaves(s.Leaves))
+	return tree
+}
+
+// erasedLocked is the number of keys whose seeds were erased
+func (s *Secrets) erasedLocked() uint64 {
+	if len(s.Seeds) > len(s.Leaves) {
+		// only decoding invalid secrets gets here, and their seeds are useless
+		return uint64(len(s.Leaves))
+	}
+	return uint64(len(s.Leaves) - len(s.Seeds))
+}
+
+func (s *Secrets) roundOf(index uint64) uint64 {
+	return s.FirstRound + index*s.KeyLifetime
+}
+
+func (s *Secrets) getTree() *merklearray.Tree {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getTreeLocked()
+}
+
+func (s *Secrets) getTreeLocked() *merklearray.Tree {
+	if s.tree == nil {
+		s.tree = s.buildTree()
+	}
+	return s.tree
+}
+
+// GetVerifier returns the commitment to the ephemeral keys
+func (s *Secrets) GetVerifier() *Verifier {
+	v := Verifier(s.getTree().Root())
+	return &v
+}
+
+// GetSigner returns the signer for round
+func (s *Secrets) GetSigner(round uint64) (*Signer, error) {
+	if s.KeyLifetime == 0 || round < s.FirstRound || round%s.KeyLifetime != 0 {
+		return nil, ErrNoStateProofKey
+	}
+	index := (round - s.FirstRound) / s.KeyLifetime
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if index < s.erasedLocked() || index >= uint64(len(s.Leaves)) {
+		return nil, ErrNoStateProofKey
+	}
+	return s.signerLocked(index)
+}
+
+// GetSigners returns the signers of the rounds between first and last
+// whose keys have not been erased
+func (s *Secrets) GetSigners(first, last uint64) ([]*Signer, error) {
+	if s.KeyLifetime == 0 || last < s.FirstRound {
+		return nil, nil
+	}
+	start := uint64(0)
+	if first > s.FirstRound {
+		start = (first - s.FirstRound + s.KeyLifetime - 1) / s.KeyLifetime
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if erased := s.erasedLocked(); start < erased {
+		start = erased
+	}
+	var signers []*Signer
+	for index := start; index < uint64(len(s.Leaves)) && s.roundOf(index) <= last; index++ {
+		signer, err := s.signerLocked(index)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+// signerLocked returns the signer of the key at index, which must not be erased
+func (s *Secrets) signerLocked(index uint64) (*Signer, error) {
+	proof, err := s.getTreeLocked().Prove([]uint64{index})
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		Round: s.roundOf(index),
+		Index: index,
+		Seed:  s.Seeds[index-s.erasedLocked()],
+		Proof: proof,
+	}, nil
+}
+
+// DeleteBefore erases the seeds of the keys of rounds strictly older than
+// round. It returns whether any seed was erased.
+func (s *Secrets) DeleteBefore(round uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	erased := s.erasedLocked()
+	n := uint64(0)
+	for n < uint64(len(s.Seeds)) && s.roundOf(erased+n) < round {
+		n++
+	}
+	if n == 0 {
+		return false
+	}
+
+	for i := uint64(0); i < n; i++ {
+		s.Seeds[i] = ephemeralSeed{}
+	}
+	// copy the remaining seeds, so that the zeroed ones are not kept alive
+	// by the backing array either
+	var seeds []ephemeralSeed
+	if n < uint64(len(s.Seeds)) {
+		seeds = append(seeds, s.Seeds[n:]...)
+		for i := range s.Seeds[n:] {
+			s.Seeds[n+uint64(i)] = ephemeralSeed{}
+		}
+	}
+	s.Seeds = seeds
+	return true
+}
+
+// Sign signs msg with the ephemeral key of the signer's round
+func (s *Signer) Sign(msg crypto.Hashable) Signature {
+	pk, sk := falcon.GenerateKey(s.Seed)
+	digest := crypto.HashObj(msg)
+	return Signature{
+		Signature:    sk.SignCompressed(digest[:]),
+		VerifyingKey: pk,
+		Index:        s.Index,
+		Proof:        append([]crypto.Digest(nil), s.Proof...),
+	}
+}
+
+// Verify checks that sig is a signature of msg by the key of round
+// committed to by the verifier
+func (v *Verifier) Verify(round uint64, msg crypto.Hashable, sig Signature) error {
+	leaf := keyLeaf(round, &sig.VerifyingKey)
+	err := merklearray.Verify(crypto.Digest(*v), map[uint64]crypto.Digest{sig.Index: leaf}, sig.Proof)
+	if err != nil {
+		return err
+	}
+	digest := crypto.HashObj(msg)
+	return sig.VerifyingKey.Verify(sig.Signature, digest[:])
+}
+
+// IsEmpty returns true if the verifier commits to no keys
+func (v *Verifier) IsEmpty() bool {
+	return *v == Verifier{}
+}
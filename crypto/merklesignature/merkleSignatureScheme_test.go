@@ -0,0 +1,232 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merklesignature
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto/falcon"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type TestMessage string
+
+func (m TestMessage) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.Message, []byte(m)
+}
+
+func TestSignVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s, err := New(1, 100, 8)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), s.FirstRound)
+	require.Len(t, s.Seeds, 12)
+	require.Len(t, s.Leaves, 12)
+	v := s.GetVerifier()
+	require.False(t, v.IsEmpty())
+
+	msg := TestMessage("state proof")
+	for round := uint64(8); round <= 96; round += 8 {
+		signer, err := s.GetSigner(round)
+		require.NoError(t, err)
+		sig := signer.Sign(msg)
+		require.NoError(t, v.Verify(round, msg, sig))
+
+		// signatures are bound to their message and round
+		require.Error(t, v.Verify(round, TestMessage("other"), sig))
+		require.Error(t, v.Verify(round+8, msg, sig))
+	}
+
+	// keys only exist for multiples of the key lifetime within range
+	for _, round := range []uint64{0, 7, 9, 104} {
+		_, err := s.GetSigner(round)
+		require.ErrorIs(t, err, ErrNoStateProofKey)
+	}
+
+	signer, err := s.GetSigner(16)
+	require.NoError(t, err)
+	sig := signer.Sign(msg)
+
+	// other keys do not verify
+	other, err := New(1, 100, 8)
+	require.NoError(t, err)
+	require.Error(t, other.GetVerifier().Verify(16, msg, sig))
+
+	// a signature claiming another position in the tree does not verify
+	moved := sig
+	moved.Index++
+	require.Error(t, v.Verify(16, msg, moved))
+
+	// neither does a signature by a key outside the tree, nor a tampered one
+	otherSigner, err := other.GetSigner(16)
+	require.NoError(t, err)
+	foreign := otherSigner.Sign(msg)
+	foreign.Index = sig.Index
+	foreign.Proof = sig.Proof
+	require.Error(t, v.Verify(16, msg, foreign))
+
+	tampered := sig
+	tampered.Signature = append(falcon.CompressedSignature(nil), sig.Signature...)
+	tampered.Signature[len(tampered.Signature)-2] ^= 1
+	require.Error(t, v.Verify(16, msg, tampered))
+
+	tampered = sig
+	tampered.VerifyingKey = foreign.VerifyingKey
+	require.Error(t, v.Verify(16, msg, tampered))
+}
+
+func TestSecretsEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s, err := New(1000, 2000, 256)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1024), s.FirstRound)
+	require.Len(t, s.Seeds, 4)
+
+	// the tree is rebuilt from the leaves of decoded secrets
+	var decoded Secrets
+	require.NoError(t, protocol.Decode(protocol.Encode(s), &decoded))
+	require.Equal(t, *s.GetVerifier(), *decoded.GetVerifier())
+
+	// signers can be stored and used on their own
+	signer, err := decoded.GetSigner(1792)
+	require.NoError(t, err)
+	var stored Signer
+	require.NoError(t, protocol.Decode(protocol.Encode(signer), &stored))
+
+	msg := TestMessage("state proof")
+	sig := stored.Sign(msg)
+	var decodedSig Signature
+	require.NoError(t, protocol.Decode(protocol.Encode(&sig), &decodedSig))
+	require.NoError(t, s.GetVerifier().Verify(1792, msg, decodedSig))
+}
+
+func TestDeleteBefore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s, err := New(1, 100, 8)
+	require.NoError(t, err)
+	seeds := append([]ephemeralSeed(nil), s.Seeds...)
+	msg := TestMessage("state proof")
+	v := s.GetVerifier()
+
+	// erasing seeds keeps the leaves, and so the verifier
+	require.True(t, s.DeleteBefore(30))
+	require.Len(t, s.Leaves, 12)
+	require.Equal(t, seeds[3:], s.Seeds)
+	require.Equal(t, *v, *s.GetVerifier())
+
+	for _, round := range []uint64{8, 16, 24} {
+		_, err := s.GetSigner(round)
+		require.ErrorIs(t, err, ErrNoStateProofKey)
+	}
+	signer, err := s.GetSigner(32)
+	require.NoError(t, err)
+	require.NoError(t, v.Verify(32, msg, signer.Sign(msg)))
+
+	// only the signers of keys that were not erased are returned
+	signers, err := s.GetSigners(0, 1000)
+	require.NoError(t, err)
+	require.Len(t, signers, 9)
+	require.Equal(t, signer, signers[0])
+	signers, err = s.GetSigners(33, 64)
+	require.NoError(t, err)
+	require.Len(t, signers, 4)
+	for i, round := range []uint64{40, 48, 56, 64} {
+		expected, err := s.GetSigner(round)
+		require.NoError(t, err)
+		require.Equal(t, expected, signers[i])
+	}
+	signers, err = s.GetSigners(0, 31)
+	require.NoError(t, err)
+	require.Empty(t, signers)
+
+	// erased seeds do not survive encoding either
+	require.True(t, s.DeleteBefore(33))
+	var decoded Secrets
+	require.NoError(t, protocol.Decode(protocol.Encode(s), &decoded))
+	require.Equal(t, s.Leaves, decoded.Leaves)
+	require.Equal(t, seeds[4:], decoded.Seeds)
+	require.Equal(t, *v, *decoded.GetVerifier())
+	_, err = decoded.GetSigner(32)
+	require.ErrorIs(t, err, ErrNoStateProofKey)
+	signer, err = decoded.GetSigner(96)
+	require.NoError(t, err)
+	require.NoError(t, v.Verify(96, msg, signer.Sign(msg)))
+
+	// erasing fewer rounds is a no-op
+	require.False(t, decoded.DeleteBefore(10))
+	require.Len(t, decoded.Seeds, 8)
+
+	require.True(t, decoded.DeleteBefore(1000))
+	require.Empty(t, decoded.Seeds)
+	require.Len(t, decoded.Leaves, 12)
+	require.Equal(t, *v, *decoded.GetVerifier())
+	_, err = decoded.GetSigner(96)
+	require.ErrorIs(t, err, ErrNoStateProofKey)
+}
+
+func TestConcurrentDeleteBefore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s, err := New(1, 100, 8)
+	require.NoError(t, err)
+	s.GetVerifier()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for round := uint64(0); round <= 104; round += 8 {
+			s.DeleteBefore(round)
+		}
+	}()
+	for round := uint64(0); round <= 104; round += 8 {
+		signers, err := s.GetSigners(round, 100)
+		require.NoError(t, err)
+		for _, signer := range signers {
+			require.GreaterOrEqual(t, signer.Round, round)
+		}
+	}
+	wg.Wait()
+}
+
+func TestNewErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, err := New(1, 100, 0)
+	require.ErrorIs(t, err, ErrKeyLifetimeIsZero)
+
+	_, err = New(100, 1, 8)
+	require.ErrorIs(t, err, ErrStartBiggerThanEndRound)
+
+	_, err = New(0, maxKeys, 1)
+	require.ErrorIs(t, err, ErrTooManyKeys)
+
+	// no rounds in range are a multiple of the key lifetime
+	s, err := New(1, 7, 8)
+	require.NoError(t, err)
+	require.Empty(t, s.Seeds)
+	require.True(t, s.GetVerifier().IsEmpty())
+	_, err = s.GetSigner(8)
+	require.ErrorIs(t, err, ErrNoStateProofKey)
+}
@@ -0,0 +1,849 @@
+package merklesignature
+
+// Code generated by github.com/algorand/msgp DO NOT EDIT.
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/falcon"
+	"github.com/algorand/msgp/msgp"
+)
+
+// The following msgp objects are implemented in this file:
+// Secrets
+//    |-----> (*) MarshalMsg
+//    |-----> (*) CanMarshalMsg
+//    |-----> (*) UnmarshalMsg
+//    |-----> (*) CanUnmarshalMsg
+//    |-----> (*) Msgsize
+//    |-----> (*) MsgIsZero
+//
+// Signature
+//     |-----> (*) MarshalMsg
+//     |-----> (*) CanMarshalMsg
+//     |-----> (*) UnmarshalMsg
+//     |-----> (*) CanUnmarshalMsg
+//     |-----> (*) Msgsize
+//     |-----> (*) MsgIsZero
+//
+// Signer
+//    |-----> (*) MarshalMsg
+//    |-----> (*) CanMarshalMsg
+//    |-----> (*) UnmarshalMsg
+//    |-----> (*) CanUnmarshalMsg
+//    |-----> (*) Msgsize
+//    |-----> (*) MsgIsZero
+//
+// Verifier
+//     |-----> (*) MarshalMsg
+//     |-----> (*) CanMarshalMsg
+//     |-----> (*) UnmarshalMsg
+//     |-----> (*) CanUnmarshalMsg
+//     |-----> (*) Msgsize
+//     |-----> (*) MsgIsZero
+//
+// ephemeralSeed
+//       |-----> (*) MarshalMsg
+//       |-----> (*) CanMarshalMsg
+//       |-----> (*) UnmarshalMsg
+//       |-----> (*) CanUnmarshalMsg
+//       |-----> (*) Msgsize
+//       |-----> (*) MsgIsZero
+//
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Secrets) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0004Len := uint32(4)
+	var zb0004Mask uint8 /* 7 bits */
+	if (*z).KeyLifetime == 0 {
+		zb0004Len--
+		zb0004Mask |= 0x2
+	}
+	if len((*z).Leaves) == 0 {
+		zb0004Len--
+		zb0004Mask |= 0x4
+	}
+	if (*z).FirstRound == 0 {
+		zb0004Len--
+		zb0004Mask |= 0x10
+	}
+	if len((*z).Seeds) == 0 {
+		zb0004Len--
+		zb0004Mask |= 0x20
+	}
+	// variable map header, size zb0004Len
+	o = append(o, 0x80|uint8(zb0004Len))
+	if zb0004Len != 0 {
+		if (zb0004Mask & 0x2) == 0 { // if not empty
+			// string "kl"
+			o = append(o, 0xa2, 0x6b, 0x6c)
+			o = msgp.AppendUint64(o, (*z).KeyLifetime)
+		}
+		if (zb0004Mask & 0x4) == 0 { // if not empty
+			// string "lvs"
+			o = append(o, 0xa3, 0x6c, 0x76, 0x73)
+			if (*z).Leaves == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Leaves)))
+			}
+			for zb0001 := range (*z).Leaves {
+				o = (*z).Leaves[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0004Mask & 0x10) == 0 { // if not empty
+			// string "rnd"
+			o = append(o, 0xa3, 0x72, 0x6e, 0x64)
+			o = msgp.AppendUint64(o, (*z).FirstRound)
+		}
+		if (zb0004Mask & 0x20) == 0 { // if not empty
+			// string "sds"
+			o = append(o, 0xa3, 0x73, 0x64, 0x73)
+			if (*z).Seeds == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Seeds)))
+			}
+			for zb0002 := range (*z).Seeds {
+				o = msgp.AppendBytes(o, ((*z).Seeds[zb0002])[:])
+			}
+		}
+	}
+	return
+}
+
+func (_ *Secrets) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Secrets)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Secrets) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0004 int
+	var zb0005 bool
+	zb0004, zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0004 > 0 {
+			zb0004--
+			(*z).FirstRound, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "FirstRound")
+				return
+			}
+		}
+		if zb0004 > 0 {
+			zb0004--
+			(*z).KeyLifetime, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "KeyLifetime")
+				return
+			}
+		}
+		if zb0004 > 0 {
+			zb0004--
+			var zb0006 int
+			var zb0007 bool
+			zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Leaves")
+				return
+			}
+			if zb0006 > maxKeys {
+				err = msgp.ErrOverflow(uint64(zb0006), uint64(maxKeys))
+				err = msgp.WrapError(err, "struct-from-array", "Leaves")
+				return
+			}
+			if zb0007 {
+				(*z).Leaves = nil
+			} else if (*z).Leaves != nil && cap((*z).Leaves) >= zb0006 {
+				(*z).Leaves = ((*z).Leaves)[:zb0006]
+			} else {
+				(*z).Leaves = make([]crypto.Digest, zb0006)
+			}
+			for zb0001 := range (*z).Leaves {
+				bts, err = (*z).Leaves[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Leaves", zb0001)
+					return
+				}
+			}
+		}
+		if zb0004 > 0 {
+			zb0004--
+			var zb0008 int
+			var zb0009 bool
+			zb0008, zb0009, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Seeds")
+				return
+			}
+			if zb0008 > maxKeys {
+				err = msgp.ErrOverflow(uint64(zb0008), uint64(maxKeys))
+				err = msgp.WrapError(err, "struct-from-array", "Seeds")
+				return
+			}
+			if zb0009 {
+				(*z).Seeds = nil
+			} else if (*z).Seeds != nil && cap((*z).Seeds) >= zb0008 {
+				(*z).Seeds = ((*z).Seeds)[:zb0008]
+			} else {
+				(*z).Seeds = make([]ephemeralSeed, zb0008)
+			}
+			for zb0002 := range (*z).Seeds {
+				bts, err = msgp.ReadExactBytes(bts, ((*z).Seeds[zb0002])[:])
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Seeds", zb0002)
+					return
+				}
+			}
+		}
+		if zb0004 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0004)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0005 {
+			(*z) = Secrets{}
+		}
+		for zb0004 > 0 {
+			zb0004--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "rnd":
+				(*z).FirstRound, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "FirstRound")
+					return
+				}
+			case "kl":
+				(*z).KeyLifetime, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "KeyLifetime")
+					return
+				}
+			case "lvs":
+				var zb0010 int
+				var zb0011 bool
+				zb0010, zb0011, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaves")
+					return
+				}
+				if zb0010 > maxKeys {
+					err = msgp.ErrOverflow(uint64(zb0010), uint64(maxKeys))
+					err = msgp.WrapError(err, "Leaves")
+					return
+				}
+				if zb0011 {
+					(*z).Leaves = nil
+				} else if (*z).Leaves != nil && cap((*z).Leaves) >= zb0010 {
+					(*z).Leaves = ((*z).Leaves)[:zb0010]
+				} else {
+					(*z).Leaves = make([]crypto.Digest, zb0010)
+				}
+				for zb0001 := range (*z).Leaves {
+					bts, err = (*z).Leaves[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Leaves", zb0001)
+						return
+					}
+				}
+			case "sds":
+				var zb0012 int
+				var zb0013 bool
+				zb0012, zb0013, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Seeds")
+					return
+				}
+				if zb0012 > maxKeys {
+					err = msgp.ErrOverflow(uint64(zb0012), uint64(maxKeys))
+					err = msgp.WrapError(err, "Seeds")
+					return
+				}
+				if zb0013 {
+					(*z).Seeds = nil
+				} else if (*z).Seeds != nil && cap((*z).Seeds) >= zb0012 {
+					(*z).Seeds = ((*z).Seeds)[:zb0012]
+				} else {
+					(*z).Seeds = make([]ephemeralSeed, zb0012)
+				}
+				for zb0002 := range (*z).Seeds {
+					bts, err = msgp.ReadExactBytes(bts, ((*z).Seeds[zb0002])[:])
+					if err != nil {
+						err = msgp.WrapError(err, "Seeds", zb0002)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *Secrets) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Secrets)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Secrets) Msgsize() (s int) {
+	s = 1 + 4 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Leaves {
+		s += (*z).Leaves[zb0001].Msgsize()
+	}
+	s += 4 + msgp.ArrayHeaderSize + (len((*z).Seeds) * (falcon.SeedSize * (msgp.ByteSize)))
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *Secrets) MsgIsZero() bool {
+	return ((*z).FirstRound == 0) && ((*z).KeyLifetime == 0) && (len((*z).Leaves) == 0) && (len((*z).Seeds) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Signature) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(4)
+	var zb0002Mask uint8 /* 5 bits */
+	if (*z).Index == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if len((*z).Proof) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	if (*z).Signature.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x8
+	}
+	if (*z).VerifyingKey.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x10
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "idx"
+			o = append(o, 0xa3, 0x69, 0x64, 0x78)
+			o = msgp.AppendUint64(o, (*z).Index)
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "prf"
+			o = append(o, 0xa3, 0x70, 0x72, 0x66)
+			if (*z).Proof == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Proof)))
+			}
+			for zb0001 := range (*z).Proof {
+				o = (*z).Proof[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x8) == 0 { // if not empty
+			// string "sig"
+			o = append(o, 0xa3, 0x73, 0x69, 0x67)
+			o = (*z).Signature.MarshalMsg(o)
+		}
+		if (zb0002Mask & 0x10) == 0 { // if not empty
+			// string "vk"
+			o = append(o, 0xa2, 0x76, 0x6b)
+			o = (*z).VerifyingKey.MarshalMsg(o)
+		}
+	}
+	return
+}
+
+func (_ *Signature) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Signature)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Signature) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).Signature.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Signature")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).VerifyingKey.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "VerifyingKey")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).Index, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Index")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Proof")
+				return
+			}
+			if zb0004 > maxProofDigests {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(maxProofDigests))
+				err = msgp.WrapError(err, "struct-from-array", "Proof")
+				return
+			}
+			if zb0005 {
+				(*z).Proof = nil
+			} else if (*z).Proof != nil && cap((*z).Proof) >= zb0004 {
+				(*z).Proof = ((*z).Proof)[:zb0004]
+			} else {
+				(*z).Proof = make([]crypto.Digest, zb0004)
+			}
+			for zb0001 := range (*z).Proof {
+				bts, err = (*z).Proof[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Proof", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = Signature{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "sig":
+				bts, err = (*z).Signature.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Signature")
+					return
+				}
+			case "vk":
+				bts, err = (*z).VerifyingKey.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "VerifyingKey")
+					return
+				}
+			case "idx":
+				(*z).Index, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Index")
+					return
+				}
+			case "prf":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Proof")
+					return
+				}
+				if zb0006 > maxProofDigests {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(maxProofDigests))
+					err = msgp.WrapError(err, "Proof")
+					return
+				}
+				if zb0007 {
+					(*z).Proof = nil
+				} else if (*z).Proof != nil && cap((*z).Proof) >= zb0006 {
+					(*z).Proof = ((*z).Proof)[:zb0006]
+				} else {
+					(*z).Proof = make([]crypto.Digest, zb0006)
+				}
+				for zb0001 := range (*z).Proof {
+					bts, err = (*z).Proof[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Proof", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *Signature) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Signature)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Signature) Msgsize() (s int) {
+	s = 1 + 4 + (*z).Signature.Msgsize() + 3 + (*z).VerifyingKey.Msgsize() + 4 + msgp.Uint64Size + 4 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Proof {
+		s += (*z).Proof[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *Signature) MsgIsZero() bool {
+	return ((*z).Signature.MsgIsZero()) && ((*z).VerifyingKey.MsgIsZero()) && ((*z).Index == 0) && (len((*z).Proof) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Signer) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0003Len := uint32(4)
+	var zb0003Mask uint8 /* 5 bits */
+	if (*z).Index == 0 {
+		zb0003Len--
+		zb0003Mask |= 0x2
+	}
+	if len((*z).Proof) == 0 {
+		zb0003Len--
+		zb0003Mask |= 0x4
+	}
+	if (*z).Round == 0 {
+		zb0003Len--
+		zb0003Mask |= 0x8
+	}
+	if (*z).Seed == (ephemeralSeed{}) {
+		zb0003Len--
+		zb0003Mask |= 0x10
+	}
+	// variable map header, size zb0003Len
+	o = append(o, 0x80|uint8(zb0003Len))
+	if zb0003Len != 0 {
+		if (zb0003Mask & 0x2) == 0 { // if not empty
+			// string "idx"
+			o = append(o, 0xa3, 0x69, 0x64, 0x78)
+			o = msgp.AppendUint64(o, (*z).Index)
+		}
+		if (zb0003Mask & 0x4) == 0 { // if not empty
+			// string "prf"
+			o = append(o, 0xa3, 0x70, 0x72, 0x66)
+			if (*z).Proof == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Proof)))
+			}
+			for zb0002 := range (*z).Proof {
+				o = (*z).Proof[zb0002].MarshalMsg(o)
+			}
+		}
+		if (zb0003Mask & 0x8) == 0 { // if not empty
+			// string "rnd"
+			o = append(o, 0xa3, 0x72, 0x6e, 0x64)
+			o = msgp.AppendUint64(o, (*z).Round)
+		}
+		if (zb0003Mask & 0x10) == 0 { // if not empty
+			// string "sd"
+			o = append(o, 0xa2, 0x73, 0x64)
+			o = msgp.AppendBytes(o, ((*z).Seed)[:])
+		}
+	}
+	return
+}
+
+func (_ *Signer) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Signer)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Signer) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0003 int
+	var zb0004 bool
+	zb0003, zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0003, zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 > 0 {
+			zb0003--
+			(*z).Round, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Round")
+				return
+			}
+		}
+		if zb0003 > 0 {
+			zb0003--
+			(*z).Index, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Index")
+				return
+			}
+		}
+		if zb0003 > 0 {
+			zb0003--
+			bts, err = msgp.ReadExactBytes(bts, ((*z).Seed)[:])
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Seed")
+				return
+			}
+		}
+		if zb0003 > 0 {
+			zb0003--
+			var zb0005 int
+			var zb0006 bool
+			zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Proof")
+				return
+			}
+			if zb0005 > maxProofDigests {
+				err = msgp.ErrOverflow(uint64(zb0005), uint64(maxProofDigests))
+				err = msgp.WrapError(err, "struct-from-array", "Proof")
+				return
+			}
+			if zb0006 {
+				(*z).Proof = nil
+			} else if (*z).Proof != nil && cap((*z).Proof) >= zb0005 {
+				(*z).Proof = ((*z).Proof)[:zb0005]
+			} else {
+				(*z).Proof = make([]crypto.Digest, zb0005)
+			}
+			for zb0002 := range (*z).Proof {
+				bts, err = (*z).Proof[zb0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Proof", zb0002)
+					return
+				}
+			}
+		}
+		if zb0003 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0003)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0004 {
+			(*z) = Signer{}
+		}
+		for zb0003 > 0 {
+			zb0003--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "rnd":
+				(*z).Round, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Round")
+					return
+				}
+			case "idx":
+				(*z).Index, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Index")
+					return
+				}
+			case "sd":
+				bts, err = msgp.ReadExactBytes(bts, ((*z).Seed)[:])
+				if err != nil {
+					err = msgp.WrapError(err, "Seed")
+					return
+				}
+			case "prf":
+				var zb0007 int
+				var zb0008 bool
+				zb0007, zb0008, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Proof")
+					return
+				}
+				if zb0007 > maxProofDigests {
+					err = msgp.ErrOverflow(uint64(zb0007), uint64(maxProofDigests))
+					err = msgp.WrapError(err, "Proof")
+					return
+				}
+				if zb0008 {
+					(*z).Proof = nil
+				} else if (*z).Proof != nil && cap((*z).Proof) >= zb0007 {
+					(*z).Proof = ((*z).Proof)[:zb0007]
+				} else {
+					(*z).Proof = make([]crypto.Digest, zb0007)
+				}
+				for zb0002 := range (*z).Proof {
+					bts, err = (*z).Proof[zb0002].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Proof", zb0002)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *Signer) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Signer)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Signer) Msgsize() (s int) {
+	s = 1 + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 3 + msgp.ArrayHeaderSize + (falcon.SeedSize * (msgp.ByteSize)) + 4 + msgp.ArrayHeaderSize
+	for zb0002 := range (*z).Proof {
+		s += (*z).Proof[zb0002].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *Signer) MsgIsZero() bool {
+	return ((*z).Round == 0) && ((*z).Index == 0) && ((*z).Seed == (ephemeralSeed{})) && (len((*z).Proof) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Verifier) MarshalMsg(b []byte) []byte {
+	return ((*(crypto.Digest))(z)).MarshalMsg(b)
+}
+func (_ *Verifier) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Verifier)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Verifier) UnmarshalMsg(bts []byte) ([]byte, error) {
+	return ((*(crypto.Digest))(z)).UnmarshalMsg(bts)
+}
+func (_ *Verifier) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Verifier)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Verifier) Msgsize() int {
+	return ((*(crypto.Digest))(z)).Msgsize()
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *Verifier) MsgIsZero() bool {
+	return ((*(crypto.Digest))(z)).MsgIsZero()
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ephemeralSeed) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendBytes(o, (*z)[:])
+	return
+}
+
+func (_ *ephemeralSeed) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ephemeralSeed)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ephemeralSeed) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	bts, err = msgp.ReadExactBytes(bts, (*z)[:])
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	o = bts
+	return
+}
+
+func (_ *ephemeralSeed) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ephemeralSeed)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ephemeralSeed) Msgsize() (s int) {
+	s = msgp.ArrayHeaderSize + (falcon.SeedSize * (msgp.ByteSize))
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *ephemeralSeed) MsgIsZero() bool {
+	return (*z) == (ephemeralSeed{})
+}
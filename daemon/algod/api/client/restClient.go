@@ -357,6 +357,34 @@ type rawFormat struct {
 	Format string `url:"format"`
 }
 
+type rawAccountParams struct {
+	Format string `url:"format"`
+	Round  uint64 `url:"round"`
+}
+
+type transactionPoolParams struct {
+	Max uint64 `url:"max"`
+}
+
+type evictTransactionsParams struct {
+	Txid   []string `url:"txid,omitempty"`
+	Sender string   `url:"sender,omitempty"`
+}
+
+type applicationBoxesParams struct {
+	Max uint64 `url:"max"`
+}
+
+type applicationBoxParams struct {
+	Name string `url:"name"`
+}
+
+type blockHeadersCommitmentParams struct {
+	FirstRound uint64  `url:"first-round"`
+	LastRound  uint64  `url:"last-round"`
+	ProofRound *uint64 `url:"proof-round,omitempty"`
+}
+
 // TransactionsByAddr returns all transactions for a PK [addr] in the [first,
 // last] rounds range.
 func (client RestClient) TransactionsByAddr(addr string, first, last, max uint64) (response v1.TransactionList, err error) {
@@ -395,6 +423,20 @@ func (client RestClient) ApplicationInformation(index uint64) (response generate
 	return
 }
 
+// ApplicationBoxes gets the names of the boxes of the passed application
+// index, returning at most maxBoxNum names unless it is zero
+func (client RestClient) ApplicationBoxes(index uint64, maxBoxNum uint64) (response generatedV2.BoxesResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/boxes", index), applicationBoxesParams{maxBoxNum})
+	return
+}
+
+// GetApplicationBoxByName gets the box of the passed application index,
+// named in the goal app call arg form "encoding:value"
+func (client RestClient) GetApplicationBoxByName(index uint64, name string) (response generatedV2.BoxResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/box", index), applicationBoxParams{name})
+	return
+}
+
 // AccountInformation also gets the AccountInformationResponse associated with the passed address
 func (client RestClient) AccountInformation(address string) (response v1.Account, err error) {
 	err = client.get(&response, fmt.Sprintf("/v1/account/%s", address), nil)
@@ -407,6 +449,18 @@ func (client RestClient) AccountInformationV2(address string) (response generate
 	return
 }
 
+// AccountAssetInformation gets the AccountAssetResponse associated with the passed address and asset index
+func (client RestClient) AccountAssetInformation(address string, assetID uint64) (response generatedV2.AccountAssetResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/assets/%d", address, assetID), nil)
+	return
+}
+
+// AccountApplicationInformation gets the AccountApplicationResponse associated with the passed address and application index
+func (client RestClient) AccountApplicationInformation(address string, applicationID uint64) (response generatedV2.AccountApplicationResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/applications/%d", address, applicationID), nil)
+	return
+}
+
 // Blob represents arbitrary blob of data satisfying v1.RawResponse interface
 type Blob []byte
 
@@ -423,6 +477,15 @@ func (client RestClient) RawAccountInformationV2(address string) (response []byt
 	return
 }
 
+// RawAccountInformationV2AtRound gets the raw AccountData associated with the passed address
+// as of the end of the given round. Old rounds require an archival node with account history.
+func (client RestClient) RawAccountInformationV2AtRound(address string, round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/accounts/%s", address), rawAccountParams{Format: "msgpack", Round: round})
+	response = blob
+	return
+}
+
 // TransactionInformation gets information about a specific transaction involving a specific account
 func (client RestClient) TransactionInformation(accountAddress, transactionID string) (response v1.Transaction, err error) {
 	transactionID = stripTransaction(transactionID)
@@ -505,6 +568,49 @@ func (client RestClient) RawBlock(round uint64) (response []byte, err error) {
 	return
 }
 
+// RawBlockHeader gets the encoded, raw msgpack block header for the given round
+func (client RestClient) RawBlockHeader(round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/blocks/%d/header", round), rawFormat{Format: "msgpack"})
+	response = blob
+	return
+}
+
+// BlockHash gets the hash of the block for the given round
+func (client RestClient) BlockHash(round uint64) (response generatedV2.BlockHashResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d/hash", round), nil)
+	return
+}
+
+// BlockHeadersCommitment gets the Merkle commitment to the block headers of rounds [first, last],
+// along with the proof of membership of the header of proofRound when it is not nil
+func (client RestClient) BlockHeadersCommitment(first, last uint64, proofRound *uint64) (response generatedV2.BlockHeadersCommitmentResponse, err error) {
+	err = client.get(&response, "/v2/blockheaders/commitment", blockHeadersCommitmentParams{first, last, proofRound})
+	return
+}
+
+// RawLedgerStateDelta gets the encoded, raw msgpack ledger state delta for the given round
+func (client RestClient) RawLedgerStateDelta(round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/deltas/%d", round), rawFormat{Format: "msgpack"})
+	response = blob
+	return
+}
+
+// TransactionPool gets the fee levels of the transaction pool and up to max of its pending transaction
+// groups, sorted by decreasing fee per byte. If max is 0, all the pending groups are returned.
+func (client RestClient) TransactionPool(max uint64) (response privateV2.TransactionPoolResponse, err error) {
+	err = client.get(&response, "/v2/transactions/pool", transactionPoolParams{max})
+	return
+}
+
+// EvictPendingTransactions removes from the transaction pool the groups holding any of the given
+// transactions or any transaction sent by sender
+func (client RestClient) EvictPendingTransactions(txids []string, sender string) (response privateV2.EvictPendingTransactionsResponse, err error) {
+	err = client.submitForm(&response, "/v2/transactions/pool", evictTransactionsParams{txids, sender}, "DELETE", false, true)
+	return
+}
+
 // Shutdown requests the node to shut itself down
 func (client RestClient) Shutdown() (err error) {
 	response := 1
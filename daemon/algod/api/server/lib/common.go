@@ -17,6 +17,8 @@
 package lib
 
 import (
+	"context"
+	"net"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -54,6 +56,22 @@ type ReqContext struct {
 	Shutdown <-chan struct{}
 }
 
+type connContextKey struct{}
+
+// ConnContext saves the connection of the requests in their context, so that
+// long running handlers can extend the timeouts of the server. It is meant to
+// be the ConnContext of the http.Server.
+func ConnContext(ctx context.Context, conn net.Conn) context.Context {
+	return context.WithValue(ctx, connContextKey{}, conn)
+}
+
+// GetRequestConnection returns the connection of request, or nil if the
+// server did not save it with ConnContext.
+func GetRequestConnection(request *http.Request) net.Conn {
+	conn, _ := request.Context().Value(connContextKey{}).(net.Conn)
+	return conn
+}
+
 // ErrorResponse sets the specified status code (should != 200), and fills in the
 // a human readable error.
 func ErrorResponse(w http.ResponseWriter, status int, internalErr error, publicErr string, logger logging.Logger) {
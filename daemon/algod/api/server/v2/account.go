@@ -131,6 +131,10 @@ func AccountDataToAccount(
 		AppsTotalSchema:             &totalAppSchema,
 		AppsTotalExtraPages:         numOrNil(totalExtraPages),
 		MinBalance:                  minBalance.Raw,
+		TotalAppsOptedIn:            uint64(len(record.AppLocalStates)),
+		TotalAssetsOptedIn:          uint64(len(record.Assets)),
+		TotalCreatedApps:            uint64(len(record.AppParams)),
+		TotalCreatedAssets:          uint64(len(record.AssetParams)),
 	}, nil
 }
 
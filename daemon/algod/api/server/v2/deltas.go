@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// preEncodedModifiedCreatable is the encoded form of a ledgercore.ModifiedCreatable.
+type preEncodedModifiedCreatable struct {
+	Index   uint64         `codec:"index"`
+	Type    uint64         `codec:"type"`
+	Created bool           `codec:"created"`
+	Creator basics.Address `codec:"creator"`
+}
+
+// preEncodedLedgerStateDelta is the encoded form of a ledgercore.StateDelta.
+// Accounts and creatables are sorted so that the encoding is deterministic.
+type preEncodedLedgerStateDelta struct {
+	Accounts        []basics.BalanceRecord        `codec:"accounts"`
+	Creatables      []preEncodedModifiedCreatable `codec:"creatables,omitempty"`
+	Totals          ledgercore.AccountTotals      `codec:"totals"`
+	PrevTimestamp   int64                         `codec:"prev-timestamp"`
+	CompactCertNext uint64                        `codec:"compact-cert-next,omitempty"`
+}
+
+// convertLedgerStateDelta converts a ledgercore.StateDelta to its encoded form.
+func convertLedgerStateDelta(delta *ledgercore.StateDelta) *preEncodedLedgerStateDelta {
+	encoded := preEncodedLedgerStateDelta{
+		Accounts:        make([]basics.BalanceRecord, delta.Accts.Len()),
+		Totals:          delta.Totals,
+		PrevTimestamp:   delta.PrevTimestamp,
+		CompactCertNext: uint64(delta.CompactCertNext),
+	}
+	for i := range encoded.Accounts {
+		encoded.Accounts[i].Addr, encoded.Accounts[i].AccountData = delta.Accts.GetByIdx(i)
+	}
+	sort.Slice(encoded.Accounts, func(i, j int) bool {
+		return bytes.Compare(encoded.Accounts[i].Addr[:], encoded.Accounts[j].Addr[:]) < 0
+	})
+
+	for cidx, mc := range delta.Creatables {
+		encoded.Creatables = append(encoded.Creatables, preEncodedModifiedCreatable{
+			Index:   uint64(cidx),
+			Type:    uint64(mc.Ctype),
+			Created: mc.Created,
+			Creator: mc.Creator,
+		})
+	}
+	sort.Slice(encoded.Creatables, func(i, j int) bool {
+		return encoded.Creatables[i].Index < encoded.Creatables[j].Index
+	})
+	return &encoded
+}
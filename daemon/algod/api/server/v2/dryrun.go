@@ -18,6 +18,7 @@ package v2
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -116,6 +117,10 @@ type dryrunDebugReceiver struct {
 	lines         []string
 	history       []generated.DryrunState
 	scratchActive []bool
+
+	// innerPrograms counts the programs of inner application calls, which
+	// are left out of the trace
+	innerPrograms int
 }
 
 func (ddr *dryrunDebugReceiver) updateScratch() {
@@ -197,6 +202,11 @@ func (ddr *dryrunDebugReceiver) stateToState(state *logic.DebugState) generated.
 
 // Register is fired on program creation (DebuggerHook interface)
 func (ddr *dryrunDebugReceiver) Register(state *logic.DebugState) error {
+	// only the program of the dryrun transaction is traced
+	if state.CallDepth > 0 {
+		ddr.innerPrograms++
+		return nil
+	}
 	ddr.disassembly = state.Disassembly
 	ddr.lines = strings.Split(state.Disassembly, "\n")
 	return nil
@@ -204,6 +214,9 @@ func (ddr *dryrunDebugReceiver) Register(state *logic.DebugState) error {
 
 // Update is fired on every step (DebuggerHook interface)
 func (ddr *dryrunDebugReceiver) Update(state *logic.DebugState) error {
+	if state.CallDepth > 0 {
+		return nil
+	}
 	st := ddr.stateToState(state)
 	ddr.history = append(ddr.history, st)
 	ddr.updateScratch()
@@ -339,6 +352,14 @@ func (dl *dryrunLedger) GetCreatorForRound(rnd basics.Round, cidx basics.Creatab
 	return basics.Address{}, false, fmt.Errorf("unknown creatable type %d", ctype)
 }
 
+// errBoxesNotSupported is returned on box access, since dryrun requests do
+// not carry boxes and reporting them all as missing would be misleading
+var errBoxesNotSupported = errors.New("boxes are not supported by dryrun")
+
+func (dl *dryrunLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return nil, errBoxesNotSupported
+}
+
 func makeBalancesAdapter(dl *dryrunLedger, txn *transactions.Transaction, appIdx basics.AppIndex) (ba apply.Balances, err error) {
 	ba = ledger.MakeDebugBalances(dl, basics.Round(dl.dr.Round), protocol.ConsensusVersion(dl.dr.ProtocolVersion), dl.dr.LatestTimestamp)
 
@@ -381,9 +402,13 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 			allowedBudget += uint64(proto.MaxAppProgramCost)
 		}
 	}
+	// budget added by increase_budget, which is paid from overpaid fees
+	budgetAdded := uint64(0)
+	credit, _ := transactions.FeeCredit(dr.Txns, proto.MinTxnFee)
 
 	response.Txns = make([]generated.DryrunTxnResult, len(dr.Txns))
 	for ti, stxn := range dr.Txns {
+		addedBefore := budgetAdded
 		pse := logic.MakePastSideEffects(len(dr.Txns))
 		ep := logic.EvalParams{
 			Txn:                     &stxn,
@@ -392,6 +417,8 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 			GroupIndex:              uint64(ti),
 			PastSideEffects:         pse,
 			PooledApplicationBudget: &pooledAppBudget,
+			BudgetAdded:             &budgetAdded,
+			FeeCredit:               &credit,
 			Specials:                &transactions.SpecialAddresses{},
 		}
 		var result generated.DryrunTxnResult
@@ -509,12 +536,20 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 				}
 
 				// ensure the program has not exceeded execution budget
-				cost := maxCurrentBudget - pooledAppBudget
+				added := budgetAdded - addedBefore
+				cost := maxCurrentBudget + added - pooledAppBudget
+				allowedBudget += added
+				var remaining uint64
+				if !origEnableAppCostPooling {
+					remaining = basics.SubSaturate(uint64(proto.MaxAppProgramCost)+added, cost)
+				} else {
+					remaining = basics.SubSaturate(allowedBudget, cumulativeCost+cost)
+				}
 				if pass {
 					if !origEnableAppCostPooling {
-						if cost > uint64(proto.MaxAppProgramCost) {
+						if cost > uint64(proto.MaxAppProgramCost)+added {
 							pass = false
-							err = fmt.Errorf("cost budget exceeded: budget is %d but program cost was %d", proto.MaxAppProgramCost, cost)
+							err = fmt.Errorf("cost budget exceeded: budget is %d but program cost was %d", uint64(proto.MaxAppProgramCost)+added, cost)
 						}
 					} else if cumulativeCost+cost > allowedBudget {
 						pass = false
@@ -522,6 +557,7 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 					}
 				}
 				result.Cost = &cost
+				result.BudgetRemaining = &remaining
 				maxCurrentBudget = pooledAppBudget
 				cumulativeCost += cost
 
@@ -530,6 +566,9 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 				if err3 != nil {
 					messages = append(messages, err3.Error())
 				}
+				if debug.innerPrograms > 0 {
+					messages = append(messages, fmt.Sprintf("trace omits %d program(s) of inner application calls", debug.innerPrograms))
+				}
 
 				if pass {
 					messages = append(messages, "PASS")
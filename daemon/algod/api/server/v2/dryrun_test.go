@@ -1219,6 +1219,62 @@ return
 
 }
 
+func TestDryrunBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := logic.AssembleString("#pragma version 7\nbyte \"box\"\nbox_len\nreturn")
+	require.NoError(t, err)
+	approval := ops.Program
+	ops, err = logic.AssembleString("#pragma version 7\nint 1")
+	require.NoError(t, err)
+	clst := ops.Program
+
+	var appIdx basics.AppIndex = 1
+	sender := randomAddress()
+	dr := DryrunRequest{
+		Txns: []transactions.SignedTxn{
+			{
+				Txn: transactions.Transaction{
+					Header: transactions.Header{Sender: sender},
+					Type:   protocol.ApplicationCallTx,
+					ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+						ApplicationID: appIdx,
+						Boxes:         []transactions.BoxRef{{Name: []byte("box")}},
+					},
+				},
+			},
+		},
+		Apps: []generated.Application{
+			{
+				Id: uint64(appIdx),
+				Params: generated.ApplicationParams{
+					Creator:           randomAddress().String(),
+					ApprovalProgram:   approval,
+					ClearStateProgram: clst,
+				},
+			},
+		},
+		Accounts: []generated.Account{
+			{
+				Address: sender.String(),
+				Status:  "Offline",
+				Amount:  10000000,
+			},
+		},
+	}
+	dr.ProtocolVersion = string(dryrunProtoVersion)
+
+	// the request has no boxes, so box access fails rather than finding none
+	var response generated.DryrunResponse
+	doDryrunRequest(&dr, &response)
+	require.Len(t, response.Txns, 1)
+	require.NotNil(t, response.Txns[0].AppCallMessages)
+	messages := *response.Txns[0].AppCallMessages
+	require.Equal(t, "REJECT", messages[len(messages)-2])
+	require.Contains(t, messages[len(messages)-1], errBoxesNotSupported.Error())
+}
+
 func TestDryrunCost(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -1333,6 +1389,65 @@ func TestDryrunCost(t *testing.T) {
 	}
 }
 
+func TestDryrunIncreaseBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[dryrunProtoVersion]
+	hashes := "byte 0x41\n" + strings.Repeat("keccak256\n", 8) + "pop\nint 1\n"
+	var tests = []struct {
+		msg       string
+		source    string
+		fee       uint64
+		cost      uint64
+		remaining uint64
+	}{
+		{"cost budget exceeded", hashes, proto.MinTxnFee, 1043, 0},
+		{"fee credit too small", "int 1\nincrease_budget\n" + hashes, proto.MinTxnFee, 3, 697},
+		{"PASS", "int 1\nincrease_budget\n" + hashes, 2 * proto.MinTxnFee, 1046, 354},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			ops, err := logic.AssembleString("#pragma version 7\n" + test.source)
+			require.NoError(t, err)
+
+			var appIdx basics.AppIndex = 1
+			sender := randomAddress()
+			dr := DryrunRequest{
+				Txns: []transactions.SignedTxn{{
+					Txn: transactions.Transaction{
+						Header: transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: test.fee}},
+						Type:   protocol.ApplicationCallTx,
+						ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+							ApplicationID: appIdx,
+						},
+					},
+				}},
+				Apps: []generated.Application{{
+					Id: uint64(appIdx),
+					Params: generated.ApplicationParams{
+						Creator:           randomAddress().String(),
+						ApprovalProgram:   ops.Program,
+						ClearStateProgram: ops.Program,
+					},
+				}},
+			}
+			dr.ProtocolVersion = string(dryrunProtoVersion)
+			var response generated.DryrunResponse
+			doDryrunRequest(&dr, &response)
+			require.Empty(t, response.Error)
+			require.Len(t, response.Txns, 1)
+
+			txn := response.Txns[0]
+			require.Equal(t, test.cost, *txn.Cost)
+			require.Equal(t, test.remaining, *txn.BudgetRemaining)
+			messages := *txn.AppCallMessages
+			require.Contains(t, messages[len(messages)-1], test.msg)
+		})
+	}
+}
+
 func TestDebugTxSubmit(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
@@ -1425,6 +1540,73 @@ int 1`
 	}
 }
 
+func TestDryrunInnerAppCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := logic.AssembleString(`#pragma version 6
+itxn_begin
+int appl
+itxn_field TypeEnum
+int 200
+itxn_field ApplicationID
+itxn_submit
+int 1`)
+	require.NoError(t, err)
+	caller := ops.Program
+	ops, err = logic.AssembleString("#pragma version 6\nint 2\nint 1\n-")
+	require.NoError(t, err)
+	callee := ops.Program
+
+	callerIdx := basics.AppIndex(100)
+	sender := randomAddress()
+	dr := DryrunRequest{
+		Txns: []transactions.SignedTxn{{
+			Txn: transactions.Transaction{
+				Type:   protocol.ApplicationCallTx,
+				Header: transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 2000}},
+				ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+					ApplicationID: callerIdx,
+					ForeignApps:   []basics.AppIndex{200},
+				},
+			},
+		}},
+		Apps: []generated.Application{{
+			Id:     uint64(callerIdx),
+			Params: generated.ApplicationParams{Creator: sender.String(), ApprovalProgram: caller, ClearStateProgram: callee},
+		}, {
+			Id:     200,
+			Params: generated.ApplicationParams{Creator: sender.String(), ApprovalProgram: callee, ClearStateProgram: callee},
+		}},
+		Accounts: []generated.Account{{
+			Address: sender.String(), Status: "Offline", Amount: 10000000, AmountWithoutPendingRewards: 10000000,
+		}, {
+			Address: callerIdx.Address().String(), Status: "Offline", Amount: 10000000, AmountWithoutPendingRewards: 10000000,
+		}, {
+			Address: basics.Address{}.String(), Status: "Offline",
+		}},
+	}
+	dr.ProtocolVersion = string(dryrunProtoVersion)
+
+	var response generated.DryrunResponse
+	doDryrunRequest(&dr, &response)
+	require.Empty(t, response.Error)
+	checkAppCallPass(t, &response)
+	if t.Failed() {
+		logResponse(t, &response)
+	}
+
+	// the cost includes the inner program, and the budget the inner app call
+	proto := config.Consensus[dryrunProtoVersion]
+	require.Equal(t, uint64(10), *response.Txns[0].Cost)
+	require.Equal(t, uint64(2*proto.MaxAppProgramCost-10), *response.Txns[0].BudgetRemaining)
+
+	// the trace has the seven opcodes and the completion of the caller only,
+	// and the response says so
+	require.Len(t, *response.Txns[0].AppCallTrace, 8)
+	require.Contains(t, *response.Txns[0].AppCallMessages, "trace omits 1 program(s) of inner application calls")
+}
+
 func TestDryrunBalanceWithReward(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -22,6 +22,7 @@ var (
 	errFailedLookingUpLedger                   = "failed to retrieve information from the ledger"
 	errFailedLookingUpTransactionPool          = "failed to retrieve information from the transaction pool"
 	errFailedRetrievingNodeStatus              = "failed retrieving node status"
+	errFailedRetrievingStateDelta              = "failed retrieving state delta: %v"
 	errFailedRetrievingLatestBlockHeaderStatus = "failed retrieving latests block header"
 	errFailedParsingFormatOption               = "failed to parse the format option"
 	errFailedToParseAddress                    = "failed to parse the address"
@@ -39,4 +40,25 @@ var (
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
+	errFailedSettingSyncRound                  = "failed to set the sync round on the ledger"
+	errSyncRoundNotSet                         = "sync round not set"
+	errIndexerNotActive                        = "the archival index is not enabled on this node"
+	errIndexedTransactionNotFound              = "could not find the transaction in the archival index"
+	errFailedSearchingIndex                    = "failed to search the archival index"
+	errFailedToParseNotePrefix                 = "failed to parse the note prefix"
+	errInvalidDevModeMintCount                 = "the number of blocks to mint must be between 1 and %d"
+	errRequestedRoundInFuture                  = "requested round %d is after the latest round %d"
+	errAccountRoundUnavailable                 = "the account state is not available for round %d"
+	errFailedMintingBlocks                     = "failed to mint developer mode blocks"
+	errInvalidTimeStampOffset                  = "block timestamp offset %d is out of range"
+	errFailedToParseExclude                    = "invalid exclude argument %s"
+	errAccountAssetNotFound                    = "the account neither holds nor created the asset"
+	errAccountAppNotFound                      = "the account neither opted in to nor created the application"
+	errBlockNotFound                           = "the block is not available on this node"
+	errInvalidBlockHeadersRange                = "invalid round range [%d, %d]: the range must cover at most %d rounds"
+	errProofRoundOutOfRange                    = "proof round %d is outside of the range [%d, %d]"
+	errFailedToEncodeSourceMap                 = "failed to encode the source map"
+	errNoEvictionCriteria                      = "no transaction ID nor sender was specified"
+	errFailedToParseBoxName                    = "failed to parse the box name"
+	errBoxDoesNotExist                         = "box not found"
 )
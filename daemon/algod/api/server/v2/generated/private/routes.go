@@ -23,6 +23,30 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Mint developer mode blocks.
+	// (POST /v2/devmode/blocks/mint/{count})
+	MintDevModeBlocks(ctx echo.Context, count uint64) error
+	// Returns the developer mode block timestamp offset.
+	// (GET /v2/devmode/blocks/offset)
+	GetBlockTimeStampOffset(ctx echo.Context) error
+	// Set the developer mode block timestamp offset.
+	// (POST /v2/devmode/blocks/offset/{offset})
+	SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error
+	// Resume the automatic developer mode block production.
+	// (DELETE /v2/devmode/blocks/pause)
+	ResumeDevModeBlocks(ctx echo.Context) error
+	// Pause the automatic developer mode block production.
+	// (POST /v2/devmode/blocks/pause)
+	PauseDevModeBlocks(ctx echo.Context) error
+	// Removes the sync round restriction from the ledger.
+	// (DELETE /v2/ledger/sync)
+	UnsetSyncRound(ctx echo.Context) error
+	// Returns the round the ledger is held at.
+	// (GET /v2/ledger/sync)
+	GetSyncRound(ctx echo.Context) error
+	// Given a round, tells the ledger not to advance beyond it.
+	// (POST /v2/ledger/sync/{round})
+	SetSyncRound(ctx echo.Context, round uint64) error
 	// Return a list of participation keys
 	// (GET /v2/participation)
 	GetParticipationKeys(ctx echo.Context) error
@@ -41,6 +65,12 @@ type ServerInterface interface {
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
+	// Evict transactions from the transaction pool.
+	// (DELETE /v2/transactions/pool)
+	EvictPendingTransactions(ctx echo.Context, params EvictPendingTransactionsParams) error
+	// Get the transaction pool status.
+	// (GET /v2/transactions/pool)
+	GetTransactionPool(ctx echo.Context, params GetTransactionPoolParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -108,6 +138,211 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// MintDevModeBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) MintDevModeBlocks(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "count" -------------
+	var count uint64
+
+	err = runtime.BindStyledParameter("simple", false, "count", ctx.Param("count"), &count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MintDevModeBlocks(ctx, count)
+	return err
+}
+
+// GetBlockTimeStampOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockTimeStampOffset(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockTimeStampOffset(ctx)
+	return err
+}
+
+// SetBlockTimeStampOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) SetBlockTimeStampOffset(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "offset" -------------
+	var offset uint64
+
+	err = runtime.BindStyledParameter("simple", false, "offset", ctx.Param("offset"), &offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetBlockTimeStampOffset(ctx, offset)
+	return err
+}
+
+// ResumeDevModeBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) ResumeDevModeBlocks(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ResumeDevModeBlocks(ctx)
+	return err
+}
+
+// PauseDevModeBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) PauseDevModeBlocks(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PauseDevModeBlocks(ctx)
+	return err
+}
+
+// UnsetSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) UnsetSyncRound(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnsetSyncRound(ctx)
+	return err
+}
+
+// GetSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSyncRound(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSyncRound(ctx)
+	return err
+}
+
+// SetSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) SetSyncRound(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetSyncRound(ctx, round)
+	return err
+}
+
 // GetParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetParticipationKeys(ctx echo.Context) error {
 
@@ -280,6 +515,89 @@ func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	return err
 }
 
+// EvictPendingTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) EvictPendingTransactions(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"txid":   true,
+		"sender": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EvictPendingTransactionsParams
+	// ------------- Optional query parameter "txid" -------------
+	if paramValue := ctx.QueryParam("txid"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Optional query parameter "sender" -------------
+	if paramValue := ctx.QueryParam("sender"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sender", ctx.QueryParams(), &params.Sender)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sender: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.EvictPendingTransactions(ctx, params)
+	return err
+}
+
+// GetTransactionPool converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionPool(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"max":    true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionPoolParams
+	// ------------- Optional query parameter "max" -------------
+	if paramValue := ctx.QueryParam("max"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTransactionPool(ctx, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -299,156 +617,225 @@ func RegisterHandlers(router interface {
 
 	router.DELETE("/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST("/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.POST("/v2/devmode/blocks/mint/:count", wrapper.MintDevModeBlocks, m...)
+	router.GET("/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
+	router.POST("/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
+	router.DELETE("/v2/devmode/blocks/pause", wrapper.ResumeDevModeBlocks, m...)
+	router.POST("/v2/devmode/blocks/pause", wrapper.PauseDevModeBlocks, m...)
+	router.DELETE("/v2/ledger/sync", wrapper.UnsetSyncRound, m...)
+	router.GET("/v2/ledger/sync", wrapper.GetSyncRound, m...)
+	router.POST("/v2/ledger/sync/:round", wrapper.SetSyncRound, m...)
 	router.GET("/v2/participation", wrapper.GetParticipationKeys, m...)
 	router.POST("/v2/participation", wrapper.AddParticipationKey, m...)
 	router.DELETE("/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET("/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST("/v2/participation/:participation-id", wrapper.AppendKeys, m...)
 	router.POST("/v2/shutdown", wrapper.ShutdownNode, m...)
+	router.DELETE("/v2/transactions/pool", wrapper.EvictPendingTransactions, m...)
+	router.GET("/v2/transactions/pool", wrapper.GetTransactionPool, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9f3PbtrLoV8Ho3pk0eaLkJE7PiWc697lx2uPXNM3Ebu97N85rIXIloSYBFgAtqXn+",
-	"7m+wAEiQBCX5x3VP5uSvxCKwWCx2F7uLxeLTKBVFKThwrUZHn0YllbQADRL/omkqKq4Tlpm/MlCpZKVm",
-	"go+O/DeitGR8MRqPmPm1pHo5Go84LaBpY/qPRxL+qJiEbHSkZQXjkUqXUFADWG9K07qGtE4WInEgji2I",
-	"05PR9ZYPNMskKNXH8ieebwjjaV5lQLSkXNHUfFJkxfSS6CVTxHUmjBPBgYg50ctWYzJnkGdq4if5RwVy",
-	"E8zSDT48pesGxUSKHPp4vhLFjHHwWEGNVL0gRAuSwRwbLakmZgSDq2+oBVFAZbokcyF3oGqRCPEFXhWj",
-	"ow8jBTwDiauVArvC/84lwJ+QaCoXoEcfx7HJzTXIRLMiMrVTR30Jqsq1ItgW57hgV8CJ6TUhP1ZKkxkQ",
-	"ysn7716R58+fvzQTKajWkDkmG5xVM3o4J9t9dDTKqAb/uc9rNF8ISXmW1O3ff/cKxz9zE9y3FVUK4sJy",
-	"bL6Q05OhCfiOERZiXMMC16HF/aZHRCian2cwFxL2XBPb+F4XJRz/L12VlOp0WQrGdWRdCH4l9nNUhwXd",
-	"t+mwGoFW+9JQShqgHw6Slx8/PR0/Pbj+tw/HyX+5P188v95z+q9quDsoEG2YVlICTzfJQgJFaVlS3qfH",
-	"e8cPaimqPCNLeoWLTwtU9a4vMX2t6ryieWX4hKVSHOcLoQh1bJTBnFa5Jn5gUvHcqCkDzXE7YYqUUlyx",
-	"DLKx0b6rJUuXJKXKgsB2ZMXy3PBgpSAb4rX47LYI03VIEoPXreiBE/rnJUYzrx2UgDVqgyTNhYJEix3b",
-	"k99xKM9IuKE0e5W62WZFzpdAcHDzwW62SDtueDrPN0TjumaEKkKJ35rGhM3JRlRkhYuTs0vs72ZjqFYQ",
-	"QzRcnNY+aoR3iHw9YkSINxMiB8qReF7u+iTjc7aoJCiyWoJeuj1PgioFV0DE7HdItVn2/3X201siJPkR",
-	"lKILeEfTSwI8FdnwGrtBYzv470qYBS/UoqTpZXy7zlnBIij/SNesqArCq2IG0qyX3x+0IBJ0JfkQQhbi",
-	"Dj4r6Lo/6LmseIqL2wzbMtQMKzFV5nQzIadzUtD1Nwdjh44iNM9JCTxjfEH0mg8aaWbs3eglUlQ828OG",
-	"0WbBgl1TlZCyOYOM1FC2YOKG2YUP4zfDp7GsAnQ8kEF06lF2oMNhHeEZI7rmCynpAgKWmZCfnebCr1pc",
-	"Aq8VHJlt8FMp4YqJStWdBnDEobeb11xoSEoJcxbhsTNHDqM9bBunXgtn4KSCa8o4ZEbzItJCg9VEgzgF",
-	"A253Zvpb9Iwq+PpwaANvvu65+nPRXfWtK77XamOjxIpkZF80X53Axs2mVv89nL9wbMUWif25t5BscW62",
-	"kjnLcZv53ayfJ0OlUAm0COE3HsUWnOpKwtEFf2L+Igk505RnVGbml8L+9GOVa3bGFuan3P70RixYesYW",
-	"A8SscY16U9itsP8YeHF1rNdRp+GNEJdVGU4obXmlsw05PRlaZAvzpox5XLuyoVdxvvaexk176HW9kANI",
-	"DtKupKbhJWwkGGxpOsd/1nPkJzqXf5p/yjKP0dQwsNtoMSjgggXv3W/mJyPyYH0CA4Wl1BB1itvn0acA",
-	"oX+XMB8djf5t2kRKpvarmjq4ZsTr8ei4gXP/IzU97fw6jkzzmTBuVwebjq1PeP/4GKhRTNBQ7eDwbS7S",
-	"y1vhUEpRgtTMruPMwOlLCoInS6AZSJJRTSeNU2XtrAF+x47/wH7oJYGMbHE/4X9oTsxnI4VUe/PNmK5M",
-	"GSNOBIGmzFh8dh+xI5kGaIkKUlgjjxjj7EZYvmoGtwq61qgfHFk+dqFFVue1tSsJ9vCTMFNvvMbjmZC3",
-	"45cOI3DS+MKEGqi19Wtm3l5ZbFqViaNPxJ62DTqAmvBjX62GFOqCj9GqRYUzTf8bqKAM1PugQhvQfVNB",
-	"FCXL4R7kdUnVsj8JY+A8f0bO/nH84umzX5+9+Nrs0KUUC0kLMttoUOQrt68QpTc5PO7PDBV8les49K8P",
-	"vQfVhruTQohwDXsfiToHoxksxYiNFxjsTuRGVvweSAhSChmxeZF1tEhFnlyBVExEwhfvXAviWhg9ZO3u",
-	"zu8WW7Kiipix0R2reAZyEqO88bNwS9dQqF0bhQV9vuYNbRxAKiXd9FbAzjcyOzfuPmvSJr637hUpQSZ6",
-	"zUkGs2oR7lFkLkVBKMmwIyrEtyKDM011pe5BCzTAGmTMQoQo0JmoNKGEi8wItGkc1w8DsUwMomDsR4cq",
-	"Ry/t/jMDYx2ntFosNTFmpYgtbdMxoaldlAT3CjXg+tU+u21lh7NxslwCzTZkBsCJmDn/ynl+OEmKYRnt",
-	"T1ycdmrQqn2CFl6lFCkoBVnijpd2oubb2VXWW+iEiCPC9ShECTKn8pbIaqFpvgNRbBNDtzYnnFPax3q/",
-	"4bctYHfwcBmpND6m5QJjuxjpzkHDEAn3pMkVSHTO/lvXzw9y2+WryoGjE7cDn7PCiC/hlAsFqeCZigLL",
-	"qdLJLrE1jVpmgplBICkxSUXAAwGCN1Rp66IznqHJaNUNjoN9cIhhhAd3FAP5F7+Z9GGnRk9yVal6Z1FV",
-	"WQqpIYvNgcN6y1hvYV2PJeYB7Hr70oJUCnZBHqJSAN8Ry87EEohqFyOqY1j9yWE43uwDmygpW0g0hNiG",
-	"yJlvFVA3DB8PIGL8i7onMg5THc6pY9bjkdKiLI386aTidb8hMp3Z1sf656Ztn7mobvR6JsCMrj1ODvOV",
-	"paw9OFhSY9shZFLQS7M3oaVmYwl9nI0wJorxFJJtnG/E8sy0CkVgh5AOGMnuaDIYrSMcHf6NMt0gE+xY",
-	"haEJD1js76jULGUlWhI/wObe3f3uAFHPn2SgKcshI8EHVOCoe+v+xAaHujBvZ2jtZYT20e9ZoZHp5Ezh",
-	"htFG/hI2CtG3pw7nwVnFPViKEahGuikniKiPZZoNOWwCa5rqfGO2Ob2EDVmBBKKqWcG0tsdIbUNSizIJ",
-	"AUQd1y0jutCBjdj7FdgnlnGGoILp9ZdiPLJmy3b8zjuGS4sczmAqhcgnuyW+R4woBvs4HsekFGbVmTu1",
-	"9EdbnpNaSDojBuNGtfJ8pFpkxhmQ/yMqklKOBlilod4RhEQ1i9uvGcFsYPWYzFo6DYUghwKsXYlfnjzp",
-	"TvzJE7fmTJE5rPxRv2nYJceTJ+glvRNKt4TrHjxeI26nEd2OHr3ZKJwN19Upk53evYO8z0q+6wD3g6JM",
-	"KeUY10z/zgqgI5nrfeYe8siSquXuuSPcvQIaAejYvO26SyHm9zBblq1j51sZrGMzdYyLPsojY9BvFOhJ",
-	"1PYqDYKRI26QlzkGQMS8I5CkACMpaslKA7I5jttoaKXy/N+v/uPow3HyXzT58yB5+T+mHz8dXj9+0vvx",
-	"2fU33/y/9k/Pr795/B//HrNXlWazeLDsH1QtDaZOca75Kbfh7rmQ1svZOONJzB8a7w6LmcX0lA+mtJe4",
-	"xRaEcULtYiPPGds439zDHmsBEQmlBIUaMfQplf0q5mEmj+M8tVEain5Yxnb9dcAofe9Nuh6XCp4zDkkh",
-	"OGyiyauMw4/4MdbbauWBzrg/DvXtmrwt/DtotcfZZzHvSl9c7UANvavziu5h8btwOxG5MIcJIwqQl4SS",
-	"NGcYbxBcaVml+oJT9GgCdo1E872fNuzjvvJN4k51xOd1oC44VYaGtZ8TjdTOIRLB+A7Au7qqWixA6Y5t",
-	"Nwe44K4V46TiTONYhVmvxC5YCRJD6hPbsqAbMqc5uuR/ghRkVum2tYOpFkobj9mGB80wRMwvONUkB6o0",
-	"+ZHx8zWC8xkNnmc46JWQlzUV4jp/ARwUU0lckX5vv6I+ddNfOt2Kea/2s9c3D70BeNxjiQAO89MT5wmc",
-	"nqC51wQGe7g/WLSoYDyJMtn5EkjBOOaTdXiLfGWMVs9Aj5sQo1v1C67X3DDSFc1ZRvXt2KGr4nqyaKWj",
-	"wzWtheg4/36uH2OntguRlDS9xEO70YLpZTWbpKKYeg9ouhC1NzTNKBSC47dsSks2VSWk06unO8yxO+gr",
-	"ElFX1+OR0zrq3uMFDnBsQt0x67Cb/1sL8uj71+dk6lZKPbJZQRZ0kM4RcVrdpZTWuYqZvM1qt2lRF/yC",
-	"n8CccWa+H13wjGo6nVHFUjWtFMhvaU55CpOFIEfEgTyhml7wnoofvHiCObsOm7Ka5Swll+FW3IimTSbu",
-	"Q7i4+GAY5OLiYy9I39843VBRGbUDJCuml6LSicuWTCSsqMwiqKs6Ww4h21znbaOOiYNtOdJlYzr4cVVN",
-	"y1IluUhpnihNNcSnX5a5mX7AhopgJ0zyIEoL6ZWg0YwWG1zft8IdU0i68qm2lQJFfito+YFx/ZEkF9XB",
-	"wXMgx2X5xsA8M3j85nSN4clNCa3wxp7pOQ2wWGgDJ24NKlhrSZOSLkBFp6+Blrj6uFEXGEjLc4LdQprU",
-	"R9wIqpmAp8fwAlg8bpyAhJM7s738tZf4FPATLiG2MdqpiU/fdr0MqH+I3DDZrZcrgBFdpUovEyPb0Vkp",
-	"w+J+Zeps+IXRyf7QQLEFN0LgLg7MgKRLSC8hwxxmKEq9Gbe6+3Mpt8N51cGUzfW3eUaYkIqRoBmQqsyo",
-	"swEo33QzAxVo7dMh38MlbM5Fk896k1TA6/Eotdn3ieGZIUFFTg02I8Osodg6GN3Fd2ecBlNalmSRi5mT",
-	"7potjmq+8H2GBdnukPcgxDGmqMmwhd9LKiOEsMw/QIJbTNTAuxPrx6ZnzJuZ3fkicROv+4lr0lht7pwy",
-	"nM35sv5eAF4cEitFZlRBRoS782KvjwRarFJ0AQPBnDAYt2dOZiuAh0B27XvRnU7Muxtab7+JomwbJ2bO",
-	"UU4B88WwihHv7um0H8nGe3EGE4JXWR3BZjmaSfXBuFU6VLaCovZu3hBqcQYGyRuDw6PRpkho2Syp8tdx",
-	"8NaSl+W9bIChI7z6CNYwuD+DRVe0MeqYGTeHKzpE/+Ec8tPgYDW4mlRniHud25XTcX1bwN4S9pnkPn3c",
-	"54yPxjfK/x6PXK5PbDkERwMogxwWduK2sWcUh9ojFSyQweOn+TxnHEgSO6OlSomU2ftUzTbjxgBjHz8h",
-	"xMaeyN4QYmwcoI3nGAiYvBWhbPLFTZDkwPDgg3rYeAIS/A27A+HNdW1nee+0kNu6sa9JGpEaN5cr7KL2",
-	"w2XjUVRBDbky7XMI22QGPd8vxrBGUfUDSP0wlYIc0G5IWno2uYyFFY35A8iUZ75b4N+Qr9jcWCOPg8Mt",
-	"CQumNDQOvpFdH7F62CDLldCQzJlUOsHYQnR6ptF3Cq3W70zTuDJqHz7Z258si+siHPYSNknG8iq+2m7c",
-	"H07MsG9rR09Vs0vY4JYDNF2SGd5Wjh5JbxnaZi1snfAbO+E39N7mux8vmaZmYCmE7ozxmXBVR7tsE6YI",
-	"A8aYo79qgyTdol7QSTuBXMeS0gOzC91voz7trYnB8EZPmDIPe5sxFmAxrIctpOhcAot86ywYHhkak5Lp",
-	"4LJvP4N2QAZoWbJs3Qk2WKiDJim9kUdhXZPImdmoBraDAkFgIZakJcEHR+ySBjuovbbNw7lN9qKMscVC",
-	"ggQKIRyKKV90pE8ow9p4M34Xrc6B5j/A5hfTFqczuh6P7habiNHaQdxB63f18kbpjEF366u2Qo03JDkt",
-	"SymuaJ64CM4Qa0px5VgTm/uAzwOrunic4Pz18Zt3Dn3jJOdApY3pbZ0Vtis/m1kZ113IAQHxRQ2M7eqd",
-	"fGuIBYtf3xQLoz6rJbgL5IEtZ7SYYy4rXk1ELxBFFwWax8/+dsZ0XPDRTnFLEBLKOgbZ+Mc2BNkOO9Ir",
-	"ynLvmHpsB87pcHJN4PfGWiEEcOfwZRCFTu5V3fSkOy4dDXft0EnhWFuuuBe2ioMigncTwIwJif4usmpB",
-	"N4aDbBS9r5x4VSRG/BKVszQexOAzZZiD2+C0aUyw8YAxaiBWbOCsg1csgGWaqT2O9TpIBmNEiYmxry20",
-	"mwlXfqvi7I8KCMuAa/NJolR2BNXIpS/h0t9Oje3QH8sBtiGwBvxdbAwDasi6QCS2GxhhKLyH7kntcPqJ",
-	"1jF880MQ87vBiVo4Ym9L3HIa5vjDcbNNS1i2Q9phtay+/jOMYSsr7C7V5YMYS4vowBjR0luDu8Xx8E5h",
-	"et9gj2i2BEQ33AzGNrKaKxEBU/EV5baSjulnaeh6K7AxA9NrJSReSVEQTSdgKplL8SfEPdm5WahIjqoj",
-	"JZqL2HsSSfXvKtE6RtPUSPP0DfEYZO0hSy74SNonngMSjlwexPjxjrcPd1Fu2dpW/Wmds8eFI8yNmVr4",
-	"jXA4nHv5RDldzWjsArwxqAxOx81pUiswpwXxnf0quBhiw3vBwVTdltl7HCXIJpG8f2fwlsbR58XyGaSs",
-	"oHncSsqQ+u1baxlbMFs6qVIQ1OZxgGzNOctFrr6RPa9rSHM6JwfjoPqXW42MXTHFZjlgi6e2xYwq3LXq",
-	"4GvdxUwPuF4qbP5sj+bLimcSMr1UlrBKkNqARVeujoTPQK8AODnAdk9fkq/wDECxK3hsqOhskdHR05cY",
-	"RLV/HMQ2O1cjbZteyVCx/KdTLHE+xkMQC8NsUg7qJHqnyBa2HFZhW6TJdt1HlrCl03q7ZamgnC4gfuxc",
-	"7MDJ9sXVxKBhhy48s1XZlJZiQ5iOjw+aGv00kENn1J9Fg6SiKJjG4z0tiBKF4aem8I4d1IOzJd5cMQyP",
-	"l/+IBy6ldRug6zA/bIDY7uWxWeOx2FtaQJusY0Lt1bucNUehTiFOyKm/wIvVQeqiIJY2ZiwzdTTp8GR0",
-	"TkrJuEYnqtLz5O8kXVJJU6P+JkPoJrOvDyMVUdpFEPjNEH9wuktQIK/ipJcDbO+tCdeXfMUFTwqjUbLH",
-	"Tc5qIJXRUgZC0zyefeM1ejf5ajvofQ1QAyUZZLeqxW400NR3Yjy+BeAdWbGez4348cYze3DOrGScPWhl",
-	"Vujn92+clVEIGSvn0Ii7szgkaMngChOB4otkYN5xLWS+1yrcBfu/9pSl8QBqs8zLcswR+LZiefZLk4Pf",
-	"KSolKU+X0TOOmen4a1MFr56yleNo9YAl5RzyKDi7Z/7q99bI7v+72HecgvE923aLRdnpdibXIN5G0yPl",
-	"BzTkZTo3A4RUbScl11ls+UJkBMdprqo3XNavfxUUzvmjAqVjFXnxg00AxViW8Qts3RYCPEOrekK+t1Ws",
-	"l0BaN2nRmmVFldtbmZAtQLoga1XmgmZjYuCcvz5+Q+yoto+tNmrrxizQmGvPohPDCOpa7JeT5cvIxfNF",
-	"94ezPYHNzFppvNiuNC3K2FUA0+LcN8D7BmFcF828kDoTcmItbOXtNzuI4Yc5k4WxTGtoVscjT5j/aE3T",
-	"JZquLW0yzPL7FzzyXKmCwp91DcW6NAXKncHb1TyyJY/GRBj/YsWULV4MV9C+fVBfxXGuk7+N0J6erDi3",
-	"nBLV0duuit2G7B45e3jvQ79RzDqEv6HhokQlU7hp/acz7BW9690tJtWr+GmvPdYV93xR+pRywVmKN62D",
-	"csk1yq4Q8j7nIntcSu+GpbyIOwmNCFe0hFWdHuSoOFjUyitCR7h+YDb4ahbVcof9U2PF3SXVZAFaOc0G",
-	"2diXKXPxEsYVuFIjWBM70JNCts6aUENGjy+TOsx9QzbCXOQBA/g78+2tc48wSe+ScTSEHNlcPqCNaGCd",
-	"Vm2sJ6bJQoBy82nfHVYfTJ8J3p/NYP1x4uu6Igx7VGOmbc8l+6CO/SmlOxU0bV+ZtgSPZZqfW3nPdtDj",
-	"snSDRq/+1iscK7Q2SODIaVPiw/0BcWv4IbQt7LY1vQD3U8NocIWHk1DiPtxjjLpmXaf45BXNK8tR2ILY",
-	"tJ7ofTXGI2i8YRyaqsORDSKNbgm4MCivA/1UKqm2JuBeOu0caI4nkjGFprQL0d4VVGeBkSQ4Rz/G8DI2",
-	"5fYGFEfdoDHcKN/UxY4NdwfGxCussu4I2S+eh1aVM6IyTOPslNOLKQ6juH0hyvYG0BeDvk1ku2tJreTc",
-	"ZCcaupmTipi9+XoNaWUP3IWt4UHLkqR41TXYL6IRTaaM81TM8kju20n9MahRiSm3sw3+G6usMkwSdyJ+",
-	"45wsf/yNHW9ssLYh9cxNw0yJYotbLnPT/17XOReLNiIPG1DYKuMhy8Sk+7VRm+FlzV7NHqtY67uUmIYk",
-	"fAFjdJrqW0BtmURFHnVKm1q0253y4aqyY1T9A8mI75syAdTuLvaMYSglMR3MoKXaJctrSpo7+X3BtKVg",
-	"YxBsPoMtQWufc4nGV4ZyGGwKg/nc672fXdSzMhH2VoL65Jg+Qj/4zDtSUuYO0BqJ7VPW5ej2s6b3yd5r",
-	"Frg7CZf5ikBiM+nV5trOIb3M5yD33ZZQmux/S7c5kMczEyyAuwDuKuC2cxr3zqyazyHV7GpHpvl/Gou1",
-	"yWIee5vWlg0PEs9ZnanjX/25oandILQtEXwrPkEpgDujM5RnegmbR4q0uCFa02nsGfU2l8CQAlgmITEs",
-	"IlQs+m+dcBeQZarmDKSCP22z3aGpUDNYTLNO94oVJNprLM+ShDo7q672M1S/U8Ss+L3GMl33SLxqsrcx",
-	"JWMoGb1fzm549zrB6oGqLoRcP+sTJFMYZ61bFWrlLqHhvYA67uSvo4Hyv/krNHYU+1xUU+4To3wrKjPf",
-	"Imq2eos4GUjv6iZM27x0Fkd6Xo/MmtyIfs5w5PI25sKkuVCML5KhlKl2OkIdy3+k7KELBgiwTiDiNQfp",
-	"yvxq/xpXooXPpdiGxzZSuMcgbkMENVjbyyI3eI3xfXNPEyvWUPsWmztQCidIJBTUYCeD25TDY24j9iv7",
-	"3SfJ+oolnfpAEbieX5Od1yF9VgxTPSKGXD8nbrfcnXx7G3+BcW6rqKvY1UpuSBlGkkopsiq1G3QoGOD9",
-	"qr0vLm9RJVErP+3Psmew5XiN/01wleESNlNrNKVLypt6Cm2xtsXU7RyCi3ed1b5XVypusOYLO4HFveD5",
-	"V3pC41EpRJ4MhI5O+zdEuzJwydJLyIjZO/x58kBBTfIVRizqs4HVcuPLh5clcMgeTwgxvlRR6o0/JmjX",
-	"RuoMzh/pbeOvcdSsspe2nZM2ueDxVAj7uuEd9ZsHs12r2ed+7ziUBbJ9IL3mA6qNriLlZfd9GScSuO+W",
-	"/GyYymIRs1JueVduL/nuO2oR1g9vOezwfy5bXp2t/tEJ1gsJ9+zdBVHKG3p3/fsb+04P54FarVLQn+fe",
-	"C9Ci7QDt9yF8E5roE3c4oqBn+0QU4pUKTHcMaViCYJkPgqiS357+RiTM3VOrT57gAE+ejF3T3561Pxvv",
-	"68mTqGQ+WDCj9QCPGzfGMb8MHe7aA8yBPILOelQsz3YxRisrpCnBh3kPv7r8mb+kCOCv1kXui6qrh3aT",
-	"MGp3EZAwkbm2Bg+GCvI99kj1cN0iiR242aSVZHqDV5i8R8V+jV4N/74OwrhX3epEcJeHbB8UdWlJTcim",
-	"eQPye2HfZSrMXo+BdY21tF+vaVHm4ATlm0ezv8Hzvx9mB8+f/m3294MXBykcvnh5cEBfHtKnL58/hWd/",
-	"f3F4AE/nX7+cPcueHT6bHT47/PrFy/T54dPZ4dcv//bIP8BoEW0eN/zfWCkzOX53mpwbZBua0JLVJfQN",
-	"G/uqezRFSTQ+ST468j/9Ty9hk1QUwZvx7teRy1EbLbUu1dF0ulqtJmGX6QJ9tESLKl1O/Tj90uXvTuv8",
-	"GXvvAVfUpkYYVsBFdaxwjN/evz47J8fvTicNw4yORgeTg8lTLG5bAqclGx2NnuNPKD1LXPepY7bR0afr",
-	"8Wi6BJrrpfujAC1Z6j+pFV0sQE5c+UHz09WzqT9+n35y/un1tm/tyxYurBB0COpUTT+1nPwshItVnKaf",
-	"/EWU4JN9NGf6Cf20wd/baHzSa5ZdT31YyPVwj09MPzWvwVxb6cghFtKxeU40eDxmbPxofCRP2V+NQPj0",
-	"aqbajwfVq3uamVU1vV7VL+MEt+iPPvyLvpz/sfOQ6LODg3+xJxEPbzjjrbZw6/gqUhv0W5oRn/qHYz99",
-	"uLFPOUbGjUIjVmFfj0cvHnL2p9ywPM0JtgwuxfSX/md+ycWK+5Zmd62KgsqNF2PVUgr+vSvU4XSh0DOS",
-	"7IpqGH1E1zt29j2gXPDtyRsrF3xQ84tyeSjl8nm8NPrshgL++c/4izr93NTpmVV3+6tTZ8rZ7PKpfe6g",
-	"sfB6tSwXEE1zx4Rzuu0Nqq6G/R5070mt0R1VzF/2uta/tpwcHhw+HAbtEoM/wIa8FZp8h8dRn6nM7ic+",
-	"2yyhjmeUZT0mt+oflP5WZJstFCrUonQZoRG7ZMa4Qbm/u/QfAug9eXUJG2KPaH0o3j352LaHru+oAz7b",
-	"17m+6JAvOkTa4Z8/3PBnIK9YCuQcilJIKlm+IT/z+j7P7d26LIumv7VFv6fTjDeSigwWwBOnsJKZyDa+",
-	"bk0L4CXYkHHPUJl+aheftOGvwbDUCf5ev7vRR3q2IacnPQvGdutq2m832LTjMUZ8wi6KWz3Dri4acMa2",
-	"sbmZyEJoYqmQuUl9UTxfFM+djJe9hSdmv0S9CR/I6e7JY3+xNXb1m+r+0Pv4HH+puP7TPnb8RSV8UQm3",
-	"VwnfQ0QYUWqdkogw3W0ivX0FgRlRWZh2bIsLGb/DNa9yKomCfcMUxwjRBSceQks8tJMWpZX10SgnsGYK",
-	"nz2ILNj9+m1fVNwXFfcZnVrtVjRtQ+TGns4lbApa1v6NWlY6EytbECaqFbEuLM1dYTUsdVZnSGhBPIDm",
-	"4hH5yd20yzdmClcsM2acZgUYk6rWdaazTydt8lkNhOa9sAXjOACqChzFVhCkQUq/glRw+7pO56zNYfbW",
-	"+oQxJftHBajRHG0cjqNx67DFLWOkXt+d7a/+2cj1llh6/URO6+/pijKdzIV0N3qQQv0sDA00n7rSB51f",
-	"7QXl4Mfwwf/or9O6KG/0Yze3JPbVpX74Rk1SV5gkhStVp0d9+GgIjnXO3CI2OT9H0ykmuy+F0tPR9fhT",
-	"Jx8o/PixpvGnen91tL7+eP3/AwAA//8tjpe5DaYAAA==",
+	"H4sIAAAAAAAC/+x9+3PcNtLgv4Ka/aqc+GYk+ZHsWlWp7xQryeoSJy7L2b0725fFkD0zWJEAlwAlTXz6",
+	"36+6AZAgCXIoaeys7/MviTXEo9HobjT6hfezROWFkiCNnh2/nxW85DkYKOkvniSqkmYhUvwrBZ2UojBC",
+	"ydmx/8a0KYVcz+Yzgb8W3Gxm85nkOcyOw/7zWQn/qkQJ6ezYlBXMZzrZQM5xYLMtsHU90vVirRZuiBM7",
+	"xNnp7GbkA0/TErTuQ/mLzLZMyCSrUmCm5FLzBD9pdiXMhpmN0Mx1ZkIyJYGpFTObVmO2EpCl+sAv8l8V",
+	"lNtglW7y4SXdNCAuSpVBH87nKl8KCR4qqIGqN4QZxVJYUaMNNwxnQFh9Q6OYBl4mG7ZS5Q5QLRAhvCCr",
+	"fHb8ZqZBplDSbiUgLumfqxLgd1gYXq7BzN7NY4tbGSgXRuSRpZ057Jegq8xoRm1pjWtxCZJhrwP2otKG",
+	"LYFxyV59/5w9efLkGS4k58ZA6ohscFXN7OGabPfZ8SzlBvznPq3xbK1KLtNF3f7V989p/nO3wKmtuNYQ",
+	"Z5YT/MLOTocW4DtGSEhIA2vahxb1Y48IUzQ/L2GlSpi4J7bxXjclnP8P3ZWEm2RTKCFNZF8YfWX2c1SG",
+	"Bd3HZFgNQKt9gZgqcdA3R4tn794/mj86uvnTm5PF/3Z/fvXkZuLyn9fj7sBAtGFSlSXIZLtYl8CJWzZc",
+	"9vHxytGD3qgqS9mGX9Lm85xEvevLsK8VnZc8q5BORFKqk2ytNOOOjFJY8SozzE/MKpmB1jSao3YmNCtK",
+	"dSlSSOdMSHa1EcmGJVzbIagduxJZhjRYaUiHaC2+uhFmuglRgnDdCR+0oH9fZDTr2oEJuCZpsEgypWFh",
+	"1I7jyZ84XKYsPFCas0rf7rBirzfAaHL8YA9bwp1Ems6yLTO0rynjmnHmj6Y5Eyu2VRW7os3JxAX1d6tB",
+	"rOUMkUab0zpHkXmH0NdDRgR5S6Uy4JKQ5/mujzK5EuuqBM2uNmA27swrQRdKamBq+U9IDG77/zj/5Wem",
+	"SvYCtOZreMmTCwYyUenwHrtJYyf4P7XCDc/1uuDJRfy4zkQuIiC/4Ncir3Imq3wJJe6XPx+MYiWYqpRD",
+	"ANkRd9BZzq/7k74uK5nQ5jbTthQ1JCWhi4xvD9jZiuX8+pujuQNHM55lrACZCrlm5loOKmk4927wFqWq",
+	"ZDpBhzG4YcGpqQtIxEpAyupRRiBx0+yCR8jbwdNoVgE4Qu4AR8hp4Ei4jtAMsi5+YQVfQ0AyB+xXJ7no",
+	"q1EXIGsBx5Zb+lSUcClUpetOAzDS1OPqtVQGFkUJKxGhsXOHDpQeto0Tr7lTcBIlDRcSUiakBVoZsJJo",
+	"EKZgwvHLTP+IXnINXz+d3ez6OnH3V6q766M7Pmm3qdHCsmTkXMSvjmHjalOr/4TLXzi3FuuF/bm3kWL9",
+	"Go+SlcjomPkn7p9HQ6VJCLQQ4Q8eLdaSm6qE47fyIf7FFuzccJnyMsVfcvvTiyoz4lys8afM/vSTWovk",
+	"XKwHkFnDGr1NUbfc/g/Hi4tjcx29NPyk1EVVhAtKWrfS5ZadnQ5tsh3ztoR5Ul9lw1vF62t/07htD3Nd",
+	"b+QAkIO4Kzg2vIBtCQgtT1b0v+sV0RNflb/j/4oii+EUCdgdtGQUcMaCk6LIRMIRe6/cZ/yK3A/2esCb",
+	"Fod0kh6/D2ArSlVAaYQdlBfFIlMJzxbacEMj/UcJq9nx7E+HjVXl0HbXh8HkP2Gvc+qEiqhVbha8KG4x",
+	"xktUaPSIlEDJTJ9IPlh5R6qQkHb3kIYEyt4MLrk0B7N5jBkbzn3jZmrwbXUYi+/OxWoQ4cw2XIK2eq1t",
+	"+ECzAPWM0MoIraRmrjO1rH/44qQoGgzS95OisPggnRAEqVtwLbTRX9LyecNC4Txnpwfsh3BsUrAVGo2W",
+	"4HQMPBRW7rhyx1dtMXJraEZ8oBltJ5pgbuY1GrQGsw+Ko8vCRmWo7uykFWz8V9c2JDP8fVLnT4PEQtwO",
+	"Exe2Yg5z9uZCvwRXli86lNMnHGfEOWAn3b53IxscJU4wd6KV0f204yIecZZ7ysGJIiq6a83nkEpo7Xfm",
+	"kp2UHIUEP3Rh+DZTycVfud7sgVuXONZiw/Wmzzk0D9sAT6Fk2ORgFtMMQs4IhpvCHrgIunKzZTDXQbNI",
+	"+nsPy7QD71hiyg0/mHXBjqsUAXg9JLjJpiDgO3uFbi3f3bq7WNDPVZ4Lk4Pch5ReiVKbxYjIpAZOcDpv",
+	"Q8nlGmLicT4TaeRG81Jpgf/E/kWp1MrO568vpgSYo2nKOLtS3SAXstIsADE+acbHl5DxW6yA5o+YG6C8",
+	"yMBCh6PkgJcFvRGFH9NvWmuNc8at/EwUGQ4k94hwwlatWCrWoO19srlWbQ20TLL/54v/PEZTLF/8frR4",
+	"9t8O371/evPlw96Pj2+++eb/tn96cvPNl//5H32OxYNSmdh1SRkPoFs17hC72igNLAN+CY2x0NEr1xvQ",
+	"LeSSWdDhvCRe/rir67BiSOYtgnFYmMKjDhlJzX7MKMbtcnHtIe/qhmv3JZw/lNCazxIoI3TwC/2DZww/",
+	"o07BjTcEohFUkGqgApdlirZDy9J2JmxANk3FcmsuZGjmuxWUz5vJ44fMHcRrKFfV9d4P8W/VdQyGb9V1",
+	"7wBX16D3QR/q2v5DGMj1BPhOHWSK9t+hj5cl3/aRTGNPQTIuEO/KJAi4DG8aOEvj6jlZqvJuZ1dHKZKs",
+	"cWAxjqPWJmsksjaSqGlVLBwpRozgtkFnoCZmYFzCdIePYayFhXPDPwAWtOEB8PfAQnugfWNB5YXIYB8K",
+	"XVRjRavkk8fs/K8nXz16/Nvjr752x/K65DnD00ezL5wxiGmzzeDL6PlItrr46F8/9W6P9rixcbSqygRy",
+	"XvSHsu4Ue25eQqmFkuwJsx1Yzos5/qfAayDwZFNPpVYrJ1iF0b55JqQ1MCQqq3I566O+o54i6upVTuHw",
+	"14DHgd07Zt2NuL7TcltW+zBSQVmqMmIyJyI2KlHZwiEpomS6FjUa3cW16P5uoWVXXDOcm7w5lXQqSm9i",
+	"dNNMlqx26NfXssHNqGy1642szs07ZU/ayPfOAc0K9CxfS5bCslq3bByrUuWMs5Q60in43aVIzEvrmHod",
+	"uLP2saU4NEQU85/jDrQScnUJqQWS9k+pbIIpxs8ziYw3MOS/c8M00wdfHSg389kPYEg5eS1yODc8L34h",
+	"ftwDuixjD3iuapA1JEqmmi3BXAFI3HQNSWXEpdfIjchBI2R6zlTJjmq3yxX6IBNsMwGpDpopOO0SoPdu",
+	"pHAJGa6Q5SrtQecEmcPp+VYm5KzZAyJHroN6K5P2dTCDdA3lBIRMN/gN4cNO9UAHUODqz2QK15DumfnQ",
+	"Fbkgb2YfE79qsJbQgq+FvZbOrQKf8wtrd1RkX8T1gzbeMmhtpjRoEwDonKrOxBgXpMHS4vuSo+5AeFLF",
+	"IkO6afFm7EY5SSr3BVuN2l0CugX0JDtv7VoOuzbiBMNIxCXPmMANJ2HyE1EEuQdOITN87/eR7gQxuJ/7",
+	"Y8PSJ0uxIVnTXwhpTuHyhUqBZJ7+sMwZ2GquSmEMSIqB+cBMShf5qKjKhTRIk44LECM/qxSlvqn0HrT3",
+	"ZrDm6MbVhgc2X6rKMM4kgqWpcVyvHwgcRLzaQCsTXhW8xW0JuMCEV+uNYejDVTH+bToueGKRvSAU6V2n",
+	"lW1lp7NBaVkJPEX3A0imli6YwYVZ0CI5xUDVZih3q4ja6wK4ilIloDW6jawHYSdovl3DoEN4IsAJ4HoW",
+	"phVb8fKOwBpleLYDUGoTA7e2uAg5APW06cc2sDt5uI28BOZZDpkHpU4GBoZQOBEnl1BSJMQH3T8/yV23",
+	"ryoG4pTdzRmVQtwXyaVyitqw1XoX22KjcC0aVxBwSoxTx8zhP3FtXjnje0pWNStuArGLUwwDPHj/wpH/",
+	"5q9e/bFJRZW60vU9TFdFoUoDaWwNpLkMzvUzXNdzqVUwdn3ZM4pVGnaNPISlYHyHLLsSiyBuau+x03z6",
+	"iyMfK54D2ygqW0A0iBgD5Ny3CrAbxmoOACJ0g2hLOEJ3KKcOEJ3PtFFFgfxnFpWs+w2h6dy2PjG/Nm37",
+	"xMVNI9dTBTi78TA5yK8sZm2U7oZr5uDwqiiZPWzgTh9mZMaFFjKBxagjCO9q2CpkgR1MOmDccnkALS9C",
+	"izk69BslukEi2LELQwsesLS95KURiShIk/gRtnvXLrsTxLXiFAwXGaQs+GAdvkXYn9lIrO6Yd1O0pl0O",
+	"euD3rgSR5WRC04HRBv4CtqQy78uS0jFw9UdF7uaSEaA+cBAP5LAJXPPEZFvnh9yyKyiB6WqZC2NszHZb",
+	"kcTL1/hd7WR0Rudd0a3r2RR3zzkNFSyvvxXzmVVbdtwlO4pLCx1OYZpoVeohIwrBxIuhwl0XLkXAx5F7",
+	"SmoB6ZSYbOvBReH5QPeNUex/qYolXJICVhmoTwRVkpil4xdnEDqY04X6NBiCDHKweiV9efiwu/CHD92e",
+	"C81WcOXzah4+7KPj4UO61r5U2rSYaw/3RmS3s4hsJ0s8HhTer96RKbvjVdzIU3byZWdwPynxlNaOcHH5",
+	"e7bmmOspaw9pZFqsjrmeuPJgPdF1230vlVrtYbXRSBIyk8VW6giX7igPUKHfajD3CvAIR2+CPT5+kIY2",
+	"Yhl3cvmgKSc4r+WZtBEBaNWjW87WKU9q9QeHX+BmeswHS5rEbrENEdKHihHNnYu8yrjZhz9xRWrKgg/c",
+	"x8hsR3ZPR3HrUlVFjCJJ8U14pSG1IRFcZFUJB+xkqUEaL4Rtf10lCQBe6DE0lWtWAuLDJ5FdbVQ2EK7k",
+	"hh32576mPC6uVQivTUJbQj0PhSILU384uO3FsomuFXkOqeAGsi0rSkjApjvhvUPbfUJRwWw8dLLB6BlU",
+	"TUpVrV1ArjP/QWlzBymhq5K9IaL4oMjoBZkvB27U1sBJ7ayZs4eXolRplcABo4T8ogS/YfR54TbLnakw",
+	"NZwlNMCSf2/hckEm66qezNuW7KijcT5rwRqVpJFLpMMupC18NLYTniRQELlwravcbiw3jMsto0NArpvs",
+	"FX/3zASkB5GrW0dGtK5TIXq6a5lo2cUcVrphhFzp2C0gIhQfCOR2Dyq6HYiV4GhGt0xS2n5VqzDr1okR",
+	"vdUG8r5V13b9bYDpXnls9dhAyUxIWORKwjZaaEJIeEEfY72tUjfQmdTrob7dG3ML/g5Y7Xmm7Op98Uu7",
+	"HXDPyzoofg+b3x23Y9AP843JIAlZwThLMgHSGm5MWSXmreRkEAmINhLE4808wyay575J3CYXMZm5od5K",
+	"TgFctZkkehSsIHLUfA/gLWW6Wq9Bm87VcAXwVrpWQrJKCkNz5bhfC7thBZQUSXNgW+Z8y1boszaK/Q6l",
+	"YsvKtC9LlBapDRrcrHcBp2Fq9VZywzLg2rAXAoMycDjvBvc0I8FcqfKixkL8UFmDBC30QHj8D/YrqWNu",
+	"+RunmuG/XWevrnxs/dHDLtJByM9OnSHh7JRui41foQf7RzM2Y6ZvlMjIUywk5X53aIt9IZWpCejLxkPh",
+	"dv2txIAYo7D4gUi5uRs5dEVcjxctd3SoprURHduhX+u7mCKxVgsMmiXdbrYWZlMtDxKVH3oF43CtamXj",
+	"MOWQK0nf0kNeiENdQHJ4+WjHbe4e8opFxFVHyCqV7UMxB1jkmPGK+kQ54ORUqEzaK1vdFGFLLur4C8VT",
+	"phojEFOXdbGDBFdGm6JtmQekKU9OlrWFDkaeozpxJRlcW03N2naQpuASyq1V250f2af9L2GrpLsRiFIP",
+	"3FFxsRjAhROPs0AIIkVINGffvKP7SIBUI/kXfIv/A+kz8IesYfMZ6UsDbji/pJ5+pedM1w6HFBK8fmDD",
+	"FjopULFCFW3FuMFKBK7zPeI6fsABzqs85+U2phM7iBe0MRO918EmanalSrPpmes8InbYFr2+M4jTYbul",
+	"Q80trZcdlumQVb25A3jpgDs1sA43mYJ3dOxWTCyHWy+Mvh8BkZhxyo3eu1fDDRxbZHfO2jkYBJM8+OG7",
+	"1+zQHQj6Ae2MGzrI8I6Y1u2HdvQHylhb6MrmQL2Vb+UprISkpKrjtzLlhh8uuRaJPqw0lN/yjMsEDtaK",
+	"HTM35Ck3/K3saZKDteiCjFRWVMtMJOjeiGkAtr5Qf4S3b9/gOfT27bteKEFfP3dTRbnGTrBAo4uqzMIT",
+	"awlXvEwjoOu6gAaNTL1HZ50zNzb96KnSjR/nZF4UuptP319+UWS4/IAMtcsWxy1j2qjS61pCe2hof39W",
+	"Lpii5Fe++k6lQbN/5Lx4I6R5xxZvq6OjJ8BaCeb/cCoN0uS2gMmydDDfvytCaeFWMMC1KfkC4910dPkG",
+	"eEG7T/eBnC7jWcaoW4iTOqqdhmoW4PExvAEWjlun+tLizm0vXwkvvgT6RFtIbVAJarzod92vINX9ztvV",
+	"SZfv7VJlNgvk7eiqNJK435m6QNaaC6l9aAMacciYs/GWwmQDyQWkVNYI8sJs563uatVSpL3oENqW/7IJ",
+	"Y1SjhvxVWBasSLm7aqD5qFMsRIMxXmd7BRewfa2aEje3qQ7SrlmhhxiVKDXQeZFYQ7Z1Y3Q330ViIaS8",
+	"KHzpB8rF82RxXNOF7zPMyFYR3wMTx4iiVVNhCBG8jCCCOgyh4A4LxfHuRfqx5eEtamlPvoh3x8t+5po0",
+	"l0MXTRWu5vWm/k55nqjma4ZpP3R3IHzYugyBFKvQ/j7gcgpdhhOrH7TcjKHyOnjuRU86teoeaL3zJgqy",
+	"bbzANUcpBfALkgpZ6DsxdH4m65V2Bn8ypjuELTMbc+vD96zQ4WXLdSvXY6DFCRhK2SgcHow2RkLNZsO1",
+	"r9CXzgNenqQDfMA6I2NlpULLfVCtsGV2r7Qn7Gaf53UBMVs42BeX8hWlfBmp2fxWJaGsy6WKb4eSpACl",
+	"kMHaLtw29oTS1DxpNgjh+GW1omy2RSySjGutEmFLLDbHjJsDUD9+yJg1cbPJI8TIOACbblw0MPtZhbwp",
+	"17cBUrqaLdyPTXEawd9xx5uLFUaVRxUowoUcMoA4CcBd+GF9fnWCYGkYJuScoZi75JlLZzetQXpFjkht",
+	"7ZQ0cvE+Xw6psyM3YXuw3GpN1ONOqwl1Jg90XKEbgXhclYhtgWZf1Ad7g6uhs3TK1APH9xCuvgjKI90J",
+	"gI5Voakg7m5+O29o7bO5f5I1In3e1PvzaQ4x2h+in+guDeCvb8+oCxq97B7X0Ut6q1WnllOgP8VEMfJI",
+	"3wPT9/NoyIA04kVLg1hcwDau2AOJ23PfLbi5U8UoLrdfBsFlJayFNtBYyL3v/eN7KS6VgYUt0kHG+ejy",
+	"sNH3mu5j3wdVaTrHbAtVzJY6FgOVY2jaC9guUpFV8d128/54itM2Sau6WmK4J+4kZWYvqTR3NCR0ZGob",
+	"NTy64J/sgn/ie1vvNFrCpjhxqZTpzPGJUFVHbo0xU4QAY8TR37VBlI6IlyAQpC9bgiM3CFE5GDPc9Zip",
+	"jn4ZDSYJ8wGHJLwdKbqWBtDxVdiIqdrkWwvG3ooGeIAXhUivO2Y0nz45cNnit7or+8qBvZi1WT3YDgwE",
+	"JrNYkkQJul0kstENbY3yVq2Ug0mYed0u5RgKhHAqof0LG31EIWlTGfhduMKyDz/C9m/YlpYzu5nP7md1",
+	"i+HajbgD1y/r7Y3imbzW1grTMqLfEuW8wHRnni2cbXKINEt16UiTmntT5kcWdXEL2OvvTn566cBH808G",
+	"vLTW6tFVUbvik1mVrUc5wCC+gj/FYjqN1ypiwebXxaxCe+bVBlyJs0CX61V3bWzVzXjevrmKB8/stFY6",
+	"s7pd4oh5HYraut5Yfqhzx6DOL7nIvMnFQzvg7qXFTSsRHJUK4QD3NswH/pXFXsVNj7vj3NFQ1w6ZFM41",
+	"Us89t08WaB9/ECRgoAqJM1hSxaCnJbgLdV84ySonF+5CZyKJm+fkUiNxSOt2wcaMGg8oozhiJQa8eLIS",
+	"wVjYTE+4JnaADOaIIlNHy540uFsqV2qikuJfFTCRgjT4qSSu7DAq8qV/r6R/nKLu0J/LDUx9guHvo2OE",
+	"dYm7Jx4BMa5ghE6eHrin9YXTL7Q2ZnDZsmbfwlccztg7Ekf8vI4+HDXbuL5N21kTPg3Vl39IGPYZgd3v",
+	"UtUFNy2gA3NE35kaPC1Ohk8K7H2LM6I5Egjc8DCYW59BplVkmEpecWkj/rGfxaHrbePPrdC4UiWlhOu4",
+	"qVDoxapUv0P8JrvCjYrkiDlUkrpIvSfEazfWn+ZBMI/fEI5B0h7S5IKPrO3LH+BwovLAe0VVbLwhl0tL",
+	"1vaJm1agWpw5ghb60I7fMIeDuReQm/GrJU8u4goVwnTS+ElbJmejmO/sd8FZxxvaC1yudVth86gLKJtE",
+	"zh4x3FU5+rRIPoVE5DyLa0kpYb8d0pWKtbDvBFUagodo3ED2gTVLRe4xnzqDxaHmbMWO5sFTV243UnEp",
+	"tFhmQC0e2RboKKO11U4P3wWXB9JsNDV/PKH5ppJpCanZaItYrVitwNJVrvbx+NJgR9Tu0TP2BXm3tLiE",
+	"LxGLTheZHT96RuZZ+8dR7LBzD4KNyZWUBMvfnWCJ0zG59+wYNqyLRj2I5vTbVxyHRdgIN9muU3iJWjqp",
+	"t5uXci75GuIBFfkOmGxf2k0yGnbwIqlRCtqUasuEic8PhqN8GghCR/FnwegUKNYqR3pqXpmxk/rhXKAr",
+	"ncM1XP4juRIL70npXJg/roHYnuWxVZPD92eeQxutVHnbpx95J79/vYCd+QI6VMC4rltscYNz4dJJpcMt",
+	"pOKhQhq6RFVmtfgLJq6VPDFU6XkA3MXy66eRos3t4qHydoB/dLyXoKG8jKO+HCB7r024vhiWLxe5QFH/",
+	"ZZP0EXDloM8zOq3xEr0bVjg+9FQFFEdZDJJb1SI3HkjqexGeHBnwnqRYr+dW9HjrlX10yqzKOHnwCnfo",
+	"11c/OS0jV2WsnFrD7k7jKMGUAi4hHdwkHPOee1Fmk3bhPtD/sV6W5gZQq2Wel2MXAayVfvx+oJB47TVw",
+	"Id0RS8gQm+IHJIOlG2rO2kWbP74c3U+wUNyr5434fScefvF4oD+6iPiDyaWuhcSppJJdyQChBEXroyST",
+	"1t8Dbzxn36rrqYTT4UJPPH8sigiCKEoqkaV/axJA2ytcllwmm6h/cIkdf2ueS6wXZ8/AGIlhnr6ELDqc",
+	"1Td/83ppRHP+p5o6Ty7kxLbdZwrscjuLawBvg+mB8hMieoXJcIIQq+2MuDq2GbPrGM3TlFlr2LVfDyAo",
+	"kW6LiEaebqYPNr7K0KORSMXUiYFM6UZ6wH6wz51vgLWqQNFNsM7cbxU4qApMeJtT/QT0nDA7q+1j3/6y",
+	"FcLXNoGptYqO/S+oyTgtUtd2GMoimD7OeFgzrlqbRV1TOpaHii1e+wZMdHwidEUKsXPATu3tVNfFZ2kI",
+	"pIeVKHNIgxLWVj8imsB/GMOTDTZQLdE6TPLTS9t7qtTBC7Hu30lNiZbvEG5X3d4Wt58zquZ8JbR95Rp8",
+	"2qOnag+GNzv4VNj28spKSkspB7c45eoiirdFuweOxq3dJlHIOoi/pdJvn1C4baX/c+oVI8reswG9p2Ft",
+	"yZ76QZ0X/nFfLpUUCWWSxo5o92L2FJ/ihIJqXZOuZ3HHoRHmij5WUAftOSwOPl8wn7UQ13dqBF9xUy11",
+	"2D8NPc2Mxso1GO0kG6Rz/yCFszUKqcGVyUQiCuWkKlt+WpKQUdf/onYR3ZKMKENl4PL4PX772ZkWkAXZ",
+	"hbAl6B3anOJnrYH0oK/Bm4cwbK1Au/W08yn1G+xzQLWfUrh+d+AfAKYxrJsTl219+v2hTryH/6V/WKRk",
+	"z7GtK89T/9wKBraTnhSFm3T4zZOoPoC1XYYQHPHULryrLEBuPX442gi5jYbm0HmKhAaX5NiHgs7hHmHU",
+	"r5N03pZCpdVSFLVgNiQuhpRMyAgYPwkJzfPUkQMiiR4JtDHErwP9dFJiUOJkmYYOffLmxwSaNs69cd+h",
+	"OhtMKKE1+jmGt7F5WGVAcNQNGsWNy239KjZSd6BMPKfn+B0i+8+kkFbllKiUgvs7D6fEBAcKbl+Lq30A",
+	"9NmgrxPZ7qbkCbT6TjiJhvI1l1W6BrMoIbePUMTeeUuo3D01rIuWUJ0kkra2q679JDZ+hCGooSgK02cQ",
+	"6W48nqY+5kpISuSG3+yn+D1DxdTj767pdRNSyLVPTehBEB0wFZprDfkyi4S5ntYfg2eckCLRuIX/jxUx",
+	"Hd5BF/xy6/BLH+lCHW+tX7dH6mnHSPsLzCa6G1U2/fdKlplatwH5uNaYUZEUkkxMGH2HUj6sONArj2vP",
+	"gbogAFG/8s8p0h2vTmVtixD8Fr9DN+X9xq0Fww+vzemkGog7ftWU1OL2MLTuxKHo42QwWJ4bl/FlOGvq",
+	"V/UZ076WFhvBhi7RdwtF3JQ6FK5ko5Xwc6/3NDWupxTT2KMI9XFwfYB+9EG2rODC+cobju1j1oXj9xMk",
+	"pgTqNhvcXYQLch80r/XeiOlrKdQigN1dXe5U/bBXdXucIHs5FUFWjS2OfDC9skUT6kPeWCq3uQbpXoJr",
+	"R0tPjtlcrSAx4nJHDsvfUZ9v8iPmXuNvygH5Gpp1DCBlz9/+PtsAlPE7wpPx/YEzFMF+AdsHmrWoIVqt",
+	"ee754i6J04QB+yA5kojSPBsyUThXj9A1ZRAWvB/fdoem9uzgMxl1IKla3XEuT5KMOy20ruM7MCUmotxx",
+	"Lux6q8w/CvYaSnPZUZepz5CRCp1XXJigpFL/Fb55pDzYSMUs8hTbuoCBfovxOqkVJDYgCFt5638Gcm02",
+	"cYTvfsysbXgIJr1HeavBylaRuk9BmacdtepjGzas3ZzSQw66fpMqUsOJbA/dXbtymfaUIlabUf2lAbT/",
+	"zWdT2lkycQHhyytktMZUUd8iegvzF7zFQKRvN3eGmjERB3pVzyyaMLl++kifRmxYZJIpjbmuQ9Gznbco",
+	"vVv3gbb+d7qBUSVkgmsFpXtxCVvi2LAwyofVjcExhgpNQQZ3QoIeLLNugRus1fCqKUZB1T851WbgLrYg",
+	"XKC7gKZQBiUjhuccQ/Zz+93nS/jqj51aq5FxPb3urn7tAySF7iExpPqVLyu9Ow/jLvdJIaV9/lXH6kdI",
+	"KEPgtC94bYPHA8YAf+/+IG8tthM8Bkp2v337JqNaRT8FWW0XsD20SrWvH+63MoTevmtn1xDkYHd2e69X",
+	"7fiFJlvbBaz3AucfeVOez/D0XQxYQs/6ZTC6PHAhsIgUU1UTWjTwtgn7ggxwtavrarP1ZR+KAiSkXx4w",
+	"diJtMKf3erXrzHYmlw/M2PzXNGta2co07hJ/8FbGo+KoZkx5T/nmhxmXahpkeu+p7CDjE5nrgRIcWNOp",
+	"/9LP5ML3fT9U9/WVhqgsFNO0lIka5V10yTgeqP4kF/XNs2P+DIrYxBE8ejl83bkDdmH1mf3DCb0ZHx89",
+	"4/cY3BJQfGD7LVLvM+4aEr8P4Be/+FG8Gj5Kdy3iHVq2aT1bs/s9HFu9uV0LoJXy71DhVuKmjpHs8IMN",
+	"EY+wf5KA2XcPfIYLirRLkVY8G68J755fWNR1oG4tnxNeligxpAqKSfkQvOYXKgRIwlxfiKKIv+2Ax5LW",
+	"0x+e6J4TYJ1quP9VkoDWqyrLtgeduJc6cwNfQUEWVxIozUAq0wwRh695YeI+Ok3vGSladGv0KGHcrQTE",
+	"JF2lb5SMHONh8u4O49tFy4JpyzV2/OiqhD1bMgMH4i0tmf205KnLo3UQ21Ua+uucvAEt3A7gfgriGzN8",
+	"H7nD1nOznGI9j5eWw+5kvrcIwUYHjEBl/3j0D1bCisrBK/bwIU3w8OHcNf3H4/bnSkjz8GFUUH80w73F",
+	"kRvDzRujmL8NxV3Z2KKBEL/OfmA04C7CaAVsNk8zUEjiby4s/A95HOI3e3T2WdXCeiuXYXcTCDGRtbYm",
+	"D6YKQjEnRGG6bpGYS1JZkqoUZkuZ+d46JH6LVjz6ofYAbICjMlPnN7r0OqMuoK7t0PgLKu2r1P6geEa5",
+	"V3hkkhPZ0BON311zfBzbMco3D5Z/hid/eZoePXn05+Vfjr46SuDpV8+Ojvizp/zRsyeP4PFfvnp6BI9W",
+	"Xz9bPk4fP328fPr46ddfPUuePH20fPr1sz8/mM1nAkG2gPrg6ePZ/6QXVBYnL88WrxHYBie8EPXLrEjG",
+	"vkw6T4gT0b6SzY79T//dcxi+M9EM73+dudSL2caYQh8fHl5dXR2EXQ7XZG9aGFUlm0M/T/9FzJdndWir",
+	"VXZoR23UIpLCwawhhRP69uq789fs5OXZQUMws+PZ0cHRwSMcXxUgeSFmx7Mn9BNxz4b2/dAR2+z4/c18",
+	"drgBnpmN+yMHU4rEf9JXfL2G8sDVi8efLh8f+si4w/fO1nYz9u0wODbw5+avhUh39NQa6AeXVD3eupWr",
+	"7EyxQYeJUIw1O1yq61s0BR00Hl4KuU8s/erDJl+x00Afvqfbys3Q74cu5nzgI40/+LmFu/eow98ceoeN",
+	"6+EefD5837zAfmNFRwYxN4ONz+bBg+1zJgzaykvKbDbJBqWFT6kUuv1gf036+M7n7AR7Pa9fow8qZx2/",
+	"6V94aSDmRyL5gMTfsG9rpkZCm7KCsJhTff602jen0JujxbN37x/NHx3d/AlPGffnV09uJnpNn9fjsvP6",
+	"CJnY8B1CblVv4urHR0f3eLDrRAbot5tUx2z0T3j/+PfgQ4tuqzoDsRoZO/KmOsMPPOf99JYrHr0otOJY",
+	"Ii9dfMtT5lMWaO5HH2/uM2ntEXhK2NPsZj776mOu/kwiyfOMUcsgEb6/9b/KC6mupG+Jqoc3TVk21i2h",
+	"wNxm0wHH0Wj8ZlaU4pIbmL0jG6s2k4WLNvwOwuUce30WLh9LuNAm7UO4tAfas3B5fEsG//RX/Fmcfmri",
+	"9NyKu+ni1KlyNra2r1CmcJmrFLxymAtpDt+Tno2NBkTx30vhfUz2YabGg2/HqdPGbNAc5dOn+CgWsgDD",
+	"CW3UGmuM/q4j1k7oZXl0XxjrujDmdQ20lcoydeVMoZpsoeQgO+idAC+ENKdw+UKl8K1/8Gv0GGhHKjTr",
+	"RJQdDBwLrkLU8IlQV555FIk+uq9EbkucEdd94Bi5KoUxINsbOCE2yo4+8Wni+p2wHlUQWgmltoBGIC3+",
+	"GEnFFuxnBMzVyBGyA/FnSXZXSYYMGN1+vUOQtQSWWq1c5cR1rIDiD+AeSW44V0OiZKrrClH4tc7vo1g1",
+	"Kk2fVBg+OgAgOt7tzNj+iOXAnXnONiALOv55hTkaiQ+WbEugH8CQ5MHE3HOc/Be7lr1yfYOfMWnWxUmI",
+	"AcuSDYbmTJXsqI7kaK9wh5Rw0NxKTDjrpzczRgVGDZ3blc8S4/9HifGK8vX1dDK4vSA5fG//P6L8nHuR",
+	"Es7mXrxt1I84fKrfEX8oSrgUqnLvTLMiq+wMFpY508p5rEUOixRQG3Ip62v7cLG1yhv74ngKBkpULLSx",
+	"idQ9cVUitE2W+ZiQOh8UUrdQlm4jXgZUKdXIxp261NF0XaqTCvjjv7PgIMHbkAW2c3FcJQYBfpYrd75T",
+	"gfkwMqXglYYxgzlGw+QYClLaEC2XWBG8od1PDYjepHwone6GiGQl8HRbX6B4CT7kvH7kXsK1aRI62uxv",
+	"Aezekz51TvrMKnc/gpEebAhvZVTOjUjifGNjqut4tclm3nOjCj1A5JSAfhteESZgjPNqaeubtm0L2vDt",
+	"kGGBVdKIzCv1yDy2jGOfUV4iq3/mk898YvmEyGEfbOIOFspOgfKwk3g18tW5dJtG1pRzSPUrt/2ftzIZ",
+	"O6l+ldrrvdjUVyRaBVaiOcvco8XcsquQTiH2S14B+lEcN/k3xzOR25K/bYaiCc+3Mnnliut8eszUWvxn",
+	"Xrr7mZOrS+jRXgnalMLKajoYOvbKyIkzbiIaousDdjJAz6kCu9ktwi64NjZmuB4wavzZRdz7t/MOrvCD",
+	"2Hc7hhs71YMQLf8ePPH06OnHg+C82QIkHGer+tStMU1Shd1lJtzTsnz8vhQcPoFrapfh5QMyamBraYy5",
+	"TSUk60Vr7D0WCBtyIHypoKDKn1UfOzALzVCgUa4JZi5FjS6hcNhpaekktkh3DHFCgX0VANc9YFopaxn0",
+	"X8ey0iKP2rBS7w/wMhOUW8FlWP/y30hqfYoiw4fwOFYzkGU6lBq4OZTOfcllAmwJW4X7MS5Diu5rxdFT",
+	"3korxlkmbAWt/ju1sTO6W6hF3/esnpZF3Jk1EmXeDyceW9l/+VCPj3rGt7aP/QhbfDOffe9lx6d72O9i",
+	"nzErTye4N017RG5PINDmW5VuRzCU63XhijFGQuuWQiLI/QCpm3nk5Owtw8qfOm0Yj9Le0XizV30dQTiL",
+	"KOyUAEqRLCtmeqBOSeR0I09R2l92BveTarSZaZ+w81mGfJYhpZ3+yUe8MkF5KRJgryEvVMlLkW3Zr7JW",
+	"su8emZym0dpqbdbvyTQMqE1UCmuQCyewFkuVbv1zi60BL2A7iyoqh+9bf7r0kEHz2yn9zriLt+sDvdyy",
+	"s9OeBmO7dSXtt9uz0/61InIz6II4eknoyqJJ94KXvYWslWEWC6lb1GfB81nw3Et5mcw8022G7iLTPZPn",
+	"/k2J2ItF3PSnnnLn+EPZdS8b3b/PxO4vtqQZBbDUH2zocBfNn0XCZ5FwT0sERJiRuNYJiQjR3SVZqS8g",
+	"qHpTGta0ZPQmplF18yrjJXPBWhPMFCc0ojNOfAwp8bEvaVFcpamvU3UtNHkdIxu233vbZxH3WcR9QomX",
+	"uwVNWxG59U3nArY5L+r7jd5UJlVXcsSDU0AieObeA6YXeusKCEYxP0BT1Zr94qrGZ1tcAlarZZxC8lCl",
+	"qmUddval7xrvDY7A9EZVWcqWsBaSJiBRQbPY8km8H6caccg4yH62d8KYkP1XBSTRHG4cjLN5K1/QbePR",
+	"B0j26af33YwYzZEqqiCCxP59iKXiMEnUlYsmDPVzxgzw7NC9OtT51b4NEvwYjVdpl23wz+BHP3ZrOsS+",
+	"9kJd2o2UysZjMNEb14QQ9GLAuj9SaJmuX/nlclsXOKZTP5ydXEvYIhxAI+Evt0EXX8CWnQuZ0E3Fhq9p",
+	"+wKrlY5cM86uNioD+1I6SCNKX8qZDpHcJ0F3StuVtMSIo/G7S5GYfn2vnblw1K+p6dwgo1fLTBj3oOfZ",
+	"6QF7YYuol1CAL0wW5Z1rW2au7zTZWXtnMqB8YEeEDmtExqBrqt/V8AUZ3D5r+6u/3ETUm/1m9AEuLlZj",
+	"7udaooW06MmgIXVf/nFH7IefZ4ohuVPPOZzeDTPMaQeflZ5PUdFwPBZu9cgW3y4yixlXdjTD4EkdKbFJ",
+	"wzKeKbm20kYYHavK7gTAnGlVOttiCvQgEzbEKQooqW5cNFgrkI4v8UDZFZVRVhK1kTTODF4aGeUMRlQz",
+	"PufX3xx5ExKFb9QLse2HRFLOr2eRW9uHyiReASzyKjOiyMRQSdREyTVoWmvTFFGQXNQBYYrXb1rSJir/",
+	"JqctlG/DBLRLEg82yL7QIXQw8hwxdIWXQcsFglTGJjScjk0fcutw6sIL6rdHBmrWjj+ssAHmomNaIHbK",
+	"4c47540ESGn3C07+BpC+kueQSJ7PLAnEQbgXvVN8U0KZRCvGDZJhSG93K3veevIiVkfb9ljQxlh1U+/K",
+	"Ug02UbMrVZpN74zpFAoYxia9dbEYxCl+HWddIW9zhHZYZt59o8IBMoCXDrhTz+EpctOVt72nwPx8dt7D",
+	"DhndGHshGw18at1y/PvXA5cgbUrguf/YlLUMy0TSMVYXiHzzDk8NDeWlP+GaqofHh4f0dMFGaXM4u5mH",
+	"33Tn47t6Be9rC6Rbyc27m/83ABWwbkTT/AAA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -80,6 +80,18 @@ type Account struct {
 	// *  Online  - indicates that the associated account used as part of the delegation pool.
 	// *   NotParticipating - indicates that the associated account is neither a delegator nor a delegate.
 	Status string `json:"status"`
+
+	// The count of all applications that have been opted in, equivalent to the count of application local data (AppLocalState objects) stored in this account.
+	TotalAppsOptedIn uint64 `json:"total-apps-opted-in"`
+
+	// The count of all assets that have been opted in, equivalent to the count of AssetHolding objects held by this account.
+	TotalAssetsOptedIn uint64 `json:"total-assets-opted-in"`
+
+	// The count of all apps (AppParams objects) created by this account.
+	TotalCreatedApps uint64 `json:"total-created-apps"`
+
+	// The count of all assets (AssetParams objects) created by this account.
+	TotalCreatedAssets uint64 `json:"total-created-assets"`
 }
 
 // AccountParticipation defines model for AccountParticipation.
@@ -247,6 +259,26 @@ type AssetParams struct {
 	UrlB64 *[]byte `json:"url-b64,omitempty"`
 }
 
+// Box defines model for Box.
+type Box struct {
+
+	// \[name\] box name, base64 encoded
+	Name []byte `json:"name"`
+
+	// The round for which this information is relevant
+	Round uint64 `json:"round"`
+
+	// \[value\] box value, base64 encoded.
+	Value []byte `json:"value"`
+}
+
+// BoxDescriptor defines model for BoxDescriptor.
+type BoxDescriptor struct {
+
+	// Base64 encoded box name
+	Name []byte `json:"name"`
+}
+
 // BuildVersion defines model for BuildVersion.
 type BuildVersion struct {
 	Branch      string `json:"branch"`
@@ -304,6 +336,9 @@ type DryrunTxnResult struct {
 	AppCallMessages *[]string      `json:"app-call-messages,omitempty"`
 	AppCallTrace    *[]DryrunState `json:"app-call-trace,omitempty"`
 
+	// Opcode budget of the group that remains after this app call transaction, including any budget added with increase_budget
+	BudgetRemaining *uint64 `json:"budget-remaining,omitempty"`
+
 	// Execution cost of app call transaction
 	Cost *uint64 `json:"cost,omitempty"`
 
@@ -345,6 +380,9 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// LedgerStateDelta defines model for LedgerStateDelta.
+type LedgerStateDelta map[string]interface{}
+
 // ParticipationKey defines model for ParticipationKey.
 type ParticipationKey struct {
 
@@ -373,6 +411,16 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// PendingTransactionGroupSummary defines model for PendingTransactionGroupSummary.
+type PendingTransactionGroupSummary struct {
+
+	// Total fee of the group divided by its total encoded length.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// The transactions of the group.
+	Transactions []PendingTransactionSummary `json:"transactions"`
+}
+
 // PendingTransactionResponse defines model for PendingTransactionResponse.
 type PendingTransactionResponse struct {
 
@@ -419,6 +467,41 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// PendingTransactionSummary defines model for PendingTransactionSummary.
+type PendingTransactionSummary struct {
+
+	// The fee paid by the transaction, in MicroAlgos.
+	Fee uint64 `json:"fee"`
+
+	// The first round the transaction is valid for.
+	FirstValid uint64 `json:"first-valid"`
+
+	// The last round the transaction is valid for.
+	LastValid uint64 `json:"last-valid"`
+
+	// The sender of the transaction.
+	Sender string `json:"sender"`
+
+	// The size of the encoded signed transaction.
+	Size uint64 `json:"size"`
+
+	// The transaction ID.
+	Txid string `json:"txid"`
+}
+
+// SimulateTransactionResult defines model for SimulateTransactionResult.
+type SimulateTransactionResult struct {
+
+	// Indicates that the transaction carried no signature and its signature check was skipped.
+	MissingSignature *bool `json:"missing-signature,omitempty"`
+
+	// Indicates whether the transaction was evaluated successfully. Transactions after the failing one are not evaluated.
+	Passed bool `json:"passed"`
+
+	// Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
+	TxnResult PendingTransactionResponse `json:"txn-result"`
+}
+
 // StateDelta defines model for StateDelta.
 type StateDelta []EvalDeltaKeyValue
 
@@ -520,6 +603,40 @@ type TxId string
 // TxType defines model for tx-type.
 type TxType string
 
+// AccountApplicationResponse defines model for AccountApplicationResponse.
+type AccountApplicationResponse struct {
+
+	// Stores local state associated with an application.
+	AppLocalState *ApplicationLocalState `json:"app-local-state,omitempty"`
+
+	// Stores the global information associated with an application.
+	CreatedApp *ApplicationParams `json:"created-app,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountAssetResponse defines model for AccountAssetResponse.
+type AccountAssetResponse struct {
+
+	// Describes an asset held by an account.
+	//
+	// Definition:
+	// data/basics/userBalance.go : AssetHolding
+	AssetHolding *AssetHolding `json:"asset-holding,omitempty"`
+
+	// AssetParams specifies the parameters for an asset.
+	//
+	// \[apar\] when part of an AssetConfig transaction.
+	//
+	// Definition:
+	// data/transactions/asset.go : AssetParams
+	CreatedAsset *AssetParams `json:"created-asset,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
 // AccountResponse defines model for AccountResponse.
 type AccountResponse Account
 
@@ -529,6 +646,39 @@ type ApplicationResponse Application
 // AssetResponse defines model for AssetResponse.
 type AssetResponse Asset
 
+// BlockHashResponse defines model for BlockHashResponse.
+type BlockHashResponse struct {
+
+	// Block header hash.
+	BlockHash string `json:"block-hash"`
+}
+
+// BlockHeaderResponse defines model for BlockHeaderResponse.
+type BlockHeaderResponse struct {
+
+	// Block header data.
+	Header map[string]interface{} `json:"header"`
+}
+
+// BlockHeadersCommitmentResponse defines model for BlockHeadersCommitmentResponse.
+type BlockHeadersCommitmentResponse struct {
+
+	// The first round of the range.
+	FirstRound uint64 `json:"first-round"`
+
+	// Position of proof-round in the tree, that is proof-round minus first-round.
+	Idx *uint64 `json:"idx,omitempty"`
+
+	// The last round of the range.
+	LastRound uint64 `json:"last-round"`
+
+	// Merkle proof of membership of the header of proof-round, as the concatenation of the proof digests.
+	Proof *[]byte `json:"proof,omitempty"`
+
+	// Root of the Merkle tree whose leaves are the block hashes of the range, in round order.
+	Root []byte `json:"root"`
+}
+
 // BlockResponse defines model for BlockResponse.
 type BlockResponse struct {
 
@@ -539,6 +689,14 @@ type BlockResponse struct {
 	Cert *map[string]interface{} `json:"cert,omitempty"`
 }
 
+// BoxResponse defines model for BoxResponse.
+type BoxResponse Box
+
+// BoxesResponse defines model for BoxesResponse.
+type BoxesResponse struct {
+	Boxes []BoxDescriptor `json:"boxes"`
+}
+
 // CatchpointAbortResponse defines model for CatchpointAbortResponse.
 type CatchpointAbortResponse struct {
 
@@ -561,6 +719,9 @@ type CompileResponse struct {
 
 	// base64 encoded program bytes
 	Result string `json:"result"`
+
+	// JSON of the version 3 source map, mapping each program offset to its source line and column
+	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
 // DryrunResponse defines model for DryrunResponse.
@@ -572,6 +733,47 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// EvictPendingTransactionsResponse defines model for EvictPendingTransactionsResponse.
+type EvictPendingTransactionsResponse struct {
+
+	// Number of transactions removed from the pool.
+	Evicted uint64 `json:"evicted"`
+}
+
+// GetBlockTimeStampOffsetResponse defines model for GetBlockTimeStampOffsetResponse.
+type GetBlockTimeStampOffsetResponse struct {
+
+	// The number of seconds between consecutive block timestamps, or 0 for the wall clock.
+	Offset uint64 `json:"offset"`
+}
+
+// GetSyncRoundResponse defines model for GetSyncRoundResponse.
+type GetSyncRoundResponse struct {
+
+	// The sync round of the ledger.
+	Round uint64 `json:"round"`
+}
+
+// IndexedTransactionsResponse defines model for IndexedTransactionsResponse.
+type IndexedTransactionsResponse struct {
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// The matching top-level transactions, in round order.
+	Transactions []PendingTransactionResponse `json:"transactions"`
+}
+
+// LedgerStateDeltaResponse defines model for LedgerStateDeltaResponse.
+type LedgerStateDeltaResponse LedgerStateDelta
+
+// MintDevModeBlocksResponse defines model for MintDevModeBlocksResponse.
+type MintDevModeBlocksResponse struct {
+
+	// The last round written to the ledger.
+	Round uint64 `json:"round"`
+}
+
 // NodeStatusResponse defines model for NodeStatusResponse.
 type NodeStatusResponse struct {
 
@@ -664,6 +866,26 @@ type ProofResponse struct {
 	Stibhash []byte `json:"stibhash"`
 }
 
+// SimulateResponse defines model for SimulateResponse.
+type SimulateResponse struct {
+
+	// The index within the group of the transaction that caused the failure. Absent if the group succeeded or was rejected as a whole.
+	FailedAt *uint64 `json:"failed-at,omitempty"`
+
+	// The reason the group would be rejected, if it would be.
+	FailureMessage *string `json:"failure-message,omitempty"`
+
+	// The round immediately preceding this simulation. State changes through this round were used to run this simulation.
+	LastRound uint64 `json:"last-round"`
+
+	// The ledger state delta the group would produce. Only present if would-succeed is true.
+	StateDelta *map[string]interface{}     `json:"state-delta,omitempty"`
+	TxnResults []SimulateTransactionResult `json:"txn-results"`
+
+	// Indicates whether the simulated group would have been accepted, assuming that any missing signature is supplied.
+	WouldSucceed bool `json:"would-succeed"`
+}
+
 // SupplyResponse defines model for SupplyResponse.
 type SupplyResponse struct {
 
@@ -704,6 +926,25 @@ type TransactionParametersResponse struct {
 	MinFee uint64 `json:"min-fee"`
 }
 
+// TransactionPoolResponse defines model for TransactionPoolResponse.
+type TransactionPoolResponse struct {
+
+	// The congestion multiplier tracking the load on the pool over the recent rounds. The fee per byte is this multiplier, grown exponentially for every whole block pending beyond the first.
+	FeeMultiplier uint64 `json:"fee-multiplier"`
+
+	// The minimum fee per byte, in MicroAlgos, a transaction needs to pay to enter the pool.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// The pending transaction groups, sorted by decreasing fee per byte and cut off at max groups.
+	Groups []PendingTransactionGroupSummary `json:"groups"`
+
+	// The number of whole blocks worth of transactions pending in the pool.
+	PendingWholeBlocks uint64 `json:"pending-whole-blocks"`
+
+	// Total number of transaction groups in the pool.
+	TotalGroups uint64 `json:"total-groups"`
+}
+
 // VersionsResponse defines model for VersionsResponse.
 type VersionsResponse Version
 
@@ -711,3 +952,20 @@ type VersionsResponse Version
 type ShutdownNodeParams struct {
 	Timeout *uint64 `json:"timeout,omitempty"`
 }
+
+// EvictPendingTransactionsParams defines parameters for EvictPendingTransactions.
+type EvictPendingTransactionsParams struct {
+
+	// Evict the groups holding the transaction with this ID. May be repeated.
+	Txid *[]string `json:"txid,omitempty"`
+
+	// Evict the groups holding a transaction sent by this account.
+	Sender *string `json:"sender,omitempty"`
+}
+
+// GetTransactionPoolParams defines parameters for GetTransactionPool.
+type GetTransactionPoolParams struct {
+
+	// Truncated number of transaction groups to return. If max=0, returns all pending groups.
+	Max *uint64 `json:"max,omitempty"`
+}
@@ -20,21 +20,51 @@ type ServerInterface interface {
 	// Get account information.
 	// (GET /v2/accounts/{address})
 	AccountInformation(ctx echo.Context, address string, params AccountInformationParams) error
+	// Get account information about a given app.
+	// (GET /v2/accounts/{address}/applications/{application-id})
+	AccountApplicationInformation(ctx echo.Context, address string, applicationId uint64, params AccountApplicationInformationParams) error
+	// Get account information about a given asset.
+	// (GET /v2/accounts/{address}/assets/{asset-id})
+	AccountAssetInformation(ctx echo.Context, address string, assetId uint64, params AccountAssetInformationParams) error
 	// Get a list of unconfirmed transactions currently in the transaction pool by address.
 	// (GET /v2/accounts/{address}/transactions/pending)
 	GetPendingTransactionsByAddress(ctx echo.Context, address string, params GetPendingTransactionsByAddressParams) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
 	GetApplicationByID(ctx echo.Context, applicationId uint64) error
+	// Get box information for a given application.
+	// (GET /v2/applications/{application-id}/box)
+	GetApplicationBoxByName(ctx echo.Context, applicationId uint64, params GetApplicationBoxByNameParams) error
+	// Get all box names for a given application.
+	// (GET /v2/applications/{application-id}/boxes)
+	GetApplicationBoxes(ctx echo.Context, applicationId uint64, params GetApplicationBoxesParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
 	GetAssetByID(ctx echo.Context, assetId uint64) error
+	// Get a Merkle commitment to a range of block headers.
+	// (GET /v2/blockheaders/commitment)
+	GetBlockHeadersCommitment(ctx echo.Context, params GetBlockHeadersCommitmentParams) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
+	// Get the block hash for the block on the given round.
+	// (GET /v2/blocks/{round}/hash)
+	GetBlockHash(ctx echo.Context, round uint64) error
+	// Get the block header for the given round.
+	// (GET /v2/blocks/{round}/header)
+	GetBlockHeader(ctx echo.Context, round uint64, params GetBlockHeaderParams) error
 	// Get a Merkle proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetProof(ctx echo.Context, round uint64, txid string, params GetProofParams) error
+	// Get a ledger state delta for a given round.
+	// (GET /v2/deltas/{round})
+	GetLedgerStateDelta(ctx echo.Context, round uint64, params GetLedgerStateDeltaParams) error
+	// Search for transactions in the archival index.
+	// (GET /v2/indexer/transactions)
+	SearchIndexedTransactions(ctx echo.Context, params SearchIndexedTransactionsParams) error
+	// Get a confirmed transaction from the archival index.
+	// (GET /v2/indexer/transactions/{txid})
+	GetIndexedTransaction(ctx echo.Context, txid string, params GetIndexedTransactionParams) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
@@ -46,7 +76,7 @@ type ServerInterface interface {
 	WaitForBlock(ctx echo.Context, round uint64) error
 	// Compile TEAL source code to binary, produce its hash
 	// (POST /v2/teal/compile)
-	TealCompile(ctx echo.Context) error
+	TealCompile(ctx echo.Context, params TealCompileParams) error
 	// Provide debugging information for a transaction (or group).
 	// (POST /v2/teal/dryrun)
 	TealDryrun(ctx echo.Context) error
@@ -62,6 +92,12 @@ type ServerInterface interface {
 	// Get a specific pending transaction.
 	// (GET /v2/transactions/pending/{txid})
 	PendingTransactionInformation(ctx echo.Context, txid string, params PendingTransactionInformationParams) error
+	// Simulates a raw transaction or transaction group as it would be evaluated by the network.
+	// (POST /v2/transactions/simulate)
+	SimulateTransaction(ctx echo.Context, params SimulateTransactionParams) error
+	// Stream the transaction pool and block events.
+	// (GET /v2/transactions/stream)
+	StreamTransactionEvents(ctx echo.Context, params StreamTransactionEventsParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -72,6 +108,510 @@ type ServerInterfaceWrapper struct {
 // AccountInformation converts echo context to params.
 func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"round":   true,
+		"exclude": true,
+		"format":  true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountInformationParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+	if paramValue := ctx.QueryParam("exclude"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude", ctx.QueryParams(), &params.Exclude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountInformation(ctx, address, params)
+	return err
+}
+
+// AccountApplicationInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountApplicationInformationParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountApplicationInformation(ctx, address, applicationId, params)
+	return err
+}
+
+// AccountAssetInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountAssetInformationParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountAssetInformation(ctx, address, assetId, params)
+	return err
+}
+
+// GetPendingTransactionsByAddress converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"max":    true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPendingTransactionsByAddressParams
+	// ------------- Optional query parameter "max" -------------
+	if paramValue := ctx.QueryParam("max"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPendingTransactionsByAddress(ctx, address, params)
+	return err
+}
+
+// GetApplicationByID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	return err
+}
+
+// GetApplicationBoxByName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"name":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxByNameParams
+	// ------------- Required query parameter "name" -------------
+	if paramValue := ctx.QueryParam("name"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument name is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
+	return err
+}
+
+// GetApplicationBoxes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"max":    true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxesParams
+	// ------------- Optional query parameter "max" -------------
+	if paramValue := ctx.QueryParam("max"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxes(ctx, applicationId, params)
+	return err
+}
+
+// GetAssetByID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetByID(ctx, assetId)
+	return err
+}
+
+// GetBlockHeadersCommitment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockHeadersCommitment(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":      true,
+		"first-round": true,
+		"last-round":  true,
+		"proof-round": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlockHeadersCommitmentParams
+	// ------------- Required query parameter "first-round" -------------
+	if paramValue := ctx.QueryParam("first-round"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument first-round is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "first-round", ctx.QueryParams(), &params.FirstRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter first-round: %s", err))
+	}
+
+	// ------------- Required query parameter "last-round" -------------
+	if paramValue := ctx.QueryParam("last-round"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument last-round is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "last-round", ctx.QueryParams(), &params.LastRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter last-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "proof-round" -------------
+	if paramValue := ctx.QueryParam("proof-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "proof-round", ctx.QueryParams(), &params.ProofRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter proof-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockHeadersCommitment(ctx, params)
+	return err
+}
+
+// GetBlock converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlockParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlock(ctx, round, params)
+	return err
+}
+
+// GetBlockHash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockHash(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockHash(ctx, round)
+	return err
+}
+
+// GetBlockHeader converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockHeader(ctx echo.Context) error {
+
 	validQueryParams := map[string]bool{
 		"pretty": true,
 		"format": true,
@@ -85,18 +625,18 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 	}
 
 	var err error
-	// ------------- Path parameter "address" -------------
-	var address string
+	// ------------- Path parameter "round" -------------
+	var round uint64
 
-	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
 	}
 
 	ctx.Set("api_key.Scopes", []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AccountInformationParams
+	var params GetBlockHeaderParams
 	// ------------- Optional query parameter "format" -------------
 	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
@@ -108,16 +648,15 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.AccountInformation(ctx, address, params)
+	err = w.Handler.GetBlockHeader(ctx, round, params)
 	return err
 }
 
-// GetPendingTransactionsByAddress converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Context) error {
+// GetProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty": true,
-		"max":    true,
 		"format": true,
 	}
 
@@ -129,28 +668,26 @@ func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Contex
 	}
 
 	var err error
-	// ------------- Path parameter "address" -------------
-	var address string
+	// ------------- Path parameter "round" -------------
+	var round uint64
 
-	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
 	}
 
-	ctx.Set("api_key.Scopes", []string{""})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPendingTransactionsByAddressParams
-	// ------------- Optional query parameter "max" -------------
-	if paramValue := ctx.QueryParam("max"); paramValue != "" {
-
-	}
+	// ------------- Path parameter "txid" -------------
+	var txid string
 
-	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	err = runtime.BindStyledParameter("simple", false, "txid", ctx.Param("txid"), &txid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
 	}
 
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProofParams
 	// ------------- Optional query parameter "format" -------------
 	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
@@ -162,15 +699,16 @@ func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Contex
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetPendingTransactionsByAddress(ctx, address, params)
+	err = w.Handler.GetProof(ctx, round, txid, params)
 	return err
 }
 
-// GetApplicationByID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
+// GetLedgerStateDelta converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLedgerStateDelta(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty": true,
+		"format": true,
 	}
 
 	// Check for unknown query parameters.
@@ -181,26 +719,50 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	}
 
 	var err error
-	// ------------- Path parameter "application-id" -------------
-	var applicationId uint64
+	// ------------- Path parameter "round" -------------
+	var round uint64
 
-	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
 	}
 
 	ctx.Set("api_key.Scopes", []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLedgerStateDeltaParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	err = w.Handler.GetLedgerStateDelta(ctx, round, params)
 	return err
 }
 
-// GetAssetByID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
+// SearchIndexedTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchIndexedTransactions(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty": true,
+		"pretty":         true,
+		"address":        true,
+		"asset-id":       true,
+		"application-id": true,
+		"tx-type":        true,
+		"note-prefix":    true,
+		"min-round":      true,
+		"max-round":      true,
+		"before-time":    true,
+		"after-time":     true,
+		"limit":          true,
+		"next":           true,
+		"format":         true,
 	}
 
 	// Check for unknown query parameters.
@@ -211,49 +773,121 @@ func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 	}
 
 	var err error
-	// ------------- Path parameter "asset-id" -------------
-	var assetId uint64
 
-	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchIndexedTransactionsParams
+	// ------------- Optional query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
 	}
 
-	ctx.Set("api_key.Scopes", []string{""})
+	// ------------- Optional query parameter "application-id" -------------
+	if paramValue := ctx.QueryParam("application-id"); paramValue != "" {
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetAssetByID(ctx, assetId)
-	return err
-}
+	}
 
-// GetBlock converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tx-type" -------------
+	if paramValue := ctx.QueryParam("tx-type"); paramValue != "" {
 
-	validQueryParams := map[string]bool{
-		"pretty": true,
-		"format": true,
 	}
 
-	// Check for unknown query parameters.
-	for name, _ := range ctx.QueryParams() {
-		if _, ok := validQueryParams[name]; !ok {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
-		}
+	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
 	}
 
-	var err error
-	// ------------- Path parameter "round" -------------
-	var round uint64
+	// ------------- Optional query parameter "note-prefix" -------------
+	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
 
-	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
 	}
 
-	ctx.Set("api_key.Scopes", []string{""})
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "before-time" -------------
+	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "after-time" -------------
+	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBlockParams
 	// ------------- Optional query parameter "format" -------------
 	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
@@ -265,12 +899,12 @@ func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetBlock(ctx, round, params)
+	err = w.Handler.SearchIndexedTransactions(ctx, params)
 	return err
 }
 
-// GetProof converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
+// GetIndexedTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIndexedTransaction(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty": true,
@@ -285,14 +919,6 @@ func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 	}
 
 	var err error
-	// ------------- Path parameter "round" -------------
-	var round uint64
-
-	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
-	}
-
 	// ------------- Path parameter "txid" -------------
 	var txid string
 
@@ -304,7 +930,7 @@ func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 	ctx.Set("api_key.Scopes", []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProofParams
+	var params GetIndexedTransactionParams
 	// ------------- Optional query parameter "format" -------------
 	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
@@ -316,7 +942,7 @@ func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetProof(ctx, round, txid, params)
+	err = w.Handler.GetIndexedTransaction(ctx, txid, params)
 	return err
 }
 
@@ -400,7 +1026,8 @@ func (w *ServerInterfaceWrapper) WaitForBlock(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty": true,
+		"pretty":    true,
+		"sourcemap": true,
 	}
 
 	// Check for unknown query parameters.
@@ -414,8 +1041,20 @@ func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 
 	ctx.Set("api_key.Scopes", []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TealCompileParams
+	// ------------- Optional query parameter "sourcemap" -------------
+	if paramValue := ctx.QueryParam("sourcemap"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sourcemap", ctx.QueryParams(), &params.Sourcemap)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sourcemap: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.TealCompile(ctx)
+	err = w.Handler.TealCompile(ctx, params)
 	return err
 }
 
@@ -578,6 +1217,100 @@ func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context)
 	return err
 }
 
+// SimulateTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":                 true,
+		"allow-empty-signatures": true,
+		"format":                 true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionParams
+	// ------------- Optional query parameter "allow-empty-signatures" -------------
+	if paramValue := ctx.QueryParam("allow-empty-signatures"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "allow-empty-signatures", ctx.QueryParams(), &params.AllowEmptySignatures)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter allow-empty-signatures: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SimulateTransaction(ctx, params)
+	return err
+}
+
+// StreamTransactionEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamTransactionEvents(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":         true,
+		"address":        true,
+		"application-id": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamTransactionEventsParams
+	// ------------- Optional query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+	if paramValue := ctx.QueryParam("application-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamTransactionEvents(ctx, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -596,11 +1329,21 @@ func RegisterHandlers(router interface {
 	}
 
 	router.GET("/v2/accounts/:address", wrapper.AccountInformation, m...)
+	router.GET("/v2/accounts/:address/applications/:application-id", wrapper.AccountApplicationInformation, m...)
+	router.GET("/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
 	router.GET("/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
 	router.GET("/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
+	router.GET("/v2/applications/:application-id/box", wrapper.GetApplicationBoxByName, m...)
+	router.GET("/v2/applications/:application-id/boxes", wrapper.GetApplicationBoxes, m...)
 	router.GET("/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
+	router.GET("/v2/blockheaders/commitment", wrapper.GetBlockHeadersCommitment, m...)
 	router.GET("/v2/blocks/:round", wrapper.GetBlock, m...)
+	router.GET("/v2/blocks/:round/hash", wrapper.GetBlockHash, m...)
+	router.GET("/v2/blocks/:round/header", wrapper.GetBlockHeader, m...)
 	router.GET("/v2/blocks/:round/transactions/:txid/proof", wrapper.GetProof, m...)
+	router.GET("/v2/deltas/:round", wrapper.GetLedgerStateDelta, m...)
+	router.GET("/v2/indexer/transactions", wrapper.SearchIndexedTransactions, m...)
+	router.GET("/v2/indexer/transactions/:txid", wrapper.GetIndexedTransaction, m...)
 	router.GET("/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET("/v2/status", wrapper.GetStatus, m...)
 	router.GET("/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
@@ -610,185 +1353,281 @@ func RegisterHandlers(router interface {
 	router.GET("/v2/transactions/params", wrapper.TransactionParams, m...)
 	router.GET("/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
 	router.GET("/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
+	router.POST("/v2/transactions/simulate", wrapper.SimulateTransaction, m...)
+	router.GET("/v2/transactions/stream", wrapper.StreamTransactionEvents, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+y9e3fbOJIo/lXw0+45eawoOa+eic/psz93nO72nSSdE7tn526c2w2RJQljEuAAoC11",
-	"rr/7PSgAJEiCkvzIq9d/JRbxKBQKhUI9P45SUZSCA9dqtP9xVFJJC9Ag8S+apqLiOmGZ+SsDlUpWaib4",
-	"aN9/I0pLxhej8YiZX0uql6PxiNMCmjam/3gk4V8Vk5CN9rWsYDxS6RIKagbW69K0rkdaJQuRuCEO7BBH",
-	"h6PLDR9olklQqg/lLzxfE8bTvMqAaEm5oqn5pMgF00uil0wR15kwTgQHIuZEL1uNyZxBnqmJX+S/KpDr",
-	"YJVu8uElXTYgJlLk0IfzhShmjIOHCmqg6g0hWpAM5thoSTUxMxhYfUMtiAIq0yWZC7kFVAtECC/wqhjt",
-	"vx8p4BlI3K0U2Dn+dy4B/oBEU7kAPfowji1urkEmmhWRpR057EtQVa4Vwba4xgU7B05Mrwl5XSlNZkAo",
-	"J+9+fEGePHny3CykoFpD5ohscFXN7OGabPfR/iijGvznPq3RfCEk5VlSt3/34wuc/9gtcNdWVCmIH5YD",
-	"84UcHQ4twHeMkBDjGha4Dy3qNz0ih6L5eQZzIWHHPbGNb3VTwvm/6K6kVKfLUjCuI/tC8Cuxn6M8LOi+",
-	"iYfVALTalwZT0gz6fi95/uHjo/Gjvct/e3+Q/Lf789mTyx2X/6IedwsGog3TSkrg6TpZSKB4WpaU9/Hx",
-	"ztGDWooqz8iSnuPm0wJZvetLTF/LOs9pXhk6YakUB/lCKEIdGWUwp1WuiZ+YVDw3bMqM5qidMEVKKc5Z",
-	"BtnYcN+LJUuXJKXKDoHtyAXLc0ODlYJsiNbiq9twmC5DlBi4roUPXNDXi4xmXVswASvkBkmaCwWJFluu",
-	"J3/jUJ6R8EJp7ip1tcuKnCyB4OTmg71sEXfc0HSer4nGfc0IVYQSfzWNCZuTtajIBW5Ozs6wv1uNwVpB",
-	"DNJwc1r3qDm8Q+jrISOCvJkQOVCOyPPnro8yPmeLSoIiF0vQS3fnSVCl4AqImP0TUm22/X8d//KGCEle",
-	"g1J0AW9pekaApyIb3mM3aewG/6cSZsMLtShpeha/rnNWsAjIr+mKFVVBeFXMQJr98veDFkSCriQfAsiO",
-	"uIXOCrrqT3oiK57i5jbTtgQ1Q0pMlTldT8jRnBR09f3e2IGjCM1zUgLPGF8QveKDQpqZezt4iRQVz3aQ",
-	"YbTZsODWVCWkbM4gI/UoGyBx02yDh/GrwdNIVgE4fpBBcOpZtoDDYRWhGXN0zRdS0gUEJDMhvzrOhV+1",
-	"OANeMzgyW+OnUsI5E5WqOw3AiFNvFq+50JCUEuYsQmPHDh2Ge9g2jr0WTsBJBdeUccgM50WghQbLiQZh",
-	"Cibc/JjpX9EzquC7p0MXePN1x92fi+6ub9zxnXYbGyX2SEbuRfPVHdi42NTqv8PjL5xbsUVif+5tJFuc",
-	"mKtkznK8Zv5p9s+joVLIBFqI8BePYgtOdSVh/5Q/NH+RhBxryjMqM/NLYX96XeWaHbOF+Sm3P70SC5Ye",
-	"s8UAMmtYo68p7FbYf8x4cXasV9FHwyshzqoyXFDaepXO1uTocGiT7ZhXJcyD+ikbvipOVv6lcdUeelVv",
-	"5ACQg7grqWl4BmsJBlqazvGf1Rzpic7lH+afssxjODUE7C5aVAo4ZcE795v5yRx5sG8CMwpLqUHqFK/P",
-	"/Y8BQP8uYT7aH/3btNGUTO1XNXXjmhkvx6ODZpzbn6npadfXecg0nwnjdnew6di+CW8fHjNqFBIUVDsw",
-	"/JCL9OxaMJRSlCA1s/s4M+P0TwoOT5ZAM5Ako5pOmkeVlbMG6B07/oz98JUEMnLF/YL/oTkxn80ppNqL",
-	"b0Z0ZcoIcSJQNGVG4rP3iJ3JNEBJVJDCCnnECGdXgvJFM7ll0DVHfe/Q8qE7WmR3Xlq5kmAPvwiz9ObV",
-	"eDAT8nr00iEETpq3MKFm1Fr6NStv7yw2rcrE4SciT9sGnYEa9WOfrYYY6g4fw1ULC8eafgIsKDPqbWCh",
-	"PdBtY0EUJcvhFs7rkqplfxFGwHnymBz/fPDs0ePfHj/7ztzQpRQLSQsyW2tQ5L67V4jS6xwe9FeGDL7K",
-	"dXz07576F1R73K0YQoDrsXc5USdgOIPFGLH6AgPdoVzLit8CCkFKISMyL5KOFqnIk3OQiomI+uKta0Fc",
-	"C8OHrNzd+d1CSy6oImZufI5VPAM5iWHevLPwStdQqG0XhR36ZMUb3LgBqZR03dsBu97I6ty8u+xJG/le",
-	"ulekBJnoFScZzKpFeEeRuRQFoSTDjsgQ34gMjjXVlboFLtAM1gBjNiIEgc5EpQklXGTmQJvGcf4woMtE",
-	"JQrqfnTIcvTS3j8zMNJxSqvFUhMjVorY1jYdE5raTUnwrlADT7/6zW5b2emsniyXQLM1mQFwImbufeVe",
-	"frhIimoZ7S0ujjs1YNVvghZcpRQpKAVZ4sxLW0Hz7ewu6w14QsAR4HoWogSZU3lNYLXQNN8CKLaJgVuL",
-	"E+5R2od6t+k3bWB38nAbqTRvTEsFRnYxpzsHDUMo3BEn5yDxcfZJ989Pct3tq8oB04m7gU9YYY4v4ZQL",
-	"BangmYoOllOlk23H1jRqiQlmBcFJiZ1UHHhAQfCKKm2f6IxnKDJadoPzYB+cYhjgwRvFjPx3f5n0x04N",
-	"n+SqUvXNoqqyFFJDFlsDh9WGud7Aqp5LzIOx6+tLC1Ip2DbyEJaC8R2y7Eosgqh2OqJah9VfHKrjzT2w",
-	"jqKyBUSDiE2AHPtWAXZD9fEAIOZ9UfdEwmGqQzm1zno8UlqUpTl/Oql43W8ITce29YH+tWnbJy6qG76e",
-	"CTCzaw+Tg/zCYtYaDpbUyHY4MinombmbUFKzuoQ+zOYwJorxFJJNlG+O5bFpFR6BLYd0QEh2pslgts7h",
-	"6NBvlOgGiWDLLgwteEBif0ulZikrUZL4G6xv/bnfnSD68icZaMpyyEjwARk48t66P7HKoe6Y1xO0dhJC",
-	"++D3pNDIcnKm8MJoA38Ga4XgW6vDSWCruAVJMTKqOd2UEwTU6zLNhRw2gRVNdb4215xewppcgASiqlnB",
-	"tLZmpLYgqUWZhANEH64bZnSqA6ux9zuwiy7jGIcKltffivHIii2b4TvpCC4tdDiBqRQin2w/8T1kRCHY",
-	"5eFxQEphdp05q6U3bXlKagHphBjUG9XM855qoRlXQP63qEhKOQpglYb6RhAS2Sxev2YGc4HVczIr6TQY",
-	"ghwKsHIlfnn4sLvwhw/dnjNF5nDhTf2mYRcdDx/iK+mtULp1uG7hxWuO21GEt+OL3lwUTobr8pTJ1te9",
-	"G3mXnXzbGdxPimdKKUe4Zvk3ZgCdk7naZe0hjSypWm5fO467k0IjGDq2brvvUoj5LayWZauYfSuDVWyl",
-	"jnDxjXLPCPRrBXoSlb1KA2DExA3yLEcFiJh3DiQpwJwUtWSlGbIxx601tFx5/s/9/9x/f5D8N03+2Eue",
-	"/8f0w8enlw8e9n58fPn99/+3/dOTy+8f/Oe/x+RVpdksriz7maqlgdQxzhU/4lbdPRfSvnLWTngS888N",
-	"d4fEzGZ6zAdL2um4xTaEcULtZiPNGdk4X9/CHWsHIhJKCQo5YvimVParmIeePI7y1FppKPpqGdv1twGh",
-	"9J0X6XpUKnjOOCSF4LCOOq8yDq/xY6y35coDnfF+HOrbFXlb8HfAas+zy2beFL+42wEbelv7Fd3C5nfH",
-	"7WjkQh8m1ChAXhJK0pyhvkFwpWWV6lNO8UUTkGtEm+/facNv3Be+SfxRHXnzuqFOOVUGh/U7J6qpnUNE",
-	"g/EjgH/qqmqxAKU7st0c4JS7VoyTijONcxVmvxK7YSVIVKlPbMuCrsmc5vgk/wOkILNKt6UddLVQ2ryY",
-	"rXrQTEPE/JRTTXKgSpPXjJ+scDjv0eBphoO+EPKsxkKc5y+Ag2IqiTPSn+xX5Kdu+UvHW9Hv1X72/OZz",
-	"XwAe9pgjgIP86NC9BI4OUdxrFIM92D+btqhgPIkS2ckSSME4+pN1aIvcN0KrJ6AHjYrR7fop1ytuCOmc",
-	"5iyj+nrk0GVxvbNoT0eHalob0Xn8+7V+iFltFyIpaXqGRrvRgullNZukopj6F9B0IerX0DSjUAiO37Ip",
-	"LdlUlZBOzx9tEcduwK9IhF1djkeO66hb1xe4gWML6s5Zq93831qQez+9PCFTt1PqnvUKskMH7hyRR6sL",
-	"SmnZVczirVe7dYs65af8EOaMM/N9/5RnVNPpjCqWqmmlQP5Ac8pTmCwE2SduyEOq6SnvsfjBwBP02XXQ",
-	"lNUsZyk5C6/i5mhaZ+L+CKen7w2BnJ5+6Cnp+xenmyp6Ru0EyQXTS1HpxHlLJhIuqMwioKvaWw5Htr7O",
-	"m2YdEze2pUjnjenGj7NqWpYqyUVK80RpqiG+/LLMzfIDMlQEO6GTB1FaSM8EDWe00OD+vhHOTCHphXe1",
-	"rRQo8ntBy/eM6w8kOa329p4AOSjLV2bMYwPH747XGJpcl9BSb+zontMMFlNt4MKtQAUrLWlS0gWo6PI1",
-	"0BJ3Hy/qAhVpeU6wW4iT2sSNQzUL8PgY3gALx5UdkHBxx7aXD3uJLwE/4RZiG8OdGv30dffLDPWzyA2R",
-	"XXu7gjGiu1TpZWLOdnRVypC435naG35heLI3Gii24OYQuMCBGZB0CekZZOjDDEWp1+NWd2+XcjecZx1M",
-	"WV9/62eEDqmoCZoBqcqMOhmA8nXXM1CB1t4d8h2cwfpENP6sV3EFvByPUut9nxiaGTqoSKnBZWSINTy2",
-	"bozu5jsbp4GUliVZ5GLmTndNFvs1Xfg+wwfZ3pC3cIhjRFGjYQO9l1RGEGGJfwAF11ioGe9GpB9bnhFv",
-	"Zvbmi+hNPO8nrkkjtTk7Zbiak2X9vQAMHBIXisyogowIF/Niw0cCLlYpuoABZU6ojNvRJ7OlwMNBtt17",
-	"0ZtOzLsXWu++iYJsGydmzVFKAfPFkIo53l3rtJ/J6ntxBROCoawOYbMcxaTaMG6ZDpUtpaiNzRsCLU7A",
-	"IHkjcHgw2hgJJZslVT4cB6OW/FneSQYYMuHVJlhD4N4Gi0/RRqhjZt4czukQ/od9yI8Cw2oQmlR7iHue",
-	"2z2n4zpawEYJe09y7z7ufcZH4yv5f49Hztcnth2CowCUQQ4Lu3Db2BOKA+2eCjbIwPHLfJ4zDiSJ2Wip",
-	"UiJlNp6quWbcHGDk44eEWN0T2XmEGBkHYKMdAwcmb0R4NvniKkByYGj4oH5stIAEf8N2RXgTru0k760S",
-	"cps39jlJc6TGTXCF3dS+umw8ijKooadM2w5hm8yg9/aLEaxhVH0FUl9NpSAHlBuSFp9NzmJqRSP+ABLl",
-	"se8WvG/IfTY30siDwLglYcGUhuaBb86u11h9XiXLudCQzJlUOkHdQnR5ptGPCqXWH03TODNqG59s9CfL",
-	"4rwIpz2DdZKxvIrvtpv3b4dm2jf1Q09VszNY45UDNF2SGUYrR03SG6a2XgsbF/zKLvgVvbX17kZLpqmZ",
-	"WAqhO3N8I1TV4S6bDlOEAGPE0d+1QZRuYC/4SDuEXMec0gOxC5/fhn3aqIlB9UbvMGV+7E3CWADFMB+2",
-	"I0XXEkjkG1fB0GRoREqmg2DfvgftwBmgZcmyVUfZYEcdFEnplV4U9mkSsZmN6sG2YCBQLMSctCR45Yjd",
-	"0uAGtWHbPFzbZCfMGFksREjAEMKpmPJJR/qIMqSNkfHbcHUCNP8brP9u2uJyRpfj0c10EzFcuxG34Ppt",
-	"vb1RPKPS3b5VW6rGK6KclqUU5zRPnAZniDSlOHekic29wuczs7q4nuDk5cGrtw5880jOgUqr09u4KmxX",
-	"fjOrMk93IQcOiE9qYGRX/8i3gliw+XWkWKj1uViCCyAPZDnDxRxx2ePVaPSCo+i0QPO47W+rTscpH+0S",
-	"Nyghoax1kM372Kog22pHek5Z7h+mHtoBOx0urlH8XpkrhAPcWH0ZaKGTW2U3vdMdPx0NdW3hSeFcG0Lc",
-	"C5vFQRHBuw5gRoTE9y6SakHXhoKsFr3PnHhVJOb4JSpnaVyJwWfKEAe3ymnTmGDjAWHUjFixAVsHr1gw",
-	"lmmmdjDrdYAM5ogiE3VfG3A3Ey79VsXZvyogLAOuzSeJp7JzUM259Clc+tepkR36c7mBrQqsGf4mMoYZ",
-	"aki6QCA2CxihKrwH7mH94PQLrXX45odA53cFi1o4Y+9K3GANc/ThqNm6JSzbKu0wW1af/xnCsJkVtqfq",
-	"8kqMpQV0YI5o6q3B2+Jg+KYwva9wRzRXAoIbXgZjq1nNlYgMU/ELym0mHdPP4tD1VmB1BqbXhZAYkqIg",
-	"6k7AVDKX4g+Iv2TnZqMiPqoOlSguYu9JxNW/y0RrHU2TI83jN4RjkLSHJLngI2lbPAdOOFJ5oOPHGG+v",
-	"7qLckrXN+tOys8cPR+gbM7XjN4fDwdzzJ8rpxYzGAuCNQGVgOmisSS3FnBbEd/a74HSIDe0Fhqm6LbNx",
-	"HCXIxpG8HzN4TeHo2yL5DFJW0DwuJWWI/XbUWsYWzKZOqhQEuXncQDbnnKUil9/I2usa1BzNyd44yP7l",
-	"diNj50yxWQ7Y4pFtMaMKb61a+Vp3McsDrpcKmz/eofmy4pmETC+VRawSpBZg8SlXa8JnoC8AONnDdo+e",
-	"k/toA1DsHB4YLDpZZLT/6DkqUe0fe7HLzuVI28RXMmQs/+UYS5yO0QhixzCXlBt1Eo0psokth1nYhtNk",
-	"u+5ylrCl43rbz1JBOV1A3OxcbIHJ9sXdRKVhBy88s1nZlJZiTZiOzw+aGv404ENn2J8Fg6SiKJhG854W",
-	"RInC0FOTeMdO6oezKd5cMgwPl/+IBpfSPhug+2D+vApie5fHVo1msTe0gDZax4Ta0LucNaZQxxAn5MgH",
-	"8GJ2kDopiMWNmcssHUU6tIzOSSkZ1/iIqvQ8+StJl1TS1LC/yRC4yey7p5GMKO0kCPxqgH92vEtQIM/j",
-	"qJcDZO+lCdeX3OeCJ4XhKNmDxmc1OJXRVAZC0zzufeM5etf5avPQuwqgZpRkkNyqFrnRgFPfiPD4hgFv",
-	"SIr1eq5Ej1de2WenzErGyYNWZod+fffKSRmFkLF0Ds1xdxKHBC0ZnKMjUHyTzJg33AuZ77QLN4H+y1pZ",
-	"mhdALZb5sxx7CPxQsTz7e+OD30kqJSlPl1Ebx8x0/K3Jglcv2Z7jaPaAJeUc8uhw9s78zd+tkdv/n2LX",
-	"eQrGd2zbTRZll9tZXAN4G0wPlJ/QoJfp3EwQYrXtlFx7seULkRGcpwlVb6isn/8qSJzzrwqUjmXkxQ/W",
-	"ARR1WeZdYPO2EOAZStUT8pPNYr0E0oqkRWmWFVVuozIhW4B0StaqzAXNxsSMc/Ly4BWxs9o+NtuozRuz",
-	"QGGuvYqODiPIa7GbT5ZPIxf3F919nM0ObGbVSmNgu9K0KGOhAKbFiW+A8QahXhfFvBA7E3JoJWzl5Tc7",
-	"iaGHOZOFkUzr0SyPR5ow/9GapksUXVvcZJjkd0945KlSBYk/6xyKdWoKPHcGbpfzyKY8GhNh3hcXTNnk",
-	"xXAO7eiDOhTHPZ18NEJ7ebLi3FJKlEdvChW7Dto9cNZ471W/Ucg6iL+i4KJEJVO4av6nY+wVjfXuJpPq",
-	"Zfy0YY91xj2flD6lXHCWYqR1kC65BtklQt7FLrJDUHpXLeWPuDuhkcMVTWFVuwc5LA4mtfKM0CGur5gN",
-	"vppNtdRh/9SYcXdJNVmAVo6zQTb2acqcvoRxBS7VCObEDvikkC1bE3LIqPkyqdXcVyQj9EUeEIB/NN/e",
-	"uOcROumdMY6CkEOb8we0Gg3M06qN9MQ0WQhQbj3t2GH13vSZYPxsBqsPE5/XFcewphqzbGuX7A914K2U",
-	"zipo2r4wbQmaZZqfW37PdtKDsnSTRkN/6x2OJVobRHDE2pR4dX+A3Hr8cLQN5LbRvQDvU0NocI7GSSjx",
-	"Hu4RRp2zrpN88pzmlaUobEGsW080Xo3xCBivGIcm63DkgkijVwJuDJ7XgX4qlVRbEXAnnnYCNEeLZIyh",
-	"Ke1UtDcdqrPBiBJco59jeBubdHsDjKNu0AhulK/rZMeGugNh4gVmWXeI7CfPQ6nKCVEZunF20unFGIdh",
-	"3D4RZfsC6B+Dvkxku2tJ7cm5yk00FJmTipi8+XIFaWUN7sLm8KBlSVIMdQ3ui6hGkynzeCpmecT37bD+",
-	"GOSoRJfb2Rr/jWVWGUaJs4hf2SfLm7+x45UF1vZIPXHTEFOi2OKa29z0v9V9zsWiDcjnVShsPOMhycRO",
-	"90vDNsNgzV7OHstY61hKdEMSPoExPprqKKD2mURGHn2UNrloNz/Kh7PKjpH1DzgjvmvSBFB7u1gbw5BL",
-	"YjroQUu1c5bXlDQx+f2DaVPBxkaw/gw2Ba0t5xLVrwz5MFgXBvO513s3uagnZeLYGxHqnWP6AP3Ne96R",
-	"kjJnQGtObB+zzke37zW9i/des8HdRTjPVxwktpJebq7NFNLzfA58320KpcnuUbqNQR5tJpgAdwHcZcBt",
-	"+zTu7Fk1n0Oq2fkWT/P/MhJr48U89jKtTRseOJ6z2lPHV/25oqjdALTJEXwjPEEqgBuDM+Rnegbre4q0",
-	"qCGa02nsCfU6QWCIAUyTkBgSESqm/bePcKeQZaqmDMSCt7bZ7tBkqBlMplm7e8USEu00lydJQp2cVWf7",
-	"GcrfKWJS/E5zma47OF413tvokjHkjN5PZzd8ex1i9kBVJ0Kuy/oEzhTmsdbNCnXhgtAwLqDWO/lwNFD+",
-	"Nx9CY2ex5aKadJ+o5bugMvMtomKrl4iTAfeursO09UtncaDn9cys8Y3o+wxHgrfRFybNhWJ8kQy5TLXd",
-	"EWpd/j1ljS6oIMA8gQjXHKRL86t9Na5EC+9LsQmOTahwxSCugwQ1mNvLAjcYxviuidPEjDXU1mJzBqVw",
-	"gURCQQ10MoimHJ5zE7Jf2O/eSdZnLOnkB4qM6+k12RoO6b1imOohMaT6OXG35Xbn2+u8FxjnNou6ioVW",
-	"coPKUJNUSpFVqb2gw4MB/l21c+DyBlYSlfLT/ip7AluOYfyvglCGM1hPrdCULilv8im0j7VNpm7XEATe",
-	"dXb7Vp9ScYE1X9gFLG4Fzi/5EhqPSiHyZEB1dNSPEO2egTOWnkFGzN3h7ckDCTXJfdRY1LaBi+Xapw8v",
-	"S+CQPZgQYt5SRanX3kzQzo3UmZzf05vmX+GsWWWDtt0jbXLK464QtrrhDfmbH2YzV7Plfm84lR1k80R6",
-	"xQdYG72IpJfdtTJORHHfTfnZEJWFIialXDNWbqfz3X+oRUg/jHLY8v45a73qbPaPjrJeSLjl112gpbzi",
-	"664fv7Hr8nAdyNUqBf117rwBLdwO4H4XxDeqiT5yhzUKeraLRiGeqcB0R5WGRQim+SAIKvn90e9EwtyV",
-	"Wn34ECd4+HDsmv7+uP3ZvL4ePoyezM+mzGgV4HHzxijm70PGXWvAHPAj6OxHxfJsG2G0vEKaFHzo9/Cb",
-	"85/5IkkAf7NP5P5RdfnQrqJG7W4CIiay1tbkwVSBv8cOrh6uW8SxAy+btJJMrzGEyb+o2G/R0PCfaiWM",
-	"q+pWO4I7P2RbUNS5JTUqm6YG5E/C1mUqzF2PinWNubRfrmhR5uAOyvf3Zn+BJ399mu09efSX2V/3nu2l",
-	"8PTZ8709+vwpffT8ySN4/NdnT/fg0fy757PH2eOnj2dPHz/97tnz9MnTR7On3z3/yz1fgNEC2hQ3/Adm",
-	"ykwO3h4lJwbYBie0ZHUKfUPGPuseTfEkmjdJPtr3P/3//oRNUlEENePdryPnozZaal2q/en04uJiEnaZ",
-	"LvCNlmhRpcupn6efuvztUe0/Y+MecEeta4QhBdxURwoH+O3dy+MTcvD2aNIQzGh/tDfZmzzC5LYlcFqy",
-	"0f7oCf6Ep2eJ+z51xDba/3g5Hk2XQHO9dH8UoCVL/Sd1QRcLkBOXftD8dP546s3v04/ufXppRl3Egrus",
-	"J1Dg/tHPyud0XWjU8XWFg+wqyiVdGde5j5z4yDN00LBPPsPaamQdZU0Gj6OgFKKLxLKh6fvvv6Ga0bHy",
-	"ALH0hpFSsI2qaLgKbFAo3xfHf/bXy4gf4IdOZc/He3ufoJrnuDWKx8s1y4I+vUUQ2wagGwPaHa7HFV7T",
-	"3NAN1JXeR7igR9/sgo446r8N2yKWLV+OR8++4R064ubg0JxgyyCSps8Kf+VnXFxw39JcyVVRULnGCzdI",
-	"OhiKVpeDLLcdw+a0tcN8GIJCFUFWtZa2aLb2dDYmqq5mVEomjOAwNq+ADFIJFK95IdFdryl54TQDYMs3",
-	"vT74B+qLXx/8g3xPhmrGB9PbF3mbif8EOlKS5Yd1U/d4I0f/Umxy/NWW2f927rybXjV3hX2+2cI+OzDt",
-	"u929K9v0zZZt+rZF0lUdf0wJFzzhmGXyHEig1rqTUb9qGfXZ3pNvdjXHIM9ZCuQEilJIKlm+Jr/yOmDj",
-	"ZiJ4zXMqHoTQbOQ/PfNWI0UH4nuQjHv6seXJkG1XnoT2haPDMWG6kQxb3g5Bht46GbAL1hs3mb4oz6yj",
-	"vfd8VWOf8Qq1ddYea/dj3MuHNYkJ6YGZ5of10eEucnkIeJiIJyabt/C1UUTvXVqfVGMRBnxF7rX43nzq",
-	"G6AHxw80Iz6i7xPz5t2Y6dO9p58PgnAX3ghNfkRHj0/M0j+pniBOVgGzwYT3048+Z88ODMZlXWqzFuc9",
-	"tJGpmBM6dkH6rrRYbd03/MQyQpuSrM81zAy78ot+yq4Yp2jSFH0tPMIm/I/QZRe9d3zhji/ciC90Carh",
-	"COgjq6Yf0ZMtZAe9I4nFLf9EhpKgnIEUhc+gK8gcdLq0vsNdW3aErfi40WGesim70o35S8e6jlvUzy6B",
-	"a3H2Wsz6s6MXD3b82ZpPL8ejFGSE+H7xQSzmM5ujL1YdE+yTiGEmDebzatQpNVziIaaIIVAtiAtVIWYX",
-	"rwTli2byvm0d0XI9bdIdgm+C4B5Te+kynNjj5RbxrSs+gtuSJOQNikN4wH1I7J9R7fEpb+RPvaA3ggOB",
-	"FVNY5sTS4p25sRYX6lLetetyWKVxQHRoGx0/6hXLLqd1bM2QUPHW1aTeKFQ0NzVrMt231Su0LIFKde1L",
-	"ers57KQz49FhWImjFQpUBwFFQDF4uaIl8T92MSP+ea11d3Xp7+rSX68u/Wd9MjcOOZZVeTuR7HCNL/qe",
-	"1l/kPf1G8ARvW+DaS34ttHy5tzUGILQK5PkcUlzYivhCopAQ8gE12el6hUFTQoupoEvnMBm7yzalOl1W",
-	"5fQj/gedQS8bt0ubMG1q1Wyb7ttj2+JWHSjsmEQ2Pvmh/7FT/UXLFKu10lD002nbrr9tSsUV5eECa+Ml",
-	"heAx12VbOe81foyGwqBRdqAzmseH+naTILbg74DVnmcXVndT/E6+DhXejcTRzmollLUTGlrrkf6b09Kt",
-	"Sxr7efqxXbbLasNdS7WsdCYugr5NMcjBs2Vb3OrZeiMysOO2vfv7KUEpujs4j+j+kaq5Rjzay+O3aWcD",
-	"75hyoYoprRZLbdNBR3PN1x0TmtqjYMP51bb4Z9vKx/mdA6G5BJqtyQyAEzEzi27nkeiWs3S8MR7G28BV",
-	"SpGCUpAlYR7ITaDVfuaoIdQb8ISAI8D1LEQJMqfymsBaJrEZ0G4C5BrcWg/k+EAf6t2m37SB3cnDbaQy",
-	"KEOsBfrZ5OAKiUdQuCNOUHhln3j//CTX3b6qxFSDkUB0+/WEFRg0xykXClLBMzWcLmLbscUEEcFaFNjs",
-	"+v6kRDO4mYEHrtZXVGmX6bIVVRukGTFTbMhvMRQjZkb+ex0h1hu7KYdaJwG1shdk0fzqsNow1xtY1XOJ",
-	"eaTUqqv9sG3kISwF49dpQYOEFTrQUZjhIou7YHmO1tq4JNICokHEJkCOfasAu6EiYAAQphpE11HobcoJ",
-	"6jIoLcrSnD+dVLzuN4SmY9v6QP/atO0Tl3MNR76eCVCh4O0gv7CYtRl/l1QRBwcp6JmT2RfOQ7sPszmM",
-	"iWI8dVl2hrI5sAKOTavwCGw5pF2xLzz+rXPWORwd+o0S3SARbNmFoQXHBM2vQiy86ruvq1H4hIrQtqAd",
-	"iFeNoGn/nl5QppO5kC6DEdaUidhUO4mdKNOukpF7FWvhFJmuKo1lKG6cIN+1Ct1bXeFxn3yBFRE/LDPV",
-	"j0LuZMJttK1aELMwUnHNfACeOW+1jPn12UPvpOc76flOer6Tnu+k5zvp+U56vpOeP7X0/GV8MkmSeD7t",
-	"A25i4TZk9E1K+N9QRMvnDEFphP5a5MdHghHRzTne6KuhgeZTV2UCjerRnOrW6TusWJGa6RgnZU6xXOVK",
-	"+9BjrFQZ1KzyqdJtRiXDa0yDJ4/J8c8Hzx49/u3xs+8M91nasllh2/s+2a/S6xweOJ+2OuWJd24DTjEn",
-	"O/q2Uf/6Sb3fg5Xm5ywHogyyXmLzQziH3Ijy1vpJzGOk/zw6AZq/cMixXAmU/kFk6w7hmPVPERVtkmlM",
-	"6IxTGamb0CeUHpK1wNoprhBI7wV1eateFHHPgf6GbdurgZKBUfLeRC9bPQVcySs39i5WM7OnHp3E1Vz4",
-	"oiybIESOzBr29NX41ndz/rqDg22NVOHO37fqB+8RHz14eGzHPicqwfrlluJWiWm0AJ44tpDMRLb2tcVd",
-	"CZcWl7W1NYaZrC1cAa4ykDsG99UDw2YRoyvdUvVEa5sFdQCbhK1fhnHaqg4b+eb1qaNddO7GXpTd4fpc",
-	"I3DDuC8kWUhRlQ9sFWu+xidxUVK+9mowIyti1TrMYI2e37fLqeu0qz0+u3vRtfC9gmH83d8tWjBZq6u4",
-	"ltmSa/GciN3CYNsx3pS92ZYHz2cEjZToGijI1d9Ev8vO9bFW/ZU2P3KkUE6nLM5duNX/iCvhrRTnzDyc",
-	"oxy275fVMITJ1ptBBiwLr4ZO8g1/N7T56Tt6cdIqXrQbT10lTvC8sVS6BBTIaiktkqnE3JdS0CylCiNK",
-	"XC3DTyyx6tVRRO+AYGLGqb7vr7nAJ1sFSxx3J3my7fvtJsSUMMqm1vyy0mXjf3rgAnha2LhTBfxZVAE/",
-	"+MOnCMUs3Z3DGdQX3YFN0Qu94lEuNUUr4bDHW3Ag3tqWt2q76w3fNuE1JkxngoC8JJSkOUMDheBKyyrV",
-	"p5yiCrSTwrxj3vOK3WFR6oVvEtfCR5TkbqhTTrEmfa0YjYpUc4hV2wTwEpuqFgtQusOJ5wCn3LVivKl/",
-	"jxnhE+sJaq5rw9EntmVB12SONfIE+QOkIDPzigizmKBCUWmW586eaKYhYn7KqSY5GKb/mhmBzgzndU61",
-	"jdzVtfVYGKh0YXPMJnEtxE/2K4YxuOV7vRGqt+znprjPF8kEncSKJTnIjw5dhrGjQ0wa01gSe7B/NvNS",
-	"wXgSJTJz4zuLfJe2yH0j43kCetDYJN2un3IjTGtBkNFTfT1y6JoBemfRno4O1bQ2omMt8Gv9EItuXYjE",
-	"PBmxbt5owfSymmEuZh/1Ol2IOgJ2mlEoBMdv2ZSWbKpKSKfnj7bIBzfgVyTCru5u7j+PEj+kA3Na6o3H",
-	"EkXdvR+4l28hoevXncV1q4vSXc7Uu5ypd1k173Km3u3uXc7Uu4yidxlF/6dmFJ1slBBdFo6tOf50T7VJ",
-	"m7qtNQMPm7WyAfbNkkxPCDnBqpjU3AFwDpLmJKXKCkauzG3BFktNVJWmANn+KU9akKSicBPfb/5rn7mn",
-	"1d7eEyB7D7p9rN4i4Lz9viiq4idbkf17cjo6HfVGklCIc3C5wcIqgbbX1mH/v3rcX3oFR1ELg8oVX9eQ",
-	"qGo+ZymzKM+FeQwsRMe/jwv8AtIAZ1NPEKZtGlbEJ/pFOu+cdjHDttDdv9+vUArnoJvP4C7NySevf7Op",
-	"wupNeeDGsXsM8Y5lfA6W8cWZxp8oI9td8rWvbEGhIbWVXfUGklRdQy5Wmt7JSE2NxrDmId5wdbXD9x8M",
-	"H1cgz/3l15Tw259OMf/5Uig9HZmrqV3eL/xo7ge6sCO4y6WU7BxzJ364/H8BAAD//9ll/tXc8QAA",
+	"H4sIAAAAAAAC/+y9e5fbNpI4+lVwtXuO7V5J3X4kO+l7cvZ2bCfpndjxcTuzMxvnxhBZkjBNARwC7Jbi",
+	"n7/771QBIEESlNhPP9L/JG4Rj0KhUCjU8/0oUatcSZBGjw7fj3Je8BUYKOgvniSqlGYiUvwrBZ0UIjdC",
+	"ydGh/8a0KYRcjMYjgb/m3CxH45HkKxgdhv3HowL+VYoC0tGhKUoYj3SyhBXHgc0mx9bVSOvJQk3cEEd2",
+	"iONnow9bPvA0LUDrLpQ/y2zDhEyyMgVmCi41T/CTZufCLJlZCs1cZyYkUxKYmjOzbDRmcwFZqqd+kf8q",
+	"odgEq3ST9y/pQw3ipFAZdOF8qlYzIcFDBRVQ1YYwo1gKc2q05IbhDAirb2gU08CLZMnmqtgBqgUihBdk",
+	"uRod/jrSIFMoaLcSEGf0z3kB8AdMDC8WYEa/jWOLmxsoJkasIks7dtgvQJeZ0Yza0hoX4gwkw15T9qLU",
+	"hs2Acclef/+UPX78+BtcyIobA6kjst5V1bOHa7LdR4ejlBvwn7u0xrOFKrhMJ1X7198/pflP3AKHtuJa",
+	"Q/ywHOEXdvysbwG+Y4SEhDSwoH1oUD/2iByK+ucZzFUBA/fENr7WTQnn/6i7knCTLHMlpInsC6OvzH6O",
+	"8rCg+zYeVgHQaJ8jpgoc9NeDyTe/vX84fnjw4d9+PZr8r/vzq8cfBi7/aTXuDgxEGyZlUYBMNpNFAZxO",
+	"y5LLLj5eO3rQS1VmKVvyM9p8viJW7/oy7GtZ5xnPSqQTkRTqKFsozbgjoxTmvMwM8xOzUmagNY3mqJ0J",
+	"zfJCnYkU0jETkp0vRbJkCdd2CGrHzkWWIQ2WGtI+Wouvbsth+hCiBOG6FD5oQZ8uMup17cAErIkbTJJM",
+	"aZgYteN68jcOlykLL5T6rtIXu6zYmyUwmhw/2MuWcCeRprNswwzta8q4Zpz5q2nMxJxtVMnOaXMycUr9",
+	"3WoQayuGSKPNadyjeHj70NdBRgR5M6Uy4JKQ589dF2VyLhZlAZqdL8Es3Z1XgM6V1MDU7J+QGNz2/z75",
+	"+SVTBXsBWvMFvOLJKQOZqLR/j92ksRv8n1rhhq/0IufJafy6zsRKREB+wddiVa6YLFczKHC//P1gFCvA",
+	"lIXsA8iOuIPOVnzdnfRNUcqENreetiGoISkJnWd8M2XHc7bi628Pxg4czXiWsRxkKuSCmbXsFdJw7t3g",
+	"TQpVynSADGNww4JbU+eQiLmAlFWjbIHETbMLHiEvBk8tWQXgCLkDHCGHgSNhHaEZPLr4heV8AQHJTNkv",
+	"jnPRV6NOQVYMjs029Ckv4EyoUledemCkqbeL11IZmOQFzEWExk4cOpB72DaOva6cgJMoabiQkDIhLdDK",
+	"gOVEvTAFE25/zHSv6BnX8PWT0YddXwfu/ly1d33rjg/abWo0sUcyci/iV3dg42JTo/+Ax184txaLif25",
+	"s5Fi8QavkrnI6Jr5J+6fR0OpiQk0EOEvHi0WkpuygMO3cg//YhN2YrhMeZHiLyv704syM+JELPCnzP70",
+	"k1qI5EQsepBZwRp9TVG3lf0fjhdnx2YdfTT8pNRpmYcLShqv0tmGHT/r22Q75kUJ86h6yoavijdr/9K4",
+	"aA+zrjayB8he3OUcG57CpgCElidz+t96TvTE58Uf+L88z2I4RQJ2Fy0pBZyy4CjPM5FwxN5r9xm/4ukH",
+	"+zzgdYt9ukkP3wew5YXKoTDCDsrzfJKphGcTbbihkf69gPnocPRv+7VWZd921/vB5D9hrxPqhIKoFW4m",
+	"PM8vMMYrFGj0Fi6BnJk+EX+w/I5EISHt7iENCeS9GZxxaaajceww1if3VzdTjW8rw1h8tx5WvQhntuEM",
+	"tJVrbcN7mgWoZ4RWRmglMXORqVn1w/2jPK8xSN+P8tzig2RCECRuwVpoox/Q8nl9hMJ5jp9N2Q/h2CRg",
+	"K1QazcDJGHgpzN115a6vSmPk1lCPeE8z2k5UwXwYV2jQGsx1UBw9FpYqQ3FnJ61g4x9d25DM8PdBnT8P",
+	"Egtx209c2Io5zNmXC/0SPFnutyinSzhOiTNlR+2+lyMbHCVOMJeila37acdFPOIsV+SDA1lUdNfqzyGV",
+	"0NovfUp2UnIUEvzQhuG7TCWnP3K9vIbTOsOxJkuul92TQ/OwJfAUCoZNpqOYZBCejGC4IccDF0FPbjYL",
+	"5prWi6S/r2GZduAdS0y54dNRG+y4SBGA10GCm2wIAp7bJ3Rj+e7V3caCfqpWK2FWIK+DS89Foc1kC8uk",
+	"Bo5xOmtDweUCYuxxPBJp5EXzSmmB/8T+eaHU3M7nny+mABijaso4vVLVYCVkqVkAYnzSjG9fQsYvsAKa",
+	"P6JugOI0AwsdjrICfCzopcj9mH7TGmscM275Z6JIcSC5R4RjtmrOUrEAbd+T9bNqY6Chkv3/7//XIapi",
+	"+eSPg8k3/7H/2/snHx7sdX589OHbb/9P86fHH7598F//3j2xeFEqE3suKeMBdKvGHWLnS6WBZcDPoFYW",
+	"Onrlegm6gVxSCzqcF3SWb3d1raMYknmDYBwWhpxRh4ykOn7MKMbtcnHt4dnV9am9LuZ8U0xrPEqgiNDB",
+	"z/QPnjH8jDIFN14RiEpQQaKBCkyWKeoO7ZG2M2ED0mkqtrLqQoZqvgtB+bSePH7JXIK9hnxVra/9Ev9O",
+	"rWMwfKfWnQtcrUFfB32otf2HMLDSA+B75iBTtP8Ofbwo+KaLZBp7CJJxgfhWJkbAZfjSwFlqU8/RTBWX",
+	"u7taQpFktQGLcRy1UlkjkTWRRE3LfOJIMaIEtw1aA9U+A9s5THv4GMYaWDgx/AawoA0PgL8CFpoDXTcW",
+	"1CoXGVyHQBeVWFEr+fgRO/nx6KuHj35/9NXX7lpeFHzF8PbR7L5TBjFtNhk8iN6PpKuLj/71E2/2aI4b",
+	"G0erskhgxfPuUNacYu/NMyi0UJI9ZrYDW/F8jP/J8RkIPFlWU6n53DFWYbRvnglpFQyJysqVHHVR3xJP",
+	"EXXVKoec8DeA14HdO2bNjbi+Z8WmKK9DSQVFoYqIypyI2KhEZROHpIiQ6VpUaHQP17z9u4WWnXPNcG6y",
+	"5pTSiSididFMM5iz2qHfrGWNm6281a43sjo375A9aSLfGwc0y9GyvJYshVm5aOg45oVaMc5S6ki34PMz",
+	"kZhX1jD1JjBnXceW4tAQEcxfxg1oBazUGaQWSNo/pbIBqhg/zyAyXkKf/c4NU08ffHWgfBiPfgBDwskb",
+	"sYITw1f5z3QerwFd9mD3WK4qkDUkSqaazcCcA0jcdA1JacSZl8iNWIFGyPSYqYIdVGaXc7RBJthmAFId",
+	"NENw2iZAb91I4QwyXCFbqbQDnWNkDqcnG5mQseYaELnlOag3Mmk+BzNIF1AMQMhwhV8fPuxU93QABa7+",
+	"WKawhvSaDx+aIidkzexi4hcNVhOa84Wwz9KxFeBX/NTqHRXpF3H9oI3XDFqdKQ1aOwA6o6pTMcYZabC0",
+	"+L6sUHYgPKl8kiHdNM5m7EU5iCt3GVuF2l0MugH0ID1vZVoOu9bsBN1IxBnPmMANJ2byE1EEmQeeQWb4",
+	"tb9H2hPE4H7qrw1LnyzFhqRNfyGkeQZnL1QKxPP0zR7OQFdzXghjQJIPzA0fUnrIR1nVSkiDNOlOAWLk",
+	"pUqR65tSX4P0Xg9WX9242vDC5jNVGsaZRLA0NY7L9T2Og4hX62hlwqeC17jNABeY8HKxNAxtuCp2fuuO",
+	"E55YZE8IRXrXbWVb2emsU1pWAE/R/ACSqZlzZnBuFrRITj5QlRrKvSqi+roArrxQCWiNZiNrQdgJmm9X",
+	"H9A+PBHgBHA1C9OKzXlxSWCNMjzbASi1iYFbaVyE7IF62PTbNrA9ebiNvADmjxweHuQ6GRjoQ+FAnJxB",
+	"QZ4QN7p/fpLLbl+Z9/gpu5czCoW4L5JL5QS1fq31rmOLjcK1aFxBcFJiJ3WbOvwnrs1rp3xPSatm2U3A",
+	"dnGKfoB731848t/806s7NomoUpe6eofpMs9VYSCNrYEkl965XsK6mkvNg7Grx55RrNSwa+Q+LAXjO2TZ",
+	"lVgEcVNZj53k010c2VjxHthEUdkAokbENkBOfKsAu6GvZg8gQteItoQjdItyKgfR8Ugbled4/syklFW/",
+	"PjSd2NZH5pe6bZe4uKn5eqoAZzceJgf5ucWs9dJdcs0cHF4UJbWHddzpwoyHcaKFTGCy1RCEbzVsFR6B",
+	"HYe0R7nl4gAaVoTG4WjRb5Toeolgxy70LbhH0/aKF0YkIidJ4q+wuXbpsj1BXCpOwXCRQcqCD9bgm4f9",
+	"mfXEao95OUFr2OOgA37nSRBZTiY0XRhN4E9hQyLzdWlSWgqu7qh4urlkBKh3HMQLOWwCa56YbOPskBt2",
+	"DgUwXc5Wwhjrs90UJPHxtf2tdrR1Rmdd0Y3n2RBzzwkNFSyvuxXjkRVbdrwlW4JLAx1OYBqoVeogIwrB",
+	"wIehwl0XLkTA+5F7SmoA6YSYbOPBReZ5T3eVUewfqmQJlySAlQaqG0EVxGbp+sUZhA7mdK4+NYYggxVY",
+	"uZK+7O21F7635/ZcaDaHcx9Xs7fXRcfeHj1rXyltGofrGt6NeNyOI7ydNPF4UXi7eoun7PZXcSMP2clX",
+	"rcH9pHSmtHaEi8u/Zm2OWQ9Ze0gjw3x1zHrgyoP1RNdt971Qan4Nq416kpCaLLZSR7j0RrmHAv1Gg7mS",
+	"g0c4eu3scftOGtqIWdzI5Z2mHONcy2NpPQJQq0evnI0TntT8I7tf4GZ6zAdLGnTcYhsipHcVI5o7Easy",
+	"4+Y67IlzElMmvOc9Rmo70ns6ilsUqsxjFEmCb8JLDal1ieAiKwuYsqOZBmk8E7b9dZkkAPigR9dUrlkB",
+	"iA8fRHa+VFmPu5Ibtt+e+4biuLhWIbw2CG0G1TzkiixM9WF60Ydl7V0rVitIBTeQbVheQAI23AnfHdru",
+	"E7IKZv2hkyV6z6BoUqhy4RxynfoPChs7SAFdpewMEcUHeUZPSH3Z86K2Ck5qZ9WcHbzkhUrLBKaMAvLz",
+	"AvyG0eeJ2yx3p8JQd5ZQAUv2vYmLBRksq3oyb2qyo4bG8agBa5STRh6RDruQNvBR6054kkBO5MK1Lld2",
+	"Y7lhXG4YXQJyUUev+LdnJiCdRp5uLR7ReE6F6GmvZaBmF2NY6YURnkp33AIiQvaBQG6uQUS3A7ECHM3o",
+	"hkpK269qHkbdOjaiN9rAqqvVtV1/7zl0rz22OsdAyUxImKyUhE000YSQ8II+xnpboa6nM4nXfX3bL+YG",
+	"/C2wmvMM2dWr4pd2Ozg9ryqn+GvY/Pa4LYV+GG9MCknIcsZZkgmQVnFjijIxbyUnhUhAtBEnHq/m6VeR",
+	"PfVN4jq5iMrMDfVWcnLgqtQk0atgDpGr5nsArynT5WIB2rSehnOAt9K1EpKVUhiaa4X7NbEblkNBnjRT",
+	"23LFN2yONmuj2B9QKDYrTfOxRGGR2qDCzVoXcBqm5m8lNywDrg17IdApA4fzZnBPMxLMuSpOKyzEL5UF",
+	"SNBC97jH/2C/kjjmlr90ohn+23X24spty48edpH2Qn78zCkSjp/Ra7G2K3RgvzVlM0b6RomMLMVCUux3",
+	"i7bYfalMRUAPaguF2/W3Eh1ijMLkByLl5nLk0GZxnbNoT0eLahob0dId+rX+FhMkFmqCTrMk240WwizL",
+	"2TRRq30vYOwvVCVs7KccVkrSt3Sf52Jf55Dsnz3c8Zq7Ar9iEXbVYrJKZdchmANMVhjxivJE0WPkVChM",
+	"2idb1RRhS04r/wvFU6ZqJRBTZ1WygwRXRpuibZoHpClPTvZoCx2MPEZx4lwyWFtJzep2kKbgDIqNFdud",
+	"HdmH/c9go6R7EYhC97xRcbHowIUTbz8CIYjkIVHffeOW7CMBUo3kn/MN/g+kj8Dv04aNRyQv9Zjh/JI6",
+	"8pUeM10ZHFJI8PmBDRvoJEfFEkW0OeMGMxG4zlfw6/gBBzgpVytebGIysYN4Qhsz0HodbKJm56owy466",
+	"ziNih27Ryzu9OO3XWzrUXFB72ToyLbKqNrcHLy1whzrW4SaT846OvYrpyOHWC6OvRkDEZpxwo6/dquEG",
+	"ji2yPWdlHAycSe798PwN23cXgr5HO+OGDiK8I6p1+6Hp/YE81ia6sjFQb+Vb+QzmQlJQ1eFbmXLD92dc",
+	"i0TvlxqK73jGZQLThWKHzA35jBv+VnYkyd5cdEFEKsvLWSYSNG/EJACbX6g7wtu3v+I99Pbtbx1Xgq58",
+	"7qaKnho7wQSVLqo0E0+sBZzzIo2ArqsEGjQy9d4665i5selHT5Vu/PhJ5nmu2/H03eXneYbLD8hQu2hx",
+	"3DKmjSq8rCW0h4b296VyzhQFP/fZd0oNmr1b8fxXIc1vbPK2PDh4DKwRYP7OiTRIk5scBvPS3nj/Ngul",
+	"hVvGAGtT8An6u+no8g3wnHaf3gMreoxnGaNuIU4qr3Yaql6Ax0f/Blg4LhzqS4s7sb18Jrz4EugTbSG1",
+	"QSGotqJfdr+CUPdLb1crXL6zS6VZTvBsR1elkcT9zlQJshZcSO1dG1CJQ8qcpdcUJktITiGltEawys1m",
+	"3Oiu5g1B2rMOoW36LxswRjlqyF6FacHylLunBqqPWslCNBjjZbbXcAqbN6pOcXOR7CDNnBW676ASpQYy",
+	"LxJreGzdGO3Nd55YCCnPc5/6gWLxPFkcVnTh+/QfZCuIX8MhjhFFI6dCHyJ4EUEEdehDwSUWiuNdifRj",
+	"y8NX1MzefBHrjuf9zDWpH4fOmypczZtl9Z3iPFHM1wzDfujtQPiweRkCLlai/r3H5BSaDAdmP2iYGUPh",
+	"tffei950at6+0Dr3TRRk23iCa45SCuAXJBXS0Ld86PxM1irtFP6kTHcIm2XW59a771mmw4uG6VYutoEW",
+	"J2AoZC1weDCaGAklmyXXPkNfOg7O8iAZ4AbzjGxLKxVq7oNshQ21e6k9Ydf7PK4SiNnEwT65lM8o5dNI",
+	"jcYXSgllTS5lfDuUJAEohQwWduG2sSeUOudJvUEIx8/zOUWzTWKeZFxrlQibYrG+ZtwcgPLxHmNWxc0G",
+	"jxAj4wBsenHRwOylCs+mXFwESOlytnA/NvlpBH/HDW/OVxhFHpUjCxeyTwHiOAB37ofV/dVygqVhmJBj",
+	"hmzujGcunN00BukkOSKxtZXSyPn7POgTZ7e8hO3FcqE1UY9LrSaUmTzQcYFuC8TbRYnYFmh2v7rYa1z1",
+	"3aVDpu65vvtwdT9Ij3QpAFpahTqDuHv57XyhNe/m7k1Ws/Rxne/PhznEaL+PfqK71IO/rj6jSmj0qn1d",
+	"Rx/pjVatXE6B/BRjxXhGuhaYrp1HQwYkEU8aEsTkFDZxwR6I3Z74bsHLnTJGcbl5EDiXFbAQ2kCtIfe2",
+	"99u3UpwpAxObpIOU89HlYaPvNb3Hvg+y0rSu2QaqmE11LHoyx9C0p7CZpCIr47vt5v3rM5y2DlrV5Qzd",
+	"PXEnKTJ7Rqm5oy6hW6a2XsNbF/yTXfBP/NrWO4yWsClOXChlWnN8JlTV4lvbDlOEAGPE0d21XpRuYS+B",
+	"I0iXtwRXbuCiMt2muOscpsr7ZaszSRgP2Mfh7UjRtdSAbl+F9ZiqVL4VY+ysqOcM8DwX6bqlRvPhkz2P",
+	"LX6ht7LPHNjxWRtVg+3AQKAyiwVJFKCbSSJr2dDmKG/kSpkOwsybZirHkCGEUwntK2x0EYWkTWngd+EK",
+	"0z78FTZ/w7a0nNGH8ehqWrcYrt2IO3D9qtreKJ7Jam21MA0l+gVRznMMd+bZxOkm+0izUGeONKm5V2Xe",
+	"MquLa8DePD/66ZUDH9U/GfDCaqu3rora5Z/Nqmw+yp4D4jP4ky+mk3itIBZsfpXMKtRnni/BpTgLZLlO",
+	"dtdaV12P5/Wb87jzzE5tpVOr2yVuUa9DXmnXa80PdW4p1PkZF5lXuXhoe8y9tLhhKYKjXCEc4MqK+cC+",
+	"MrlWdtM53fHTUVPXDp4UzrUln/vKlizQ3v8gCMBAERJnsKSKTk8zcA/qLnOS5YpMuBOdiSSunpMzjcQh",
+	"rdkFGzNq3COM4oil6LHiyVIEY2EzPeCZ2AIymCOKTB1Ne1LjbqZcqolSin+VwEQK0uCngk5l66DiufT1",
+	"SrrXKcoO3bncwNQnGP4qMkaYl7h94xEQ2wWM0MjTAfdZ9eD0C62UGVw2tNkXsBWHM3auxC12Xkcfjpqt",
+	"X9+yaawJS0N1+R8Shi0jsLsuVZVw0wLaM0e0zlTvbXHUf1Ng7wvcEfWVQOCGl8HY2gwyrSLDlPKcS+vx",
+	"j/0sDl1v639umca5KigkXMdVhUJP5oX6A+Iv2TluVCRGzKGSxEXqPcBfu9b+1AXBPH5DOHpJu0+SCz6y",
+	"pi2/54QTlQfWK8pi4xW5XFqytiVuGo5q8cMRtND7dvz6cDiYOw65GT+f8eQ0LlAhTEe1nbShcjaK+c5+",
+	"F5x2vKa9wORatRU2jjqHog7k7BDDZYWjz4vkU0jEimdxKSkl7DddulKxELZOUKkhKETjBrIF1iwVuWI+",
+	"VQSLQ83xnB2Mg1JXbjdScSa0mGVALR7aFmgoo7VVRg/fBZcH0iw1NX80oPmylGkBqVlqi1itWCXA0lOu",
+	"svH41GAH1O7hN+w+Wbe0OIMHiEUni4wOH35D6ln7x0HssnMFwbbxlZQYy/84xhKnYzLv2TGsWxeNOo3G",
+	"9Nsqjv0sbMtpsl2HnCVq6bje7rO04pIvIO5QsdoBk+1Lu0lKwxZeJDVKQZtCbZgw8fnBcORPPU7oyP4s",
+	"GK0ExVqtkJ7qKjN2Uj+cc3Sle7iCy38kU2LuLSmtB/PtKojtXR5bNRl8X/IVNNFKmbd9+JE38vvqBezY",
+	"J9ChBMZV3mKLG5wLl04iHW4hJQ8V0tAjqjTzyV8wcK3giaFMzz3gTmZfP4kkbW4mD5UXA/zW8V6AhuIs",
+	"jvqih+y9NOH6olu+nKwEsvoHddBHcCp7bZ7RaY3n6G23wu1DDxVAcZRJL7mVDXLjAae+EuHJLQNekRSr",
+	"9VyIHi+8slunzLKIkwcvcYd+ef2TkzJWqoilU6uPu5M4CjCFgDNIezcJx7ziXhTZoF24CvQf18pSvwAq",
+	"scyf5dhDAHOlH77vSSReWQ2cS3dEE9J3TPEDksHMDTVmzaTNt89Hr8dZKG7V80r8rhEPv3g80B9tRHxk",
+	"cqlyIXFKqWRX0kMoQdL6KMmk1ffAGs/Zd2o9lHBap9ATz8dFEUEQRUkpsvRvdQBoc4WzgstkGbUPzrDj",
+	"73W5xGpx9g6MkRjG6UvIosNZefN3L5dGJOd/qqHzrIQc2LZdpsAut7W4GvAmmB4oPyGiV5gMJwix2oyI",
+	"q3ybMbqO0Tx1mrX6uHbzAQQp0m0S0UjpZvpg/asMFY1EKqZODGRKL9Ip+8GWO18Ca2SBopdgFbnfSHBQ",
+	"5hjwNqb8CWg5YXZW28fW/rIZwhc2gKmxipb+L8jJOMxT13boiyIYPs52t2ZctTaTKqd0LA4VW7zxDZho",
+	"2UToiRRiZ8qe2deprpLP0hBID3NRrCANUlhb+YhoAv9hDE+W2EA1WGs/yQ9Pbe+pUgcVYt2/k4oS7blD",
+	"uF12e5vcfswom/O50LbKNfiwR0/VHgyvdvChsM3lFaWUllKmF7jlqiSKF0W7B47GrcwmUchaiL+g0G9L",
+	"KFw00/8J9YoRZadsQKc0rE3ZUxXUeeGL+3KppEgokjR2RbuK2UNsigMSqrVVuv6IuxMaOVzRYgWV057D",
+	"Ym/5gvGogbiuUSP4iptqqcP+aag0MyorF2C042yQjn1BCqdrFFKDS5OJRBTySVU07LTEIaOm/0llIrog",
+	"GVGESs/j8Xv89tKpFvAIslNhU9A7tDnBz2oDqaCvwZeHMGyhQLv1NOMp9a/YZ0q5n1JY/zb1BYBpDGvm",
+	"xGVbm353qCNv4X/lC4sU7Cm2del5qp8bzsB20qM8d5P21zyJygOY26UPwRFL7cSbygLkVuOHo20ht62u",
+	"OXSfIqHBGRn2Iad7uEMYVXWSVm0pFFotRVELZl3iYkjJhIyA8ZOQUJenjlwQSfRKoI2h89rTTycFOiUO",
+	"5mlo0CdrfoyhaePMG1cdqrXBhBJao5+jfxvrwio9jKNqUAtuXG6qqthI3YEw8ZTK8TtEdsukkFTlhKiU",
+	"nPtbhVNijAMZt8/F1bwAusegKxPZ7qbgCTT6DriJ+uI1Z2W6ADMpYGWLUMTqvCWU7p4aVklLKE8ScVvb",
+	"VVd2Eus/whDUkBWF4TOIdDceT1PvcyUkBXLD7/ZT/J2hYuLx8zVVNyGBXPvQhA4E0QFTobnWsJplETfX",
+	"Z9XHoIwTUiQqt/D/sSSm/TvonF8u7H7pPV2o44Xl6+ZIHekYaX+C0USXo8q6/7WSZaYWTUBuVxuzlSWF",
+	"JBNjRs+Ry4cZBzrpce09UCUEIOpXvpwivfGqUNYmC8Fv8Td0nd5vu7agv/DamG6qHr/j13VKLW4vQ2tO",
+	"7PM+Tnqd5blxEV+Gszp/Vfdg2mppsRGs6xJ9t1DEVal97krWWwk/d3oPE+M6QjGNvRWh3g+uC9BfvZMt",
+	"y7lwtvL6xHYx69zxuwESQxx16w1uL8I5ufeq1zo1YrpSCrUIYHdPl0tlP+xk3d5OkJ2YiiCqxiZHng7P",
+	"bFG7+pA1ltJtLkC6SnBNb+nBPpvzOSRGnO2IYfkflOfr+Iixl/jrdEA+h2blA0jR8xd/z9YAZfyS8GT8",
+	"+sDp82A/hc09zRrUEM3WPPbn4jKB04QBW5AcSURpnvWpKJypR+iKMggL3o5vu0Ode7a3TEblSKrml5zL",
+	"kyTjTgqt8vj2TImBKJecC7teKPKPnL36wlx25GXqHshIhs5zLkyQUqlbhW8cSQ+2JWMWWYptXsBAvkV/",
+	"ndQyEusQhK289j8DuTDLOMJ3FzNrKh6CSa+Q3qo3s1Uk71OQ5mlHrvrYhvVLN8+okIOualJFcjiR7qG9",
+	"a+cu0p5CxCo1qn80gPa/+WhKO0smTiGsvEJKawwV9S2irzD/wJv0ePq2Y2eoGRNxoOfVzKJ2k+uGj3Rp",
+	"xLpFJpnSGOva5z3bqkXpzbr3tLW/0wuMMiETXHMoXMUlbIljw8Qo71a3DY5tqNDkZHApJOjeNOsWuN5c",
+	"Da/rZBSU/ZNTbgbufAvCBboHaApFkDKif85tyH5qv/t4CZ/9sZVrNTKup9fd2a+9g6TQHSSGVD/3aaV3",
+	"x2Fc5j0ppLTlX3Usf4SEIgRO+4TX1nk8OBjg3903UmuxGeDRk7L77dtfM8pV9FMQ1XYKm30rVPv84X4r",
+	"Q+htXTu7hiAGu7Xb1/rUjj9osoVdwOJa4PyYL+XxCG/fSY8m9LibBqN9Bk4FJpFiqqxdi3pqm7D7pICr",
+	"TF3ny41P+5DnICF9MGXsSFpnTm/1auaZbU0u75lt869p1rS0mWncI376Vsa94ihnTHFF/uaH2c7VNMj0",
+	"ylPZQbZPZNY9KTgwp1O30s/gxPddO1S7+kpNVBaKYVLKQInyMrJkHA+Uf5KL6uXZUn8GSWziCN76OHzT",
+	"egO2YfWR/f0BvRnfPnrGrzC4JaD4wPZbJN9n3DQk/ujBL37xo3gxfCvdNYi3b9mmUbZmdz0cm725mQug",
+	"EfLvUOFW4qaOkWx/wYaIRdiXJGC27oGPcEGWdibSkmfbc8K78guTKg/UhflzwosCOYZUQTIp74JX/0KJ",
+	"AImZ61OR5/HaDngtaT288ET7ngBrVMP9L5MEtJ6XWbaZtvxeqsgNrIKCR1xJoDADqUw9RBy+usLEVWSa",
+	"ThkpWnRj9ChhXC4FxCBZpauUjFzjYfDuDuXbaUODadM1tuzoqoBr1mQGBsQLajK7YclDl0froGNXauiu",
+	"c/AGNHDbg/shiK/V8F3k9mvPzWyI9jyeWg67k/reIgQbTRmByt49fMcKmFM6eMX29miCvb2xa/ruUfNz",
+	"KaTZ24sy6ltT3FscuTHcvDGK+Vuf35X1Lepx8WvtB3oD7iKMhsNmXZqBXBJ/d27hH6U4xO/26uweVQvr",
+	"hUyG7U0gxETW2pg8mCpwxRzghem6RXwuSWRJykKYDUXme+2Q+D2a8eiHygKwBI7CTBXf6MLrjDqFKrdD",
+	"bS8otc9S+4PiGcVe4ZVJRmRDJRqfrzkWx3YH5dt7s/+Ex395kh48fvifs78cfHWQwJOvvjk44N884Q+/",
+	"efwQHv3lqycH8HD+9TezR+mjJ49mTx49+fqrb5LHTx7Onnz9zX/eG41HAkG2gHrn6cPR36mCyuTo1fHk",
+	"DQJb44TnoqrMimTs06TzhE4i6ley0aH/6f/zJwzrTNTD+19HLvRitDQm14f7++fn59Owy/6C9E0To8pk",
+	"ue/n6VbEfHVcubZaYYd21HotIilMRzUpHNG3189P3rCjV8fTmmBGh6OD6cH0IY6vcpA8F6PD0WP6iU7P",
+	"kvZ93xHb6PD9h/Fofwk8M0v3xwpMIRL/SZ/zxQKKqcsXjz+dPdr3nnH7752u7QOOuojlLLBOuoFnZjeN",
+	"ujO0kAODdcJtpCXVLkvmuEpW657CMiXfSau+0qPxqEIWVob0+aWOa0blEwzYjEuHv8ZKucaSvBN9IfKC",
+	"7a+U//XxNkUJYSagmlkhAzqYfPPb+6/+8iEmcHesi0qdUqhhjQXGdf0WSKvnurNISW2Ap76Bc8+lb1NG",
+	"Ng/NVJb6OqXYRklA80dOYcUrWKli45P82iK6yRJTR9pC1WSyfy4R3Q6rPwptsItTeBKG/lVCsalRVLlB",
+	"VgjpGlSiJjgNFKr5jmfZO1s6HNakFveJHVww6ziSkpNExHGtlKUO9aaPyRmn+hp0r9s0/W7fSSXhXd8a",
+	"HWCNVfp0sTzLsKGSEMkT2126jcgvi9ZToHKbsJycCc3+++Tnl0wV7IV1bniFAfCBc2wMTnePxsB0LrQr",
+	"vcib/mYVqL+NRx4K4h6PDg6urbJE5RX/YdwYxYNziYFwqCfXCGLTweXKgLaH69wEL3iG24X0WZvUnxw8",
+	"/GwXdCytMgWvOHsV04KefLYLehOw5SqxnFQmcOj3mu3ChrJAarkxrvyrz5g2jyXeaDxj1DJIh9C9+H+R",
+	"p1KdS98SBVCvoBz9AEFNhPAh8aFXwNgPFoY/139NRHol8aOTuv742Q6J5J7uu3m6OdFa6aHxe5UAmSxf",
+	"Lgc2rIU2+sGU/RD2ptuPwm5tUGtZyLpiOTqrk8He4ajKTlLDdk+HEclR+ShQsHyOotJRU/vSyFQVA6ZB",
+	"N1th2i2tfLlXdteVu1Xd51LVc4JE1JdISHqjJQaigbi/xZ7ROxnsHe56cNd983Y4kL97WgnEb57v2id3",
+	"cE007oMb5MpfmrDaDis7fnYnvn7C4qsvweFrRjCjqARHnQGtQbl38us2+bVyA7T1CSmv7DaJVmugH1zG",
+	"wGuQYl1OyAHya6hJCfrWQh8lsw+Z5IMpO2q3uRwndC59OyVTylT5mcqk3bypMTDqXJF3cugAOZTQtaxz",
+	"wF6kKGCj4MmFctV+poLnnxhZvZImQrpbxrwEb+zIj44T3xjP/CLlRoe0O4nxM5AY8Xzorqzo61HeSYlD",
+	"pUQbNLBFTmykYHYRJv2iIlhXtkzY0PBIRIomx3Y7elhaPC+EKoTZkPNmUGdcFZQxxxSlTKzdzFR2SG7Y",
+	"i6O/U4zLi6O/s28xEbCXOCmhQGR660XcFPl+ANN1LNPfbY4qQe3zEP3eVEiKVq4nk6LLokxIW/H1t30o",
+	"W1ujf0woW/H16E5GjNWXiFARLopM2gXf+NqgTR9azWDNE4zF4nTdbmyQkS5ndQrkpnRlVD7ZHvZ2tHVG",
+	"h28dS6lwUffxSL4lKvG3IyyvlS62gQ7nHO4dwnfIYR1kRCG4nFB7t7uf7e52pXCWKzzTgvJ51feJv6sa",
+	"QNYl9By4PZExU/YPVZInmq2vDLGKDjSD0MGcTt6uMQQZVbeusLO311743p7bc6HZHM69N83eXhcde3tf",
+	"gIS+rtLncyaVnEgq/3sGLHBf/eLE9C9Lbv3q4PFnu5oTKM5EAuwNrHJV8EJkG/aLrFwsriaWVzynlEEW",
+	"y638pxOSV0vRgfh+JS+FtheCMLVkGHxqaEyqNFNONTCuC9Vxmdpcdz6bkx57Ixl+cvYzux/jjgltGhPS",
+	"A1vdd5vjZ0Pk8luyzt+ol1poAYnca/G9uekboAPHdzxlPqnuDfPmj6/z2LoLL5Vh39+Gw9WN6g7iZDWQ",
+	"2ezP1HoXw5EtjkM8oE4SH7AfqsUTJqK3/vv3XbXnMFH4gynzKet1JUE4HrpQPKuz1fFiYTsh+8L1sXv+",
+	"z0Ma/96Ufa8KJqTRYwpDMq46C7snpDl8+OjxE9cEY3IpwqXdbvb1k8Ojb791zeoCBfb52WmuTXG4hCxT",
+	"roNj8N1x8cPh3//xv9Pp9N5OTqnW321e2kydnwq77Bqtwo3v263PfJNiugtp92Un6m7FHRoLQMQYu1rf",
+	"XSwf7WJB7H8RF8qsSUbOZlU5K9ShnYMvGNAXvWK8rrOq82NrJWWUaV0YzWhUW4fLfuZFaCaTjOvEaUiR",
+	"l1jt9AAODPpT5r742q51EbPq/jTKrd1ri72rORUgUgX99G1H7T5Ta0oNfXkF8vVa7itSGRQy3KxusjOm",
+	"ksYeon+qxRJbCbPl2PTnZqmfrZRsyd1t7G6WdmGnq9qpKnyO0487HuJWmLJ59KhQ2IZVWXx4Vostce6F",
+	"Mwx9Y9+gt9GNvquttTjylmuj9+583r2lr8Ql2gRVcwRKXmqjx/V+XS20ly+8Dp7EhVI2ATt7AcVpBswU",
+	"gAYIpYFlwM+c+IJNMdQfqhhemtTl0Le5dVwmIkV5iexf9BCz8g07wuB+OtyVZ5KhaWgkF6Zv6wUX4C0h",
+	"HkRS7o1dJehCqbmbTWjLKscujQ0lwkGbfwZuRMYXXEht6tVSLDD95WWyKhVrh4d9h7D9aEF7WmN2B0Nr",
+	"p35yKCu4XECvBbrG4RUlsVZqqHDysXNbSxSG2zNu2Eppwx4eHBzY1r3iVsavCTofID22VaQDWZp2AcFd",
+	"AYqReilyD7zbykbMuF+LV9DgtgpZr7VvJQH53KYAGe7v4UUJppt1RaSR7J+vlBaGrLfz5inx+n+AsT0i",
+	"QjcarIQsdXiMtyQk27KEHqqb9tXJiiVTdmxoEDEESxg70zhLFNlHJfeIqEkrFQvQ1qZ928UiVYwNO9aL",
+	"AO5kvo5JNliwO9JCepz7h+RHrG7YZGNNroFYGPLKccho1r3mdrn0uAwvjOnHFKzYhHlRx24BwfgnFLde",
+	"KgnWkZjUGrRBn7W0xS5KhKEspvffEzmET7O4aLFLkvh0nd7GW9zjUSZ0/vGKzcGghYXQ1cq5FXni7RYx",
+	"VkKKFUJ5ML5xpQ9tUbesbLD1VP5laObUQJqkGAUoTKx+kyssg5/FHGGFqqzgG1fQgbzvhS9rXVW0tjNh",
+	"A5eCxpWPYbiLF4LyaT15V1+VqQZNXD7E4w7BF0Nwh+U996WNCWNuEZ+741bzgn1Jqik64L6q3l10xae1",
+	"oI9x+39GIRS1/O6T6lj1rntpxUWHfV9/e6v88CPXy10yxJd6MU88hrZcHthkd4boYLghTBeRbnVmoRD4",
+	"sR8it88n794ht8g6kDor/mF/UnI4M7Ey0S6NMEk9bYWbm25MGjZVdhKNUwgWy8RiaViSCcQVaXHnKsvU",
+	"ObVOllzIqn+qzmWmeOozreZ847IwbtO/fhZs7k8b2V4T2E2I8i1+7Sa7jhfIJwL3DsHeH8c7+f5Ovr+T",
+	"7z/hS9qe04uI+Y3Y6PdYeuTDfmUa6ZP9X1GDwReikP46bEWB8DwHXuhL34XDjJHNgi1h9oxGWczKChsB",
+	"BfFywYDn/xj9qXMWN6+5qLnwmEoEdqv8eHshUeo9Hchnl7bihaPXFr3bt8RpI2bxZ6N/1LkA1rU8lt9V",
+	"b3byXNgg86uI9CPa2HAzPeaDJQ0RK17FNkTIugLubb9h6zQqllV5F9KixTU+6lvWfKy37IQuXZDGv/ga",
+	"aPl4D1vAluPAYzAvlFGJyqxnb5nnqiBZIeQDejroloVdJjkazPlJ9pKxu2wTbpJlme+/p39QbYoPdRUI",
+	"KsQUM9b1ZCSxJdODIk6NSpvBw3xeuXDay5/97B/WdkrPdMn7xpbRtS2twb9Z1SD6MO4UeB/+Og6SV0Fj",
+	"MZS7ymX7vnse36DXaGfzrizuRkbsnN6nvuyOo2NLinePrU9sQU9VmaXER+dCpoy7w4nH9gvNwH8X498b",
+	"49+9ccL4gNbLMoWzlUrBvzBXQpr995RTqnHnNRqp+dwmSNz2ef+9/X//MDkvNdRfqfg4FPvtFC/R6/UE",
+	"eNF9m1J5eVWk9d3q0qdgBTIzEUGFH5qtXUuyALbCOx9SpycXBUNkzwVkqWaqsD8r7SuUUi3Ediltm1TA",
+	"LN1gpLFW+SSDM8iYaU8YBnmFLnkUC+YKsjsle0UhTAUrwSVq66B8rI8tEo8SSlkS1ZBb1NmGYdKdnZFi",
+	"PwfuDc11OO9ooesEjpIpCbF3osVl3/Vb5ynrD0XtBvH6DIo9Y9YRIL1aCNTLLtTE9bA5eOn9EP95W73O",
+	"fjjaoXNDoan7RWBqfXwfndqsJ64CYlesyTk2PIVNAYvReMSTOf1vPSdA+Lz4Y2QZ4iCPrpOqWDdneQFz",
+	"sW65XLtCfpDWGY4MWKroIwpsMbGDbSGMuGrdRumPPuz42lVzWDK39VvJ610VQbFZX5Oc3KBr9+cY9FWD",
+	"C+aui4Mwg7kqoA0DX++Aga+vBYZgdnubGLGCKXvh9pZL9vr7p+zx48ffOK8nqt5L+9MHmh1yggM1gKv2",
+	"KOWm+jxkx19//5QAOKkUOINa7UR/tffXtXIa8dNb+Au+xsdYEJjrUVCH5fasKBMrYUYXV/ZKWBuW8wUE",
+	"s03ZLy6rAX21pTerRMHues8LOBOq1FWnPg4Ca3OxO+XPouRFzEwIuRFZU4N9+ud8IWxwhAunWvFTmyVa",
+	"EWacBsBvjxUF7I5VgVNuj31B1WhB4u3J/XYLVLG4hkGx19vKe+8IxL6GbI93O3KrOxLL0Oh4T9jVuoQg",
+	"olqPhi/IlH6nyPkUc443yM2nvgAqRHtXS9GqOtz7f940blRpTDsndouOwRnQd2REwFGPn1ktfTR3YmjZ",
+	"4LKrFqhSwAij48yS8QvrL2Ja/u7Lfmcuhbbd7M6cfuPq/G3321UP4daxoywnTo/uqe5dLuuqrkGju9vw",
+	"7ja8udswIEYybtiobecpdXdPDjUJRC+sLfKtuy2tJWHfJvHZ5lp2Yltca0kDOyYrIC9AI+4IVJef2CcW",
+	"UnP2QiSFOsoWqrr89UYbWHXS27uuv/fkInjt1GNddyUlMyFhslISNpHIQPr6gj7Gets06T2dKWF9X9/W",
+	"s6YJfwus5jxDHj1Xxe/004gUuZIDZmu1BeRVWZjad6N7HjYyqc1WwY+BU4j7mPPCiETk3AIV/Xn/feNP",
+	"l53LtdTL0mDoQ/CL4abUW0+jbXGtp/GlSsGO66UCSy+xCj8oszLtgWgdwsqlJq5O8DtSt6tyj8yA0ozx",
+	"EmNGypwZFdNY1B0nPLGHZ2LtjfEJg/SD1MpOt+RnwHhWAE+xYBlIpmbOahEI5oxrhnvnzVzOcSjKBgK4",
+	"8kIloDUWmnNlj3aB5tvVLLsPTwQ4AVzNwrRic15cEljLVrYDalplNypwq1hoIXugHjb9tg1sTx5uo7Wv",
+	"WipgRlGtjAwM9AAzFCcuJ9XN7p+f5LLbV+bWdtAB7an9+kas8PgyyaXSkCiZ6v78PbuOLTYK16JxBcFJ",
+	"iZ3UbYmBfuLavHZu6CnFu1t2EyQLwin6AT6DQluWGxn5b/ZjbOxESQ1Sl5q5EbxjIqSxNZCutneul7Cu",
+	"5lLzYOzK89EoVmrYNXIfloLxX/vMZqby2eMmcNnD4SKLo6qM3AlvXVQ2gKgRsQ2QE98qwG74rO8BROga",
+	"0ZZwhG5RzkypDLi0Htkqz/H8mUkpq359aDqxrY/ML3XbLnG58m44J0sV6NAr1UF+XvlbypQtuWYODq98",
+	"p3oT1nuhCzMexokWMoHJ1pRYYgUn2Co8AjsOaVtQDI9/K59S43C06DdKdL1EsGMX+hYcE00/y0ywbWXR",
+	"Db7gmqJ5IF7Voqn9e/+cC4OmXntjTsjCvNNV+X+4MD6HLPVjRrkoH2f1pgGYG4eoP6yQ4HSUFgRfJpHM",
+	"4x31JE71vSoGpTGqvY+NYrgwVkojfGlvPG+VjPnppR64k57vpOc76flOer6Tnu+k5zvp+U56vmnp+WNl",
+	"EJp4Pu3de2MlM9ldrYcbjli5zRCTWuivRH56JKCI7kqa9ScyMMAzWpDI6HLNle51uXjz/OgnplVZJMDQ",
+	"yI9HOc+4kMzA2lT+FM06bL7ooy25ZUu7cQ2PH7GTH4++evjo90dffW3zIql5q+19V3adabPJ4IHL6wgy",
+	"tbeyj8JwBkbrdsf96yfxXgyuyJDIKPxCs+fU/Bka11UOhQ0NZvgY6T6P3gDPnjrk7Hgd+ZToONg7HO3d",
+	"uPEoc3hb8TzIZ02L5Zpx50rh8lc+s5RADr7v5jzT8K7Pn8IOu+J5zJu24tf2+UQs4juVblpkj7u3TxvZ",
+	"JPjaK19IXmwiviNdA3GbRIyyMQaEw+7778P15i+KJgXoktsuSoum/yaH5vjofdQeG6fesM5QlgYscXhJ",
+	"4nFAOGP8T45Hmuod+qlsWBdiWhjtm6Mpkg5borJyJUcxP9hGViObg9Ctcoi9Es+G31j22vb7qFcfI4jc",
+	"ca3Z/CeTPK/ZsmJA1PYWHSVuSi/mER9lAcRAxj7SngjVUdx6go0WICeOQU1mKt1MGuyteVulxaYoZf9l",
+	"9XwNSYmnmiBxp+S+foDXFWF0bRoqsxRm5WKBx6qr/sGjCDQeulZ9nAvomV3vNg5+eeqwg1f+Vld1o2kP",
+	"t9WD6L4q2KJQZf6A9oPLDakWVjmXG69ORJl7VWYWhzaF2/XeGTZTRVd/PB75Z23/i/iVaxG++9zN3vzd",
+	"ooWdc83s/kLKSpn2Ofmv5fAqdXboN2tZs+Ctzvh2vZHVuXmHsH6/y3YTahVqDsXErKU9Uc0aj4VaMc7s",
+	"0Z3eldH6c1wJr1ywS5zDdpO/1AxhuvNmKAKWRVdDKzLG3w1Nfvqanzf9sIfx1PXEicBXlo+XYOuFenlR",
+	"U6aqBhbwviwUTxOuSaqTYM5VcXrDsrNZH0f0N1Vd7Ejg+LC0zDTuIHmymWDOTajL2UpofRuOzTukyzrJ",
+	"1ZGL4Wxg406l8qWoVL7zh08zTsXCW4fTak/pTA5gU/zcrGWUS+2TPqHfczA4EK9sy2u1gXaGb5pCa2WH",
+	"M+VAljPuckNjU22KMjFvJSdVciMCoWMm9QryflHqqW8St2ZEjA1uqLfSZuKqFMxRkWoOEdPR9wBeYtPl",
+	"YtEJpWBzgLfStRKSlVIYmmslkkJNrA8uXtfI0ae25Ypv2JxnZAv5AwrFZqUJx9RWMasNmiqsXRanYWr+",
+	"VnLDMuDasBcCBToczuvuKl8DS3cVFuL5HBcgQQvdk1v/B/uVciW65Xv9G/7bdfZJ2G47uaOHXaS9kB8/",
+	"Q7g5XROZ0Ka2yHZgvzUzHSaViBIZxeRaz4Y2bbH7UpmKgB7Utl23628lCtNGMWL03FyOHNrmlM5ZtKej",
+	"RTWNjWhZXfxaf4sl016oCT4Z+QJ/XwizLGfTRK32feKD/YWqkiDspxxWStK3dJ/nYl/nkOyfPdwhH1yB",
+	"X7EIu7q7ub8cY0hIB3haqo2nMND23vfcyzZcb3cqSaHJgcG175RT8NbfvBCqEGZDsfcpJAVwje0pAH/M",
+	"TFFStcvUu2qBNXe/OPo7ldt/cfR31imtH5tz+lbG4k+70Yc7M0u9qUCqvT7CmZhRLBU6z/iGQFzx9bd9",
+	"AK7l5cv//4liU1uape6euTuPtDq4H92Xo2aw5onBxGTEEzfsHAr3hDIuHWjr0afyyfaUEkdbZ3T41o0s",
+	"EkMKLLgEzc00uE29lY+g2pHyouUvFQs+z9Wgm7GDjCgEl8socre7n+3uxrKTKDzTgmeYD7hilf46aADp",
+	"JLVs48F11oEQzbQC9g9VUsUdXzXd8zdVkCqwunCEDuYUVmavMQQZrMA6K9KXvb32wvf23J4LzeZwThyU",
+	"S2rYRsfe3vSLDMG+y7z6J8m86k5kKaMB15HT2TmWWyXEYZlKeLt2BuUssQnDs03NwFvJS0wlTnXNksJM",
+	"GeYjLYCcgjWcQYHWeK6tYCStx+GKynnpMkkA0sO3ctLKJrFyE9+v/2mfuW/Lg4PHwA4etPtYvUXAebt9",
+	"SVSlT2RqYt+yt6O3o85IBazUGbg6X9Q8LclWbHvtHPb/qcb9uehsHWphSLmy5HkOeK3pcj4XibAozxQ+",
+	"Bhaq5ScpFX2BAoGz9S2YMD57rNDWv9TuCuMuyX1M6O7e78f1Ft4lf7lL/tLPEO9Yxm2wjI/ONO6S9Nwl",
+	"6bmNJD0vlWHf+4IDV5CkXGrjJKZ36pORlMrqLBmNT86lZ4vD8YlrETPBtcoKkcMA43jq2DmVXZgBgzOe",
+	"lfRCIptd5XNLYxpf0OWlMpQqEy0GlcUdDy4e6jBrPzJGlVIA0+WcwK7iA+ZxcYEEcs/d8ltKO2RcCS+K",
+	"DS4Q3/vclAXF/Yl5oFJIVFEAaRqsTqDvOudYGnYCq9xsJtVoersj8ucmcHxc75CdZG9wH91huln/kDlH",
+	"B+4J7wmZsym/MJWtN8URfBG3EUeFpQZbkwEHLguYsqOZBjxQ86C/E0TwHBfkPlcAkgOk1mv+fKkyiNtA",
+	"3bCTlaWWONQFcK1CeCsG4ucZIzwBZ7mwUTEo4LhaQSq4gQxNApCAq50sNKt9HKeM6v+wZMnlguyPhSoX",
+	"rpiDHYfOqEWfYkUpO0NE8WGdKan8SBzISJmSNl6c+66rhOXyVCGC6PPEbZbTUMHQYrthwSNyQpy45OGD",
+	"fSAjHLLPG3I8asAaracYifPzhyxt4KMOb+VJAjmRC9e6XNmN5YZ8Wsl/SS4ChuvCAzMBobdCwCQbmsuG",
+	"7TVET3st15Hs+u6Y3x3zu2P+uR3zjiRh8WLVBl2pISSiL6oM+Uf20fyYT9VPxJX8zvzxKZg/ru/57LNe",
+	"XIe/qzYF8FV/CTn6rJG3Mg3FGRQTunjgDJE67kgYBLlmPPW6UBU14bD775zS4p0b6sHY+ZR6daFLssJJ",
+	"xVeV4zh+puuqcnVZjWBF7P476loNXBWaa7RKC5tXoUqXQmC1ZqtlFPcK973uv3P/qmaZsuc8Wdq/6D1v",
+	"q3sFIcRM2LT2KTfclfKi8nV2BxjItNL5gneRQ69VzYyy+VlmsBQutXPV0G4KOy+EsfmkUK8MGc816P/X",
+	"DWNr6ME6t+KbUZRBX0qMa+5qNgic4Dp/TgscVPfO5oaNFRycQwEyqYQul61myl7wjRX5cuBmi1YjUvGu",
+	"7f0QxGq1Yq0uBGvCs8w6gkXArhnPcND7C9q1VxAmRG4uYbedheLFifom9ZG+QCK2I0+Hah456D0iyY5J",
+	"b1abfZOT93goPL5FEN4oxVYow9qR7RlW+RUSOtuTHefHlAIC2Wa94XRbWHdkpDkaC5ISnSbp/PNc/H4K",
+	"+O/fkD4t0VjWUBbZ6HC0NCY/3N/PVMKzpdJmn+qo1d906yPK0HxhR3CHJy/EGTcw+vDbh/87AOkVHCzU",
+	"igEA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
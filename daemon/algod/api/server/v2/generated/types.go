@@ -80,6 +80,18 @@ type Account struct {
 	// *  Online  - indicates that the associated account used as part of the delegation pool.
 	// *   NotParticipating - indicates that the associated account is neither a delegator nor a delegate.
 	Status string `json:"status"`
+
+	// The count of all applications that have been opted in, equivalent to the count of application local data (AppLocalState objects) stored in this account.
+	TotalAppsOptedIn uint64 `json:"total-apps-opted-in"`
+
+	// The count of all assets that have been opted in, equivalent to the count of AssetHolding objects held by this account.
+	TotalAssetsOptedIn uint64 `json:"total-assets-opted-in"`
+
+	// The count of all apps (AppParams objects) created by this account.
+	TotalCreatedApps uint64 `json:"total-created-apps"`
+
+	// The count of all assets (AssetParams objects) created by this account.
+	TotalCreatedAssets uint64 `json:"total-created-assets"`
 }
 
 // AccountParticipation defines model for AccountParticipation.
@@ -247,6 +259,26 @@ type AssetParams struct {
 	UrlB64 *[]byte `json:"url-b64,omitempty"`
 }
 
+// Box defines model for Box.
+type Box struct {
+
+	// \[name\] box name, base64 encoded
+	Name []byte `json:"name"`
+
+	// The round for which this information is relevant
+	Round uint64 `json:"round"`
+
+	// \[value\] box value, base64 encoded.
+	Value []byte `json:"value"`
+}
+
+// BoxDescriptor defines model for BoxDescriptor.
+type BoxDescriptor struct {
+
+	// Base64 encoded box name
+	Name []byte `json:"name"`
+}
+
 // BuildVersion defines model for BuildVersion.
 type BuildVersion struct {
 	Branch      string `json:"branch"`
@@ -304,6 +336,9 @@ type DryrunTxnResult struct {
 	AppCallMessages *[]string      `json:"app-call-messages,omitempty"`
 	AppCallTrace    *[]DryrunState `json:"app-call-trace,omitempty"`
 
+	// Opcode budget of the group that remains after this app call transaction, including any budget added with increase_budget
+	BudgetRemaining *uint64 `json:"budget-remaining,omitempty"`
+
 	// Execution cost of app call transaction
 	Cost *uint64 `json:"cost,omitempty"`
 
@@ -345,6 +380,9 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// LedgerStateDelta defines model for LedgerStateDelta.
+type LedgerStateDelta map[string]interface{}
+
 // ParticipationKey defines model for ParticipationKey.
 type ParticipationKey struct {
 
@@ -373,6 +411,16 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// PendingTransactionGroupSummary defines model for PendingTransactionGroupSummary.
+type PendingTransactionGroupSummary struct {
+
+	// Total fee of the group divided by its total encoded length.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// The transactions of the group.
+	Transactions []PendingTransactionSummary `json:"transactions"`
+}
+
 // PendingTransactionResponse defines model for PendingTransactionResponse.
 type PendingTransactionResponse struct {
 
@@ -419,6 +467,41 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// PendingTransactionSummary defines model for PendingTransactionSummary.
+type PendingTransactionSummary struct {
+
+	// The fee paid by the transaction, in MicroAlgos.
+	Fee uint64 `json:"fee"`
+
+	// The first round the transaction is valid for.
+	FirstValid uint64 `json:"first-valid"`
+
+	// The last round the transaction is valid for.
+	LastValid uint64 `json:"last-valid"`
+
+	// The sender of the transaction.
+	Sender string `json:"sender"`
+
+	// The size of the encoded signed transaction.
+	Size uint64 `json:"size"`
+
+	// The transaction ID.
+	Txid string `json:"txid"`
+}
+
+// SimulateTransactionResult defines model for SimulateTransactionResult.
+type SimulateTransactionResult struct {
+
+	// Indicates that the transaction carried no signature and its signature check was skipped.
+	MissingSignature *bool `json:"missing-signature,omitempty"`
+
+	// Indicates whether the transaction was evaluated successfully. Transactions after the failing one are not evaluated.
+	Passed bool `json:"passed"`
+
+	// Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
+	TxnResult PendingTransactionResponse `json:"txn-result"`
+}
+
 // StateDelta defines model for StateDelta.
 type StateDelta []EvalDeltaKeyValue
 
@@ -520,6 +603,40 @@ type TxId string
 // TxType defines model for tx-type.
 type TxType string
 
+// AccountApplicationResponse defines model for AccountApplicationResponse.
+type AccountApplicationResponse struct {
+
+	// Stores local state associated with an application.
+	AppLocalState *ApplicationLocalState `json:"app-local-state,omitempty"`
+
+	// Stores the global information associated with an application.
+	CreatedApp *ApplicationParams `json:"created-app,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountAssetResponse defines model for AccountAssetResponse.
+type AccountAssetResponse struct {
+
+	// Describes an asset held by an account.
+	//
+	// Definition:
+	// data/basics/userBalance.go : AssetHolding
+	AssetHolding *AssetHolding `json:"asset-holding,omitempty"`
+
+	// AssetParams specifies the parameters for an asset.
+	//
+	// \[apar\] when part of an AssetConfig transaction.
+	//
+	// Definition:
+	// data/transactions/asset.go : AssetParams
+	CreatedAsset *AssetParams `json:"created-asset,omitempty"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
 // AccountResponse defines model for AccountResponse.
 type AccountResponse Account
 
@@ -529,6 +646,39 @@ type ApplicationResponse Application
 // AssetResponse defines model for AssetResponse.
 type AssetResponse Asset
 
+// BlockHashResponse defines model for BlockHashResponse.
+type BlockHashResponse struct {
+
+	// Block header hash.
+	BlockHash string `json:"block-hash"`
+}
+
+// BlockHeaderResponse defines model for BlockHeaderResponse.
+type BlockHeaderResponse struct {
+
+	// Block header data.
+	Header map[string]interface{} `json:"header"`
+}
+
+// BlockHeadersCommitmentResponse defines model for BlockHeadersCommitmentResponse.
+type BlockHeadersCommitmentResponse struct {
+
+	// The first round of the range.
+	FirstRound uint64 `json:"first-round"`
+
+	// Position of proof-round in the tree, that is proof-round minus first-round.
+	Idx *uint64 `json:"idx,omitempty"`
+
+	// The last round of the range.
+	LastRound uint64 `json:"last-round"`
+
+	// Merkle proof of membership of the header of proof-round, as the concatenation of the proof digests.
+	Proof *[]byte `json:"proof,omitempty"`
+
+	// Root of the Merkle tree whose leaves are the block hashes of the range, in round order.
+	Root []byte `json:"root"`
+}
+
 // BlockResponse defines model for BlockResponse.
 type BlockResponse struct {
 
@@ -539,6 +689,14 @@ type BlockResponse struct {
 	Cert *map[string]interface{} `json:"cert,omitempty"`
 }
 
+// BoxResponse defines model for BoxResponse.
+type BoxResponse Box
+
+// BoxesResponse defines model for BoxesResponse.
+type BoxesResponse struct {
+	Boxes []BoxDescriptor `json:"boxes"`
+}
+
 // CatchpointAbortResponse defines model for CatchpointAbortResponse.
 type CatchpointAbortResponse struct {
 
@@ -561,6 +719,9 @@ type CompileResponse struct {
 
 	// base64 encoded program bytes
 	Result string `json:"result"`
+
+	// JSON of the version 3 source map, mapping each program offset to its source line and column
+	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
 // DryrunResponse defines model for DryrunResponse.
@@ -572,6 +733,47 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// EvictPendingTransactionsResponse defines model for EvictPendingTransactionsResponse.
+type EvictPendingTransactionsResponse struct {
+
+	// Number of transactions removed from the pool.
+	Evicted uint64 `json:"evicted"`
+}
+
+// GetBlockTimeStampOffsetResponse defines model for GetBlockTimeStampOffsetResponse.
+type GetBlockTimeStampOffsetResponse struct {
+
+	// The number of seconds between consecutive block timestamps, or 0 for the wall clock.
+	Offset uint64 `json:"offset"`
+}
+
+// GetSyncRoundResponse defines model for GetSyncRoundResponse.
+type GetSyncRoundResponse struct {
+
+	// The sync round of the ledger.
+	Round uint64 `json:"round"`
+}
+
+// IndexedTransactionsResponse defines model for IndexedTransactionsResponse.
+type IndexedTransactionsResponse struct {
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// The matching top-level transactions, in round order.
+	Transactions []PendingTransactionResponse `json:"transactions"`
+}
+
+// LedgerStateDeltaResponse defines model for LedgerStateDeltaResponse.
+type LedgerStateDeltaResponse LedgerStateDelta
+
+// MintDevModeBlocksResponse defines model for MintDevModeBlocksResponse.
+type MintDevModeBlocksResponse struct {
+
+	// The last round written to the ledger.
+	Round uint64 `json:"round"`
+}
+
 // NodeStatusResponse defines model for NodeStatusResponse.
 type NodeStatusResponse struct {
 
@@ -664,6 +866,26 @@ type ProofResponse struct {
 	Stibhash []byte `json:"stibhash"`
 }
 
+// SimulateResponse defines model for SimulateResponse.
+type SimulateResponse struct {
+
+	// The index within the group of the transaction that caused the failure. Absent if the group succeeded or was rejected as a whole.
+	FailedAt *uint64 `json:"failed-at,omitempty"`
+
+	// The reason the group would be rejected, if it would be.
+	FailureMessage *string `json:"failure-message,omitempty"`
+
+	// The round immediately preceding this simulation. State changes through this round were used to run this simulation.
+	LastRound uint64 `json:"last-round"`
+
+	// The ledger state delta the group would produce. Only present if would-succeed is true.
+	StateDelta *map[string]interface{}     `json:"state-delta,omitempty"`
+	TxnResults []SimulateTransactionResult `json:"txn-results"`
+
+	// Indicates whether the simulated group would have been accepted, assuming that any missing signature is supplied.
+	WouldSucceed bool `json:"would-succeed"`
+}
+
 // SupplyResponse defines model for SupplyResponse.
 type SupplyResponse struct {
 
@@ -704,12 +926,51 @@ type TransactionParametersResponse struct {
 	MinFee uint64 `json:"min-fee"`
 }
 
+// TransactionPoolResponse defines model for TransactionPoolResponse.
+type TransactionPoolResponse struct {
+
+	// The congestion multiplier tracking the load on the pool over the recent rounds. The fee per byte is this multiplier, grown exponentially for every whole block pending beyond the first.
+	FeeMultiplier uint64 `json:"fee-multiplier"`
+
+	// The minimum fee per byte, in MicroAlgos, a transaction needs to pay to enter the pool.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// The pending transaction groups, sorted by decreasing fee per byte and cut off at max groups.
+	Groups []PendingTransactionGroupSummary `json:"groups"`
+
+	// The number of whole blocks worth of transactions pending in the pool.
+	PendingWholeBlocks uint64 `json:"pending-whole-blocks"`
+
+	// Total number of transaction groups in the pool.
+	TotalGroups uint64 `json:"total-groups"`
+}
+
 // VersionsResponse defines model for VersionsResponse.
 type VersionsResponse Version
 
 // AccountInformationParams defines parameters for AccountInformation.
 type AccountInformationParams struct {
 
+	// Look up the account as of the end of this round instead of the latest round. Rounds older than the ones kept in memory require an archival node with EnableAccountHistory set.
+	Round *uint64 `json:"round,omitempty"`
+
+	// When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
+	Exclude *string `json:"exclude,omitempty"`
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// AccountApplicationInformationParams defines parameters for AccountApplicationInformation.
+type AccountApplicationInformationParams struct {
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// AccountAssetInformationParams defines parameters for AccountAssetInformation.
+type AccountAssetInformationParams struct {
+
 	// Configures whether the response object is JSON or MessagePack encoded.
 	Format *string `json:"format,omitempty"`
 }
@@ -724,6 +985,33 @@ type GetPendingTransactionsByAddressParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// GetApplicationBoxByNameParams defines parameters for GetApplicationBoxByName.
+type GetApplicationBoxByNameParams struct {
+
+	// A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Name string `json:"name"`
+}
+
+// GetApplicationBoxesParams defines parameters for GetApplicationBoxes.
+type GetApplicationBoxesParams struct {
+
+	// Max number of box names to return. If max is not set, or max == 0, returns all box-names.
+	Max *uint64 `json:"max,omitempty"`
+}
+
+// GetBlockHeadersCommitmentParams defines parameters for GetBlockHeadersCommitment.
+type GetBlockHeadersCommitmentParams struct {
+
+	// The first round of the range.
+	FirstRound uint64 `json:"first-round"`
+
+	// The last round of the range, at most 1000 rounds after first-round.
+	LastRound uint64 `json:"last-round"`
+
+	// When set, also return the proof of membership of the header of this round, which must be within the range.
+	ProofRound *uint64 `json:"proof-round,omitempty"`
+}
+
 // GetBlockParams defines parameters for GetBlock.
 type GetBlockParams struct {
 
@@ -731,6 +1019,13 @@ type GetBlockParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// GetBlockHeaderParams defines parameters for GetBlockHeader.
+type GetBlockHeaderParams struct {
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
 // GetProofParams defines parameters for GetProof.
 type GetProofParams struct {
 
@@ -738,6 +1033,65 @@ type GetProofParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// GetLedgerStateDeltaParams defines parameters for GetLedgerStateDelta.
+type GetLedgerStateDeltaParams struct {
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// SearchIndexedTransactionsParams defines parameters for SearchIndexedTransactions.
+type SearchIndexedTransactionsParams struct {
+
+	// Only include transactions with this address in one of the transaction fields.
+	Address *string `json:"address,omitempty"`
+
+	// Asset ID
+	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Application ID
+	ApplicationId *uint64 `json:"application-id,omitempty"`
+	TxType        *string `json:"tx-type,omitempty"`
+
+	// Specifies a prefix which must be contained in the note field.
+	NotePrefix *string `json:"note-prefix,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Include results before the given time. Must be an RFC 3339 formatted string.
+	BeforeTime *time.Time `json:"before-time,omitempty"`
+
+	// Include results after the given time. Must be an RFC 3339 formatted string.
+	AfterTime *time.Time `json:"after-time,omitempty"`
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// GetIndexedTransactionParams defines parameters for GetIndexedTransaction.
+type GetIndexedTransactionParams struct {
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// TealCompileParams defines parameters for TealCompile.
+type TealCompileParams struct {
+
+	// When set to `true`, returns the source map of the program as a JSON object. Defaults to `false`.
+	Sourcemap *bool `json:"sourcemap,omitempty"`
+}
+
 // TealDryrunJSONBody defines parameters for TealDryrun.
 type TealDryrunJSONBody DryrunRequest
 
@@ -758,5 +1112,25 @@ type PendingTransactionInformationParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// SimulateTransactionParams defines parameters for SimulateTransaction.
+type SimulateTransactionParams struct {
+
+	// Evaluate transactions that carry no signature as if they were correctly signed.
+	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// StreamTransactionEventsParams defines parameters for StreamTransactionEvents.
+type StreamTransactionEventsParams struct {
+
+	// Only report the transactions referencing this account. May be repeated.
+	Address *[]string `json:"address,omitempty"`
+
+	// Only report the transactions calling or referencing this application. May be repeated.
+	ApplicationId *[]uint64 `json:"application-id,omitempty"`
+}
+
 // TealDryrunRequestBody defines body for TealDryrun for application/json ContentType.
 type TealDryrunJSONRequestBody TealDryrunJSONBody
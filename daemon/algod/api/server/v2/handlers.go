@@ -19,18 +19,22 @@ package v2
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data"
@@ -39,15 +43,21 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/indexer"
+	"github.com/algorand/go-algorand/node/txnstream"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 )
 
 const maxTealSourceBytes = 1e5
 const maxTealDryrunBytes = 1e5
+const maxDevModeMintBlocks = 1000
+const maxBlockHeadersCommitmentRounds = 1000
 
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
@@ -74,6 +84,18 @@ type NodeInterface interface {
 	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
 	RemoveParticipationKey(account.ParticipationID) error
 	AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error
+	SetSyncRound(rnd basics.Round) error
+	GetSyncRound() basics.Round
+	UnsetSyncRound() error
+	Indexer() (*indexer.Indexer, error)
+	SetDevModeBlockProduction(paused bool) error
+	MintDevModeBlocks(count uint64) (basics.Round, error)
+	SetBlockTimeStampOffset(offset int64) error
+	GetBlockTimeStampOffset() (int64, error)
+	GetTxPoolStatus() node.TxPoolStatus
+	EvictPendingTxns(txids []transactions.Txid) int
+	EvictPendingTxnsFromSender(sender basics.Address) int
+	SubscribeTxnStream(filter txnstream.Filter, client string) (*txnstream.Subscription, error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -259,10 +281,34 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	var excludeAll bool
+	if params.Exclude != nil {
+		switch *params.Exclude {
+		case "all":
+			excludeAll = true
+		case "none":
+		default:
+			err := fmt.Errorf(errFailedToParseExclude, *params.Exclude)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
 	myLedger := v2.Node.Ledger()
 	lastRound := myLedger.Latest()
+	if params.Round != nil {
+		if basics.Round(*params.Round) > lastRound {
+			err := fmt.Errorf(errRequestedRoundInFuture, *params.Round, lastRound)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		lastRound = basics.Round(*params.Round)
+	}
 	record, err := myLedger.Lookup(lastRound, addr)
 	if err != nil {
+		var roundOffsetErr *ledger.RoundOffsetError
+		var historyRangeErr *ledger.AccountHistoryRangeError
+		if errors.As(err, &roundOffsetErr) || errors.As(err, &historyRangeErr) {
+			return notFound(ctx, err, fmt.Sprintf(errAccountRoundUnavailable, lastRound), v2.Log)
+		}
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
@@ -272,6 +318,12 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	}
 
 	if handle == protocol.CodecHandle {
+		if excludeAll {
+			record.Assets = nil
+			record.AssetParams = nil
+			record.AppLocalStates = nil
+			record.AppParams = nil
+		}
 		data, err := encode(handle, record)
 		if err != nil {
 			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
@@ -286,7 +338,7 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	amountWithoutPendingRewards := recordWithoutPendingRewards.MicroAlgos
 
 	assetsCreators := make(map[basics.AssetIndex]string, len(record.Assets))
-	if len(record.Assets) > 0 {
+	if len(record.Assets) > 0 && !excludeAll {
 		//assets = make(map[uint64]v1.AssetHolding)
 		for curid := range record.Assets {
 			var creator string
@@ -307,10 +359,164 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return internalError(ctx, err, errInternalFailure, v2.Log)
 	}
 
+	// the min balance and the totals are computed from the full record, so
+	// the resources are only dropped from the response.
+	if excludeAll {
+		account.Assets = nil
+		account.CreatedAssets = nil
+		account.AppsLocalState = nil
+		account.CreatedApps = nil
+	}
+
 	response := generated.AccountResponse(account)
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// AccountAssetInformation gets account information about a given asset.
+// (GET /v2/accounts/{address}/assets/{asset-id})
+func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, assetID uint64, params generated.AccountAssetInformationParams) error {
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	myLedger := v2.Node.Ledger()
+	lastRound := myLedger.Latest()
+	record, err := myLedger.Lookup(lastRound, addr)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	aidx := basics.AssetIndex(assetID)
+	holding, hasHolding := record.Assets[aidx]
+	assetParams, hasParams := record.AssetParams[aidx]
+	if !hasHolding && !hasParams {
+		return notFound(ctx, errors.New(errAccountAssetNotFound), errAccountAssetNotFound, v2.Log)
+	}
+
+	if handle == protocol.CodecHandle {
+		response := struct {
+			Round        basics.Round         `codec:"round"`
+			AssetHolding *basics.AssetHolding `codec:"asset-holding,omitempty"`
+			CreatedAsset *basics.AssetParams  `codec:"created-asset,omitempty"`
+		}{
+			Round: lastRound,
+		}
+		if hasHolding {
+			response.AssetHolding = &holding
+		}
+		if hasParams {
+			response.CreatedAsset = &assetParams
+		}
+
+		data, err := encode(handle, response)
+		if err != nil {
+			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+		}
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
+
+	response := generated.AccountAssetResponse{Round: uint64(lastRound)}
+	if hasParams {
+		asset := AssetParamsToAsset(address, aidx, &assetParams)
+		response.CreatedAsset = &asset.Params
+	}
+	if hasHolding {
+		var creator string
+		if hasParams {
+			creator = address
+		} else {
+			creatorAddr, ok, err := myLedger.GetCreator(basics.CreatableIndex(aidx), basics.AssetCreatable)
+			if err == nil && ok {
+				creator = creatorAddr.String()
+			}
+			// otherwise the asset may have been deleted, so we can no
+			// longer fetch the creator
+		}
+		response.AssetHolding = &generated.AssetHolding{
+			Amount:   holding.Amount,
+			AssetId:  assetID,
+			Creator:  creator,
+			IsFrozen: holding.Frozen,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountApplicationInformation gets account information about a given app.
+// (GET /v2/accounts/{address}/applications/{application-id})
+func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address string, applicationID uint64, params generated.AccountApplicationInformationParams) error {
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	myLedger := v2.Node.Ledger()
+	lastRound := myLedger.Latest()
+	record, err := myLedger.Lookup(lastRound, addr)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	aidx := basics.AppIndex(applicationID)
+	localState, hasLocalState := record.AppLocalStates[aidx]
+	appParams, hasParams := record.AppParams[aidx]
+	if !hasLocalState && !hasParams {
+		return notFound(ctx, errors.New(errAccountAppNotFound), errAccountAppNotFound, v2.Log)
+	}
+
+	if handle == protocol.CodecHandle {
+		response := struct {
+			Round         basics.Round          `codec:"round"`
+			AppLocalState *basics.AppLocalState `codec:"app-local-state,omitempty"`
+			CreatedApp    *basics.AppParams     `codec:"created-app,omitempty"`
+		}{
+			Round: lastRound,
+		}
+		if hasLocalState {
+			response.AppLocalState = &localState
+		}
+		if hasParams {
+			response.CreatedApp = &appParams
+		}
+
+		data, err := encode(handle, response)
+		if err != nil {
+			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+		}
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
+
+	response := generated.AccountApplicationResponse{Round: uint64(lastRound)}
+	if hasParams {
+		app := AppParamsToApplication(address, aidx, &appParams)
+		response.CreatedApp = &app.Params
+	}
+	if hasLocalState {
+		response.AppLocalState = &generated.ApplicationLocalState{
+			Id:       applicationID,
+			KeyValue: convertTKVToGenerated(&localState.KeyValue),
+			Schema: generated.ApplicationStateSchema{
+				NumByteSlice: localState.Schema.NumByteSlice,
+				NumUint:      localState.Schema.NumUint,
+			},
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetBlock gets the block for the given round.
 // (GET /v2/blocks/{round})
 func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params generated.GetBlockParams) error {
@@ -351,6 +557,118 @@ func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params generated.Ge
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
+// GetBlockHeader gets the block header for the given round.
+// (GET /v2/blocks/{round}/header)
+func (v2 *Handlers) GetBlockHeader(ctx echo.Context, round uint64, params generated.GetBlockHeaderParams) error {
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	hdr, err := v2.blockHeader(basics.Round(round))
+	if err != nil {
+		return v2.blockLookupError(ctx, err)
+	}
+
+	response := struct {
+		Header bookkeeping.BlockHeader `codec:"header"`
+	}{
+		Header: hdr,
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// GetBlockHash gets the block hash for the given round.
+// (GET /v2/blocks/{round}/hash)
+func (v2 *Handlers) GetBlockHash(ctx echo.Context, round uint64) error {
+	hdr, err := v2.blockHeader(basics.Round(round))
+	if err != nil {
+		return v2.blockLookupError(ctx, err)
+	}
+
+	response := generated.BlockHashResponse{
+		BlockHash: crypto.Digest(hdr.Hash()).String(),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetBlockHeadersCommitment gets a Merkle commitment to the headers of a range of rounds.
+// (GET /v2/blockheaders/commitment)
+func (v2 *Handlers) GetBlockHeadersCommitment(ctx echo.Context, params generated.GetBlockHeadersCommitmentParams) error {
+	first := basics.Round(params.FirstRound)
+	last := basics.Round(params.LastRound)
+	if last < first || uint64(last-first)+1 > maxBlockHeadersCommitmentRounds {
+		err := fmt.Errorf(errInvalidBlockHeadersRange, first, last, maxBlockHeadersCommitmentRounds)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if params.ProofRound != nil && (basics.Round(*params.ProofRound) < first || basics.Round(*params.ProofRound) > last) {
+		err := fmt.Errorf(errProofRoundOutOfRange, *params.ProofRound, first, last)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	hdrs := make([]bookkeeping.BlockHeader, 0, last-first+1)
+	for rnd := first; rnd <= last; rnd++ {
+		hdr, err := v2.blockHeader(rnd)
+		if err != nil {
+			return v2.blockLookupError(ctx, err)
+		}
+		hdrs = append(hdrs, hdr)
+	}
+
+	tree, err := bookkeeping.BlockHeaderMerkleTree(hdrs)
+	if err != nil {
+		return internalError(ctx, err, "building Merkle tree", v2.Log)
+	}
+
+	root := tree.Root()
+	response := generated.BlockHeadersCommitmentResponse{
+		FirstRound: uint64(first),
+		LastRound:  uint64(last),
+		Root:       root[:],
+	}
+
+	if params.ProofRound != nil {
+		idx := *params.ProofRound - uint64(first)
+		proof, err := tree.Prove([]uint64{idx})
+		if err != nil {
+			return internalError(ctx, err, "generating proof", v2.Log)
+		}
+
+		proofconcat := make([]byte, 0, len(proof)*crypto.DigestSize)
+		for _, proofelem := range proof {
+			proofconcat = append(proofconcat, proofelem[:]...)
+		}
+		response.Proof = &proofconcat
+		response.Idx = &idx
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// blockHeader returns the header of the given round, or a ledgercore.ErrNoEntry
+// if the ledger does not have that round yet.
+func (v2 *Handlers) blockHeader(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	ledger := v2.Node.Ledger()
+	if latest := ledger.Latest(); rnd > latest {
+		return bookkeeping.BlockHeader{}, ledgercore.ErrNoEntry{Round: rnd, Latest: latest}
+	}
+	return ledger.BlockHdr(rnd)
+}
+
+func (v2 *Handlers) blockLookupError(ctx echo.Context, err error) error {
+	var noEntry ledgercore.ErrNoEntry
+	if errors.As(err, &noEntry) {
+		return notFound(ctx, err, errBlockNotFound, v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
 // GetProof generates a Merkle proof for a transaction in a block.
 // (GET /v2/blocks/{round}/transactions/{txid}/proof)
 func (v2 *Handlers) GetProof(ctx echo.Context, round uint64, txid string, params generated.GetProofParams) error {
@@ -409,6 +727,27 @@ func (v2 *Handlers) GetProof(ctx echo.Context, round uint64, txid string, params
 	return notFound(ctx, err, err.Error(), v2.Log)
 }
 
+// GetLedgerStateDelta returns the ledger state delta produced by the block of a given round.
+// (GET /v2/deltas/{round})
+func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64, params generated.GetLedgerStateDeltaParams) error {
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	delta, err := v2.Node.Ledger().GetStateDeltaForRound(basics.Round(round))
+	if err != nil {
+		return notFound(ctx, err, fmt.Sprintf(errFailedRetrievingStateDelta, err), v2.Log)
+	}
+
+	data, err := encode(handle, convertLedgerStateDelta(&delta))
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
 // GetSupply gets the current supply reported by the ledger.
 // (GET /v2/ledger/supply)
 func (v2 *Handlers) GetSupply(ctx echo.Context) error {
@@ -546,6 +885,66 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, generated.PostTransactionsResponse{TxId: txid.String()})
 }
 
+// SimulateTransaction simulates broadcasting a raw transaction or transaction group to the network.
+// (POST /v2/transactions/simulate)
+func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params generated.SimulateTransactionParams) error {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		return ctx.String(http.StatusNotFound, "/transactions/simulate was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("SimulateTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	var txgroup []transactions.SignedTxn
+	dec := protocol.NewDecoder(ctx.Request().Body)
+	for {
+		var st transactions.SignedTxn
+		err := dec.Decode(&st)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		txgroup = append(txgroup, st)
+
+		if len(txgroup) > proto.MaxTxGroupSize {
+			err := fmt.Errorf("max group size is %d", proto.MaxTxGroupSize)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	if len(txgroup) == 0 {
+		err := errors.New("empty txgroup")
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	allowEmptySignatures := params.AllowEmptySignatures != nil && *params.AllowEmptySignatures
+	result, err := simulation.MakeSimulator(v2.Node.Ledger()).Simulate(txgroup, allowEmptySignatures)
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	data, err := encode(handle, convertSimulationResult(&result))
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
 // (POST /v2/teal/dryrun)
 func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
@@ -655,6 +1054,37 @@ type preEncodedTxInfo struct {
 	Inners             *[]preEncodedTxInfo            `codec:"inner-txns,omitempty"`
 }
 
+// preEncodedIndexedTransactions represents a page of transactions from the archival index.
+type preEncodedIndexedTransactions struct {
+	Transactions []preEncodedTxInfo `codec:"transactions"`
+	NextToken    *string            `codec:"next-token,omitempty"`
+}
+
+// convertTxnWithStatus fills in the response for a pending or confirmed transaction.
+func (v2 *Handlers) convertTxnWithStatus(txn node.TxnWithStatus) preEncodedTxInfo {
+	// Encoding wasn't working well without embedding "real" objects.
+	response := preEncodedTxInfo{
+		Txn: txn.Txn,
+	}
+
+	if txn.ConfirmedRound != 0 {
+		r := uint64(txn.ConfirmedRound)
+		response.ConfirmedRound = &r
+
+		response.ClosingAmount = &txn.ApplyData.ClosingAmount.Raw
+		response.AssetClosingAmount = &txn.ApplyData.AssetClosingAmount
+		response.SenderRewards = &txn.ApplyData.SenderRewards.Raw
+		response.ReceiverRewards = &txn.ApplyData.ReceiverRewards.Raw
+		response.CloseRewards = &txn.ApplyData.CloseRewards.Raw
+		response.AssetIndex = computeAssetIndexFromTxn(txn, v2.Node.Ledger())
+		response.ApplicationIndex = computeAppIndexFromTxn(txn, v2.Node.Ledger())
+		response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
+		response.Logs = convertLogs(txn)
+		response.Inners = convertInners(&txn)
+	}
+	return response
+}
+
 // PendingTransactionInformation returns a transaction with the specified txID
 // from the transaction pool. If not found looks for the transaction in the
 // last proto.MaxTxnLife rounds
@@ -683,32 +1113,163 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 		return notFound(ctx, err, err.Error(), v2.Log)
 	}
 
-	// Encoding wasn't working well without embedding "real" objects.
-	response := preEncodedTxInfo{
-		Txn: txn.Txn,
+	response := v2.convertTxnWithStatus(txn)
+
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 	}
 
-	if txn.ConfirmedRound != 0 {
-		r := uint64(txn.ConfirmedRound)
-		response.ConfirmedRound = &r
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
 
-		response.ClosingAmount = &txn.ApplyData.ClosingAmount.Raw
-		response.AssetClosingAmount = &txn.ApplyData.AssetClosingAmount
-		response.SenderRewards = &txn.ApplyData.SenderRewards.Raw
-		response.ReceiverRewards = &txn.ApplyData.ReceiverRewards.Raw
-		response.CloseRewards = &txn.ApplyData.CloseRewards.Raw
-		response.AssetIndex = computeAssetIndexFromTxn(txn, v2.Node.Ledger())
-		response.ApplicationIndex = computeAppIndexFromTxn(txn, v2.Node.Ledger())
-		response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
-		response.Logs = convertLogs(txn)
-		response.Inners = convertInners(&txn)
+// lookupIndexedTransactions loads the top-level transactions found by the archival index from the ledger.
+func (v2 *Handlers) lookupIndexedTransactions(locs []indexer.TransactionLocation) ([]preEncodedTxInfo, error) {
+	txns := make([]preEncodedTxInfo, 0, len(locs))
+	var payset []transactions.SignedTxnWithAD
+	var paysetRound basics.Round
+	for i, loc := range locs {
+		rnd := basics.Round(loc.Round)
+		if i == 0 || rnd != paysetRound {
+			blk, err := v2.Node.Ledger().Block(rnd)
+			if err != nil {
+				return nil, err
+			}
+			payset, err = blk.DecodePaysetFlat()
+			if err != nil {
+				return nil, err
+			}
+			paysetRound = rnd
+		}
+		if loc.Offset >= uint64(len(payset)) {
+			return nil, fmt.Errorf("indexed transaction %d is out of range of block %d", loc.Offset, rnd)
+		}
+
+		stxn := payset[loc.Offset]
+		txns = append(txns, v2.convertTxnWithStatus(node.TxnWithStatus{
+			Txn:            stxn.SignedTxn,
+			ConfirmedRound: rnd,
+			ApplyData:      stxn.ApplyData,
+		}))
+	}
+	return txns, nil
+}
+
+// SearchIndexedTransactions searches the built-in archival index for
+// transactions matching the given filters.
+// (GET /v2/indexer/transactions)
+func (v2 *Handlers) SearchIndexedTransactions(ctx echo.Context, params generated.SearchIndexedTransactionsParams) error {
+	idx, err := v2.Node.Indexer()
+	if err != nil {
+		return notFound(ctx, err, errIndexerNotActive, v2.Log)
+	}
+
+	var filter indexer.TransactionFilter
+	if params.Address != nil {
+		addr, err := basics.UnmarshalChecksumAddress(*params.Address)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
+		filter.Address = addr.String()
+	}
+	if params.NotePrefix != nil {
+		filter.NotePrefix, err = base64.StdEncoding.DecodeString(*params.NotePrefix)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseNotePrefix, v2.Log)
+		}
+	}
+	if params.TxType != nil {
+		filter.TxType = *params.TxType
+	}
+	if params.AssetId != nil {
+		filter.AssetID = *params.AssetId
+	}
+	if params.ApplicationId != nil {
+		filter.AppID = *params.ApplicationId
+	}
+	if params.MinRound != nil {
+		filter.MinRound = *params.MinRound
+	}
+	if params.MaxRound != nil {
+		filter.MaxRound = *params.MaxRound
+	}
+	if params.AfterTime != nil {
+		filter.AfterTime = params.AfterTime.Unix()
+	}
+	if params.BeforeTime != nil {
+		filter.BeforeTime = params.BeforeTime.Unix()
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Next != nil {
+		filter.Next = *params.Next
+	}
+
+	locs, next, err := idx.SearchTransactions(filter)
+	if err == indexer.ErrInvalidNextToken {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedSearchingIndex, v2.Log)
+	}
+
+	txns, err := v2.lookupIndexedTransactions(locs)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
 	handle, contentType, err := getCodecHandle(params.Format)
 	if err != nil {
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
-	data, err := encode(handle, response)
+	data, err := encode(handle, preEncodedIndexedTransactions{
+		Transactions: txns,
+		NextToken:    strOrNil(next),
+	})
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// GetIndexedTransaction returns the top-level transaction containing the
+// given confirmed transaction, as found by the built-in archival index.
+// (GET /v2/indexer/transactions/{txid})
+func (v2 *Handlers) GetIndexedTransaction(ctx echo.Context, txid string, params generated.GetIndexedTransactionParams) error {
+	idx, err := v2.Node.Indexer()
+	if err != nil {
+		return notFound(ctx, err, errIndexerNotActive, v2.Log)
+	}
+
+	txID := transactions.Txid{}
+	if err := txID.UnmarshalText([]byte(txid)); err != nil {
+		return badRequest(ctx, err, errNoTxnSpecified, v2.Log)
+	}
+
+	loc, err := idx.GetTransactionLocation(txID.String())
+	if err == indexer.ErrTransactionNotFound {
+		return notFound(ctx, err, errIndexedTransactionNotFound, v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedSearchingIndex, v2.Log)
+	}
+
+	txns, err := v2.lookupIndexedTransactions([]indexer.TransactionLocation{loc})
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	data, err := encode(handle, txns[0])
 	if err != nil {
 		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 	}
@@ -869,6 +1430,61 @@ func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) e
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetApplicationBoxes returns the box names of an application
+// (GET /v2/applications/{application-id}/boxes)
+func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64, params generated.GetApplicationBoxesParams) error {
+	appIdx := basics.AppIndex(applicationID)
+	ledger := v2.Node.Ledger()
+	lastRound := ledger.Latest()
+	prefix := ledgercore.BoxKeyPrefix(appIdx)
+
+	var maxKeys uint64
+	if params.Max != nil {
+		maxKeys = *params.Max
+	}
+	keys, err := ledger.LookupKeysByPrefix(lastRound, prefix, maxKeys)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := generated.BoxesResponse{Boxes: make([]generated.BoxDescriptor, 0, len(keys))}
+	for _, key := range keys {
+		_, name, err := ledgercore.SplitBoxKey(key)
+		if err != nil {
+			return internalError(ctx, err, errInternalFailure, v2.Log)
+		}
+		response.Boxes = append(response.Boxes, generated.BoxDescriptor{Name: []byte(name)})
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetApplicationBoxByName returns the value of an application's box
+// (GET /v2/applications/{application-id}/box)
+func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint64, params generated.GetApplicationBoxByNameParams) error {
+	appIdx := basics.AppIndex(applicationID)
+	name, err := parseBoxName(params.Name)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseBoxName, v2.Log)
+	}
+
+	ledger := v2.Node.Ledger()
+	lastRound := ledger.Latest()
+	value, err := ledger.LookupKv(lastRound, ledgercore.MakeBoxKey(appIdx, string(name)))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if value == nil {
+		return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
+	}
+
+	response := generated.BoxResponse{
+		Round: uint64(lastRound),
+		Name:  name,
+		Value: value,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetAssetByID returns application information by app idx.
 // (GET /v2/assets/{asset-id})
 func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
@@ -916,9 +1532,272 @@ func (v2 *Handlers) AbortCatchup(ctx echo.Context, catchpoint string) error {
 	return v2.abortCatchup(ctx, catchpoint)
 }
 
+// SetSyncRound sets the round the ledger of a node in follower mode does not advance beyond.
+// (POST /v2/ledger/sync/{round})
+func (v2 *Handlers) SetSyncRound(ctx echo.Context, round uint64) error {
+	err := v2.Node.SetSyncRound(basics.Round(round))
+	if err != nil {
+		switch err {
+		case catchup.ErrSyncRoundInvalid, node.ErrFollowModeDisabled:
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		default:
+			return internalError(ctx, err, errFailedSettingSyncRound, v2.Log)
+		}
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetSyncRound returns the round the ledger is held at.
+// (GET /v2/ledger/sync)
+func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
+	rnd := v2.Node.GetSyncRound()
+	if rnd == 0 {
+		return notFound(ctx, errors.New(errSyncRoundNotSet), errSyncRoundNotSet, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.GetSyncRoundResponse{Round: uint64(rnd)})
+}
+
+// UnsetSyncRound removes the sync round restriction from the ledger.
+// (DELETE /v2/ledger/sync)
+func (v2 *Handlers) UnsetSyncRound(ctx echo.Context) error {
+	err := v2.Node.UnsetSyncRound()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// PauseDevModeBlocks stops a developer mode node from writing a block for each transaction group.
+// (POST /v2/devmode/blocks/pause)
+func (v2 *Handlers) PauseDevModeBlocks(ctx echo.Context) error {
+	err := v2.Node.SetDevModeBlockProduction(true)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ResumeDevModeBlocks lets a developer mode node write a block for each transaction group again.
+// (DELETE /v2/devmode/blocks/pause)
+func (v2 *Handlers) ResumeDevModeBlocks(ctx echo.Context) error {
+	err := v2.Node.SetDevModeBlockProduction(false)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// MintDevModeBlocks writes count blocks to the ledger of a developer mode node.
+// (POST /v2/devmode/blocks/mint/{count})
+func (v2 *Handlers) MintDevModeBlocks(ctx echo.Context, count uint64) error {
+	if count == 0 || count > maxDevModeMintBlocks {
+		err := fmt.Errorf(errInvalidDevModeMintCount, maxDevModeMintBlocks)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	rnd, err := v2.Node.MintDevModeBlocks(count)
+	if err != nil {
+		if err == node.ErrDevModeDisabled {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, errFailedMintingBlocks, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.MintDevModeBlocksResponse{Round: uint64(rnd)})
+}
+
+// GetBlockTimeStampOffset returns the timestamp offset of developer mode blocks.
+// (GET /v2/devmode/blocks/offset)
+func (v2 *Handlers) GetBlockTimeStampOffset(ctx echo.Context) error {
+	offset, err := v2.Node.GetBlockTimeStampOffset()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.GetBlockTimeStampOffsetResponse{Offset: uint64(offset)})
+}
+
+// SetBlockTimeStampOffset sets the timestamp offset of developer mode blocks.
+// (POST /v2/devmode/blocks/offset/{offset})
+func (v2 *Handlers) SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error {
+	if offset > math.MaxInt64 {
+		err := fmt.Errorf(errInvalidTimeStampOffset, offset)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	err := v2.Node.SetBlockTimeStampOffset(int64(offset))
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetTransactionPool returns the fee levels of the transaction pool and its pending transaction groups.
+// (GET /v2/transactions/pool)
+func (v2 *Handlers) GetTransactionPool(ctx echo.Context, params private.GetTransactionPoolParams) error {
+	status := v2.Node.GetTxPoolStatus()
+
+	groups := status.Groups
+	if params.Max != nil && *params.Max != 0 && uint64(len(groups)) > *params.Max {
+		groups = groups[:*params.Max]
+	}
+
+	response := private.TransactionPoolResponse{
+		FeePerByte:         status.FeePerByte,
+		FeeMultiplier:      status.FeeThresholdMultiplier,
+		PendingWholeBlocks: uint64(status.PendingWholeBlocks),
+		TotalGroups:        uint64(len(status.Groups)),
+		Groups:             make([]private.PendingTransactionGroupSummary, len(groups)),
+	}
+	for i, group := range groups {
+		txns := make([]private.PendingTransactionSummary, len(group.Txns))
+		for j, txn := range group.Txns {
+			txns[j] = private.PendingTransactionSummary{
+				Txid:       txn.Txid.String(),
+				Sender:     txn.Sender.String(),
+				Fee:        txn.Fee.Raw,
+				FirstValid: uint64(txn.FirstValid),
+				LastValid:  uint64(txn.LastValid),
+				Size:       uint64(txn.EncodedLength),
+			}
+		}
+		response.Groups[i] = private.PendingTransactionGroupSummary{
+			FeePerByte:   group.FeePerByte,
+			Transactions: txns,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// EvictPendingTransactions removes transaction groups from the transaction pool.
+// (DELETE /v2/transactions/pool)
+func (v2 *Handlers) EvictPendingTransactions(ctx echo.Context, params private.EvictPendingTransactionsParams) error {
+	var txids []transactions.Txid
+	if params.Txid != nil {
+		for _, txid := range *params.Txid {
+			var txID transactions.Txid
+			if err := txID.UnmarshalText([]byte(txid)); err != nil {
+				return badRequest(ctx, err, errNoTxnSpecified, v2.Log)
+			}
+			txids = append(txids, txID)
+		}
+	}
+
+	var sender basics.Address
+	if params.Sender != nil {
+		var err error
+		sender, err = basics.UnmarshalChecksumAddress(*params.Sender)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
+	}
+
+	if len(txids) == 0 && params.Sender == nil {
+		err := errors.New(errNoEvictionCriteria)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	var evicted int
+	if len(txids) > 0 {
+		evicted += v2.Node.EvictPendingTxns(txids)
+	}
+	if params.Sender != nil {
+		evicted += v2.Node.EvictPendingTxnsFromSender(sender)
+	}
+
+	return ctx.JSON(http.StatusOK, private.EvictPendingTransactionsResponse{Evicted: uint64(evicted)})
+}
+
+// streamKeepAliveInterval is how often an idle event stream sends a comment
+// line, so that intermediaries do not consider the connection dead.
+const streamKeepAliveInterval = 15 * time.Second
+
+// streamWriteTimeout bounds the time to write a single event of a stream
+const streamWriteTimeout = 2 * streamKeepAliveInterval
+
+// StreamTransactionEvents streams the pool admissions and drops and the
+// committed blocks as server-sent events.
+// (GET /v2/transactions/stream)
+func (v2 *Handlers) StreamTransactionEvents(ctx echo.Context, params generated.StreamTransactionEventsParams) error {
+	var filter txnstream.Filter
+	if params.Address != nil {
+		filter.Addresses = make(map[basics.Address]bool, len(*params.Address))
+		for _, a := range *params.Address {
+			addr, err := basics.UnmarshalChecksumAddress(a)
+			if err != nil {
+				return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+			}
+			filter.Addresses[addr] = true
+		}
+	}
+	if params.ApplicationId != nil {
+		filter.Apps = make(map[basics.AppIndex]bool, len(*params.ApplicationId))
+		for _, app := range *params.ApplicationId {
+			filter.Apps[basics.AppIndex(app)] = true
+		}
+	}
+
+	// clients are told apart by their address, and not by headers that they
+	// could set at will
+	client, _, err := net.SplitHostPort(ctx.Request().RemoteAddr)
+	if err != nil {
+		client = ctx.Request().RemoteAddr
+	}
+	sub, err := v2.Node.SubscribeTxnStream(filter, client)
+	if err != nil {
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	}
+	defer sub.Close()
+
+	// the stream outlives the write timeout of the server, so every write
+	// gets its own deadline instead.
+	conn := lib.GetRequestConnection(ctx.Request())
+	extendDeadline := func() {
+		if conn != nil {
+			conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		}
+	}
+
+	w := ctx.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	extendDeadline()
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case ev, ok := <-sub.Events():
+			if !ok {
+				// the subscription fell behind and was disconnected
+				return nil
+			}
+			// an event is sent on a single data line
+			var data bytes.Buffer
+			err = json.Compact(&data, protocol.EncodeJSONStrict(&ev))
+			if err == nil {
+				extendDeadline()
+				_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, data.Bytes())
+			}
+		case <-keepAlive.C:
+			extendDeadline()
+			_, err = fmt.Fprint(w, ": keep-alive\n\n")
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-v2.Shutdown:
+			return nil
+		}
+		if err != nil {
+			// the client went away
+			v2.Log.Debugf("StreamTransactionEvents: %v", err)
+			return nil
+		}
+		w.Flush()
+	}
+}
+
 // TealCompile compiles TEAL code to binary, return both binary and hash
 // (POST /v2/teal/compile)
-func (v2 *Handlers) TealCompile(ctx echo.Context) error {
+func (v2 *Handlers) TealCompile(ctx echo.Context, params generated.TealCompileParams) error {
 	// return early if teal compile is not allowed in node config
 	if !v2.Node.Config().EnableDeveloperAPI {
 		return ctx.String(http.StatusNotFound, "/teal/compile was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
@@ -939,5 +1818,19 @@ func (v2 *Handlers) TealCompile(ctx echo.Context) error {
 		Hash:   addr.String(),
 		Result: base64.StdEncoding.EncodeToString(ops.Program),
 	}
+
+	if params.Sourcemap != nil && *params.Sourcemap {
+		// the source map is returned as a generic JSON object
+		sourceMap := ops.GetSourceMap("")
+		var sourceMapObject map[string]interface{}
+		data, err := json.Marshal(&sourceMap)
+		if err == nil {
+			err = json.Unmarshal(data, &sourceMapObject)
+		}
+		if err != nil {
+			return internalError(ctx, err, errFailedToEncodeSourceMap, v2.Log)
+		}
+		response.Sourcemap = &sourceMapObject
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
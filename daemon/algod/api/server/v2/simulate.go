@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/node"
+)
+
+type preEncodedSimulateTxnResult struct {
+	Txn              preEncodedTxInfo `codec:"txn-result"`
+	Passed           bool             `codec:"passed"`
+	MissingSignature *bool            `codec:"missing-signature,omitempty"`
+}
+
+type preEncodedSimulateResponse struct {
+	LastRound      uint64                        `codec:"last-round"`
+	WouldSucceed   bool                          `codec:"would-succeed"`
+	FailureMessage *string                       `codec:"failure-message,omitempty"`
+	FailedAt       *uint64                       `codec:"failed-at"` // not omitempty, 0 is a valid index
+	TxnResults     []preEncodedSimulateTxnResult `codec:"txn-results"`
+	StateDelta     *preEncodedLedgerStateDelta   `codec:"state-delta,omitempty"`
+}
+
+// convertSimulatedTxn converts a transaction evaluated by the simulator. The
+// ApplyData is only populated if the transaction itself passed, even if a later
+// transaction of its group failed.
+func convertSimulatedTxn(txn *transactions.SignedTxnWithAD, applied bool) preEncodedTxInfo {
+	if !applied {
+		return preEncodedTxInfo{Txn: txn.SignedTxn}
+	}
+
+	response := convertInnerTxn(txn)
+	status := node.TxnWithStatus{Txn: txn.SignedTxn, ApplyData: txn.ApplyData}
+	response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(status)
+	response.Logs = convertLogs(status)
+	response.Inners = convertInners(&status)
+	return response
+}
+
+func convertSimulationResult(result *simulation.Result) preEncodedSimulateResponse {
+	response := preEncodedSimulateResponse{
+		LastRound:      uint64(result.Round),
+		WouldSucceed:   result.WouldSucceed,
+		FailureMessage: strOrNil(result.FailureMessage),
+		TxnResults:     make([]preEncodedSimulateTxnResult, len(result.TxnResults)),
+	}
+	if result.FailedAt >= 0 {
+		failedAt := uint64(result.FailedAt)
+		response.FailedAt = &failedAt
+	}
+	for i := range result.TxnResults {
+		txnResult := &result.TxnResults[i]
+		response.TxnResults[i] = preEncodedSimulateTxnResult{
+			Txn:    convertSimulatedTxn(&txnResult.Txn, txnResult.Passed),
+			Passed: txnResult.Passed,
+		}
+		if txnResult.MissingSignature {
+			response.TxnResults[i].MissingSignature = &txnResult.MissingSignature
+		}
+	}
+	if result.WouldSucceed {
+		response.StateDelta = convertLedgerStateDelta(&result.Delta)
+	}
+	return response
+}
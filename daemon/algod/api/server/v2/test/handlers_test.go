@@ -17,29 +17,41 @@
 package test
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"errors"
 	"io"
+	"math"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/indexer"
+	"github.com/algorand/go-algorand/node/txnstream"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/execpool"
@@ -97,6 +109,114 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func TestAccountInformationExclude(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	lookup := func(exclude string, expectedCode int) *httptest.ResponseRecorder {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.AccountInformation(c, poolAddr.String(), generatedV2.AccountInformationParams{Exclude: &exclude})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+
+	rec := lookup("none", 200)
+	var response generatedV2.AccountResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, poolAddrResponseGolden, response)
+
+	rec = lookup("all", 200)
+	response = generatedV2.AccountResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	expected := poolAddrResponseGolden
+	expected.Assets = nil
+	expected.CreatedAssets = nil
+	expected.AppsLocalState = nil
+	expected.CreatedApps = nil
+	require.Equal(t, expected, response)
+
+	lookup("some", 400)
+}
+
+func TestAccountAssetAndApplicationInformation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	program := logic.Program(retOneProgram)
+	lhash := crypto.HashObj(&program)
+	var holder basics.Address
+	copy(holder[:], lhash[:])
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), rec
+	}
+
+	c, rec := newContext()
+	err := handler.AccountAssetInformation(c, holder.String(), 2, generatedV2.AccountAssetInformationParams{})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var assetResponse generatedV2.AccountAssetResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &assetResponse))
+	require.NotNil(t, assetResponse.AssetHolding)
+	require.Equal(t, uint64(2), assetResponse.AssetHolding.AssetId)
+	require.Equal(t, uint64(10), assetResponse.AssetHolding.Amount)
+	require.Nil(t, assetResponse.CreatedAsset)
+
+	c, rec = newContext()
+	err = handler.AccountAssetInformation(c, holder.String(), 3, generatedV2.AccountAssetInformationParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newContext()
+	err = handler.AccountApplicationInformation(c, holder.String(), 1, generatedV2.AccountApplicationInformationParams{})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var appResponse generatedV2.AccountApplicationResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &appResponse))
+	require.NotNil(t, appResponse.AppLocalState)
+	require.Equal(t, uint64(1), appResponse.AppLocalState.Id)
+	require.Nil(t, appResponse.CreatedApp)
+
+	c, rec = newContext()
+	err = handler.AccountApplicationInformation(c, poolAddr.String(), 1, generatedV2.AccountApplicationInformationParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newContext()
+	err = handler.AccountAssetInformation(c, "bad account", 2, generatedV2.AccountAssetInformationParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
+func TestAccountInformationAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	lookup := func(round uint64, expectedCode int) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.AccountInformation(c, poolAddr.String(), generatedV2.AccountInformationParams{Round: &round})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+	}
+	lookup(0, 200)
+	lookup(1, 400)
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
 	defer releasefunc()
@@ -212,6 +332,258 @@ func TestGetBlockJsonEncoding(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetBlockHeaderAndHash(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	genHdr, err := handler.Node.Ledger().BlockHdr(0)
+	require.NoError(t, err)
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), rec
+	}
+
+	for _, format := range []string{"json", "msgpack"} {
+		c, rec := newContext()
+		err = handler.GetBlockHeader(c, 0, generatedV2.GetBlockHeaderParams{Format: &format})
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+
+		response := struct {
+			Header bookkeeping.BlockHeader `codec:"header"`
+		}{}
+		if format == "json" {
+			err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+		} else {
+			err = protocol.DecodeReflect(rec.Body.Bytes(), &response)
+		}
+		require.NoError(t, err)
+		require.Equal(t, genHdr.Hash(), response.Header.Hash())
+	}
+
+	c, rec := newContext()
+	err = handler.GetBlockHeader(c, 1, generatedV2.GetBlockHeaderParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newContext()
+	err = handler.GetBlockHash(c, 0)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var hashResponse generatedV2.BlockHashResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &hashResponse))
+	require.Equal(t, crypto.Digest(genHdr.Hash()).String(), hashResponse.BlockHash)
+
+	c, rec = newContext()
+	err = handler.GetBlockHash(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+}
+
+func TestGetBlockHeadersCommitment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	genHdr, err := handler.Node.Ledger().BlockHdr(0)
+	require.NoError(t, err)
+
+	commitment := func(first, last uint64, proofRound *uint64, expectedCode int) generatedV2.BlockHeadersCommitmentResponse {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		params := generatedV2.GetBlockHeadersCommitmentParams{FirstRound: first, LastRound: last, ProofRound: proofRound}
+		err := handler.GetBlockHeadersCommitment(c, params)
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+
+		var response generatedV2.BlockHeadersCommitmentResponse
+		if expectedCode == 200 {
+			require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		}
+		return response
+	}
+
+	proofRound := uint64(0)
+	response := commitment(0, 0, &proofRound, 200)
+	require.NotNil(t, response.Proof)
+	require.NotNil(t, response.Idx)
+
+	var root crypto.Digest
+	copy(root[:], response.Root)
+	tree, err := bookkeeping.BlockHeaderMerkleTree([]bookkeeping.BlockHeader{genHdr})
+	require.NoError(t, err)
+	require.Equal(t, tree.Root(), root)
+
+	var proof []crypto.Digest
+	for i := 0; i < len(*response.Proof); i += crypto.DigestSize {
+		var d crypto.Digest
+		copy(d[:], (*response.Proof)[i:])
+		proof = append(proof, d)
+	}
+	elems := map[uint64]crypto.Digest{*response.Idx: crypto.Digest(genHdr.Hash())}
+	require.NoError(t, merklearray.Verify(root, elems, proof))
+
+	commitment(1, 0, nil, 400)
+	commitment(0, 1000, nil, 400)
+	commitment(1, 1000, nil, 404)
+	proofRound = 5
+	commitment(0, 2, &proofRound, 400)
+	commitment(0, 1, nil, 404)
+}
+
+func TestGetLedgerStateDelta(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	// add an empty block so that the ledger tracks a delta for round 1
+	l := handler.Node.Ledger()
+	hdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	eval, err := l.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+	require.NoError(t, err)
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+
+	getDelta := func(round uint64, format string, expectedCode int) []byte {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.GetLedgerStateDelta(c, round, generatedV2.GetLedgerStateDeltaParams{Format: &format})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		return rec.Body.Bytes()
+	}
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(getDelta(1, "json", 200), &response))
+	require.Contains(t, response, "accounts")
+	require.Contains(t, response, "totals")
+
+	var msgpResponse map[string]interface{}
+	require.NoError(t, protocol.DecodeReflect(getDelta(1, "msgpack", 200), &msgpResponse))
+	require.Contains(t, msgpResponse, "totals")
+
+	getDelta(2, "json", 404)
+	getDelta(1, "bad format", 400)
+}
+
+func TestGetApplicationBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	err := handler.GetApplicationBoxes(c, 1, generatedV2.GetApplicationBoxesParams{})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response generatedV2.BoxesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.NotNil(t, response.Boxes)
+	require.Empty(t, response.Boxes)
+
+	getBox := func(name string, expectedCode int) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.GetApplicationBoxByName(c, 1, generatedV2.GetApplicationBoxByNameParams{Name: name})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+	}
+	getBox("str:missing", 404)
+	getBox("int:7", 404)
+	getBox("b64:AAE=", 404)
+	getBox("missing", 400)
+	getBox("int:seven", 400)
+	getBox("hex:0001", 400)
+}
+
+func TestIndexedTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, stxns, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	search := func(params generatedV2.SearchIndexedTransactionsParams, expectedCode int) (response generatedV2.IndexedTransactionsResponse) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.SearchIndexedTransactions(c, params)
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		if rec.Code == 200 {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return
+	}
+	lookup := func(txid string, expectedCode int) (response generatedV2.PendingTransactionResponse) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.GetIndexedTransaction(c, txid, generatedV2.GetIndexedTransactionParams{})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		if rec.Code == 200 {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return
+	}
+
+	stxn := stxns[0]
+	search(generatedV2.SearchIndexedTransactionsParams{}, 404)
+	lookup(stxn.ID().String(), 404)
+
+	// commit the transaction in round 1 and index it
+	l := handler.Node.Ledger()
+	hdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	eval, err := l.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, eval.Transaction(stxn, transactions.ApplyData{}))
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+
+	idx, err := indexer.MakeIndexer(t.TempDir(), l, true)
+	require.NoError(t, err)
+	defer idx.Shutdown()
+	require.NoError(t, idx.NewBlock(vb.Block()))
+	handler.Node.(*mockNode).indexer = idx
+
+	sender := stxn.Txn.Sender.String()
+	response := search(generatedV2.SearchIndexedTransactionsParams{Address: &sender}, 200)
+	require.Len(t, response.Transactions, 1)
+	require.Equal(t, uint64(1), *response.Transactions[0].ConfirmedRound)
+	require.Nil(t, response.NextToken)
+
+	txType := string(protocol.KeyRegistrationTx)
+	response = search(generatedV2.SearchIndexedTransactionsParams{TxType: &txType}, 200)
+	require.Empty(t, response.Transactions)
+
+	bad := "%%%"
+	search(generatedV2.SearchIndexedTransactionsParams{Address: &bad}, 400)
+	search(generatedV2.SearchIndexedTransactionsParams{NotePrefix: &bad}, 400)
+	search(generatedV2.SearchIndexedTransactionsParams{Next: &bad}, 400)
+
+	txn := lookup(stxn.ID().String(), 200)
+	require.Equal(t, uint64(1), *txn.ConfirmedRound)
+	lookup(transactions.Txid{1}.String(), 404)
+	lookup(bad, 400)
+}
+
 func TestGetSupply(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -439,6 +811,70 @@ func TestPostTransaction(t *testing.T) {
 	postTransactionTest(t, 0, 200)
 }
 
+func simulateTransactionTest(t *testing.T, txnToUse int, unsigned bool, allowEmptySignatures bool, enableDeveloperAPI bool, expectedCode int) (response generatedV2.SimulateResponse) {
+	numAccounts := 5
+	numTransactions := 5
+	offlineAccounts := true
+	mockLedger, _, _, stxns, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	defer mockLedger.Close()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	mockNode.config.EnableDeveloperAPI = enableDeveloperAPI
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+	e := echo.New()
+	var body io.Reader
+	if txnToUse >= 0 {
+		stxn := stxns[txnToUse]
+		if unsigned {
+			stxn = transactions.SignedTxn{Txn: stxn.Txn}
+		}
+		bodyBytes := protocol.Encode(&stxn)
+		body = bytes.NewReader(bodyBytes)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := handler.SimulateTransaction(c, generatedV2.SimulateTransactionParams{AllowEmptySignatures: &allowEmptySignatures})
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if rec.Code == 200 {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	}
+	return
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	simulateTransactionTest(t, 0, false, false, false, 404)
+	simulateTransactionTest(t, -1, false, false, true, 400)
+
+	response := simulateTransactionTest(t, 0, false, false, true, 200)
+	require.True(t, response.WouldSucceed)
+	require.Nil(t, response.FailedAt)
+	require.Len(t, response.TxnResults, 1)
+	require.True(t, response.TxnResults[0].Passed)
+	require.NotNil(t, response.StateDelta)
+
+	response = simulateTransactionTest(t, 0, true, false, true, 200)
+	require.False(t, response.WouldSucceed)
+	require.NotNil(t, response.FailedAt)
+	require.Equal(t, uint64(0), *response.FailedAt)
+	require.NotNil(t, response.FailureMessage)
+	require.Nil(t, response.StateDelta)
+
+	response = simulateTransactionTest(t, 0, true, true, true, 200)
+	require.True(t, response.WouldSucceed)
+	require.NotNil(t, response.TxnResults[0].MissingSignature)
+	require.True(t, *response.TxnResults[0].MissingSignature)
+}
+
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -530,7 +966,7 @@ func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int, enableDe
 	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(bytesToUse))
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	err := handler.TealCompile(c)
+	err := handler.TealCompile(c, generatedV2.TealCompileParams{})
 	require.NoError(t, err)
 	require.Equal(t, expectedCode, rec.Code)
 }
@@ -549,6 +985,42 @@ func TestTealCompile(t *testing.T) {
 	tealCompileTest(t, badProgramBytes, 400, true)
 }
 
+func TestTealCompileSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	mockNode.config.EnableDeveloperAPI = true
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	compile := func(sourcemap *bool) generatedV2.CompileResponse {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("#pragma version 4\nint 1\n  int 2\n+\n"))
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.TealCompile(e.NewContext(req, rec), generatedV2.TealCompileParams{Sourcemap: sourcemap}))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response generatedV2.CompileResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response
+	}
+
+	require.Nil(t, compile(nil).Sourcemap)
+	disabled := false
+	require.Nil(t, compile(&disabled).Sourcemap)
+
+	enabled := true
+	response := compile(&enabled)
+	require.NotNil(t, response.Sourcemap)
+	sourceMap := *response.Sourcemap
+	require.Equal(t, float64(3), sourceMap["version"])
+	require.Equal(t, ";AACA;;AACE;;AACF", sourceMap["mappings"])
+}
+
 func tealDryrunTest(
 	t *testing.T, obj *generatedV2.DryrunRequest, format string,
 	expCode int, expResult string, enableDeveloperAPI bool,
@@ -779,3 +1251,322 @@ func TestAppendParticipationKeys(t *testing.T) {
 		require.Contains(t, rec.Body.String(), expectedErr.Error())
 	})
 }
+
+func TestSyncRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), rec
+	}
+
+	c, rec := newContext()
+	require.NoError(t, handler.GetSyncRound(c))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.SetSyncRound(c, 5))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, basics.Round(5), mockNode.syncRound)
+
+	c, rec = newContext()
+	require.NoError(t, handler.GetSyncRound(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response private.GetSyncRoundResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(5), response.Round)
+
+	c, rec = newContext()
+	require.NoError(t, handler.UnsetSyncRound(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, basics.Round(0), mockNode.syncRound)
+
+	for _, tc := range []struct {
+		err          error
+		expectedCode int
+	}{
+		{catchup.ErrSyncRoundInvalid, http.StatusBadRequest},
+		{node.ErrFollowModeDisabled, http.StatusBadRequest},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	} {
+		mockNode.err = tc.err
+		c, rec = newContext()
+		require.NoError(t, handler.SetSyncRound(c, 5))
+		require.Equal(t, tc.expectedCode, rec.Code)
+	}
+
+	mockNode.err = node.ErrFollowModeDisabled
+	c, rec = newContext()
+	require.NoError(t, handler.UnsetSyncRound(c))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestDevModeBlockControl(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec), rec
+	}
+
+	c, rec := newContext()
+	require.NoError(t, handler.PauseDevModeBlocks(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, mockNode.devModePaused)
+
+	c, rec = newContext()
+	require.NoError(t, handler.MintDevModeBlocks(c, 3))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var mintResponse private.MintDevModeBlocksResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &mintResponse))
+	require.Equal(t, uint64(3), mintResponse.Round)
+
+	c, rec = newContext()
+	require.NoError(t, handler.MintDevModeBlocks(c, 0))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.ResumeDevModeBlocks(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, mockNode.devModePaused)
+
+	c, rec = newContext()
+	require.NoError(t, handler.SetBlockTimeStampOffset(c, 20))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.GetBlockTimeStampOffset(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var offsetResponse private.GetBlockTimeStampOffsetResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &offsetResponse))
+	require.Equal(t, uint64(20), offsetResponse.Offset)
+
+	c, rec = newContext()
+	require.NoError(t, handler.SetBlockTimeStampOffset(c, math.MaxUint64))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.err = node.ErrDevModeDisabled
+	c, rec = newContext()
+	require.NoError(t, handler.PauseDevModeBlocks(c))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	c, rec = newContext()
+	require.NoError(t, handler.MintDevModeBlocks(c, 1))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.err = errors.New("unexpected")
+	c, rec = newContext()
+	require.NoError(t, handler.MintDevModeBlocks(c, 1))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestTransactionPoolIntrospection(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), rec
+	}
+
+	txid1 := transactions.Txid{1}
+	txid2 := transactions.Txid{2}
+	mockNode.txPoolStatus = node.TxPoolStatus{
+		FeePerByte:             4,
+		FeeThresholdMultiplier: 2,
+		PendingWholeBlocks:     3,
+		Groups: []pools.PendingTxGroupSummary{
+			{FeePerByte: 10, Txns: []pools.PendingTxnSummary{{Txid: txid1, Sender: poolAddr, Fee: basics.MicroAlgos{Raw: 2000}, FirstValid: 1, LastValid: 5, EncodedLength: 200}}},
+			{FeePerByte: 5, Txns: []pools.PendingTxnSummary{{Txid: txid2, Sender: poolAddr, Fee: basics.MicroAlgos{Raw: 1000}, FirstValid: 2, LastValid: 6, EncodedLength: 200}}},
+		},
+	}
+
+	c, rec := newContext()
+	max := uint64(1)
+	require.NoError(t, handler.GetTransactionPool(c, private.GetTransactionPoolParams{Max: &max}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var poolResponse private.TransactionPoolResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poolResponse))
+	require.Equal(t, uint64(4), poolResponse.FeePerByte)
+	require.Equal(t, uint64(2), poolResponse.FeeMultiplier)
+	require.Equal(t, uint64(3), poolResponse.PendingWholeBlocks)
+	require.Equal(t, uint64(2), poolResponse.TotalGroups)
+	require.Len(t, poolResponse.Groups, 1)
+	require.Equal(t, uint64(10), poolResponse.Groups[0].FeePerByte)
+	require.Equal(t, private.PendingTransactionSummary{
+		Txid:       txid1.String(),
+		Sender:     poolAddr.String(),
+		Fee:        2000,
+		FirstValid: 1,
+		LastValid:  5,
+		Size:       200,
+	}, poolResponse.Groups[0].Transactions[0])
+
+	c, rec = newContext()
+	txids := []string{txid1.String(), txid2.String()}
+	sender := poolAddr.String()
+	require.NoError(t, handler.EvictPendingTransactions(c, private.EvictPendingTransactionsParams{Txid: &txids, Sender: &sender}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var evictResponse private.EvictPendingTransactionsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &evictResponse))
+	require.Equal(t, uint64(3), evictResponse.Evicted)
+	require.Equal(t, []transactions.Txid{txid1, txid2}, mockNode.evictedTxids)
+	require.Equal(t, []basics.Address{poolAddr}, mockNode.evictedSenders)
+
+	c, rec = newContext()
+	require.NoError(t, handler.EvictPendingTransactions(c, private.EvictPendingTransactionsParams{}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = newContext()
+	badTxids := []string{"not a txid"}
+	require.NoError(t, handler.EvictPendingTransactions(c, private.EvictPendingTransactionsParams{Txid: &badTxids}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestStreamTransactionEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		return e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), rec
+	}
+
+	other := basics.Address{1}
+	matching := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:             protocol.PaymentTx,
+		Header:           transactions.Header{Sender: other},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: poolAddr},
+	}}
+	unrelated := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: other, Note: []byte{1}},
+	}}
+	mockNode.streamTxGroups = [][]transactions.SignedTxn{{unrelated}, {matching}}
+
+	c, rec := newContext()
+	addrs := []string{poolAddr.String()}
+	apps := []uint64{5}
+	require.NoError(t, handler.StreamTransactionEvents(c, generated.StreamTransactionEventsParams{Address: &addrs, ApplicationId: &apps}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	require.Equal(t, map[basics.Address]bool{poolAddr: true}, mockNode.streamFilter.Addresses)
+	require.Equal(t, map[basics.AppIndex]bool{5: true}, mockNode.streamFilter.Apps)
+
+	events := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n\n"), "\n\n")
+	require.Len(t, events, 1)
+	lines := strings.Split(events[0], "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, "event: pending", lines[0])
+	require.True(t, strings.HasPrefix(lines[1], "data: "))
+	var ev txnstream.Event
+	require.NoError(t, protocol.DecodeJSON([]byte(strings.TrimPrefix(lines[1], "data: ")), &ev))
+	require.Equal(t, txnstream.PendingEvent, ev.Type)
+	require.Equal(t, []string{matching.ID().String()}, ev.Txids)
+	require.Equal(t, []transactions.SignedTxn{matching}, ev.Txns)
+
+	c, rec = newContext()
+	badAddrs := []string{"not an address"}
+	require.NoError(t, handler.StreamTransactionEvents(c, generated.StreamTransactionEventsParams{Address: &badAddrs}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// clients that already hold their share of the streams are turned away
+	mockNode.config.TxnStreamMaxSubscriptionsPerClient = 1
+	mockNode.streamHub = txnstream.MakeHub(mockNode.config, logging.Base())
+	c, rec = newContext()
+	client, _, err := net.SplitHostPort(c.Request().RemoteAddr)
+	require.NoError(t, err)
+	sub, err := mockNode.streamHub.Subscribe(txnstream.Filter{}, client)
+	require.NoError(t, err)
+	defer sub.Close()
+	require.NoError(t, handler.StreamTransactionEvents(c, generated.StreamTransactionEventsParams{}))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestStreamTransactionEventsOutlivesWriteTimeout(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	mockNode.streamHub = txnstream.MakeHub(mockNode.config, logging.Base())
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	e := echo.New()
+	e.GET("/", func(c echo.Context) error {
+		return handler.StreamTransactionEvents(c, generated.StreamTransactionEventsParams{})
+	})
+	const writeTimeout = 500 * time.Millisecond
+	server := httptest.NewUnstartedServer(e)
+	server.Config.WriteTimeout = writeTimeout
+	server.Config.ConnContext = lib.ConnContext
+	server.Start()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	reader := bufio.NewReader(resp.Body)
+
+	// events keep coming well past the write timeout of the server
+	for i := 0; i < 4; i++ {
+		time.Sleep(writeTimeout)
+		stxn := transactions.SignedTxn{Txn: transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: transactions.Header{Sender: poolAddr, Note: []byte{byte(i)}},
+		}}
+		mockNode.streamHub.OnTxGroupAdmitted([]transactions.SignedTxn{stxn})
+
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "event: pending\n", line)
+		line, err = reader.ReadString('\n')
+		require.NoError(t, err)
+		require.Contains(t, line, stxn.ID().String())
+		line, err = reader.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "\n", line)
+	}
+}
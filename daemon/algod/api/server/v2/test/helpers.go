@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/node/indexer"
+	"github.com/algorand/go-algorand/node/txnstream"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
 )
@@ -87,6 +88,20 @@ type mockNode struct {
 	err       error
 	id        account.ParticipationID
 	keys      account.StateProofKeys
+	syncRound basics.Round
+	indexer   *indexer.Indexer
+
+	devModePaused   bool
+	devModeRound    basics.Round
+	timestampOffset int64
+
+	txPoolStatus   node.TxPoolStatus
+	evictedTxids   []transactions.Txid
+	evictedSenders []basics.Address
+
+	streamFilter   txnstream.Filter
+	streamTxGroups [][]transactions.SignedTxn
+	streamHub      *txnstream.Hub
 }
 
 func (m mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -111,6 +126,87 @@ func (m *mockNode) AppendParticipationKeys(id account.ParticipationID, keys acco
 	return m.err
 }
 
+func (m *mockNode) SetSyncRound(rnd basics.Round) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.syncRound = rnd
+	return nil
+}
+
+func (m *mockNode) GetSyncRound() basics.Round {
+	return m.syncRound
+}
+
+func (m *mockNode) UnsetSyncRound() error {
+	if m.err != nil {
+		return m.err
+	}
+	m.syncRound = 0
+	return nil
+}
+
+func (m *mockNode) SetDevModeBlockProduction(paused bool) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.devModePaused = paused
+	return nil
+}
+
+func (m *mockNode) MintDevModeBlocks(count uint64) (basics.Round, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	m.devModeRound += basics.Round(count)
+	return m.devModeRound, nil
+}
+
+func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.timestampOffset = offset
+	return nil
+}
+
+func (m *mockNode) GetBlockTimeStampOffset() (int64, error) {
+	return m.timestampOffset, m.err
+}
+
+func (m *mockNode) GetTxPoolStatus() node.TxPoolStatus {
+	return m.txPoolStatus
+}
+
+func (m *mockNode) EvictPendingTxns(txids []transactions.Txid) int {
+	m.evictedTxids = append(m.evictedTxids, txids...)
+	return len(txids)
+}
+
+func (m *mockNode) EvictPendingTxnsFromSender(sender basics.Address) int {
+	m.evictedSenders = append(m.evictedSenders, sender)
+	return 1
+}
+
+// SubscribeTxnStream subscribes to streamHub if set. Otherwise it replays
+// streamTxGroups as pool admissions and then ends the stream.
+func (m *mockNode) SubscribeTxnStream(filter txnstream.Filter, client string) (*txnstream.Subscription, error) {
+	m.streamFilter = filter
+	if m.streamHub != nil {
+		return m.streamHub.Subscribe(filter, client)
+	}
+	hub := txnstream.MakeHub(m.config, logging.Base())
+	sub, err := hub.Subscribe(filter, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, txgroup := range m.streamTxGroups {
+		hub.OnTxGroupAdmitted(txgroup)
+	}
+	sub.Close()
+	return sub, nil
+}
+
 func makeMockNode(ledger *data.Ledger, genesisID string, nodeError error) *mockNode {
 	return &mockNode{
 		ledger:    ledger,
@@ -188,7 +284,10 @@ func (m mockNode) Uint64() uint64 {
 }
 
 func (m mockNode) Indexer() (*indexer.Indexer, error) {
-	return nil, fmt.Errorf("indexer not implemented")
+	if m.indexer == nil {
+		return nil, fmt.Errorf("indexer not implemented")
+	}
+	return m.indexer, nil
 }
 
 func (m mockNode) GetTransactionByID(txid transactions.Txid, rnd basics.Round) (node.TxnWithStatus, error) {
@@ -258,7 +357,7 @@ func testingenv(t testing.TB, numAccounts, numTxs int, offlineAccounts bool) (*d
 		}
 		accessors = append(accessors, access)
 
-		part, err := account.FillDBWithParticipationKeys(access, root.Address(), 0, lastValid, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+		part, err := account.FillDBWithParticipationKeys(access, root.Address(), 0, lastValid, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 		if err != nil {
 			panic(err)
 		}
@@ -288,6 +387,7 @@ func testingenv(t testing.TB, numAccounts, numTxs int, offlineAccounts bool) (*d
 	copy(addr[:], lhash[:])
 	ad := basics.MakeAccountData(basics.NotParticipating, basics.MicroAlgos{Raw: 100000 * uint64(proto.RewardsRateRefreshInterval)})
 	ad.AppLocalStates = map[basics.AppIndex]basics.AppLocalState{1: {}}
+	ad.Assets = map[basics.AssetIndex]basics.AssetHolding{2: {Amount: 10}}
 	genesis[addr] = ad
 
 	bootstrap := bookkeeping.MakeGenesisBalances(genesis, sinkAddr, poolAddr)
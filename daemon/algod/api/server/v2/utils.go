@@ -17,9 +17,12 @@
 package v2
 
 import (
+	"encoding/base32"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -344,3 +347,45 @@ func printableUTF8OrEmpty(in string) string {
 	}
 	return in
 }
+
+// parseBoxName decodes a box name given in the goal app call arg form
+// "encoding:value", e.g. "str:hello", "int:1234", "b64:A==" or "addr:XYZ...".
+func parseBoxName(name string) ([]byte, error) {
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("box name %s should be of the form encoding:value", name)
+	}
+	encoding, value := parts[0], parts[1]
+	switch encoding {
+	case "str", "string":
+		return []byte(value), nil
+	case "int", "integer":
+		num, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse uint64 from string (%s): %v", value, err)
+		}
+		ibytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(ibytes, num)
+		return ibytes, nil
+	case "addr", "address":
+		addr, err := basics.UnmarshalChecksumAddress(value)
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal checksummed address from string (%s): %v", value, err)
+		}
+		return addr[:], nil
+	case "b32", "base32", "byte base32":
+		data, err := base32.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode base32-encoded string (%s): %v", value, err)
+		}
+		return data, nil
+	case "b64", "base64", "byte base64":
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode base64-encoded string (%s): %v", value, err)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unknown encoding: %s", encoding)
+	}
+}
@@ -236,6 +236,7 @@ func (s *Server) Start() {
 		Addr:         addr,
 		ReadTimeout:  time.Duration(cfg.RestReadTimeoutSeconds) * time.Second,
 		WriteTimeout: time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
+		ConnContext:  lib.ConnContext,
 	}
 
 	e := apiServer.NewRouter(
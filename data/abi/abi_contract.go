@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// VoidReturnType is the ABI return type string for a method that returns nothing
+const VoidReturnType = "void"
+
+// MethodReturnPrefix is the prefix of the log line holding the return value of a method call,
+// from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
+var MethodReturnPrefix = []byte{0x15, 0x1f, 0x7c, 0x75}
+
+// Arg is a method argument, as described by the ARC-4 JSON description of a method
+type Arg struct {
+	// Type is an ABI type, a transaction type or a reference type
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// Return is the return value of a method, as described by the ARC-4 JSON description of a method
+type Return struct {
+	// Type is an ABI type, or VoidReturnType
+	Type string `json:"type"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// Method is an ARC-4 method description
+type Method struct {
+	Name    string `json:"name"`
+	Desc    string `json:"desc,omitempty"`
+	Args    []Arg  `json:"args"`
+	Returns Return `json:"returns"`
+}
+
+// Interface is an ARC-4 interface description, a named set of methods
+type Interface struct {
+	Name    string   `json:"name"`
+	Desc    string   `json:"desc,omitempty"`
+	Methods []Method `json:"methods"`
+}
+
+// ContractNetworkInfo holds the deployment of a contract on one network
+type ContractNetworkInfo struct {
+	AppID uint64 `json:"appID"`
+}
+
+// Contract is an ARC-4 contract description. Networks is keyed by the base64
+// encoded genesis hash of each network the contract is deployed on.
+type Contract struct {
+	Name     string                         `json:"name"`
+	Desc     string                         `json:"desc,omitempty"`
+	Networks map[string]ContractNetworkInfo `json:"networks,omitempty"`
+	Methods  []Method                       `json:"methods"`
+}
+
+// MethodFromSignature builds a Method out of a method signature such as `add(uint64,uint64)uint128`
+func MethodFromSignature(methodSig string) (Method, error) {
+	name, argTypes, returnType, err := ParseMethodSignature(methodSig)
+	if err != nil {
+		return Method{}, err
+	}
+
+	method := Method{
+		Name:    name,
+		Args:    make([]Arg, len(argTypes)),
+		Returns: Return{Type: returnType},
+	}
+	for i, argType := range argTypes {
+		method.Args[i].Type = argType
+	}
+
+	err = method.validate()
+	if err != nil {
+		return Method{}, err
+	}
+	return method, nil
+}
+
+// validate checks that the method has a name and that all its argument and return types are known
+func (m Method) validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("method name is missing")
+	}
+	for i, arg := range m.Args {
+		if IsTransactionType(arg.Type) || IsReferenceType(arg.Type) {
+			continue
+		}
+		if _, err := TypeOf(arg.Type); err != nil {
+			return fmt.Errorf("method %s argument %d has an invalid type %s: %v", m.Name, i, arg.Type, err)
+		}
+	}
+	if m.Returns.Type != VoidReturnType {
+		if _, err := TypeOf(m.Returns.Type); err != nil {
+			return fmt.Errorf("method %s has an invalid return type %s: %v", m.Name, m.Returns.Type, err)
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes a method description and checks that its types are well formed
+func (m *Method) UnmarshalJSON(data []byte) error {
+	// methodJSON has no methods, so decoding into it does not recurse here
+	type methodJSON Method
+	var decoded methodJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded.Returns.Type == "" {
+		return fmt.Errorf("method %s has no return type", decoded.Name)
+	}
+	if err := Method(decoded).validate(); err != nil {
+		return err
+	}
+	*m = Method(decoded)
+	return nil
+}
+
+// GetSignature returns the method signature, e.g. `add(uint64,uint64)uint128`
+func (m Method) GetSignature() string {
+	argTypes := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argTypes[i] = arg.Type
+	}
+	return m.Name + "(" + strings.Join(argTypes, ",") + ")" + m.Returns.Type
+}
+
+// GetSelector returns the 4-byte method selector, the prefix of the SHA-512/256 hash of the signature
+func (m Method) GetSelector() []byte {
+	hash := sha512.Sum512_256([]byte(m.GetSignature()))
+	return hash[:4]
+}
+
+// GetTxCount returns the number of transactions a call to the method takes: one for each
+// transaction argument, plus the application call itself.
+func (m Method) GetTxCount() int {
+	count := 1
+	for _, arg := range m.Args {
+		if IsTransactionType(arg.Type) {
+			count++
+		}
+	}
+	return count
+}
+
+// GetReturnType returns the ABI type of the method return value. The second return value is
+// false if the method returns void.
+func (m Method) GetReturnType() (Type, bool, error) {
+	if m.Returns.Type == VoidReturnType {
+		return Type{}, false, nil
+	}
+	t, err := TypeOf(m.Returns.Type)
+	if err != nil {
+		return Type{}, false, err
+	}
+	return t, true, nil
+}
+
+// getMethodByName looks up a method by name, failing if the name is overloaded
+func getMethodByName(methods []Method, name string) (Method, error) {
+	var found []Method
+	for _, method := range methods {
+		if method.Name == name {
+			found = append(found, method)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Method{}, fmt.Errorf("found no method named %s", name)
+	case 1:
+		return found[0], nil
+	default:
+		signatures := make([]string, len(found))
+		for i, method := range found {
+			signatures[i] = method.GetSignature()
+		}
+		return Method{}, fmt.Errorf("found %d methods named %s, use a signature instead: %s", len(found), name, strings.Join(signatures, ", "))
+	}
+}
+
+// GetMethodByName returns the interface method with the given name
+func (i Interface) GetMethodByName(name string) (Method, error) {
+	return getMethodByName(i.Methods, name)
+}
+
+// GetMethodByName returns the contract method with the given name
+func (c Contract) GetMethodByName(name string) (Method, error) {
+	return getMethodByName(c.Methods, name)
+}
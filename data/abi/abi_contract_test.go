@@ -0,0 +1,180 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractJSON = `{
+  "name": "Calculator",
+  "desc": "Basic arithmetic",
+  "networks": {
+    "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=": {"appID": 1234}
+  },
+  "methods": [
+    {
+      "name": "add",
+      "desc": "Add two integers",
+      "args": [{"type": "uint64", "name": "a"}, {"type": "uint64", "name": "b"}],
+      "returns": {"type": "uint128"}
+    },
+    {
+      "name": "pay",
+      "args": [{"type": "pay"}, {"type": "account"}, {"type": "asset"}],
+      "returns": {"type": "void"}
+    }
+  ]
+}`
+
+func TestContractJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var contract Contract
+	require.NoError(t, json.Unmarshal([]byte(testContractJSON), &contract))
+	require.Equal(t, "Calculator", contract.Name)
+	require.Equal(t, uint64(1234), contract.Networks["wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8="].AppID)
+	require.Len(t, contract.Methods, 2)
+	require.Equal(t, "add(uint64,uint64)uint128", contract.Methods[0].GetSignature())
+	require.Equal(t, []byte{0x8a, 0xa3, 0xb6, 0x1f}, contract.Methods[0].GetSelector())
+	require.Equal(t, 1, contract.Methods[0].GetTxCount())
+	require.Equal(t, 2, contract.Methods[1].GetTxCount())
+
+	encoded, err := json.Marshal(contract)
+	require.NoError(t, err)
+	var decoded Contract
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, contract, decoded)
+
+	var iface Interface
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Calc","methods":[{"name":"add","args":[],"returns":{"type":"void"}}]}`), &iface))
+	method, err := iface.GetMethodByName("add")
+	require.NoError(t, err)
+	require.Equal(t, "add()void", method.GetSignature())
+
+	// invalid types are rejected
+	var method2 Method
+	require.Error(t, json.Unmarshal([]byte(`{"name":"f","args":[{"type":"uint7"}],"returns":{"type":"void"}}`), &method2))
+	require.Error(t, json.Unmarshal([]byte(`{"name":"f","args":[],"returns":{"type":"pay"}}`), &method2))
+	require.Error(t, json.Unmarshal([]byte(`{"name":"f","args":[]}`), &method2))
+	require.Error(t, json.Unmarshal([]byte(`{"args":[],"returns":{"type":"void"}}`), &method2))
+}
+
+func TestGetMethodByName(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	add1, err := MethodFromSignature("add(uint64,uint64)uint128")
+	require.NoError(t, err)
+	add2, err := MethodFromSignature("add(uint32,uint32)uint64")
+	require.NoError(t, err)
+	sub, err := MethodFromSignature("sub(uint64,uint64)uint64")
+	require.NoError(t, err)
+
+	contract := Contract{Name: "c", Methods: []Method{add1, add2, sub}}
+	found, err := contract.GetMethodByName("sub")
+	require.NoError(t, err)
+	require.Equal(t, sub, found)
+
+	_, err = contract.GetMethodByName("add")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "add(uint32,uint32)uint64")
+
+	_, err = contract.GetMethodByName("mul")
+	require.Error(t, err)
+
+	_, err = MethodFromSignature("add(uint64,uint64")
+	require.Error(t, err)
+	_, err = MethodFromSignature("add(foo)void")
+	require.Error(t, err)
+}
+
+func TestMethodCallGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	method, err := MethodFromSignature("f(account,pay,application,account,asset,account,application,asset,uint64)void")
+	require.NoError(t, err)
+
+	var sender, other basics.Address
+	sender[0] = 1
+	other[0] = 2
+
+	appCall := transactions.Transaction{Type: protocol.ApplicationCallTx}
+	appCall.Sender = sender
+	appCall.ApplicationID = 10
+	appCall.ForeignAssets = []basics.AssetIndex{7}
+
+	args := []string{
+		`"` + other.String() + `"`, // new account, index 1
+		"10",                       // the called app, index 0
+		sender.String(),            // the sender, index 0
+		"8",                        // new asset, index 1
+		other.String(),             // duplicate account, index 1
+		"11",                       // new app, index 1
+		"7",                        // existing asset, index 0
+		"42",
+	}
+	err = method.EncodeAppCall(&appCall, args)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Address{other}, appCall.Accounts)
+	require.Equal(t, []basics.AppIndex{11}, appCall.ForeignApps)
+	require.Equal(t, []basics.AssetIndex{7, 8}, appCall.ForeignAssets)
+
+	expected := [][]byte{method.GetSelector(), {1}, {0}, {0}, {1}, {1}, {1}, {0}, {0, 0, 0, 0, 0, 0, 0, 42}}
+	require.Equal(t, expected, appCall.ApplicationArgs)
+
+	require.Error(t, method.EncodeAppCall(&appCall, args[1:]))
+
+	payment := transactions.SignedTxn{Txn: transactions.Transaction{Type: protocol.PaymentTx}}
+	payment.Txn.Sender = other
+
+	group, err := MakeMethodCallGroup(method, appCall, []transactions.SignedTxn{payment})
+	require.NoError(t, err)
+	require.Len(t, group, 2)
+
+	var txGroup transactions.TxGroup
+	txGroup.TxGroupHashes = []crypto.Digest{crypto.HashObj(payment.Txn), crypto.HashObj(appCall)}
+	groupID := crypto.HashObj(txGroup)
+	require.Equal(t, groupID, group[0].Txn.Group)
+	require.Equal(t, groupID, group[1].Txn.Group)
+	require.Equal(t, other, group[0].Txn.Sender)
+	require.Equal(t, appCall.ApplicationArgs, group[1].Txn.ApplicationArgs)
+
+	// wrong count, wrong type, and already grouped transaction arguments
+	_, err = MakeMethodCallGroup(method, appCall, nil)
+	require.Error(t, err)
+	keyreg := transactions.SignedTxn{Txn: transactions.Transaction{Type: protocol.KeyRegistrationTx}}
+	_, err = MakeMethodCallGroup(method, appCall, []transactions.SignedTxn{keyreg})
+	require.Error(t, err)
+	_, err = MakeMethodCallGroup(method, appCall, []transactions.SignedTxn{group[0]})
+	require.Error(t, err)
+
+	// a call without transaction arguments is not grouped
+	noTxns, err := MethodFromSignature("g()void")
+	require.NoError(t, err)
+	group, err = MakeMethodCallGroup(noTxns, appCall, nil)
+	require.NoError(t, err)
+	require.Len(t, group, 1)
+	require.True(t, group[0].Txn.Group.IsZero())
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// EncodeAppCall sets the application arguments of appCall to the method selector followed by
+// the ABI encoding of args. args holds one JSON value per method argument that is not a
+// transaction; transaction arguments are supplied to MakeMethodCallGroup instead.
+//
+// Reference arguments are resolved to an index into the matching foreign array of appCall,
+// which is extended as needed. Values are deduplicated, and the sender and the called app are
+// referred to by index 0 without being added to the foreign arrays. An account may be given
+// as a JSON string or as a bare address; apps and assets are given by their decimal ID.
+//
+// The sender and application ID of appCall must be set before calling EncodeAppCall.
+func (m Method) EncodeAppCall(appCall *transactions.Transaction, args []string) error {
+	expected := len(m.Args) - (m.GetTxCount() - 1)
+	if len(args) != expected {
+		return fmt.Errorf("method %s expects %d non-transaction arguments but got %d", m.Name, expected, len(args))
+	}
+
+	argTypes := make([]string, 0, len(args))
+	argValues := make([]string, 0, len(args))
+	for _, arg := range m.Args {
+		if IsTransactionType(arg.Type) {
+			continue
+		}
+
+		argType, argValue := arg.Type, args[len(argValues)]
+		if IsReferenceType(argType) {
+			index, err := resolveReference(appCall, argType, argValue)
+			if err != nil {
+				return err
+			}
+			// references are encoded as uint8 indexes into the foreign arrays
+			argType, argValue = "uint8", strconv.Itoa(index)
+		}
+		argTypes = append(argTypes, argType)
+		argValues = append(argValues, argValue)
+	}
+
+	appArgs := [][]byte{m.GetSelector()}
+	err := ParseArgJSONtoByteSlice(argTypes, argValues, &appArgs)
+	if err != nil {
+		return fmt.Errorf("cannot encode arguments of method %s: %v", m.Name, err)
+	}
+	appCall.ApplicationArgs = appArgs
+	return nil
+}
+
+// resolveReference returns the foreign array index of a reference argument, adding the
+// referenced value to appCall if it is not already available.
+func resolveReference(appCall *transactions.Transaction, refType string, value string) (int, error) {
+	switch refType {
+	case AccountReferenceType:
+		var encoded string
+		if json.Unmarshal([]byte(value), &encoded) != nil {
+			encoded = value
+		}
+		addr, err := basics.UnmarshalChecksumAddress(encoded)
+		if err != nil {
+			return 0, fmt.Errorf("unable to parse account '%s': %v", value, err)
+		}
+		if addr == appCall.Sender {
+			return 0, nil
+		}
+		for i, account := range appCall.Accounts {
+			if account == addr {
+				return i + 1, nil // + 1 because 0 is the sender
+			}
+		}
+		appCall.Accounts = append(appCall.Accounts, addr)
+		return len(appCall.Accounts), nil
+	case ApplicationReferenceType:
+		appID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unable to parse application ID '%s': %v", value, err)
+		}
+		if basics.AppIndex(appID) == appCall.ApplicationID {
+			return 0, nil
+		}
+		for i, app := range appCall.ForeignApps {
+			if app == basics.AppIndex(appID) {
+				return i + 1, nil // + 1 because 0 is the called app
+			}
+		}
+		appCall.ForeignApps = append(appCall.ForeignApps, basics.AppIndex(appID))
+		return len(appCall.ForeignApps), nil
+	case AssetReferenceType:
+		assetID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unable to parse asset ID '%s': %v", value, err)
+		}
+		for i, asset := range appCall.ForeignAssets {
+			if asset == basics.AssetIndex(assetID) {
+				return i, nil
+			}
+		}
+		appCall.ForeignAssets = append(appCall.ForeignAssets, basics.AssetIndex(assetID))
+		return len(appCall.ForeignAssets) - 1, nil
+	default:
+		return 0, fmt.Errorf("unknown reference type: %s", refType)
+	}
+}
+
+// MakeMethodCallGroup assembles the transaction group of a method call: the transaction
+// arguments in method order, followed by appCall. When the group has more than one
+// transaction, a group ID is assigned to all of them.
+//
+// Transaction arguments must not be signed or grouped yet. A logic signature or an auth
+// address they carry is kept, so they can be signed by their own means afterwards.
+func MakeMethodCallGroup(m Method, appCall transactions.Transaction, txnArgs []transactions.SignedTxn) ([]transactions.SignedTxn, error) {
+	var txnTypes []string
+	for _, arg := range m.Args {
+		if IsTransactionType(arg.Type) {
+			txnTypes = append(txnTypes, arg.Type)
+		}
+	}
+	if len(txnArgs) != len(txnTypes) {
+		return nil, fmt.Errorf("method %s expects %d transaction arguments but got %d", m.Name, len(txnTypes), len(txnArgs))
+	}
+
+	group := make([]transactions.SignedTxn, 0, len(txnArgs)+1)
+	for i, txn := range txnArgs {
+		if !txn.Sig.Blank() || !txn.Msig.Blank() {
+			return nil, fmt.Errorf("transaction argument %d has already been signed", i)
+		}
+		if !txn.Txn.Group.IsZero() {
+			return nil, fmt.Errorf("transaction argument %d already has a group ID: %s", i, txn.Txn.Group)
+		}
+		if txnTypes[i] != AnyTransactionType && txn.Txn.Type != protocol.TxType(txnTypes[i]) {
+			return nil, fmt.Errorf("transaction argument %d does not match method argument type. Expected %s, got %s", i, txnTypes[i], txn.Txn.Type)
+		}
+		group = append(group, txn)
+	}
+	if !appCall.Group.IsZero() {
+		return nil, fmt.Errorf("application call already has a group ID: %s", appCall.Group)
+	}
+	group = append(group, transactions.SignedTxn{Txn: appCall})
+
+	if len(group) > 1 {
+		var txGroup transactions.TxGroup
+		for _, stxn := range group {
+			txGroup.TxGroupHashes = append(txGroup.TxGroupHashes, crypto.HashObj(stxn.Txn))
+		}
+		groupID := crypto.HashObj(txGroup)
+		for i := range group {
+			group[i].Txn.Group = groupID
+		}
+	}
+	return group, nil
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -135,7 +136,7 @@ func (root Root) Address() basics.Address {
 // RestoreParticipation restores a Participation from a database
 // handle.
 func RestoreParticipation(store db.Accessor) (acc PersistedParticipation, err error) {
-	var rawParent, rawVRF, rawVoting []byte
+	var rawParent, rawVRF, rawVoting, rawStateProof []byte
 
 	err = Migrate(store)
 	if err != nil {
@@ -153,8 +154,8 @@ func RestoreParticipation(store db.Accessor) (acc PersistedParticipation, err er
 			logging.Base().Infof("RestoreParticipation: state not found (n = %v)", nrows)
 		}
 
-		row = tx.QueryRow("select parent, vrf, voting, firstValid, lastValid, keyDilution from ParticipationAccount")
-		err = row.Scan(&rawParent, &rawVRF, &rawVoting, &acc.FirstValid, &acc.LastValid, &acc.KeyDilution)
+		row = tx.QueryRow("select parent, vrf, voting, stateProof, firstValid, lastValid, keyDilution from ParticipationAccount")
+		err = row.Scan(&rawParent, &rawVRF, &rawVoting, &rawStateProof, &acc.FirstValid, &acc.LastValid, &acc.KeyDilution)
 		if err != nil {
 			return fmt.Errorf("RestoreParticipation: could not read account raw data: %v", err)
 		}
@@ -178,6 +179,15 @@ func RestoreParticipation(store db.Accessor) (acc PersistedParticipation, err er
 		return PersistedParticipation{}, err
 	}
 
+	// keys generated before state proofs have none
+	if len(rawStateProof) > 0 {
+		acc.StateProofSecrets = &merklesignature.Secrets{}
+		err = protocol.Decode(rawStateProof, acc.StateProofSecrets)
+		if err != nil {
+			return PersistedParticipation{}, err
+		}
+	}
+
 	acc.Store = store
 	return acc, nil
 }
@@ -25,7 +25,7 @@ import (
 const PartTableSchemaName = "parttable"
 
 // PartTableSchemaVersion is the latest version of the PartTable schema
-const PartTableSchemaVersion = 2
+const PartTableSchemaVersion = 3
 
 // ErrUnsupportedSchema is the error returned when the PartTable schema version is wrong.
 var ErrUnsupportedSchema = fmt.Errorf("unsupported participation file schema version (expected %d)", PartTableSchemaVersion)
@@ -38,6 +38,7 @@ func partInstallDatabase(tx *sql.Tx) error {
 
 		vrf BLOB,    --*  msgpack encoding of ParticipationAccount.vrf
 		voting BLOB, --*  msgpack encoding of ParticipationAccount.voting
+		stateProof BLOB, --*  msgpack encoding of ParticipationAccount.stateProof
 
 		firstValid INTEGER,
 		lastValid INTEGER,
@@ -106,6 +107,19 @@ func partMigrate(tx *sql.Tx) (err error) {
 		}
 	}
 
+	if partVersion == 2 {
+		_, err = tx.Exec("ALTER TABLE ParticipationAccount ADD stateProof BLOB")
+		if err != nil {
+			return
+		}
+
+		partVersion = 3
+		_, err = tx.Exec("UPDATE schema SET version=? WHERE tablename=?", partVersion, PartTableSchemaName)
+		if err != nil {
+			return
+		}
+	}
+
 	if partVersion != PartTableSchemaVersion {
 		return ErrUnsupportedSchema
 	}
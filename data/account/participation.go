@@ -23,6 +23,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
@@ -47,6 +48,8 @@ type Participation struct {
 
 	VRF    *crypto.VRFSecrets
 	Voting *crypto.OneTimeSignatureSecrets
+	// StateProofSecrets is nil for keys generated before state proofs
+	StateProofSecrets *merklesignature.Secrets
 
 	// The first and last rounds for which this account is valid, respectively.
 	//
@@ -138,6 +141,32 @@ func (part Participation) VotingSecrets() *crypto.OneTimeSignatureSecrets {
 	return part.Voting
 }
 
+// StateProofSigner returns the signer of the state proof of the block of round,
+// which is a multiple of the CompactCertRounds the keys were generated for
+func (part Participation) StateProofSigner(round basics.Round) (*merklesignature.Signer, error) {
+	if part.StateProofSecrets == nil {
+		return nil, merklesignature.ErrNoStateProofKey
+	}
+	return part.StateProofSecrets.GetSigner(uint64(round))
+}
+
+// StateProofKeys returns the encoded state proof signers of the rounds between
+// first and last, in the form appended to the participation registry.
+func (part Participation) StateProofKeys(first, last basics.Round) (StateProofKeys, error) {
+	keys := make(StateProofKeys)
+	if part.StateProofSecrets == nil {
+		return keys, nil
+	}
+	signers, err := part.StateProofSecrets.GetSigners(uint64(first), uint64(last))
+	if err != nil {
+		return nil, err
+	}
+	for _, signer := range signers {
+		keys[signer.Round] = protocol.Encode(signer)
+	}
+	return keys, nil
+}
+
 // VotingSigner returns the voting secrets associated with this Participation account,
 // together with the KeyDilution value.
 func (part Participation) VotingSigner() crypto.OneTimeSigner {
@@ -178,6 +207,16 @@ func (part PersistedParticipation) DeleteOldKeys(current basics.Round, proto con
 
 	part.Voting.DeleteBeforeFineGrained(basics.OneTimeIDForRound(current, keyDilution), keyDilution)
 
+	// a state proof is signed in the round after the one it certifies,
+	// so the key of the previous round is still needed. Keys only expire
+	// once every key lifetime, so the secrets are rarely rewritten.
+	var encodedStateProofSecrets []byte
+	if part.StateProofSecrets != nil && current > 0 {
+		if part.StateProofSecrets.DeleteBefore(uint64(current - 1)) {
+			encodedStateProofSecrets = protocol.Encode(part.StateProofSecrets)
+		}
+	}
+
 	errorCh := make(chan error, 1)
 	deleteOldKeys := func(encodedVotingSecrets []byte) {
 		errorCh <- part.Store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -185,6 +224,12 @@ func (part PersistedParticipation) DeleteOldKeys(current basics.Round, proto con
 			if err != nil {
 				return fmt.Errorf("Participation.DeleteOldKeys: failed to update account: %v", err)
 			}
+			if encodedStateProofSecrets != nil {
+				_, err = tx.Exec("UPDATE ParticipationAccount SET stateProof=?", encodedStateProofSecrets)
+				if err != nil {
+					return fmt.Errorf("Participation.DeleteOldKeys: failed to update state proof keys: %v", err)
+				}
+			}
 			return nil
 		})
 		close(errorCh)
@@ -203,8 +248,11 @@ func (part PersistedParticipation) PersistNewParent() error {
 	})
 }
 
-// FillDBWithParticipationKeys initializes the passed database with participation keys
-func FillDBWithParticipationKeys(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64) (part PersistedParticipation, err error) {
+// FillDBWithParticipationKeys initializes the passed database with participation keys.
+// State proof keys are generated for the rounds that are multiples of
+// compactCertRounds, which are the rounds that compact certificates sign,
+// or not at all if compactCertRounds is zero.
+func FillDBWithParticipationKeys(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64, compactCertRounds uint64) (part PersistedParticipation, err error) {
 	if lastValid < firstValid {
 		err = fmt.Errorf("FillDBWithParticipationKeys: firstValid %d is after lastValid %d", firstValid, lastValid)
 		return
@@ -221,15 +269,26 @@ func FillDBWithParticipationKeys(store db.Accessor, address basics.Address, firs
 	// Also generate a new VRF key, which lives in the participation keys db
 	vrf := crypto.GenerateVRFSecrets()
 
+	// and the keys for signing state proofs
+	var stateProofSecrets *merklesignature.Secrets
+	if compactCertRounds > 0 {
+		stateProofSecrets, err = merklesignature.New(uint64(firstValid), uint64(lastValid), compactCertRounds)
+		if err != nil {
+			err = fmt.Errorf("FillDBWithParticipationKeys: %w", err)
+			return
+		}
+	}
+
 	// Construct the Participation containing these keys to be persisted
 	part = PersistedParticipation{
 		Participation: Participation{
-			Parent:      address,
-			VRF:         vrf,
-			Voting:      v,
-			FirstValid:  firstValid,
-			LastValid:   lastValid,
-			KeyDilution: keyDilution,
+			Parent:            address,
+			VRF:               vrf,
+			Voting:            v,
+			StateProofSecrets: stateProofSecrets,
+			FirstValid:        firstValid,
+			LastValid:         lastValid,
+			KeyDilution:       keyDilution,
 		},
 		Store: store,
 	}
@@ -243,6 +302,10 @@ func (part PersistedParticipation) Persist() error {
 	rawVRF := protocol.Encode(part.VRF)
 	voting := part.Voting.Snapshot()
 	rawVoting := protocol.Encode(&voting)
+	var rawStateProof []byte
+	if part.StateProofSecrets != nil {
+		rawStateProof = protocol.Encode(part.StateProofSecrets)
+	}
 
 	err := part.Store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		err := partInstallDatabase(tx)
@@ -250,8 +313,8 @@ func (part PersistedParticipation) Persist() error {
 			return fmt.Errorf("failed to install database: %w", err)
 		}
 
-		_, err = tx.Exec("INSERT INTO ParticipationAccount (parent, vrf, voting, firstValid, lastValid, keyDilution) VALUES (?, ?, ?, ?, ?, ?)",
-			part.Parent[:], rawVRF, rawVoting, part.FirstValid, part.LastValid, part.KeyDilution)
+		_, err = tx.Exec("INSERT INTO ParticipationAccount (parent, vrf, voting, stateProof, firstValid, lastValid, keyDilution) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			part.Parent[:], rawVRF, rawVoting, rawStateProof, part.FirstValid, part.LastValid, part.KeyDilution)
 		if err != nil {
 			return fmt.Errorf("failed to insert account: %w", err)
 		}
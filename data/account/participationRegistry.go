@@ -28,6 +28,7 @@ import (
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -83,8 +84,7 @@ type ParticipationRecord struct {
 }
 
 type (
-	// StateProofKey is a placeholder for the real state proof key type.
-	// PKI TODO: Replace this with a real object.
+	// StateProofKey is the msgpack encoding of the merklesignature.Signer of a round.
 	StateProofKey []byte
 
 	// StateProofKeys are a map of StateProofKeys.
@@ -108,6 +108,19 @@ func (r ParticipationRecordForRound) IsZero() bool {
 	return r.StateProof == nil && r.ParticipationRecord.IsZero()
 }
 
+// StateProofSigner decodes the state proof key of the round.
+func (r ParticipationRecordForRound) StateProofSigner() (*merklesignature.Signer, error) {
+	if len(r.StateProof) == 0 {
+		return nil, merklesignature.ErrNoStateProofKey
+	}
+	var signer merklesignature.Signer
+	err := protocol.Decode(r.StateProof, &signer)
+	if err != nil {
+		return nil, err
+	}
+	return &signer, nil
+}
+
 var zeroParticipationRecord = ParticipationRecord{}
 
 // IsZero returns true if the object contains zero values.
@@ -556,7 +556,7 @@ func TestParticipion_Blobs(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	part, err := FillDBWithParticipationKeys(access, root.Address(), 0, 101, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+	part, err := FillDBWithParticipationKeys(access, root.Address(), 0, 101, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 	access.Close()
 	a.NoError(err)
 
@@ -599,7 +599,7 @@ func TestParticipion_EmptyBlobs(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	part, err := FillDBWithParticipationKeys(access, root.Address(), 0, 101, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+	part, err := FillDBWithParticipationKeys(access, root.Address(), 0, 101, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 	access.Close()
 	a.NoError(err)
 	part.VRF = nil
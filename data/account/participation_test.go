@@ -26,6 +26,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -48,7 +49,7 @@ func TestParticipation_NewDB(t *testing.T) {
 	a.NoError(err)
 	a.NotNil(partDB)
 
-	part, err := FillDBWithParticipationKeys(partDB, root.Address(), 0, 0, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+	part, err := FillDBWithParticipationKeys(partDB, root.Address(), 0, 0, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 	a.NoError(err)
 	a.NotNil(part)
 
@@ -137,7 +138,7 @@ func BenchmarkOldKeysDeletion(b *testing.B) {
 		os.Remove(b.Name() + "_part")
 	}()
 
-	part, err := FillDBWithParticipationKeys(partDB, rootAddr, 0, 3000000, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+	part, err := FillDBWithParticipationKeys(partDB, rootAddr, 0, 3000000, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 	a.NoError(err)
 	a.NotNil(part)
 
@@ -149,3 +150,98 @@ func BenchmarkOldKeysDeletion(b *testing.B) {
 	}
 	part.Close()
 }
+
+func TestParticipation_StateProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := require.New(t)
+
+	partDB, err := db.MakeAccessor(t.Name()+"_part", true, true)
+	a.NoError(err)
+	defer partDB.Close()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	a.Equal(uint64(128), proto.CompactCertRounds)
+
+	var parent basics.Address
+	crypto.RandBytes(parent[:])
+	part, err := FillDBWithParticipationKeys(partDB, parent, 0, 1024, proto.DefaultKeyDilution, proto.CompactCertRounds)
+	a.NoError(err)
+	a.NotNil(part.StateProofSecrets)
+	verifier := part.StateProofSecrets.GetVerifier()
+
+	restored, err := RestoreParticipation(partDB)
+	a.NoError(err)
+	a.Equal(part.ID(), restored.ID())
+	a.Equal(*verifier, *restored.StateProofSecrets.GetVerifier())
+
+	// every block that a compact certificate signs has a key
+	msg := &ParticipationKeyIdentity{Parent: parent, FirstValid: 512}
+	for round := basics.Round(0); round <= 1024; round++ {
+		signer, err := restored.StateProofSigner(round)
+		if round%basics.Round(proto.CompactCertRounds) != 0 {
+			a.ErrorIs(err, merklesignature.ErrNoStateProofKey)
+			continue
+		}
+		a.NoError(err)
+		a.NoError(verifier.Verify(uint64(round), msg, signer.Sign(msg)))
+	}
+
+	// keys handed to the registry decode to the same signers
+	keys, err := restored.StateProofKeys(256, 1000)
+	a.NoError(err)
+	a.Len(keys, 6)
+	record := ParticipationRecordForRound{StateProof: keys[768]}
+	signer, err := record.StateProofSigner()
+	a.NoError(err)
+	a.NoError(verifier.Verify(768, msg, signer.Sign(msg)))
+
+	_, err = ParticipationRecordForRound{}.StateProofSigner()
+	a.ErrorIs(err, merklesignature.ErrNoStateProofKey)
+
+	// keys are erased from the database once their round passed, but the
+	// key of the previous round is kept to sign its state proof
+	a.NoError(<-restored.DeleteOldKeys(769, proto))
+	restored, err = RestoreParticipation(partDB)
+	a.NoError(err)
+	a.Equal(*verifier, *restored.StateProofSecrets.GetVerifier())
+	_, err = restored.StateProofSigner(640)
+	a.ErrorIs(err, merklesignature.ErrNoStateProofKey)
+	signer, err = restored.StateProofSigner(768)
+	a.NoError(err)
+	a.NoError(verifier.Verify(768, msg, signer.Sign(msg)))
+	keys, err = restored.StateProofKeys(0, 1024)
+	a.NoError(err)
+	a.Len(keys, 3)
+
+	// the keys are only rewritten when some of them expire
+	sentinel := []byte("sentinel")
+	a.NoError(partDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("UPDATE ParticipationAccount SET stateProof=?", sentinel)
+		return err
+	}))
+	a.NoError(<-restored.DeleteOldKeys(769, proto))
+	var rawStateProof []byte
+	a.NoError(partDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRow("SELECT stateProof FROM ParticipationAccount").Scan(&rawStateProof)
+	}))
+	a.Equal(sentinel, rawStateProof)
+
+	a.NoError(<-restored.DeleteOldKeys(770, proto))
+	restored, err = RestoreParticipation(partDB)
+	a.NoError(err)
+	a.Equal(*verifier, *restored.StateProofSecrets.GetVerifier())
+	keys, err = restored.StateProofKeys(0, 1024)
+	a.NoError(err)
+	a.Len(keys, 2)
+
+	// protocols without compact certificates get no state proof keys
+	noCertDB, err := db.MakeAccessor(t.Name()+"_nocert", true, true)
+	a.NoError(err)
+	defer noCertDB.Close()
+	part, err = FillDBWithParticipationKeys(noCertDB, parent, 0, 1024, proto.DefaultKeyDilution, 0)
+	a.NoError(err)
+	a.Nil(part.StateProofSecrets)
+	_, err = part.StateProofSigner(512)
+	a.ErrorIs(err, merklesignature.ErrNoStateProofKey)
+}
@@ -221,6 +221,12 @@ type AccountData struct {
 	// TotalExtraAppPages stores the extra length in pages (MaxAppProgramLen bytes per page)
 	// requested for app program by this account
 	TotalExtraAppPages uint32 `codec:"teap"`
+
+	// TotalBoxes and TotalBoxBytes track the number of boxes created by
+	// the application whose account this is, and the sum of the lengths
+	// of their names and contents, so that MinBalance can account for them.
+	TotalBoxes    uint64 `codec:"tbx"`
+	TotalBoxBytes uint64 `codec:"tbxb"`
 }
 
 // AppLocalState stores the LocalState associated with an application. It also
@@ -485,6 +491,12 @@ func (u AccountData) MinBalance(proto *config.ConsensusParams) (res MicroAlgos)
 	extraAppProgramLenCost := MulSaturate(proto.AppFlatParamsMinBalance, uint64(u.TotalExtraAppPages))
 	min = AddSaturate(min, extraAppProgramLenCost)
 
+	// MinBalance for boxes owned by this (application) account
+	boxFlatCost := MulSaturate(proto.BoxFlatMinBalance, u.TotalBoxes)
+	min = AddSaturate(min, boxFlatCost)
+	boxByteCost := MulSaturate(proto.BoxByteMinBalance, u.TotalBoxBytes)
+	min = AddSaturate(min, boxByteCost)
+
 	res.Raw = min
 	return res
 }
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package bookkeeping
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+)
+
+// BlockHeaderMerkleTree returns a cryptographic commitment to a sequence of
+// consecutive block headers, as a Merkle tree whose leaves are the block
+// hashes.  Position i in the tree holds the hash of hdrs[i], so a verifier
+// holding the headers can recompute the root, or check a single header
+// against the root with a proof of membership.
+func BlockHeaderMerkleTree(hdrs []BlockHeader) (*merklearray.Tree, error) {
+	for i := 1; i < len(hdrs); i++ {
+		if hdrs[i].Round != hdrs[i-1].Round+1 {
+			return nil, fmt.Errorf("BlockHeaderMerkleTree: header %d is for round %d, expected round %d", i, hdrs[i].Round, hdrs[i-1].Round+1)
+		}
+	}
+	return merklearray.Build(blockHeaderMerkleArray(hdrs))
+}
+
+// blockHeaderMerkleArray is a representation of a sequence of block headers
+// as an array for the merklearray package.
+type blockHeaderMerkleArray []BlockHeader
+
+// Length implements the merklearray.Array interface.
+func (bma blockHeaderMerkleArray) Length() uint64 {
+	return uint64(len(bma))
+}
+
+// GetHash implements the merklearray.Array interface.
+func (bma blockHeaderMerkleArray) GetHash(pos uint64) (crypto.Digest, error) {
+	if pos >= uint64(len(bma)) {
+		return crypto.Digest{}, fmt.Errorf("blockHeaderMerkleArray.GetHash(%d): out of bounds, %d headers", pos, len(bma))
+	}
+	return crypto.Digest(bma[pos].Hash()), nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package bookkeeping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockHeaderMerkle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var genesisHash crypto.Digest
+	crypto.RandBytes(genesisHash[:])
+
+	for nhdr := 0; nhdr < 40; nhdr++ {
+		hdrs := make([]BlockHeader, nhdr)
+		for i := range hdrs {
+			hdrs[i].Round = basics.Round(100 + i)
+			hdrs[i].GenesisHash = genesisHash
+			hdrs[i].CurrentProtocol = protocol.ConsensusCurrentVersion
+			if i > 0 {
+				hdrs[i].Branch = hdrs[i-1].Hash()
+			}
+		}
+
+		tree, err := BlockHeaderMerkleTree(hdrs)
+		require.NoError(t, err)
+
+		root := tree.Root()
+		for i := range hdrs {
+			proof, err := tree.Prove([]uint64{uint64(i)})
+			require.NoError(t, err)
+
+			elems := map[uint64]crypto.Digest{uint64(i): crypto.Digest(hdrs[i].Hash())}
+			require.NoError(t, merklearray.Verify(root, elems, proof))
+
+			// a different header does not verify in its place
+			forged := hdrs[i]
+			forged.TimeStamp++
+			elems[uint64(i)] = crypto.Digest(forged.Hash())
+			require.Error(t, merklearray.Verify(root, elems, proof))
+		}
+	}
+}
+
+func TestBlockHeaderMerkleNotConsecutive(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	hdrs := []BlockHeader{{Round: 1}, {Round: 3}}
+	_, err := BlockHeaderMerkleTree(hdrs)
+	require.Error(t, err)
+}
@@ -19,6 +19,7 @@ package pools
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -92,6 +93,18 @@ type TransactionPool struct {
 
 	// proposalAssemblyTime is the ProposalAssemblyTime configured for this node.
 	proposalAssemblyTime time.Duration
+
+	// txnListeners are notified of the groups admitted to the pool and of the
+	// transactions dropped from it; protected by mu.
+	txnListeners []TxnListener
+}
+
+// TxnListener is notified of the transaction groups admitted to the pool and
+// of the transactions dropped from it. The callbacks are invoked while the pool
+// is locked, so they must return promptly and must not call into the pool.
+type TxnListener interface {
+	OnTxGroupAdmitted(txgroup []transactions.SignedTxn)
+	OnTxnDropped(txn transactions.SignedTxn, reason string)
 }
 
 // BlockEvaluator defines the block evaluator interface exposed by the ledger package.
@@ -129,6 +142,22 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 	return &pool
 }
 
+// RegisterTxnListener adds a listener to be notified of the pool admissions and drops.
+func (pool *TransactionPool) RegisterTxnListener(l TxnListener) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.txnListeners = append(pool.txnListeners, l)
+}
+
+// dropTxn records the reason txn was dropped from the pool and notifies the
+// listeners. The caller holds pool.mu.
+func (pool *TransactionPool) dropTxn(txn transactions.SignedTxn, reason string) {
+	pool.statusCache.put(txn, reason)
+	for _, l := range pool.txnListeners {
+		l.OnTxnDropped(txn, reason)
+	}
+}
+
 // poolAsmResults is used to syncronize the state of the block assembly process. The structure reading/writing is syncronized
 // via the pool.assemblyMu lock.
 type poolAsmResults struct {
@@ -168,6 +197,9 @@ const (
 // i.e. typically it means that we're trying to make a proposal for an older round than what the ledger is currently pointing at.
 var ErrStaleBlockAssemblyRequest = fmt.Errorf("AssembleBlock: requested block assembly specified a round that is older than current transaction pool round")
 
+// errTxnEvicted is the status recorded for the transactions removed from the pool by EvictTxns or EvictSender.
+const errTxnEvicted = "transaction evicted from the pool by the node operator"
+
 // Reset resets the content of the transaction pool
 func (pool *TransactionPool) Reset() {
 	pool.mu.Lock()
@@ -218,6 +250,119 @@ func (pool *TransactionPool) PendingTxGroups() [][]transactions.SignedTxn {
 	return pool.pendingTxGroups
 }
 
+// PendingTxnSummary describes a transaction waiting in the pool.
+type PendingTxnSummary struct {
+	Txid          transactions.Txid
+	Sender        basics.Address
+	Fee           basics.MicroAlgos
+	FirstValid    basics.Round
+	LastValid     basics.Round
+	EncodedLength int
+}
+
+// PendingTxGroupSummary describes a transaction group waiting in the pool.
+type PendingTxGroupSummary struct {
+	Txns []PendingTxnSummary
+
+	// FeePerByte is the total fee of the group divided by its total encoded length.
+	FeePerByte uint64
+}
+
+// PendingTxGroupsByFeePerByte returns a summary of the transaction groups
+// pending in the pool, sorted by decreasing fee per byte. Groups paying the
+// same fee per byte are listed in the order they would be proposed.
+func (pool *TransactionPool) PendingTxGroupsByFeePerByte() []PendingTxGroupSummary {
+	txgroups := pool.PendingTxGroups()
+
+	summaries := make([]PendingTxGroupSummary, 0, len(txgroups))
+	for _, txgroup := range txgroups {
+		var fee uint64
+		var length int
+		txns := make([]PendingTxnSummary, len(txgroup))
+		for i, t := range txgroup {
+			txns[i] = PendingTxnSummary{
+				Txid:          t.ID(),
+				Sender:        t.Txn.Sender,
+				Fee:           t.Txn.Fee,
+				FirstValid:    t.Txn.FirstValid,
+				LastValid:     t.Txn.LastValid,
+				EncodedLength: t.GetEncodedLength(),
+			}
+			fee += t.Txn.Fee.Raw
+			length += txns[i].EncodedLength
+		}
+		summary := PendingTxGroupSummary{Txns: txns}
+		if length > 0 {
+			summary.FeePerByte = fee / uint64(length)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].FeePerByte > summaries[j].FeePerByte
+	})
+	return summaries
+}
+
+// EvictTxns removes from the pool the transaction groups holding any of
+// the given transactions, and returns the number of transactions removed.
+// Since a group is only valid as a whole, evicting one of its transactions
+// evicts the entire group.
+func (pool *TransactionPool) EvictTxns(txids []transactions.Txid) int {
+	evict := make(map[transactions.Txid]bool, len(txids))
+	for _, txid := range txids {
+		evict[txid] = true
+	}
+	return pool.evict(func(t transactions.SignedTxn) bool {
+		return evict[t.ID()]
+	})
+}
+
+// EvictSender removes from the pool the transaction groups holding a
+// transaction sent by sender, and returns the number of transactions removed.
+func (pool *TransactionPool) EvictSender(sender basics.Address) int {
+	return pool.evict(func(t transactions.SignedTxn) bool {
+		return t.Txn.Sender == sender
+	})
+}
+
+// evict removes the pending groups holding a transaction matched by match,
+// and replays the remaining groups through a new block evaluator.
+func (pool *TransactionPool) evict(match func(transactions.SignedTxn) bool) int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	defer pool.cond.Broadcast()
+
+	pool.pendingMu.Lock()
+	evicted := 0
+	remaining := make([][]transactions.SignedTxn, 0, len(pool.pendingTxGroups))
+	for _, txgroup := range pool.pendingTxGroups {
+		matched := false
+		for _, t := range txgroup {
+			if match(t) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			remaining = append(remaining, txgroup)
+			continue
+		}
+		for _, t := range txgroup {
+			delete(pool.pendingTxids, t.ID())
+			pool.dropTxn(t, errTxnEvicted)
+		}
+		evicted += len(txgroup)
+	}
+	pool.pendingTxGroups = remaining
+	pool.pendingMu.Unlock()
+
+	if evicted > 0 {
+		pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
+	}
+	return evicted
+}
+
 // pendingTxIDsCount returns the number of pending transaction ids that are still waiting
 // in the transaction pool. This is identical to the number of transaction ids that would
 // be retrieved by a call to PendingTxIDs()
@@ -287,6 +432,16 @@ func (pool *TransactionPool) FeePerByte() uint64 {
 	return atomic.LoadUint64(&pool.feePerByte)
 }
 
+// FeeThreshold returns the current minimum microalgos per byte a transaction
+// needs to pay in order to get into the pool, along with the congestion
+// multiplier and the number of whole blocks pending in the pool that it is
+// derived from.
+func (pool *TransactionPool) FeeThreshold() (feePerByte uint64, multiplier uint64, pendingWholeBlocks basics.Round) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.computeFeePerByte(), pool.feeThresholdMultiplier, pool.numPendingWholeBlocks
+}
+
 // computeFeePerByte computes and returns the current minimum microalgos per byte a transaction
 // needs to pay in order to get into the pool. It also updates the atomic counter that holds
 // the current fee per byte
@@ -456,6 +611,9 @@ func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 	}
 
 	pool.rememberCommit(false)
+	for _, l := range pool.txnListeners {
+		l.OnTxGroupAdmitted(txgroup)
+	}
 	return nil
 }
 
@@ -719,8 +877,13 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 		}
 		err := pool.add(txgroup, &asmStats)
 		if err != nil {
+			_, committed := err.(*ledgercore.TransactionInLedgerError)
 			for _, tx := range txgroup {
-				pool.statusCache.put(tx, err.Error())
+				if committed {
+					pool.statusCache.put(tx, err.Error())
+				} else {
+					pool.dropTxn(tx, err.Error())
+				}
 			}
 
 			switch err.(type) {
@@ -945,6 +1108,9 @@ func (pool *TransactionPool) AssembleDevModeBlock() (assembled *ledgercore.Valid
 
 	// drop the current block evaluator and start with a new one.
 	pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
+	if pool.pendingBlockEvaluator == nil {
+		return nil, fmt.Errorf("TransactionPool.AssembleDevModeBlock: no block evaluator for round %d", pool.ledger.Latest()+1)
+	}
 
 	// The above was already pregenerating the entire block,
 	// so there won't be any waiting on this call.
@@ -855,6 +855,158 @@ func TestRemove(t *testing.T) {
 	require.Equal(t, transactionPool.PendingTxGroups(), [][]transactions.SignedTxn{{signedTx}})
 }
 
+func TestPendingTxGroupsByFeePerByteAndEvict(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	// Generate accounts
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		addr := basics.Address(secret.SignatureVerifier)
+		secrets[i] = secret
+		addresses[i] = addr
+	}
+
+	ledger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	makeTxn := func(sender int, fee uint64) transactions.Transaction {
+		return transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  0,
+				LastValid:   10,
+				Note:        []byte{byte(fee), byte(fee >> 8), byte(fee >> 16)},
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(sender+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 0},
+			},
+		}
+	}
+
+	// a cheap single transaction from sender 0
+	cheap := makeTxn(0, proto.MinTxnFee).Sign(secrets[0])
+	require.NoError(t, transactionPool.RememberOne(cheap))
+
+	// a group of sender 1 and sender 2 paying a higher fee
+	tx1 := makeTxn(1, 50*proto.MinTxnFee)
+	tx2 := makeTxn(2, 50*proto.MinTxnFee)
+	var group transactions.TxGroup
+	group.TxGroupHashes = []crypto.Digest{crypto.HashObj(tx1), crypto.HashObj(tx2)}
+	tx1.Group = crypto.HashObj(group)
+	tx2.Group = tx1.Group
+	txgroup := []transactions.SignedTxn{tx1.Sign(secrets[1]), tx2.Sign(secrets[2])}
+	require.NoError(t, transactionPool.Remember(txgroup))
+
+	// an expensive single transaction from sender 0
+	expensive := makeTxn(0, 100*proto.MinTxnFee).Sign(secrets[0])
+	require.NoError(t, transactionPool.RememberOne(expensive))
+
+	summaries := transactionPool.PendingTxGroupsByFeePerByte()
+	require.Len(t, summaries, 3)
+	require.Equal(t, expensive.ID(), summaries[0].Txns[0].Txid)
+	require.Len(t, summaries[1].Txns, 2)
+	require.Equal(t, txgroup[0].ID(), summaries[1].Txns[0].Txid)
+	require.Equal(t, addresses[2], summaries[1].Txns[1].Sender)
+	require.Equal(t, basics.Round(10), summaries[1].Txns[1].LastValid)
+	require.Equal(t, cheap.ID(), summaries[2].Txns[0].Txid)
+	for i := 1; i < len(summaries); i++ {
+		require.GreaterOrEqual(t, summaries[i-1].FeePerByte, summaries[i].FeePerByte)
+	}
+	length := summaries[2].Txns[0].EncodedLength
+	require.Equal(t, cheap.GetEncodedLength(), length)
+	require.Equal(t, proto.MinTxnFee/uint64(length), summaries[2].FeePerByte)
+
+	feePerByte, multiplier, pendingWholeBlocks := transactionPool.FeeThreshold()
+	require.Zero(t, feePerByte)
+	require.Zero(t, multiplier)
+	require.Zero(t, pendingWholeBlocks)
+
+	// evicting one transaction of the group evicts the whole group
+	require.Equal(t, 2, transactionPool.EvictTxns([]transactions.Txid{txgroup[1].ID()}))
+	require.Equal(t, [][]transactions.SignedTxn{{cheap}, {expensive}}, transactionPool.PendingTxGroups())
+	_, txErr, found := transactionPool.Lookup(txgroup[0].ID())
+	require.True(t, found)
+	require.Equal(t, errTxnEvicted, txErr)
+
+	require.Equal(t, 0, transactionPool.EvictTxns([]transactions.Txid{txgroup[1].ID()}))
+	require.Equal(t, 0, transactionPool.EvictSender(addresses[1]))
+
+	require.Equal(t, 2, transactionPool.EvictSender(addresses[0]))
+	require.Empty(t, transactionPool.PendingTxGroups())
+	require.Zero(t, transactionPool.PendingCount())
+
+	// the pool still accepts transactions after an eviction
+	require.NoError(t, transactionPool.Remember(txgroup))
+	require.Equal(t, [][]transactions.SignedTxn{txgroup}, transactionPool.PendingTxGroups())
+}
+
+type recordingTxnListener struct {
+	admitted [][]transactions.SignedTxn
+	dropped  map[transactions.Txid]string
+}
+
+func (l *recordingTxnListener) OnTxGroupAdmitted(txgroup []transactions.SignedTxn) {
+	l.admitted = append(l.admitted, txgroup)
+}
+
+func (l *recordingTxnListener) OnTxnDropped(txn transactions.SignedTxn, reason string) {
+	l.dropped[txn.ID()] = reason
+}
+
+func TestTxnListener(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secret := keypair()
+	addr := basics.Address(secret.SignatureVerifier)
+	receiver := basics.Address(keypair().SignatureVerifier)
+
+	ledger := makeMockLedger(t, initAccFixed([]basics.Address{addr}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	listener := &recordingTxnListener{dropped: make(map[transactions.Txid]string)}
+	transactionPool.RegisterTxnListener(listener)
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:      addr,
+			Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+			FirstValid:  0,
+			LastValid:   10,
+			GenesisHash: ledger.GenesisHash(),
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: proto.MinBalance},
+		},
+	}
+	signed := tx.Sign(secret)
+	require.NoError(t, transactionPool.RememberOne(signed))
+	require.Equal(t, [][]transactions.SignedTxn{{signed}}, listener.admitted)
+	require.Empty(t, listener.dropped)
+
+	// a rejected transaction is not reported as admitted
+	require.Error(t, transactionPool.RememberOne(signed))
+	require.Len(t, listener.admitted, 1)
+
+	require.Equal(t, 1, transactionPool.EvictSender(addr))
+	require.Equal(t, map[transactions.Txid]string{signed.ID(): errTxnEvicted}, listener.dropped)
+}
+
 func TestLogicSigOK(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -0,0 +1,636 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// BasicBlock is a straight run of instructions that is only entered at its
+// first instruction and only left after its last one.
+type BasicBlock struct {
+	Start int `json:"start"` // pc of the first instruction
+	End   int `json:"end"`   // pc just after the last instruction
+	Cost  int `json:"cost"`  // opcode cost of the block, not counting called subroutines
+
+	// Successors are the starts of the blocks control may flow to next.
+	// The successor of a callsub block is the instruction after the callsub.
+	Successors []int `json:"successors,omitempty"`
+	// Call is the entry of the subroutine a block ending with callsub calls
+	Call      int  `json:"call,omitempty"`
+	Reachable bool `json:"reachable"`
+}
+
+// SubroutineAnalysis summarizes a subroutine, or the main program.
+type SubroutineAnalysis struct {
+	Entry int `json:"entry"`
+
+	// Cost is the opcode cost of the most expensive path through the
+	// subroutine, including the subroutines it calls. When CostBounded is
+	// false, the subroutine loops or recurses and Cost counts loop bodies once.
+	Cost        int  `json:"cost"`
+	CostBounded bool `json:"costBounded"`
+
+	// MaxStackDepth is the deepest the stack grows, relative to its depth on
+	// entry. It is only meaningful when StackDepthKnown is true, which is not
+	// the case when different paths leave the stack at different depths.
+	MaxStackDepth   int  `json:"maxStackDepth"`
+	StackDepthKnown bool `json:"stackDepthKnown"`
+}
+
+// CodeRange is a range of program bytes, from Start up to but excluding End
+type CodeRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// ScratchSlotUse reports a scratch slot and the pcs of the instructions using it
+type ScratchSlotUse struct {
+	Slot int   `json:"slot"`
+	PCs  []int `json:"pcs"`
+}
+
+// UnavailableOp is an instruction that uses an opcode or a field introduced
+// after the target version of an analysis.
+type UnavailableOp struct {
+	PC      int    `json:"pc"`
+	Op      string `json:"op"`
+	Version uint64 `json:"version"` // the version that introduced the opcode or field
+}
+
+// AnalysisWarning is a problem found by the analysis at a given pc
+type AnalysisWarning struct {
+	PC      int    `json:"pc"`
+	Message string `json:"message"`
+}
+
+// ProgramAnalysis is the result of AnalyzeProgram
+type ProgramAnalysis struct {
+	Version       uint64 `json:"version"`
+	TargetVersion uint64 `json:"targetVersion"`
+
+	Blocks []BasicBlock `json:"blocks"`
+	// Subroutines starts with the main program, followed by the reachable
+	// subroutines in program order.
+	Subroutines []SubroutineAnalysis `json:"subroutines"`
+
+	Unreachable []CodeRange `json:"unreachable,omitempty"`
+	// UnusedScratch lists the slots that are stored to but never loaded. It is
+	// left empty when the program uses loads, whose slots are not known statically.
+	// The slots of an application call may still be read by later application
+	// calls of its group, with gload or gloads.
+	UnusedScratch []ScratchSlotUse  `json:"unusedScratch,omitempty"`
+	Unavailable   []UnavailableOp   `json:"unavailable,omitempty"`
+	Warnings      []AnalysisWarning `json:"warnings,omitempty"`
+}
+
+type analyzedOp struct {
+	pc     int
+	next   int
+	spec   *OpSpec
+	text   string
+	target int // branch or callsub target, -1 for other instructions
+	// targets are the labels of switch and match
+	targets []int
+}
+
+type subroutineState int
+
+const (
+	subroutinePending subroutineState = iota
+	subroutineInProgress
+	subroutineDone
+)
+
+type subroutineSummary struct {
+	SubroutineAnalysis
+	state subroutineState
+	// stackEffect is the change in stack depth from entry to retsub
+	stackEffect int
+}
+
+// blockOps records the range of a block in analyzer.ops
+type blockOps struct {
+	first, last int
+}
+
+type analyzer struct {
+	program []byte
+	version uint64
+
+	ops     []analyzedOp
+	opIndex map[int]int
+
+	blocks     []BasicBlock
+	blockOps   []blockOps
+	blockIndex map[int]int
+
+	subroutines map[int]*subroutineSummary
+	warned      map[int]bool
+
+	result ProgramAnalysis
+}
+
+// AnalyzeProgram builds the control-flow graph of an assembled program and
+// computes static properties over it: the worst-case path cost and maximum
+// stack depth of the program and of each subroutine, unreachable code, unused
+// scratch slots, and the opcodes and fields that are not available in
+// targetVersion. A targetVersion of 0 means the version of the program.
+func AnalyzeProgram(program []byte, targetVersion uint64) (*ProgramAnalysis, error) {
+	version, vlen := binary.Uvarint(program)
+	if vlen <= 0 {
+		return nil, errors.New("invalid version")
+	}
+	if version > LogicVersion {
+		return nil, fmt.Errorf("program version %d greater than max supported version %d", version, LogicVersion)
+	}
+	if targetVersion == 0 {
+		targetVersion = version
+	}
+
+	a := analyzer{
+		program:     program,
+		version:     version,
+		opIndex:     make(map[int]int),
+		blockIndex:  make(map[int]int),
+		subroutines: make(map[int]*subroutineSummary),
+		warned:      make(map[int]bool),
+	}
+	a.result.Version = version
+	a.result.TargetVersion = targetVersion
+
+	err := a.decode(vlen)
+	if err != nil {
+		return nil, err
+	}
+	a.buildBlocks()
+	a.markReachable()
+	a.summarizeSubroutines()
+	a.findUnreachable()
+	a.findUnusedScratch()
+	a.findUnavailable(targetVersion)
+
+	a.result.Blocks = a.blocks
+	return &a.result, nil
+}
+
+func isBranch(spec *OpSpec) bool {
+	switch spec.Name {
+	case "bnz", "bz", "b", "callsub":
+		return true
+	}
+	return false
+}
+
+// endsBlock reports whether control never falls through to the next instruction
+// without a block boundary.
+func endsBlock(spec *OpSpec) bool {
+	switch spec.Name {
+	case "bnz", "bz", "b", "callsub", "switch", "match", "return", "retsub", "err":
+		return true
+	}
+	return false
+}
+
+// decode splits the program into instructions, reusing the disassembler to
+// find their lengths, and validates branch targets.
+func (a *analyzer) decode(pc int) error {
+	for pc < len(a.program) {
+		spec := &opsByOpcode[a.version][a.program[pc]]
+		if spec.op == nil {
+			return fmt.Errorf("illegal opcode 0x%02x at pc=%d", a.program[pc], pc)
+		}
+		dis := disassembleState{program: a.program, pc: pc, numericTargets: true}
+		text, err := spec.dis(&dis, spec)
+		if err != nil {
+			return fmt.Errorf("pc=%3d %w", pc, err)
+		}
+		op := analyzedOp{pc: pc, next: dis.nextpc, spec: spec, text: text, target: -1}
+		if isBranch(spec) {
+			cx := EvalContext{program: a.program, pc: pc, version: a.version}
+			op.target, err = branchTarget(&cx)
+			if err != nil {
+				return fmt.Errorf("pc=%3d %w", pc, err)
+			}
+		}
+		if spec.Name == "switch" || spec.Name == "match" {
+			cx := EvalContext{program: a.program, pc: pc, version: a.version}
+			for i := 0; i < int(a.program[pc+1]); i++ {
+				target, err := switchTarget(&cx, i, op.next)
+				if err != nil {
+					return fmt.Errorf("pc=%3d %w", pc, err)
+				}
+				op.targets = append(op.targets, target)
+			}
+		}
+		a.opIndex[pc] = len(a.ops)
+		a.ops = append(a.ops, op)
+		pc = op.next
+	}
+
+	for _, op := range a.ops {
+		for _, target := range append([]int{op.target}, op.targets...) {
+			if target < 0 || target == len(a.program) {
+				continue
+			}
+			if _, ok := a.opIndex[target]; !ok {
+				return fmt.Errorf("pc=%3d branch target %d is not an aligned instruction", op.pc, target)
+			}
+		}
+	}
+	return nil
+}
+
+func (a *analyzer) buildBlocks() {
+	leaders := make(map[int]bool)
+	if len(a.ops) > 0 {
+		leaders[a.ops[0].pc] = true
+	}
+	for _, op := range a.ops {
+		if op.target >= 0 {
+			leaders[op.target] = true
+		}
+		for _, target := range op.targets {
+			leaders[target] = true
+		}
+		if endsBlock(op.spec) {
+			leaders[op.next] = true
+		}
+	}
+
+	for i, op := range a.ops {
+		if leaders[op.pc] {
+			a.blockIndex[op.pc] = len(a.blocks)
+			a.blocks = append(a.blocks, BasicBlock{Start: op.pc})
+			a.blockOps = append(a.blockOps, blockOps{first: i})
+		}
+		b := len(a.blocks) - 1
+		a.blocks[b].End = op.next
+		a.blocks[b].Cost += op.spec.Details.Cost
+		a.blockOps[b].last = i
+	}
+
+	end := len(a.program)
+	for b := range a.blocks {
+		last := a.ops[a.blockOps[b].last]
+		var successors []int
+		switch last.spec.Name {
+		case "b":
+			successors = []int{last.target}
+		case "bnz", "bz":
+			successors = []int{last.next, last.target}
+		case "switch", "match":
+			successors = append([]int{last.next}, last.targets...)
+		case "callsub":
+			successors = []int{last.next}
+			a.blocks[b].Call = last.target
+		case "return", "retsub", "err":
+		default:
+			successors = []int{last.next}
+		}
+	successor:
+		for _, s := range successors {
+			// running off the end, or branching to it, leaves the program
+			if s == end {
+				continue
+			}
+			for _, known := range a.blocks[b].Successors {
+				if known == s {
+					continue successor
+				}
+			}
+			a.blocks[b].Successors = append(a.blocks[b].Successors, s)
+		}
+	}
+}
+
+func (a *analyzer) markReachable() {
+	if len(a.blocks) == 0 {
+		return
+	}
+	work := []int{0}
+	a.blocks[0].Reachable = true
+	for len(work) > 0 {
+		b := work[len(work)-1]
+		work = work[:len(work)-1]
+		next := a.blocks[b].Successors
+		if a.blocks[b].Call != 0 {
+			next = append([]int{a.blocks[b].Call}, next...)
+		}
+		for _, pc := range next {
+			s := a.blockIndex[pc]
+			if !a.blocks[s].Reachable {
+				a.blocks[s].Reachable = true
+				work = append(work, s)
+			}
+		}
+	}
+}
+
+func (a *analyzer) warn(pc int, format string, args ...interface{}) {
+	if a.warned[pc] {
+		return
+	}
+	a.warned[pc] = true
+	a.result.Warnings = append(a.result.Warnings, AnalysisWarning{PC: pc, Message: fmt.Sprintf(format, args...)})
+}
+
+func (a *analyzer) summarizeSubroutines() {
+	if len(a.blocks) == 0 {
+		a.result.Subroutines = []SubroutineAnalysis{{CostBounded: true, StackDepthKnown: true}}
+		return
+	}
+
+	main := subroutineSummary{SubroutineAnalysis: SubroutineAnalysis{Entry: a.blocks[0].Start}}
+	a.summarize(&main, true)
+	a.result.Subroutines = append(a.result.Subroutines, main.SubroutineAnalysis)
+
+	var entries []int
+	for _, block := range a.blocks {
+		if block.Reachable && block.Call != 0 {
+			a.subroutine(block.Call)
+		}
+	}
+	for entry := range a.subroutines {
+		entries = append(entries, entry)
+	}
+	sort.Ints(entries)
+	for _, entry := range entries {
+		a.result.Subroutines = append(a.result.Subroutines, a.subroutines[entry].SubroutineAnalysis)
+	}
+
+	sort.Slice(a.result.Warnings, func(i, j int) bool {
+		return a.result.Warnings[i].PC < a.result.Warnings[j].PC
+	})
+}
+
+// subroutine returns the summary of the subroutine at entry, computing it if
+// needed. It returns nil for a subroutine that is being summarized, which
+// means the call is recursive.
+func (a *analyzer) subroutine(entry int) *subroutineSummary {
+	s, ok := a.subroutines[entry]
+	if !ok {
+		s = &subroutineSummary{SubroutineAnalysis: SubroutineAnalysis{Entry: entry}}
+		a.subroutines[entry] = s
+	}
+	switch s.state {
+	case subroutineInProgress:
+		return nil
+	case subroutinePending:
+		s.state = subroutineInProgress
+		a.summarize(s, false)
+		s.state = subroutineDone
+	}
+	return s
+}
+
+func (a *analyzer) summarize(s *subroutineSummary, isMain bool) {
+	s.CostBounded = true
+	s.Cost = a.pathCost(s, a.blockIndex[s.Entry], make(map[int]int), make(map[int]bool))
+	a.stackDepth(s, isMain)
+}
+
+// pathCost returns the cost of the most expensive path from block b to the end
+// of the subroutine s. onPath holds the blocks of the path being explored, so
+// that loops are noticed and only followed once.
+func (a *analyzer) pathCost(s *subroutineSummary, b int, memo map[int]int, onPath map[int]bool) int {
+	if cost, ok := memo[b]; ok {
+		return cost
+	}
+	onPath[b] = true
+	cost := a.blocks[b].Cost
+	if call := a.blocks[b].Call; call != 0 {
+		callee := a.subroutine(call)
+		if callee == nil {
+			a.warn(a.ops[a.blockOps[b].last].pc, "recursive call to subroutine at pc %d", call)
+			s.CostBounded = false
+		} else {
+			cost += callee.Cost
+			s.CostBounded = s.CostBounded && callee.CostBounded
+		}
+	}
+	longest := 0
+	for _, pc := range a.blocks[b].Successors {
+		next := a.blockIndex[pc]
+		if onPath[next] {
+			s.CostBounded = false
+			continue
+		}
+		if c := a.pathCost(s, next, memo, onPath); c > longest {
+			longest = c
+		}
+	}
+	delete(onPath, b)
+	memo[b] = cost + longest
+	return memo[b]
+}
+
+// stackDepth propagates stack depths through the blocks of s. Every block must
+// be entered with the same depth on all paths, which rules out loops that grow
+// or shrink the stack.
+func (a *analyzer) stackDepth(s *subroutineSummary, isMain bool) {
+	entry := a.blockIndex[s.Entry]
+	depths := map[int]int{entry: 0}
+	work := []int{entry}
+	exitDepth, exited := 0, false
+	for len(work) > 0 {
+		b := work[len(work)-1]
+		work = work[:len(work)-1]
+
+		depth := depths[b]
+		for i := a.blockOps[b].first; i <= a.blockOps[b].last; i++ {
+			op := a.ops[i]
+			switch op.spec.Name {
+			case "callsub":
+				callee := a.subroutine(op.target)
+				if callee == nil || !callee.StackDepthKnown {
+					return
+				}
+				if depth+callee.MaxStackDepth > s.MaxStackDepth {
+					s.MaxStackDepth = depth + callee.MaxStackDepth
+				}
+				depth += callee.stackEffect
+				continue
+			case "retsub":
+				if isMain {
+					a.warn(op.pc, "retsub outside of a subroutine")
+					break
+				}
+				if exited && exitDepth != depth {
+					a.warn(op.pc, "subroutine at pc %d returns with stack depth %d and %d", s.Entry, exitDepth, depth)
+					return
+				}
+				exitDepth, exited = depth, true
+			}
+			pops, pushes := a.stackChange(op)
+			if isMain && depth < pops {
+				a.warn(op.pc, "%s needs %d stack values but only %d are available", op.spec.Name, pops, depth)
+			}
+			depth += pushes - pops
+			if depth > s.MaxStackDepth {
+				s.MaxStackDepth = depth
+			}
+		}
+
+		for _, pc := range a.blocks[b].Successors {
+			next := a.blockIndex[pc]
+			if known, ok := depths[next]; ok {
+				if known != depth {
+					a.warn(pc, "stack depth at pc %d is %d or %d depending on the path taken", pc, known, depth)
+					return
+				}
+				continue
+			}
+			depths[next] = depth
+			work = append(work, next)
+		}
+	}
+	s.StackDepthKnown = true
+	s.stackEffect = exitDepth
+	if first := a.ops[a.blockOps[entry].first]; !isMain && first.spec.Name == "proto" {
+		// retsub replaces the frame with the declared returns
+		s.stackEffect = int(a.program[first.pc+2]) - int(a.program[first.pc+1])
+	}
+}
+
+// stackChange returns the number of values op pops and pushes, including
+// for the opcodes whose effect depends on their immediates.
+func (a *analyzer) stackChange(op analyzedOp) (int, int) {
+	switch op.spec.Name {
+	case "popn":
+		return int(a.program[op.pc+1]), 0
+	case "dupn":
+		n := int(a.program[op.pc+1])
+		return 1, n + 1
+	case "match":
+		return len(op.targets) + 1, 0
+	}
+	return len(op.spec.Args), len(op.spec.Returns)
+}
+
+func (a *analyzer) findUnreachable() {
+	for _, block := range a.blocks {
+		if block.Reachable {
+			continue
+		}
+		last := len(a.result.Unreachable) - 1
+		if last >= 0 && a.result.Unreachable[last].End == block.Start {
+			a.result.Unreachable[last].End = block.End
+			continue
+		}
+		a.result.Unreachable = append(a.result.Unreachable, CodeRange{Start: block.Start, End: block.End})
+	}
+}
+
+func (a *analyzer) findUnusedScratch() {
+	stores := make(map[int][]int)
+	loaded := make(map[int]bool)
+	for b, block := range a.blocks {
+		if !block.Reachable {
+			continue
+		}
+		for i := a.blockOps[b].first; i <= a.blockOps[b].last; i++ {
+			op := a.ops[i]
+			switch op.spec.Name {
+			case "loads":
+				return
+			case "load":
+				loaded[int(a.program[op.pc+1])] = true
+			case "store":
+				slot := int(a.program[op.pc+1])
+				stores[slot] = append(stores[slot], op.pc)
+			}
+		}
+	}
+
+	var slots []int
+	for slot := range stores {
+		if !loaded[slot] {
+			slots = append(slots, slot)
+		}
+	}
+	sort.Ints(slots)
+	for _, slot := range slots {
+		a.result.UnusedScratch = append(a.result.UnusedScratch, ScratchSlotUse{Slot: slot, PCs: stores[slot]})
+	}
+}
+
+// opcodeVersion returns the first version in which opcode is available
+func opcodeVersion(opcode byte) uint64 {
+	for v := uint64(1); v <= LogicVersion; v++ {
+		if opsByOpcode[v][opcode].op != nil {
+			return v
+		}
+	}
+	return LogicVersion + 1
+}
+
+// fieldVersion returns the version that introduced the field an instruction
+// names in its immediates, if it names one.
+func (a *analyzer) fieldVersion(op analyzedOp) (uint64, bool) {
+	immediate := func(i int) byte {
+		return a.program[op.pc+i]
+	}
+	switch op.spec.Name {
+	case "txn", "txna", "txnas", "gtxns", "gtxnsa", "gtxnsas", "itxn", "itxna":
+		fs, ok := txnFieldSpecByField[TxnField(immediate(1))]
+		return fs.version, ok
+	case "gtxn", "gtxna", "gtxnas", "gitxn", "gitxna":
+		fs, ok := txnFieldSpecByField[TxnField(immediate(2))]
+		return fs.version, ok
+	case "itxn_field":
+		fs, ok := txnFieldSpecByField[TxnField(immediate(1))]
+		return fs.itxVersion, ok
+	case "global":
+		fs, ok := globalFieldSpecByField[GlobalField(immediate(1))]
+		return fs.version, ok
+	case "asset_holding_get":
+		fs, ok := assetHoldingFieldSpecByField[AssetHoldingField(immediate(1))]
+		return fs.version, ok
+	case "asset_params_get":
+		fs, ok := assetParamsFieldSpecByField[AssetParamsField(immediate(1))]
+		return fs.version, ok
+	case "app_params_get":
+		fs, ok := appParamsFieldSpecByField[AppParamsField(immediate(1))]
+		return fs.version, ok
+	case "acct_params_get":
+		fs, ok := acctParamsFieldSpecByField[AcctParamsField(immediate(1))]
+		return fs.version, ok
+	case "ecdsa_verify", "ecdsa_pk_decompress", "ecdsa_pk_recover":
+		fs, ok := ecdsaCurveSpecByField[EcdsaCurve(immediate(1))]
+		return fs.version, ok
+	case "base64_decode":
+		fs, ok := base64EncodingSpecByField[Base64Encoding(immediate(1))]
+		return fs.version, ok
+	}
+	return 0, false
+}
+
+func (a *analyzer) findUnavailable(target uint64) {
+	for _, op := range a.ops {
+		required := opcodeVersion(op.spec.Opcode)
+		if fv, ok := a.fieldVersion(op); ok && fv > required {
+			required = fv
+		}
+		if required > target {
+			a.result.Unavailable = append(a.result.Unavailable, UnavailableOp{PC: op.pc, Op: op.text, Version: required})
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeProgramCostAndStack(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `#pragma version 5
+int 1
+bnz expensive
+int 2
+int 3
++
+b done
+expensive:
+byte "x"
+sha256
+callsub hash
+len
+done:
+return
+hash:
+dup
+concat
+sha256
+retsub
+`
+	ops := testProg(t, source, 5)
+	analysis, err := AnalyzeProgram(ops.Program, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), analysis.TargetVersion)
+	require.Len(t, analysis.Subroutines, 2)
+
+	main, hash := analysis.Subroutines[0], analysis.Subroutines[1]
+	require.True(t, main.CostBounded)
+	require.True(t, hash.CostBounded)
+	// dup, concat, sha256, retsub
+	require.Equal(t, 1+1+35+1, hash.Cost)
+	// int, bnz, byte, sha256, callsub, len, return along with the subroutine
+	require.Equal(t, 1+1+1+35+1+1+1+hash.Cost, main.Cost)
+
+	require.True(t, hash.StackDepthKnown)
+	require.Equal(t, 1, hash.MaxStackDepth)
+	require.True(t, main.StackDepthKnown)
+	require.Equal(t, 2, main.MaxStackDepth)
+
+	require.Empty(t, analysis.Unreachable)
+	require.Empty(t, analysis.Warnings)
+	require.Empty(t, analysis.Unavailable)
+}
+
+func TestAnalyzeProgramFrames(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `#pragma version 7
+pushint 1
+pushint 2
+callsub add
+pushint 2
+switch small big
+err
+small:
+pushint 1
+return
+big:
+pushint 1
+dupn 2
+popn 2
+return
+add:
+proto 2 1
+frame_dig -2
+frame_dig -1
++
+retsub
+`
+	ops := testProg(t, source, 7)
+	analysis, err := AnalyzeProgram(ops.Program, 0)
+	require.NoError(t, err)
+	require.Len(t, analysis.Subroutines, 2)
+
+	main, add := analysis.Subroutines[0], analysis.Subroutines[1]
+	require.True(t, add.StackDepthKnown)
+	require.Equal(t, 2, add.MaxStackDepth)
+	// retsub leaves one value in place of the two arguments, so main is
+	// left with one value at the switch, and reaches four after dupn
+	require.True(t, main.StackDepthKnown)
+	require.Equal(t, 4, main.MaxStackDepth)
+
+	// both switch targets, and the fall through, are reachable
+	require.Empty(t, analysis.Unreachable)
+	require.Empty(t, analysis.Warnings)
+}
+
+func TestAnalyzeProgramLoops(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	balanced := `#pragma version 5
+int 10
+loop:
+int 1
+-
+dup
+bnz loop
+`
+	ops := testProg(t, balanced, 5)
+	analysis, err := AnalyzeProgram(ops.Program, 0)
+	require.NoError(t, err)
+	main := analysis.Subroutines[0]
+	require.False(t, main.CostBounded)
+	require.True(t, main.StackDepthKnown)
+	require.Equal(t, 2, main.MaxStackDepth)
+
+	growing := `#pragma version 5
+int 10
+loop:
+int 1
+dup
+bnz loop
+`
+	ops = testProg(t, growing, 5)
+	analysis, err = AnalyzeProgram(ops.Program, 0)
+	require.NoError(t, err)
+	require.False(t, analysis.Subroutines[0].StackDepthKnown)
+	require.Len(t, analysis.Warnings, 1)
+
+	recursive := `#pragma version 5
+int 1
+callsub f
+return
+f:
+callsub f
+retsub
+`
+	ops = testProg(t, recursive, 5)
+	analysis, err = AnalyzeProgram(ops.Program, 0)
+	require.NoError(t, err)
+	require.False(t, analysis.Subroutines[0].CostBounded)
+	require.False(t, analysis.Subroutines[1].CostBounded)
+	require.Contains(t, analysis.Warnings[0].Message, "recursive")
+}
+
+func TestAnalyzeProgramFindings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `#pragma version 6
+int 1
+store 1
+int 2
+store 2
+load 1
+bnz skip
+err
+int 5
+pop
+skip:
+byte "aGk="
+base64_decode StdEncoding
+global GroupID
+pop
+pop
+int 1
+`
+	ops := testProg(t, source, 6)
+	analysis, err := AnalyzeProgram(ops.Program, 4)
+	require.NoError(t, err)
+
+	lineToPC := make(map[int]int)
+	for pc, line := range ops.OffsetToLine {
+		lineToPC[line] = pc
+	}
+
+	// the instructions between err and skip
+	require.Equal(t, []CodeRange{{Start: lineToPC[8], End: lineToPC[11]}}, analysis.Unreachable)
+	require.Equal(t, []ScratchSlotUse{{Slot: 2, PCs: []int{lineToPC[4]}}}, analysis.UnusedScratch)
+
+	require.Len(t, analysis.Unavailable, 2)
+	require.Equal(t, "base64_decode StdEncoding", analysis.Unavailable[0].Op)
+	require.Equal(t, uint64(6), analysis.Unavailable[0].Version)
+	require.Equal(t, "global GroupID", analysis.Unavailable[1].Op)
+	require.Equal(t, uint64(5), analysis.Unavailable[1].Version)
+
+	analysis, err = AnalyzeProgram(ops.Program, 0)
+	require.NoError(t, err)
+	require.Empty(t, analysis.Unavailable)
+
+	// b into the middle of pushint
+	_, err = AnalyzeProgram([]byte{0x05, 0x42, 0x00, 0x01, 0x81, 0x01}, 0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not an aligned instruction")
+}
+
+func TestAnalyzeFieldVersion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the field of gtxn and gitxn opcodes follows the group index
+	for _, source := range []string{
+		"gtxn 1 Nonparticipation",
+		"gitxn 1 Nonparticipation",
+		"txn Nonparticipation",
+		"itxn Nonparticipation",
+	} {
+		ops := testProg(t, "#pragma version 6\n"+source, 6)
+		a := analyzer{program: ops.Program, version: 6}
+		op := analyzedOp{pc: 1, spec: &opsByOpcode[6][ops.Program[1]]}
+		version, ok := a.fieldVersion(op)
+		require.True(t, ok, source)
+		require.Equal(t, uint64(5), version, source)
+	}
+
+	ops := testProg(t, "#pragma version 6\ngitxna 1 Accounts 0", 6)
+	a := analyzer{program: ops.Program, version: 6}
+	version, ok := a.fieldVersion(analyzedOp{pc: 1, spec: &opsByOpcode[6][ops.Program[1]]})
+	require.True(t, ok)
+	require.Equal(t, uint64(2), version)
+}
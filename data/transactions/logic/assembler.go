@@ -47,10 +47,19 @@ type Writer interface {
 type labelReference struct {
 	sourceLine int
 
+	// source the reference was read from, see OpStream.sourceIndex
+	sourceIndex int
+
 	// position of the opcode start that refers to the label
 	position int
 
 	label string
+
+	// offsetPosition is where the int16 offset is written, relative to
+	// position. The offset is relative to the end of the instruction,
+	// position+size.
+	offsetPosition int
+	size           int
 }
 
 type constReference interface {
@@ -102,7 +111,7 @@ func (ref intReference) length(ops *OpStream, assembled []byte) (int, error) {
 	case opIntc:
 		return 2, nil
 	default:
-		return 0, ops.lineErrorf(ops.OffsetToLine[ref.position], "Unexpected op at intReference: %d", assembled[ref.position])
+		return 0, ops.offsetErrorf(ref.position, "Unexpected op at intReference: %d", assembled[ref.position])
 	}
 }
 
@@ -171,7 +180,7 @@ func (ref byteReference) length(ops *OpStream, assembled []byte) (int, error) {
 	case opBytec:
 		return 2, nil
 	default:
-		return 0, ops.lineErrorf(ops.OffsetToLine[ref.position], "Unexpected op at byteReference: %d", assembled[ref.position])
+		return 0, ops.offsetErrorf(ref.position, "Unexpected op at byteReference: %d", assembled[ref.position])
 	}
 }
 
@@ -235,6 +244,23 @@ type OpStream struct {
 	// current sourceLine during assembly
 	sourceLine int
 
+	// column of the current opcode within its source line
+	sourceColumn int
+
+	// index of the current source: 0 for the assembled text, i for
+	// IncludedSources[i-1]
+	sourceIndex int
+
+	// resolveInclude loads the sources of #include directives
+	resolveInclude IncludeResolver
+
+	// includeStack holds the names of the sources being included, to
+	// detect include cycles
+	includeStack []string
+
+	// macros maps the names defined by #define to their replacement fields
+	macros map[string][]string
+
 	// map label string to position within pending buffer
 	labels map[string]int
 
@@ -244,6 +270,17 @@ type OpStream struct {
 	// map opcode offsets to source line
 	OffsetToLine map[int]int
 
+	// map opcode offsets to their column within the source line
+	OffsetToColumn map[int]int
+
+	// map opcode offsets to the index of their source, 0 being the assembled
+	// text and i being IncludedSources[i-1]
+	OffsetToSource map[int]int
+
+	// names of the sources pulled in by #include directives, in the order
+	// they were first included
+	IncludedSources []string
+
 	HasStatefulOps bool
 }
 
@@ -271,13 +308,33 @@ func (ops *OpStream) createLabel(label string) {
 func (ops *OpStream) RecordSourceLine() {
 	if ops.OffsetToLine == nil {
 		ops.OffsetToLine = make(map[int]int)
+		ops.OffsetToColumn = make(map[int]int)
+		ops.OffsetToSource = make(map[int]int)
 	}
 	ops.OffsetToLine[ops.pending.Len()] = ops.sourceLine - 1
+	ops.OffsetToColumn[ops.pending.Len()] = ops.sourceColumn
+	ops.OffsetToSource[ops.pending.Len()] = ops.sourceIndex
+}
+
+// shiftOffsets returns a copy of an offset keyed map with the keys moved by shift.
+func shiftOffsets(m map[int]int, shift func(offset int) int) map[int]int {
+	shifted := make(map[int]int, len(m))
+	for offset, v := range m {
+		shifted[shift(offset)] = v
+	}
+	return shifted
 }
 
 // ReferToLabel records an opcode label refence to resolve later
 func (ops *OpStream) ReferToLabel(pc int, label string) {
-	ops.labelReferences = append(ops.labelReferences, labelReference{ops.sourceLine, pc, label})
+	ops.referToLabel(pc, label, 1, 3)
+}
+
+// referToLabel records a label reference from an instruction of the given
+// size, whose offset bytes are found at offsetPosition within it.
+func (ops *OpStream) referToLabel(pc int, label string, offsetPosition int, size int) {
+	ops.labelReferences = append(ops.labelReferences,
+		labelReference{ops.sourceLine, ops.sourceIndex, pc, label, offsetPosition, size})
 }
 
 type opTypeFunc func(ops *OpStream, immediates []string) (StackTypes, StackTypes)
@@ -772,6 +829,40 @@ func assembleBranch(ops *OpStream, spec *OpSpec, args []string) error {
 	return nil
 }
 
+// asmSwitch assembles switch and match, which take any number of labels,
+// encoded as a count and an int16 offset for each.
+func asmSwitch(ops *OpStream, spec *OpSpec, args []string) error {
+	if len(args) > 255 {
+		return ops.errorf("%s cannot take more than 255 labels", spec.Name)
+	}
+	pc := ops.pending.Len()
+	size := 2 + 2*len(args)
+	ops.pending.WriteByte(spec.Opcode)
+	ops.pending.WriteByte(byte(len(args)))
+	for i, label := range args {
+		ops.referToLabel(pc, label, 2+2*i, size)
+		// zero bytes will get replaced with actual offset in resolveLabels()
+		ops.pending.WriteByte(0)
+		ops.pending.WriteByte(0)
+	}
+	return nil
+}
+
+// asmProto assembles proto, which begins a subroutine, so the assembler's
+// view of the stack is reset to the subroutine's arguments.
+func asmProto(ops *OpStream, spec *OpSpec, args []string) error {
+	err := asmDefault(ops, spec, args)
+	if err != nil {
+		return err
+	}
+	a, _ := strconv.ParseUint(args[0], 0, 64)
+	ops.typeStack = make(StackTypes, a)
+	for i := range ops.typeStack {
+		ops.typeStack[i] = StackAny
+	}
+	return nil
+}
+
 func assembleSubstring(ops *OpStream, spec *OpSpec, args []string) error {
 	err := asmDefault(ops, spec, args)
 	if err != nil {
@@ -873,7 +964,7 @@ func assembleTxnas(ops *OpStream, spec *OpSpec, args []string) error {
 
 func assembleGtxn(ops *OpStream, spec *OpSpec, args []string) error {
 	if len(args) != 2 {
-		return ops.error("gtxn expects two arguments")
+		return ops.errorf("%s expects two arguments", spec.Name)
 	}
 	slot, err := strconv.ParseUint(args[0], 0, 64)
 	if err != nil {
@@ -913,6 +1004,19 @@ func assembleGtxn2(ops *OpStream, spec *OpSpec, args []string) error {
 	return ops.errorf("%s expects two or three arguments", spec.Name)
 }
 
+// asmGitxn delegates to assembleGtxn or assembleGtxna (for gitxna) depending
+// on number of operands
+func asmGitxn(ops *OpStream, spec *OpSpec, args []string) error {
+	if len(args) == 2 {
+		return assembleGtxn(ops, spec, args)
+	}
+	if len(args) == 3 {
+		gitxna := OpsByName[ops.Version]["gitxna"]
+		return assembleGtxna(ops, &gitxna, args)
+	}
+	return ops.errorf("%s expects two or three arguments", spec.Name)
+}
+
 func assembleGtxna(ops *OpStream, spec *OpSpec, args []string) error {
 	if len(args) != 3 {
 		return ops.errorf("%s expects three arguments", spec.Name)
@@ -1207,6 +1311,27 @@ func assembleAppParams(ops *OpStream, spec *OpSpec, args []string) error {
 	return nil
 }
 
+func assembleAcctParams(ops *OpStream, spec *OpSpec, args []string) error {
+	if len(args) != 1 {
+		return ops.errorf("%s expects one argument", spec.Name)
+	}
+	fs, ok := acctParamsFieldSpecByName[args[0]]
+	if !ok {
+		return ops.errorf("%s unknown field: %#v", spec.Name, args[0])
+	}
+	if fs.version > ops.Version {
+		//nolint:errcheck // we continue to maintain typestack
+		ops.errorf("%s %s available in version %d. Missed #pragma version?", spec.Name, args[0], fs.version)
+	}
+
+	val := fs.field
+	ops.pending.WriteByte(spec.Opcode)
+	ops.pending.WriteByte(uint8(val))
+	ops.trace("%s (%s)", fs.field.String(), fs.ftype.String())
+	ops.returns(fs.ftype, StackUint64)
+	return nil
+}
+
 func asmTxField(ops *OpStream, spec *OpSpec, args []string) error {
 	if len(args) != 1 {
 		return ops.errorf("%s expects one argument", spec.Name)
@@ -1215,8 +1340,9 @@ func asmTxField(ops *OpStream, spec *OpSpec, args []string) error {
 	if !ok {
 		return ops.errorf("txn unknown field: %#v", args[0])
 	}
+	// Array fields that can be set in inner transactions are appended to
 	_, ok = txnaFieldSpecByField[fs.field]
-	if ok {
+	if ok && fs.itxVersion == 0 {
 		return ops.errorf("found array field %#v in %s op", args[0], spec.Name)
 	}
 	ops.pending.WriteByte(spec.Opcode)
@@ -1275,6 +1401,17 @@ func asmDefault(ops *OpStream, spec *OpSpec, args []string) error {
 	}
 	ops.pending.WriteByte(spec.Opcode)
 	for i := 0; i < spec.Details.Size-1; i++ {
+		if spec.Details.Immediates[i].kind == immInt8 {
+			val, err := strconv.ParseInt(args[i], 0, 64)
+			if err != nil {
+				return ops.error(err)
+			}
+			if val < -128 || val > 127 {
+				return ops.errorf("%s outside -128..127: %d", spec.Name, val)
+			}
+			ops.pending.WriteByte(byte(int8(val)))
+			continue
+		}
 		val, err := strconv.ParseUint(args[i], 0, 64)
 		if err != nil {
 			return ops.error(err)
@@ -1430,6 +1567,73 @@ func typeUncover(ops *OpStream, args []string) (StackTypes, StackTypes) {
 	return anys, returns
 }
 
+func typeBury(ops *OpStream, args []string) (StackTypes, StackTypes) {
+	if len(args) == 0 {
+		return oneAny, nil
+	}
+	n, err := strconv.ParseUint(args[0], 0, 64)
+	if err != nil || n == 0 {
+		return oneAny, nil
+	}
+	depth := int(n) + 1
+	anys := make(StackTypes, depth)
+	for i := range anys {
+		anys[i] = StackAny
+	}
+	returns := make(StackTypes, depth-1)
+	for i := range returns {
+		returns[i] = StackAny
+	}
+	idx := len(ops.typeStack) - depth
+	if idx >= 0 {
+		copy(returns, ops.typeStack[idx:])
+		returns[0] = ops.typeStack[len(ops.typeStack)-1]
+	}
+	return anys, returns
+}
+
+func typePopN(ops *OpStream, args []string) (StackTypes, StackTypes) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	n, err := strconv.ParseUint(args[0], 0, 64)
+	if err != nil {
+		return nil, nil
+	}
+	anys := make(StackTypes, n)
+	for i := range anys {
+		anys[i] = StackAny
+	}
+	return anys, nil
+}
+
+func typeDupN(ops *OpStream, args []string) (StackTypes, StackTypes) {
+	if len(args) == 0 {
+		return oneAny, oneAny
+	}
+	n, err := strconv.ParseUint(args[0], 0, 64)
+	if err != nil {
+		return oneAny, oneAny
+	}
+	top := StackAny
+	if len(ops.typeStack) > 0 {
+		top = ops.typeStack[len(ops.typeStack)-1]
+	}
+	returns := make(StackTypes, n+1)
+	for i := range returns {
+		returns[i] = top
+	}
+	return StackTypes{top}, returns
+}
+
+func typeMatch(ops *OpStream, args []string) (StackTypes, StackTypes) {
+	anys := make(StackTypes, len(args)+1)
+	for i := range anys {
+		anys[i] = StackAny
+	}
+	return anys, nil
+}
+
 func typeTxField(ops *OpStream, args []string) (StackTypes, StackTypes) {
 	if len(args) != 1 {
 		return oneAny, nil
@@ -1444,17 +1648,20 @@ func typeTxField(ops *OpStream, args []string) (StackTypes, StackTypes) {
 // keywords handle parsing and assembling special asm language constructs like 'addr'
 // We use OpSpec here, but somewhat degenerate, since they don't have opcodes or eval functions
 var keywords = map[string]OpSpec{
-	"int":  {0, "int", nil, assembleInt, nil, nil, oneInt, 1, modeAny, opDetails{1, 2, nil, nil, nil}},
-	"byte": {0, "byte", nil, assembleByte, nil, nil, oneBytes, 1, modeAny, opDetails{1, 2, nil, nil, nil}},
+	"int":  {0, "int", nil, assembleInt, nil, nil, oneInt, 1, modeAny, opDetails{1, 2, nil, nil, nil, false}},
+	"byte": {0, "byte", nil, assembleByte, nil, nil, oneBytes, 1, modeAny, opDetails{1, 2, nil, nil, nil, false}},
 	// parse basics.Address, actually just another []byte constant
-	"addr": {0, "addr", nil, assembleAddr, nil, nil, oneBytes, 1, modeAny, opDetails{1, 2, nil, nil, nil}},
+	"addr": {0, "addr", nil, assembleAddr, nil, nil, oneBytes, 1, modeAny, opDetails{1, 2, nil, nil, nil, false}},
 	// take a signature, hash it, and take first 4 bytes, actually just another []byte constant
-	"method": {0, "method", nil, assembleMethod, nil, nil, oneBytes, 1, modeAny, opDetails{1, 2, nil, nil, nil}},
+	"method": {0, "method", nil, assembleMethod, nil, nil, oneBytes, 1, modeAny, opDetails{1, 2, nil, nil, nil, false}},
 }
 
 type lineError struct {
-	Line int
-	Err  error
+	// Source names the included source the error was found in, and is empty
+	// for the assembled text itself.
+	Source string
+	Line   int
+	Err    error
 }
 
 func (le *lineError) Error() string {
@@ -1610,12 +1817,46 @@ func (ops *OpStream) assemble(fin io.Reader) error {
 	if ops.Version > LogicVersion && ops.Version != assemblerNoVersion {
 		return ops.errorf("Can not assemble version %d", ops.Version)
 	}
+	ops.assembleSource(fin)
+
+	// backward compatibility: do not allow jumps behind last instruction in TEAL v1
+	if ops.Version <= 1 {
+		for label, dest := range ops.labels {
+			if dest == ops.pending.Len() {
+				ops.errorf("label %#v is too far away", label)
+			}
+		}
+	}
+
+	if ops.Version >= optimizeConstantsEnabledVersion {
+		ops.optimizeIntcBlock()
+		ops.optimizeBytecBlock()
+	}
+
+	// TODO: warn if expected resulting stack is not len==1 ?
+	ops.resolveLabels()
+	program := ops.prependCBlocks()
+	if ops.Errors != nil {
+		l := len(ops.Errors)
+		if l == 1 {
+			return errors.New("1 error")
+		}
+		return fmt.Errorf("%d errors", l)
+	}
+	ops.Program = program
+	return nil
+}
+
+// assembleSource reads the lines of a single source, which is either the
+// assembled text or an included source, and accumulates the program
+func (ops *OpStream) assembleSource(fin io.Reader) {
 	scanner := bufio.NewScanner(fin)
 	ops.sourceLine = 0
 	for scanner.Scan() {
 		ops.sourceLine++
-		line := scanner.Text()
-		line = strings.TrimSpace(line)
+		rawLine := scanner.Text()
+		line := strings.TrimSpace(rawLine)
+		ops.sourceColumn = strings.Index(rawLine, line)
 		if len(line) == 0 {
 			ops.trace("%d: 0 line\n", ops.sourceLine)
 			continue
@@ -1629,6 +1870,16 @@ func (ops *OpStream) assemble(fin io.Reader) error {
 			ops.pragma(line)
 			continue
 		}
+		if strings.HasPrefix(line, "#define") {
+			ops.trace("%d: #define line\n", ops.sourceLine)
+			ops.define(line)
+			continue
+		}
+		if strings.HasPrefix(line, "#include") {
+			ops.trace("%d: #include line\n", ops.sourceLine)
+			ops.include(line)
+			continue
+		}
 		fields := fieldsFromLine(line)
 		if len(fields) == 0 {
 			ops.trace("%d: no fields\n", ops.sourceLine)
@@ -1647,6 +1898,15 @@ func (ops *OpStream) assemble(fin io.Reader) error {
 				// There was a label, not need to ops.trace this
 				continue
 			}
+			ops.sourceColumn += len(opstring) + strings.Index(line[len(opstring):], fields[0])
+			opstring = fields[0]
+		}
+
+		if len(ops.macros) > 0 {
+			fields = ops.expandMacros(fields)
+			if len(fields) == 0 {
+				continue
+			}
 			opstring = fields[0]
 		}
 
@@ -1683,33 +1943,6 @@ func (ops *OpStream) assemble(fin io.Reader) error {
 			ops.errorf("unknown opcode: %s", opstring)
 		}
 	}
-
-	// backward compatibility: do not allow jumps behind last instruction in TEAL v1
-	if ops.Version <= 1 {
-		for label, dest := range ops.labels {
-			if dest == ops.pending.Len() {
-				ops.errorf("label %#v is too far away", label)
-			}
-		}
-	}
-
-	if ops.Version >= optimizeConstantsEnabledVersion {
-		ops.optimizeIntcBlock()
-		ops.optimizeBytecBlock()
-	}
-
-	// TODO: warn if expected resulting stack is not len==1 ?
-	ops.resolveLabels()
-	program := ops.prependCBlocks()
-	if ops.Errors != nil {
-		l := len(ops.Errors)
-		if l == 1 {
-			return errors.New("1 error")
-		}
-		return fmt.Errorf("%d errors", l)
-	}
-	ops.Program = program
-	return nil
 }
 
 func (ops *OpStream) pragma(line string) error {
@@ -1755,12 +1988,140 @@ func (ops *OpStream) pragma(line string) error {
 	}
 }
 
+// define records a #define directive, which names a sequence of fields
+// substituted wherever the name later appears as a field of an instruction:
+//
+//	#define FEE 1000
+//	#define PAY_TO addr AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ
+//	int FEE
+//	PAY_TO
+//
+// Macros used in the value are expanded at definition time, so a macro can
+// not refer to itself.
+func (ops *OpStream) define(line string) error {
+	fields := fieldsFromLine(line)
+	if fields[0] != "#define" {
+		return ops.errorf("unknown directive: %s", fields[0])
+	}
+	if len(fields) < 2 {
+		return ops.error("#define needs a name")
+	}
+	name := fields[1]
+	if !isMacroName(name) {
+		return ops.errorf("invalid #define name: %#v", name)
+	}
+	if _, ok := OpsByName[AssemblerMaxVersion][name]; ok {
+		return ops.errorf("#define name %#v is an opcode", name)
+	}
+	if _, ok := keywords[name]; ok {
+		return ops.errorf("#define name %#v is an opcode", name)
+	}
+	value := ops.expandMacros(fields[2:])
+	if prev, ok := ops.macros[name]; ok {
+		// an identical definition, as from a source included twice, is fine
+		if strings.Join(prev, " ") != strings.Join(value, " ") {
+			return ops.errorf("#define %s redefined with a different value", name)
+		}
+		return nil
+	}
+	if ops.macros == nil {
+		ops.macros = make(map[string][]string)
+	}
+	ops.macros[name] = value
+	return nil
+}
+
+func isMacroName(name string) bool {
+	for i, c := range name {
+		letter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		digit := c >= '0' && c <= '9'
+		if !letter && (i == 0 || !digit) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+// expandMacros replaces the fields naming a macro by the macro value.
+func (ops *OpStream) expandMacros(fields []string) []string {
+	expanded := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if value, ok := ops.macros[field]; ok {
+			expanded = append(expanded, value...)
+		} else {
+			expanded = append(expanded, field)
+		}
+	}
+	return expanded
+}
+
+// maxIncludeDepth bounds the nesting of #include directives.
+const maxIncludeDepth = 16
+
+// IncludeResolver loads the source named by an #include directive found in
+// the source named from. It returns the name identifying the included source,
+// which errors and source maps refer to and which is passed as from for the
+// directives of the included source.
+type IncludeResolver func(from string, name string) (resolved string, text []byte, err error)
+
+// include assembles the source named by an #include directive in place of
+// the directive.
+func (ops *OpStream) include(line string) error {
+	fields := fieldsFromLine(line)
+	if fields[0] != "#include" {
+		return ops.errorf("unknown directive: %s", fields[0])
+	}
+	if len(fields) != 2 {
+		return ops.error("#include needs a single quoted source name")
+	}
+	name, err := strconv.Unquote(fields[1])
+	if err != nil || name == "" {
+		return ops.errorf("#include needs a single quoted source name: %s", fields[1])
+	}
+	if ops.resolveInclude == nil {
+		return ops.errorf("#include %#v: includes can not be resolved here", name)
+	}
+	if len(ops.includeStack) > maxIncludeDepth {
+		return ops.errorf("#include %#v: more than %d nested includes", name, maxIncludeDepth)
+	}
+
+	resolved, text, err := ops.resolveInclude(ops.includeStack[len(ops.includeStack)-1], name)
+	if err != nil {
+		return ops.errorf("#include %#v: %v", name, err)
+	}
+	for _, including := range ops.includeStack {
+		if including == resolved {
+			return ops.errorf("#include %#v: %s includes itself", name, resolved)
+		}
+	}
+
+	index := 0
+	for i, included := range ops.IncludedSources {
+		if included == resolved {
+			index = i + 1
+			break
+		}
+	}
+	if index == 0 {
+		ops.IncludedSources = append(ops.IncludedSources, resolved)
+		index = len(ops.IncludedSources)
+	}
+
+	savedLine, savedIndex := ops.sourceLine, ops.sourceIndex
+	ops.includeStack = append(ops.includeStack, resolved)
+	ops.sourceIndex = index
+	ops.assembleSource(bytes.NewReader(text))
+	ops.includeStack = ops.includeStack[:len(ops.includeStack)-1]
+	ops.sourceLine, ops.sourceIndex = savedLine, savedIndex
+	return nil
+}
+
 func (ops *OpStream) resolveLabels() {
-	saved := ops.sourceLine
+	saved, savedIndex := ops.sourceLine, ops.sourceIndex
 	raw := ops.pending.Bytes()
 	reported := make(map[string]bool)
 	for _, lr := range ops.labelReferences {
-		ops.sourceLine = lr.sourceLine
+		ops.sourceLine, ops.sourceIndex = lr.sourceLine, lr.sourceIndex
 		dest, ok := ops.labels[lr.label]
 		if !ok {
 			if !reported[lr.label] {
@@ -1769,8 +2130,8 @@ func (ops *OpStream) resolveLabels() {
 			reported[lr.label] = true
 			continue
 		}
-		// all branch instructions (currently) are opcode byte and 2 offset bytes, and the destination is relative to the next pc as if the branch was a no-op
-		naturalPc := lr.position + 3
+		// the destination is relative to the next pc as if the branch was a no-op
+		naturalPc := lr.position + lr.size
 		if ops.Version < backBranchEnabledVersion && dest < naturalPc {
 			ops.errorf("label %#v is a back reference, back jump support was introduced in TEAL v4", lr.label)
 			continue
@@ -1780,11 +2141,11 @@ func (ops *OpStream) resolveLabels() {
 			ops.errorf("label %#v is too far away", lr.label)
 			continue
 		}
-		raw[lr.position+1] = uint8(jump >> 8)
-		raw[lr.position+2] = uint8(jump & 0x0ff)
+		raw[lr.position+lr.offsetPosition] = uint8(jump >> 8)
+		raw[lr.position+lr.offsetPosition+1] = uint8(jump & 0x0ff)
 	}
 	ops.pending = *bytes.NewBuffer(raw)
-	ops.sourceLine = saved
+	ops.sourceLine, ops.sourceIndex = saved, savedIndex
 }
 
 // AssemblerDefaultVersion what version of code do we emit by default
@@ -1934,7 +2295,7 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 		if !found {
-			err = ops.lineErrorf(ops.OffsetToLine[ref.getPosition()], "Value not found in constant block: %v", ref.getValue())
+			err = ops.offsetErrorf(ref.getPosition(), "Value not found in constant block: %v", ref.getValue())
 			return
 		}
 	}
@@ -1972,7 +2333,7 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 		if newIndex == -1 {
-			return nil, ops.lineErrorf(ops.OffsetToLine[ref.getPosition()], "Value not found in constant block: %v", ref.getValue())
+			return nil, ops.offsetErrorf(ref.getPosition(), "Value not found in constant block: %v", ref.getValue())
 		}
 
 		newBytes := ref.makeNewReference(ops, singleton, newIndex)
@@ -2012,15 +2373,15 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 
-		fixedOffsetsToLine := make(map[int]int, len(ops.OffsetToLine))
-		for pos, sourceLine := range ops.OffsetToLine {
+		fixOffset := func(pos int) int {
 			if pos > position {
-				fixedOffsetsToLine[pos+positionDelta] = sourceLine
-			} else {
-				fixedOffsetsToLine[pos] = sourceLine
+				return pos + positionDelta
 			}
+			return pos
 		}
-		ops.OffsetToLine = fixedOffsetsToLine
+		ops.OffsetToLine = shiftOffsets(ops.OffsetToLine, fixOffset)
+		ops.OffsetToColumn = shiftOffsets(ops.OffsetToColumn, fixOffset)
+		ops.OffsetToSource = shiftOffsets(ops.OffsetToSource, fixOffset)
 	}
 
 	ops.pending = *bytes.NewBuffer(raw)
@@ -2077,11 +2438,10 @@ func (ops *OpStream) prependCBlocks() []byte {
 	}
 
 	// fixup offset to line mapping
-	newOffsetToLine := make(map[int]int, len(ops.OffsetToLine))
-	for o, l := range ops.OffsetToLine {
-		newOffsetToLine[o+pbl] = l
-	}
-	ops.OffsetToLine = newOffsetToLine
+	prepend := func(o int) int { return o + pbl }
+	ops.OffsetToLine = shiftOffsets(ops.OffsetToLine, prepend)
+	ops.OffsetToColumn = shiftOffsets(ops.OffsetToColumn, prepend)
+	ops.OffsetToSource = shiftOffsets(ops.OffsetToSource, prepend)
 
 	return out
 }
@@ -2091,19 +2451,38 @@ func (ops *OpStream) error(problem interface{}) error {
 }
 
 func (ops *OpStream) lineError(line int, problem interface{}) error {
-	var le *lineError
+	return ops.sourceLineError(ops.sourceIndex, line, problem)
+}
+
+func (ops *OpStream) sourceLineError(source int, line int, problem interface{}) error {
+	le := &lineError{Source: ops.sourceName(source), Line: line}
 	switch p := problem.(type) {
 	case string:
-		le = &lineError{Line: line, Err: errors.New(p)}
+		le.Err = errors.New(p)
 	case error:
-		le = &lineError{Line: line, Err: p}
+		le.Err = p
 	default:
-		le = &lineError{Line: line, Err: fmt.Errorf("%#v", p)}
+		le.Err = fmt.Errorf("%#v", p)
 	}
 	ops.Errors = append(ops.Errors, le)
 	return le
 }
 
+// offsetErrorf reports an error against the source line of the opcode
+// assembled at offset.
+func (ops *OpStream) offsetErrorf(offset int, format string, a ...interface{}) error {
+	return ops.sourceLineError(ops.OffsetToSource[offset], ops.OffsetToLine[offset], fmt.Errorf(format, a...))
+}
+
+// sourceName returns the name of an included source, or the empty string
+// for the assembled text.
+func (ops *OpStream) sourceName(source int) string {
+	if source == 0 {
+		return ""
+	}
+	return ops.IncludedSources[source-1]
+}
+
 func (ops *OpStream) errorf(format string, a ...interface{}) error {
 	return ops.error(fmt.Errorf(format, a...))
 }
@@ -2113,14 +2492,14 @@ func (ops *OpStream) lineErrorf(line int, format string, a ...interface{}) error
 }
 
 func (ops *OpStream) warn(problem interface{}) error {
-	var le *lineError
+	le := &lineError{Source: ops.sourceName(ops.sourceIndex), Line: ops.sourceLine}
 	switch p := problem.(type) {
 	case string:
-		le = &lineError{Line: ops.sourceLine, Err: errors.New(p)}
+		le.Err = errors.New(p)
 	case error:
-		le = &lineError{Line: ops.sourceLine, Err: p}
+		le.Err = p
 	default:
-		le = &lineError{Line: ops.sourceLine, Err: fmt.Errorf("%#v", p)}
+		le.Err = fmt.Errorf("%#v", p)
 	}
 	warning := fmt.Errorf("warning: %w", le)
 	ops.Warnings = append(ops.Warnings, warning)
@@ -2131,26 +2510,31 @@ func (ops *OpStream) warnf(format string, a ...interface{}) error {
 }
 
 // ReportProblems issues accumulated warnings and outputs errors to an io.Writer.
+// Problems found in included sources are reported against their own names.
 func (ops *OpStream) ReportProblems(fname string, writer io.Writer) {
 	for i, e := range ops.Errors {
 		if i > 9 {
 			break
 		}
-		if fname == "" {
-			fmt.Fprintf(writer, "%s\n", e)
-		} else {
-			fmt.Fprintf(writer, "%s: %s\n", fname, e)
-		}
+		reportProblem(writer, fname, e)
 	}
 	for i, w := range ops.Warnings {
 		if i > 9 {
 			break
 		}
-		if fname == "" {
-			fmt.Fprintf(writer, "%s\n", w)
-		} else {
-			fmt.Fprintf(writer, "%s: %s\n", fname, w)
-		}
+		reportProblem(writer, fname, w)
+	}
+}
+
+func reportProblem(writer io.Writer, fname string, problem error) {
+	var le *lineError
+	if errors.As(problem, &le) && le.Source != "" {
+		fname = le.Source
+	}
+	if fname == "" {
+		fmt.Fprintf(writer, "%s\n", problem)
+	} else {
+		fmt.Fprintf(writer, "%s: %s\n", fname, problem)
 	}
 }
 
@@ -2173,6 +2557,16 @@ func AssembleStringWithVersion(text string, version uint64) (*OpStream, error) {
 	return &ops, err
 }
 
+// AssembleStringWithIncludes assembles the program held by text, read from
+// the source named name, loading the sources of its #include directives with
+// resolve. The version is set by #pragma version as with AssembleString.
+func AssembleStringWithIncludes(name string, text string, resolve IncludeResolver) (*OpStream, error) {
+	sr := strings.NewReader(text)
+	ops := OpStream{Version: assemblerNoVersion, resolveInclude: resolve, includeStack: []string{name}}
+	err := ops.assemble(sr)
+	return &ops, err
+}
+
 type disassembleState struct {
 	program []byte
 	pc      int
@@ -2226,6 +2620,10 @@ func disDefault(dis *disassembleState, spec *OpSpec) (string, error) {
 	dis.nextpc = dis.pc + spec.Details.Size
 	out := spec.Name
 	for s := 1; s < spec.Details.Size; s++ {
+		if spec.Details.Immediates[s-1].kind == immInt8 {
+			out += fmt.Sprintf(" %d", int8(dis.program[dis.pc+s]))
+			continue
+		}
 		b := uint(dis.program[dis.pc+s])
 		out += fmt.Sprintf(" %d", b)
 	}
@@ -2546,7 +2944,7 @@ func disTxna(dis *disassembleState, spec *OpSpec) (string, error) {
 	return fmt.Sprintf("%s %s %d", spec.Name, TxnFieldNames[txarg], arrayFieldIdx), nil
 }
 
-// This is also used to disassemble gtxnas
+// This is also used to disassemble gtxnas, gitxn
 func disGtxn(dis *disassembleState, spec *OpSpec) (string, error) {
 	lastIdx := dis.pc + 2
 	if len(dis.program) <= lastIdx {
@@ -2562,6 +2960,7 @@ func disGtxn(dis *disassembleState, spec *OpSpec) (string, error) {
 	return fmt.Sprintf("%s %d %s", spec.Name, gi, TxnFieldNames[txarg]), nil
 }
 
+// This is also used to disassemble gitxna
 func disGtxna(dis *disassembleState, spec *OpSpec) (string, error) {
 	lastIdx := dis.pc + 3
 	if len(dis.program) <= lastIdx {
@@ -2575,7 +2974,7 @@ func disGtxna(dis *disassembleState, spec *OpSpec) (string, error) {
 		return "", fmt.Errorf("invalid txn arg index %d at pc=%d", txarg, dis.pc)
 	}
 	arrayFieldIdx := dis.program[dis.pc+3]
-	return fmt.Sprintf("gtxna %d %s %d", gi, TxnFieldNames[txarg], arrayFieldIdx), nil
+	return fmt.Sprintf("%s %d %s %d", spec.Name, gi, TxnFieldNames[txarg], arrayFieldIdx), nil
 }
 
 func disGlobal(dis *disassembleState, spec *OpSpec) (string, error) {
@@ -2600,8 +2999,14 @@ func disBranch(dis *disassembleState, spec *OpSpec) (string, error) {
 	}
 
 	dis.nextpc = dis.pc + 3
-	offset := (uint(dis.program[dis.pc+1]) << 8) | uint(dis.program[dis.pc+2])
-	target := int(offset) + dis.pc + 3
+	return fmt.Sprintf("%s %s", spec.Name, dis.targetLabel(dis.pc+1, dis.nextpc)), nil
+}
+
+// targetLabel names the destination of the int16 offset at pos, which is
+// relative to base. A label is created if none is known yet.
+func (dis *disassembleState) targetLabel(pos int, base int) string {
+	offset := (uint(dis.program[pos]) << 8) | uint(dis.program[pos+1])
+	target := int(offset) + base
 	if target > 0xffff {
 		target -= 0x10000
 	}
@@ -2617,7 +3022,26 @@ func disBranch(dis *disassembleState, spec *OpSpec) (string, error) {
 			dis.putLabel(label, target)
 		}
 	}
-	return fmt.Sprintf("%s %s", spec.Name, label), nil
+	return label
+}
+
+func disSwitch(dis *disassembleState, spec *OpSpec) (string, error) {
+	if len(dis.program) <= dis.pc+1 {
+		return "", fmt.Errorf("unexpected %s opcode end: missing 1 bytes", spec.Name)
+	}
+	n := int(dis.program[dis.pc+1])
+	lastIdx := dis.pc + 1 + 2*n
+	if len(dis.program) <= lastIdx {
+		missing := lastIdx - len(dis.program) + 1
+		return "", fmt.Errorf("unexpected %s opcode end: missing %d bytes", spec.Name, missing)
+	}
+
+	dis.nextpc = lastIdx + 1
+	out := spec.Name
+	for i := 0; i < n; i++ {
+		out += " " + dis.targetLabel(dis.pc+2+2*i, dis.nextpc)
+	}
+	return out, nil
 }
 
 func disAssetHolding(dis *disassembleState, spec *OpSpec) (string, error) {
@@ -2662,6 +3086,20 @@ func disAppParams(dis *disassembleState, spec *OpSpec) (string, error) {
 	return fmt.Sprintf("%s %s", spec.Name, AppParamsFieldNames[arg]), nil
 }
 
+func disAcctParams(dis *disassembleState, spec *OpSpec) (string, error) {
+	lastIdx := dis.pc + 1
+	if len(dis.program) <= lastIdx {
+		missing := lastIdx - len(dis.program) + 1
+		return "", fmt.Errorf("unexpected %s opcode end: missing %d bytes", spec.Name, missing)
+	}
+	dis.nextpc = dis.pc + 2
+	arg := dis.program[dis.pc+1]
+	if int(arg) >= len(AcctParamsFieldNames) {
+		return "", fmt.Errorf("invalid acct params arg index %d at pc=%d", arg, dis.pc)
+	}
+	return fmt.Sprintf("%s %s", spec.Name, AcctParamsFieldNames[arg]), nil
+}
+
 func disTxField(dis *disassembleState, spec *OpSpec) (string, error) {
 	lastIdx := dis.pc + 1
 	if len(dis.program) <= lastIdx {
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"path"
 	"strings"
 	"testing"
 
@@ -345,6 +346,34 @@ itxna Logs 3
 const v6Nonsense = v5Nonsense + `
 itxn_next
 base64_decode URLEncoding
+gitxn 4 CreatedAssetID
+gitxna 3 Logs 12
+`
+
+const v7Nonsense = v6Nonsense + `
+box_create
+box_extract
+box_replace
+box_del
+box_len
+box_get
+box_put
+acct_params_get AcctMinBalance
+increase_budget
+bury 1
+popn 1
+dupn 1
+pushint 1
+switch done1 done2
+pushint 1
+match done1 done2
+done1:
+done2:
+callsub sub
+sub:
+proto 1 1
+frame_dig -1
+frame_bury 0
 `
 
 var nonsense = map[uint64]string{
@@ -354,6 +383,7 @@ var nonsense = map[uint64]string{
 	4: v4Nonsense,
 	5: v5Nonsense,
 	6: v6Nonsense,
+	7: v7Nonsense,
 }
 
 var compiled = map[uint64]string{
@@ -362,7 +392,8 @@ var compiled = map[uint64]string{
 	3: "032008b7a60cf8acd19181cf959a12f8acd19181cf951af8acd19181cf15f8acd191810f01020026050212340c68656c6c6f20776f726c6421208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d024242047465737400320032013202320328292929292a0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e0102222324252104082209240a220b230c240d250e230f23102311231223132314181b1c2b171615400003290349483403350222231d4a484848482a50512a63222352410003420000432105602105612105270463484821052b62482b642b65484821052b2106662b21056721072b682b692107210570004848210771004848361c0037001a0031183119311b311d311e311f3120210721051e312131223123312431253126312731283129312a312b312c312d312e312f4478222105531421055427042106552105082106564c4d4b02210538212106391c0081e80780046a6f686e",
 	4: "042004010200b7a60c26040242420c68656c6c6f20776f726c6421208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d047465737400320032013202320380021234292929292a0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e01022581f8acd19181cf959a1281f8acd19181cf951a81f8acd19181cf1581f8acd191810f082209240a220b230c240d250e230f23102311231223132314181b1c28171615400003290349483403350222231d4a484848482a50512a632223524100034200004322602261222b634848222862482864286548482228236628226724286828692422700048482471004848361c0037001a0031183119311b311d311e311f312024221e312131223123312431253126312731283129312a312b312c312d312e312f44782522531422542b2355220823564c4d4b0222382123391c0081e80780046a6f686e2281d00f24231f880003420001892223902291922394239593a0a1a2a3a4a5a6a7a8a9aaabacadae23af3a00003b003c003d8164",
 	5: "052004010002b7a60c26050242420c68656c6c6f20776f726c6421070123456789abcd208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d047465737400320032013202320380021234292929292b0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e01022581f8acd19181cf959a1281f8acd19181cf951a81f8acd19181cf1581f8acd191810f082209240a220b230c240d250e230f23102311231223132314181b1c28171615400003290349483403350222231d4a484848482b50512a632223524100034200004322602261222704634848222862482864286548482228246628226723286828692322700048482371004848361c0037001a0031183119311b311d311e311f312023221e312131223123312431253126312731283129312a312b312c312d312e312f447825225314225427042455220824564c4d4b0222382124391c0081e80780046a6f686e2281d00f23241f880003420001892224902291922494249593a0a1a2a3a4a5a6a7a8a9aaabacadae24af3a00003b003c003d816472064e014f012a57000823810858235b235a2359b03139330039b1b200b322c01a23c1001a2323c21a23c3233e233f8120af06002a494905002a49490700b53a03",
-	6: "062004010002b7a60c26050242420c68656c6c6f20776f726c6421070123456789abcd208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d047465737400320032013202320380021234292929292b0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e01022581f8acd19181cf959a1281f8acd19181cf951a81f8acd19181cf1581f8acd191810f082209240a220b230c240d250e230f23102311231223132314181b1c28171615400003290349483403350222231d4a484848482b50512a632223524100034200004322602261222704634848222862482864286548482228246628226723286828692322700048482371004848361c0037001a0031183119311b311d311e311f312023221e312131223123312431253126312731283129312a312b312c312d312e312f447825225314225427042455220824564c4d4b0222382124391c0081e80780046a6f686e2281d00f23241f880003420001892224902291922494249593a0a1a2a3a4a5a6a7a8a9aaabacadae24af3a00003b003c003d816472064e014f012a57000823810858235b235a2359b03139330039b1b200b322c01a23c1001a2323c21a23c3233e233f8120af06002a494905002a49490700b53a03b65c00",
+	6: "062004010002b7a60c26050242420c68656c6c6f20776f726c6421070123456789abcd208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d047465737400320032013202320380021234292929292b0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e01022581f8acd19181cf959a1281f8acd19181cf951a81f8acd19181cf1581f8acd191810f082209240a220b230c240d250e230f23102311231223132314181b1c28171615400003290349483403350222231d4a484848482b50512a632223524100034200004322602261222704634848222862482864286548482228246628226723286828692322700048482371004848361c0037001a0031183119311b311d311e311f312023221e312131223123312431253126312731283129312a312b312c312d312e312f447825225314225427042455220824564c4d4b0222382124391c0081e80780046a6f686e2281d00f23241f880003420001892224902291922494249593a0a1a2a3a4a5a6a7a8a9aaabacadae24af3a00003b003c003d816472064e014f012a57000823810858235b235a2359b03139330039b1b200b322c01a23c1001a2323c21a23c3233e233f8120af06002a494905002a49490700b53a03b65c00b7043cb8033a0c",
+	7: "072004010002b7a60c26050242420c68656c6c6f20776f726c6421070123456789abcd208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d047465737400320032013202320380021234292929292b0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e01022581f8acd19181cf959a1281f8acd19181cf951a81f8acd19181cf1581f8acd191810f082209240a220b230c240d250e230f23102311231223132314181b1c28171615400003290349483403350222231d4a484848482b50512a632223524100034200004322602261222704634848222862482864286548482228246628226723286828692322700048482371004848361c0037001a0031183119311b311d311e311f312023221e312131223123312431253126312731283129312a312b312c312d312e312f447825225314225427042455220824564c4d4b0222382124391c0081e80780046a6f686e2281d00f23241f880003420001892224902291922494249593a0a1a2a3a4a5a6a7a8a9aaabacadae24af3a00003b003c003d816472064e014f012a57000823810858235b235a2359b03139330039b1b200b322c01a23c1001a2323c21a23c3233e233f8120af06002a494905002a49490700b53a03b65c00b7043cb8033a0cb9babbbcbdbebf7301d045014601470181018d020008000881018e02000000008800008a01018bff8c00",
 }
 
 func pseudoOp(opcode string) bool {
@@ -1801,6 +1832,34 @@ label1:
 	}
 }
 
+func TestDisassembleSwitch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t.Parallel()
+
+	source := `#pragma version 7
+pushint 1
+label1:
+switch label1 label2 label2
+pushint 1
+pushint 2
+match label2
+frame_dig -3
+frame_bury 2
+label2:
+`
+	ops, err := AssembleStringWithVersion(source, 7)
+	require.NoError(t, err)
+	dis, err := Disassemble(ops.Program)
+	require.NoError(t, err)
+	require.Equal(t, source, dis)
+
+	// truncated label offsets are reported
+	_, err = Disassemble(ops.Program[:8])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected switch opcode end: missing 3 bytes")
+}
+
 func TestAssembleOffsets(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -2294,6 +2353,59 @@ func TestUncoverAsm(t *testing.T) {
 	testProg(t, `int 4; byte "ayush"; int 5; uncover 1; +`, AssemblerMaxVersion, expect{5, "+ arg 1..."})
 }
 
+func TestBuryAsm(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testProg(t, `int 4; byte "john"; int 5; bury 1; +`, AssemblerMaxVersion)
+	testProg(t, `int 4; int 5; byte "john"; bury 1; +`, AssemblerMaxVersion, expect{5, "+ arg 1..."})
+	testProg(t, `int 4; bury 1`, AssemblerMaxVersion, expect{2, "bury 1 expects 2 stack arguments..."})
+	testProg(t, `int 4; int 5; bury 2`, AssemblerMaxVersion, expect{3, "bury 2 expects 3 stack arguments..."})
+}
+
+func TestPopNDupNAsm(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testProg(t, `int 1; byte "x"; popn 1; int 2; +`, AssemblerMaxVersion)
+	testProg(t, `int 1; popn 2`, AssemblerMaxVersion, expect{2, "popn 2 expects 2 stack arguments..."})
+	testProg(t, `int 1; dupn 2; +; +`, AssemblerMaxVersion)
+	testProg(t, `byte "x"; dupn 2; +`, AssemblerMaxVersion, expect{3, "...wanted type uint64 got []byte"})
+	testProg(t, `dupn 2`, AssemblerMaxVersion, expect{1, "dupn 2 expects 1 stack argument..."})
+}
+
+func TestFrameAsm(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testProg(t, "proto 1", AssemblerMaxVersion, expect{1, "proto expects 2 immediate arguments"})
+	testProg(t, "proto 2 256", AssemblerMaxVersion, expect{1, "proto outside 0..255: 256"})
+	testProg(t, "frame_dig -1; frame_bury 127; frame_dig -128", AssemblerMaxVersion)
+	testProg(t, "frame_dig 128", AssemblerMaxVersion, expect{1, "frame_dig outside -128..127: 128"})
+	testProg(t, "int 1; frame_bury -129", AssemblerMaxVersion, expect{2, "frame_bury outside -128..127: -129"})
+	testProg(t, "frame_bury 1", AssemblerMaxVersion, expect{1, "frame_bury 1 expects 1 stack argument..."})
+
+	// proto starts a subroutine, so the stack holds only its (untyped) arguments
+	testProg(t, `byte "x"; proto 1 1; int 1; +`, AssemblerMaxVersion)
+	testProg(t, `int 1; proto 0 1; int 1; +`, AssemblerMaxVersion, expect{4, "+ expects 2 stack arguments..."})
+}
+
+func TestSwitchAsm(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	ops := testProg(t, "pushint 1; switch a b; a:; b:; pushint 1", AssemblerMaxVersion)
+	require.Equal(t, "0781018d02000000008101", hex.EncodeToString(ops.Program))
+	ops = testProg(t, "pushint 1; a: switch a b; b:", AssemblerMaxVersion)
+	require.Equal(t, "0781018d02fffa0000", hex.EncodeToString(ops.Program))
+	testProg(t, "int 1; switch", AssemblerMaxVersion)
+	testProg(t, "int 1; switch a", AssemblerMaxVersion, expect{2, "reference to undefined label \"a\""})
+	testProg(t, `byte "x"; switch`, AssemblerMaxVersion, expect{2, "...wanted type uint64 got []byte"})
+
+	labels := strings.Repeat(" a", 255)
+	testProg(t, "int 1; switch"+labels+"; a:", AssemblerMaxVersion)
+	testProg(t, "int 1; switch"+labels+" a; a:", AssemblerMaxVersion, expect{2, "switch cannot take more than 255 labels"})
+
+	testProg(t, "int 1; int 2; int 3; match a b; a:; b:", AssemblerMaxVersion)
+	testProg(t, "int 1; int 2; match a b; a:; b:", AssemblerMaxVersion, expect{3, "match a b expects 3 stack arguments..."})
+}
+
 func TestTxTypes(t *testing.T) {
 	testProg(t, "itxn_begin; itxn_field Sender", 5, expect{2, "itxn_field Sender expects 1 stack argument..."})
 	testProg(t, "itxn_begin; int 1; itxn_field Sender", 5, expect{3, "...wanted type []byte got uint64"})
@@ -2303,3 +2415,117 @@ func TestTxTypes(t *testing.T) {
 	testProg(t, "itxn_begin; byte 0x87123376; itxn_field Amount", 5, expect{3, "...wanted type uint64 got []byte"})
 	testProg(t, "itxn_begin; int 1; itxn_field Amount", 5)
 }
+
+func TestAssembleDefine(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source := `#pragma version 5
+#define FEE 1000
+#define GREETING "hello world"
+#define MAX_FEE FEE
+#define PUSH_FEE int FEE
+int FEE
+byte GREETING
+len
+PUSH_FEE
+int MAX_FEE
+==
+&&
+`
+	ops := testProg(t, source, assemblerNoVersion)
+	expected := testProg(t, `#pragma version 5
+int 1000
+byte "hello world"
+len
+int 1000
+int 1000
+==
+&&
+`, assemblerNoVersion)
+	require.Equal(t, expected.Program, ops.Program)
+
+	testProg(t, "#define", AssemblerMaxVersion, expect{1, "#define needs a name"})
+	testProg(t, "#define 1FEE 1000", AssemblerMaxVersion, expect{1, "invalid #define name: \"1FEE\""})
+	testProg(t, "#define int 1", AssemblerMaxVersion, expect{1, "#define name \"int\" is an opcode"})
+	testProg(t, "#define sha256 1", AssemblerMaxVersion, expect{1, "#define name \"sha256\" is an opcode"})
+	testProg(t, "#defined FEE 1", AssemblerMaxVersion, expect{1, "unknown directive: #defined"})
+	testProg(t, "#define FEE 1; #define FEE 1; int FEE", AssemblerMaxVersion)
+	testProg(t, "#define FEE 1; #define FEE 2; int FEE", AssemblerMaxVersion, expect{2, "#define FEE redefined with a different value"})
+}
+
+func TestAssembleInclude(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sources := map[string]string{
+		"lib/consts.teal": "#define FEE 1000\n#define RECEIVER addr AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ\n",
+		"lib/check.teal":  "#include \"consts.teal\"\ntxn Fee\nint FEE\n<=\n",
+		"lib/bad.teal":    "int 1\nnot_an_op\n",
+		"lib/loop.teal":   "#include \"loop.teal\"\n",
+	}
+	resolve := func(from string, name string) (string, []byte, error) {
+		resolved := path.Join(path.Dir(from), name)
+		text, ok := sources[resolved]
+		if !ok {
+			return "", nil, fmt.Errorf("%s not found", resolved)
+		}
+		return resolved, []byte(text), nil
+	}
+
+	main := `#pragma version 5
+#include "lib/check.teal"
+#include "lib/consts.teal"
+txn Receiver
+RECEIVER
+==
+&&
+`
+	ops, err := AssembleStringWithIncludes("main.teal", main, resolve)
+	require.NoError(t, err, "%v", ops.Errors)
+	expected := testProg(t, `#pragma version 5
+txn Fee
+int 1000
+<=
+txn Receiver
+addr AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ
+==
+&&
+`, assemblerNoVersion)
+	require.Equal(t, expected.Program, ops.Program)
+	require.Equal(t, []string{"lib/check.teal", "lib/consts.teal"}, ops.IncludedSources)
+
+	// the opcodes are mapped to their own sources
+	sourceMap := ops.GetSourceMap("main.teal")
+	require.Equal(t, []string{"main.teal", "lib/check.teal", "lib/consts.teal"}, sourceMap.Sources)
+	for pc, line := range ops.OffsetToLine {
+		if ops.OffsetToSource[pc] == 1 {
+			require.Contains(t, []int{1, 2, 3}, line)
+		} else {
+			require.Equal(t, 0, ops.OffsetToSource[pc])
+			require.Contains(t, []int{3, 4, 5, 6}, line)
+		}
+	}
+
+	// errors are reported against the included source and line
+	ops, err = AssembleStringWithIncludes("main.teal", "#pragma version 5\n#include \"lib/bad.teal\"\n", resolve)
+	require.Error(t, err)
+	require.Len(t, ops.Errors, 1)
+	require.Equal(t, "lib/bad.teal", ops.Errors[0].Source)
+	require.Equal(t, 2, ops.Errors[0].Line)
+	var report strings.Builder
+	ops.ReportProblems("main.teal", &report)
+	require.Equal(t, "lib/bad.teal: 2: unknown opcode: not_an_op\n", report.String())
+
+	ops, err = AssembleStringWithIncludes("main.teal", "int 1\n#include \"missing.teal\"\n", resolve)
+	require.Error(t, err)
+	require.Equal(t, "", ops.Errors[0].Source)
+	require.Equal(t, 2, ops.Errors[0].Line)
+	require.Contains(t, ops.Errors[0].Error(), "missing.teal not found")
+
+	ops, err = AssembleStringWithIncludes("main.teal", "#include \"lib/loop.teal\"\n", resolve)
+	require.Error(t, err)
+	require.Equal(t, "lib/loop.teal", ops.Errors[0].Source)
+	require.Contains(t, ops.Errors[0].Error(), "lib/loop.teal includes itself")
+
+	testProg(t, "#include \"lib/check.teal\"", AssemblerMaxVersion, expect{1, "#include \"lib/check.teal\": includes can not be resolved here"})
+	testProg(t, "#include lib/check.teal", AssemblerMaxVersion, expect{1, "#include needs a single quoted source name: lib/check.teal"})
+}
@@ -0,0 +1,321 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// boxRef names a box that the transaction group made available
+type boxRef struct {
+	app  basics.AppIndex
+	name string
+}
+
+// availableBoxes collects the boxes named by the box references of the
+// application calls in the group. A reference to index 0 in a transaction
+// that creates an application names a box of the new app only in the
+// transaction being evaluated, since the other apps do not exist yet.
+func (cx *EvalContext) availableBoxes() map[boxRef]bool {
+	if cx.boxes != nil {
+		return cx.boxes
+	}
+	cx.boxes = make(map[boxRef]bool)
+	for gi := range cx.TxnGroup {
+		if uint64(gi) != cx.GroupIndex {
+			cx.addBoxRefs(&cx.TxnGroup[gi].Txn, cx.TxnGroup[gi].Txn.ApplicationID)
+		}
+	}
+	cx.addBoxRefs(&cx.Txn.Txn, cx.Ledger.ApplicationID())
+	return cx.boxes
+}
+
+// addBoxRefs adds the boxes referenced by txn, where index 0 stands for
+// the application self.
+func (cx *EvalContext) addBoxRefs(txn *transactions.Transaction, self basics.AppIndex) {
+	if txn.Type != protocol.ApplicationCallTx {
+		return
+	}
+	for _, br := range txn.Boxes {
+		app := self
+		if br.Index > 0 {
+			if br.Index > uint64(len(txn.ForeignApps)) {
+				continue
+			}
+			app = txn.ForeignApps[br.Index-1]
+		}
+		if app != 0 {
+			cx.boxes[boxRef{app, string(br.Name)}] = true
+		}
+	}
+}
+
+// checkBox verifies that name is a valid box name, available to the
+// current application.
+func (cx *EvalContext) checkBox(name string) error {
+	if cx.Ledger == nil {
+		return fmt.Errorf("ledger not available")
+	}
+	if len(name) == 0 {
+		return errors.New("box names may not be zero length")
+	}
+	if len(name) > cx.Proto.MaxAppKeyLen {
+		return fmt.Errorf("name too long: length was %d, maximum is %d", len(name), cx.Proto.MaxAppKeyLen)
+	}
+	if !cx.availableBoxes()[boxRef{cx.Ledger.ApplicationID(), name}] {
+		return fmt.Errorf("invalid Box reference %#x", name)
+	}
+	return nil
+}
+
+// boxRange checks that [start, start+length) lies within a box of the given size
+func boxRange(size int, start uint64, length uint64) (int, int, error) {
+	if start > math.MaxInt32 || length > math.MaxInt32 || start+length > uint64(size) {
+		return 0, 0, fmt.Errorf("extraction end %d is beyond length: %d", start+length, size)
+	}
+	return int(start), int(start + length), nil
+}
+
+func opBoxCreate(cx *EvalContext) {
+	last := len(cx.stack) - 1 // size
+	prev := last - 1          // name
+
+	name := string(cx.stack[prev].Bytes)
+	size := cx.stack[last].Uint
+
+	cx.err = cx.checkBox(name)
+	if cx.err != nil {
+		return
+	}
+	if size > cx.Proto.MaxBoxSize {
+		cx.err = fmt.Errorf("box size too large: %d, maximum is %d", size, cx.Proto.MaxBoxSize)
+		return
+	}
+
+	appIdx := cx.Ledger.ApplicationID()
+	value, exists, err := cx.Ledger.GetBox(appIdx, name)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	if exists {
+		if uint64(len(value)) != size {
+			cx.err = fmt.Errorf("box size mismatch %d %d", len(value), size)
+			return
+		}
+	} else {
+		appAddr, err := cx.getApplicationAddress()
+		if err != nil {
+			cx.err = err
+			return
+		}
+		value = make([]byte, size)
+		cx.err = cx.Ledger.NewBox(appIdx, name, value, appAddr)
+		if cx.err != nil {
+			return
+		}
+		cx.recordBoxChange(appIdx, name, value, false)
+	}
+
+	cx.stack[prev] = stackValue{Uint: boolToUint(!exists)}
+	cx.stack = cx.stack[:last]
+}
+
+func opBoxExtract(cx *EvalContext) {
+	last := len(cx.stack) - 1 // length
+	prev := last - 1          // start
+	pprev := prev - 1         // name
+
+	name := string(cx.stack[pprev].Bytes)
+	start := cx.stack[prev].Uint
+	length := cx.stack[last].Uint
+
+	cx.err = cx.checkBox(name)
+	if cx.err != nil {
+		return
+	}
+	value, exists, err := cx.Ledger.GetBox(cx.Ledger.ApplicationID(), name)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	if !exists {
+		cx.err = fmt.Errorf("no such box %#x", name)
+		return
+	}
+	first, end, err := boxRange(len(value), start, length)
+	if err != nil {
+		cx.err = err
+		return
+	}
+
+	cx.stack[pprev].Bytes = append([]byte{}, value[first:end]...)
+	cx.stack = cx.stack[:prev]
+}
+
+func opBoxReplace(cx *EvalContext) {
+	last := len(cx.stack) - 1 // replacement
+	prev := last - 1          // start
+	pprev := prev - 1         // name
+
+	name := string(cx.stack[pprev].Bytes)
+	start := cx.stack[prev].Uint
+	replacement := cx.stack[last].Bytes
+
+	cx.err = cx.checkBox(name)
+	if cx.err != nil {
+		return
+	}
+	appIdx := cx.Ledger.ApplicationID()
+	value, exists, err := cx.Ledger.GetBox(appIdx, name)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	if !exists {
+		cx.err = fmt.Errorf("no such box %#x", name)
+		return
+	}
+	first, end, err := boxRange(len(value), start, uint64(len(replacement)))
+	if err != nil {
+		cx.err = err
+		return
+	}
+
+	updated := append([]byte{}, value...)
+	copy(updated[first:end], replacement)
+	cx.err = cx.Ledger.SetBox(appIdx, name, updated)
+	if cx.err != nil {
+		return
+	}
+	cx.recordBoxChange(appIdx, name, updated, false)
+	cx.stack = cx.stack[:pprev]
+}
+
+func opBoxDel(cx *EvalContext) {
+	last := len(cx.stack) - 1 // name
+
+	name := string(cx.stack[last].Bytes)
+	cx.err = cx.checkBox(name)
+	if cx.err != nil {
+		return
+	}
+	appAddr, err := cx.getApplicationAddress()
+	if err != nil {
+		cx.err = err
+		return
+	}
+	appIdx := cx.Ledger.ApplicationID()
+	existed, err := cx.Ledger.DelBox(appIdx, name, appAddr)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	if existed {
+		cx.recordBoxChange(appIdx, name, nil, true)
+	}
+	cx.stack[last] = stackValue{Uint: boolToUint(existed)}
+}
+
+func opBoxLen(cx *EvalContext) {
+	last := len(cx.stack) - 1 // name
+
+	name := string(cx.stack[last].Bytes)
+	cx.err = cx.checkBox(name)
+	if cx.err != nil {
+		return
+	}
+	value, exists, err := cx.Ledger.GetBox(cx.Ledger.ApplicationID(), name)
+	if err != nil {
+		cx.err = err
+		return
+	}
+
+	cx.stack[last] = stackValue{Uint: uint64(len(value))}
+	cx.stack = append(cx.stack, stackValue{Uint: boolToUint(exists)})
+}
+
+func opBoxGet(cx *EvalContext) {
+	last := len(cx.stack) - 1 // name
+
+	name := string(cx.stack[last].Bytes)
+	cx.err = cx.checkBox(name)
+	if cx.err != nil {
+		return
+	}
+	value, exists, err := cx.Ledger.GetBox(cx.Ledger.ApplicationID(), name)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	if len(value) > MaxStringSize {
+		cx.err = fmt.Errorf("box_get produced a too big (%d) byte-array", len(value))
+		return
+	}
+
+	cx.stack[last] = stackValue{Bytes: append([]byte{}, value...)}
+	cx.stack = append(cx.stack, stackValue{Uint: boolToUint(exists)})
+}
+
+func opBoxPut(cx *EvalContext) {
+	last := len(cx.stack) - 1 // value
+	prev := last - 1          // name
+
+	name := string(cx.stack[prev].Bytes)
+	value := cx.stack[last].Bytes
+
+	cx.err = cx.checkBox(name)
+	if cx.err != nil {
+		return
+	}
+
+	appIdx := cx.Ledger.ApplicationID()
+	old, exists, err := cx.Ledger.GetBox(appIdx, name)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	if exists {
+		if len(old) != len(value) {
+			cx.err = fmt.Errorf("attempt to box_put wrong size %d != %d", len(old), len(value))
+			return
+		}
+		cx.err = cx.Ledger.SetBox(appIdx, name, value)
+	} else {
+		if uint64(len(value)) > cx.Proto.MaxBoxSize {
+			cx.err = fmt.Errorf("box size too large: %d, maximum is %d", len(value), cx.Proto.MaxBoxSize)
+			return
+		}
+		appAddr, err := cx.getApplicationAddress()
+		if err != nil {
+			cx.err = err
+			return
+		}
+		cx.err = cx.Ledger.NewBox(appIdx, name, value, appAddr)
+	}
+	if cx.err != nil {
+		return
+	}
+	cx.recordBoxChange(appIdx, name, value, false)
+	cx.stack = cx.stack[:prev]
+}
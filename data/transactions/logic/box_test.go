@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logictest"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeBoxEnv(boxes ...transactions.BoxRef) (EvalParams, *logictest.Ledger) {
+	ep, ledger := makeSampleEnv()
+	ep.Txn.Txn.Type = protocol.ApplicationCallTx
+	ep.Txn.Txn.ApplicationID = 888
+	ep.Txn.Txn.ForeignApps = []basics.AppIndex{889}
+	ep.Txn.Txn.Boxes = boxes
+	ep.TxnGroup[0] = *ep.Txn
+	ledger.NewApp(ep.Txn.Txn.Sender, 888, basics.AppParams{})
+	return ep, ledger
+}
+
+func TestBoxCreate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, ledger := makeBoxEnv(transactions.BoxRef{Name: []byte("self")})
+
+	testApp(t, `byte "self"; int 8; box_create`, ep)
+	value, exists, err := ledger.GetBox(888, "self")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, make([]byte, 8), value)
+
+	// Creating an existing box of the same size returns false
+	testApp(t, `byte "self"; int 8; box_create; !`, ep)
+	testApp(t, `byte "self"; int 9; box_create`, ep, "box size mismatch")
+
+	testApp(t, `byte "other"; int 8; box_create`, ep, "invalid Box reference")
+	testApp(t, `byte ""; int 8; box_create`, ep, "zero length")
+	testApp(t, `byte "self"; box_del; pop; byte "self"; int 1001; box_create`, ep,
+		"box size too large")
+}
+
+func TestBoxAvailability(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Index 1 names a box of the foreign app, which the program cannot touch
+	ep, _ := makeBoxEnv(transactions.BoxRef{Index: 1, Name: []byte("self")})
+	testApp(t, `byte "self"; int 8; box_create`, ep, "invalid Box reference")
+
+	// A box named by another app call in the group is available
+	ep, _ = makeBoxEnv()
+	ep.TxnGroup[1].Txn.Type = protocol.ApplicationCallTx
+	ep.TxnGroup[1].Txn.ApplicationID = 888
+	ep.TxnGroup[1].Txn.Boxes = []transactions.BoxRef{{Name: []byte("self")}}
+	testApp(t, `byte "self"; int 8; box_create`, ep)
+
+	// But not if that txn is not an app call
+	ep, _ = makeBoxEnv()
+	ep.TxnGroup[1].Txn.Boxes = []transactions.BoxRef{{Name: []byte("self")}}
+	testApp(t, `byte "self"; int 8; box_create`, ep, "invalid Box reference")
+
+	testProg(t, `byte "self"; int 8; box_create`, boxVersion-1, expect{3, "box_create opcode was introduced..."})
+}
+
+func TestBoxReadWrite(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, ledger := makeBoxEnv(transactions.BoxRef{Name: []byte("self")})
+
+	testApp(t, `byte "self"; box_len; !; assert; !`, ep)
+	testApp(t, `byte "self"; box_get; !; assert; len; !`, ep)
+	testApp(t, `byte "self"; int 0; int 0; box_extract; int 1`, ep, "no such box")
+	testApp(t, `byte "self"; int 0; byte 0x01; box_replace; int 1`, ep, "no such box")
+
+	testApp(t, `byte "self"; int 4; box_create; assert
+                    byte "self"; box_len; assert; int 4; ==`, ep)
+	testApp(t, `byte "self"; int 1; byte 0x1122; box_replace
+                    byte "self"; box_get; assert; byte 0x00112200; ==`, ep)
+	testApp(t, `byte "self"; int 1; int 2; box_extract; byte 0x1122; ==`, ep)
+	testApp(t, `byte "self"; int 3; int 2; box_extract; int 1`, ep, "extraction end 5")
+	testApp(t, `byte "self"; int 3; byte 0x1122; box_replace; int 1`, ep, "extraction end 5")
+
+	testApp(t, `byte "self"; byte 0x01020304; box_put; int 1`, ep)
+	value, _, _ := ledger.GetBox(888, "self")
+	require.Equal(t, []byte{1, 2, 3, 4}, value)
+	testApp(t, `byte "self"; byte 0x010203; box_put; int 1`, ep, "wrong size")
+
+	testApp(t, `byte "self"; box_del`, ep)
+	testApp(t, `byte "self"; box_del; !`, ep)
+
+	// box_put creates a box of the size of its value
+	testApp(t, `byte "self"; byte 0x0102; box_put; byte "self"; box_len; assert; int 2; ==`, ep)
+}
+
+func TestBoxMinBalance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, _ := makeBoxEnv(transactions.BoxRef{Name: []byte("self")})
+	minBalance := fmt.Sprintf("global CurrentApplicationAddress; min_balance; int %d; ==",
+		ep.Proto.MinBalance+ep.Proto.BoxFlatMinBalance+ep.Proto.BoxByteMinBalance*(4+10))
+	testApp(t, `byte "self"; int 10; box_create; assert; `+minBalance, ep)
+	testApp(t, `global CurrentApplicationAddress; acct_params_get AcctTotalBoxes; pop; int 1; ==`, ep)
+	testApp(t, `global CurrentApplicationAddress; acct_params_get AcctTotalBoxBytes; pop; int 14; ==`, ep)
+}
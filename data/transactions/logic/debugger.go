@@ -34,7 +34,8 @@ import (
 )
 
 // DebuggerHook functions are called by eval function during TEAL program execution
-// if provided
+// if provided. The hook is passed on to the programs of app calls made with inner
+// transactions, so the calls for them nest within the calls for their caller.
 type DebuggerHook interface {
 	// Register is fired on program creation
 	Register(state *DebugState) error
@@ -56,6 +57,30 @@ type PCOffset struct {
 	Offset int `codec:"offset"`
 }
 
+// CallFrame describes a subroutine call that has not yet returned: the
+// line of the callsub, and the label of the subroutine it called.
+type CallFrame struct {
+	FrameLine int    `codec:"frameLine"`
+	LabelName string `codec:"labelname"`
+}
+
+// ProgramContext identifies a program in the chain of app calls made with
+// inner transactions
+type ProgramContext struct {
+	ExecID     string `codec:"execid"`
+	GroupIndex int    `codec:"gindex"`
+	Depth      int    `codec:"depth"`
+}
+
+// BoxChange is a write of a box by a program: its new value, or its deletion.
+// Names and values are base64 encoded, like the bytes of TealValues.
+type BoxChange struct {
+	App     basics.AppIndex `codec:"app"`
+	Name    string          `codec:"name"`
+	Value   string          `codec:"value,omitempty"`
+	Deleted bool            `codec:"deleted,omitempty"`
+}
+
 // DebugState is a representation of the evaluation context that we encode
 // to json and send to tealdbg
 type DebugState struct {
@@ -67,6 +92,12 @@ type DebugState struct {
 	GroupIndex  int                      `codec:"gindex"`
 	Proto       *config.ConsensusParams  `codec:"proto"`
 	Globals     []basics.TealValue       `codec:"globals"`
+	// CallDepth is the number of app calls that led to this
+	// evaluation with inner transactions, 0 for top level programs
+	CallDepth int `codec:"depth"`
+	// Contexts lists the programs that led to this evaluation, outermost
+	// first and ending with this program
+	Contexts []ProgramContext `codec:"contexts"`
 
 	// fields updated every step
 	PC      int                `codec:"pc"`
@@ -75,6 +106,12 @@ type DebugState struct {
 	Scratch []basics.TealValue `codec:"scratch"`
 	Error   string             `codec:"error"`
 
+	// CallStack lists the active subroutine calls, outermost first
+	CallStack []CallFrame `codec:"callstack"`
+
+	// BoxChanges lists the box writes of the program so far, in order
+	BoxChanges []BoxChange `codec:"boxes"`
+
 	// global/local state changes are updated every step. Stateful TEAL only.
 	transactions.EvalDelta
 }
@@ -93,9 +130,28 @@ func makeDebugState(cx *EvalContext) DebugState {
 		disasm = err.Error()
 	}
 
+	depth := 0
+	for parent := cx.caller; parent != nil; parent = parent.caller {
+		depth++
+	}
+	execID := GetProgramID(cx.program)
+	if depth > 0 {
+		// the same program might be running in a caller
+		execID = fmt.Sprintf("%s-%d", execID, depth)
+	}
+
+	// callers got their debug state when they started
+	contexts := make([]ProgramContext, depth+1)
+	contexts[depth] = ProgramContext{ExecID: execID, GroupIndex: int(cx.GroupIndex), Depth: depth}
+	for parent, i := cx.caller, depth-1; parent != nil; parent, i = parent.caller, i-1 {
+		contexts[i] = ProgramContext{ExecID: parent.debugState.ExecID, GroupIndex: int(parent.GroupIndex), Depth: i}
+	}
+
 	// initialize DebuggerState with immutable fields
 	ds := DebugState{
-		ExecID:      GetProgramID(cx.program),
+		ExecID:      execID,
+		CallDepth:   depth,
+		Contexts:    contexts,
 		Disassembly: disasm,
 		PCOffset:    dsInfo.pcOffset,
 		GroupIndex:  int(cx.GroupIndex),
@@ -194,6 +250,18 @@ func valueDeltaToValueDelta(vd *basics.ValueDelta) basics.ValueDelta {
 	}
 }
 
+// recordBoxChange adds a box write to the debug state
+func (cx *EvalContext) recordBoxChange(app basics.AppIndex, name string, value []byte, deleted bool) {
+	if cx.Debugger == nil {
+		return
+	}
+	bc := BoxChange{App: app, Name: base64.StdEncoding.EncodeToString([]byte(name)), Deleted: deleted}
+	if !deleted {
+		bc.Value = base64.StdEncoding.EncodeToString(value)
+	}
+	cx.debugState.BoxChanges = append(cx.debugState.BoxChanges, bc)
+}
+
 func (cx *EvalContext) refreshDebugState() *DebugState {
 	ds := &cx.debugState
 
@@ -216,6 +284,7 @@ func (cx *EvalContext) refreshDebugState() *DebugState {
 
 	ds.Stack = stack
 	ds.Scratch = scratch
+	ds.CallStack = ds.callStack(cx.callstack)
 
 	if (cx.runModeFlags & runModeApplication) != 0 {
 		var err error
@@ -226,11 +295,31 @@ func (cx *EvalContext) refreshDebugState() *DebugState {
 			vd := tv.ToValueDelta()
 			ds.EvalDelta.GlobalDelta = basics.StateDelta{"error": vd}
 		}
+		ds.EvalDelta.Logs = cx.Logs
 	}
 
 	return ds
 }
 
+// callStack describes the frames of the evaluator's call stack in terms of
+// the disassembly lines of their callsub instructions.
+func (d *DebugState) callStack(frames []frame) []CallFrame {
+	if len(frames) == 0 {
+		return nil
+	}
+	lines := strings.Split(d.Disassembly, "\n")
+	callstack := make([]CallFrame, len(frames))
+	for i, f := range frames {
+		// retpc follows the 3 byte callsub
+		line := d.PCToLine(f.retpc - 3)
+		callstack[i].FrameLine = line
+		if line < len(lines) {
+			callstack[i].LabelName = strings.TrimPrefix(lines[line], "callsub ")
+		}
+	}
+	return callstack
+}
+
 func (dbg *WebDebuggerHook) postState(state *DebugState, endpoint string) error {
 	var body bytes.Buffer
 	enc := protocol.NewJSONEncoder(&body)
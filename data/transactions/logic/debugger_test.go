@@ -19,6 +19,7 @@ package logic
 import (
 	"encoding/base64"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/algorand/go-algorand/data/basics"
@@ -133,6 +134,52 @@ func TestDebuggerHook(t *testing.T) {
 	require.Equal(t, 1, len(testDbg.state.Stack))
 }
 
+// callstackDbgHook remembers the deepest call stack it was shown
+type callstackDbgHook struct {
+	testDbgHook
+	deepest []CallFrame
+}
+
+func (d *callstackDbgHook) Update(state *DebugState) error {
+	if len(state.CallStack) > len(d.deepest) {
+		d.deepest = append([]CallFrame(nil), state.CallStack...)
+	}
+	return d.testDbgHook.Update(state)
+}
+
+func TestDebuggerCallStack(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source := `#pragma version 4
+int 1
+callsub outer
+return
+outer:
+callsub inner
+retsub
+inner:
+retsub
+`
+	ops, err := AssembleStringWithVersion(source, 4)
+	require.NoError(t, err)
+	testDbg := callstackDbgHook{}
+	ep := defaultEvalParams(nil, nil)
+	ep.Debugger = &testDbg
+	pass, err := Eval(ops.Program, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	dis, err := Disassemble(ops.Program)
+	require.NoError(t, err)
+	lines := strings.Split(dis, "\n")
+	require.Len(t, testDbg.deepest, 2)
+	require.Equal(t, "callsub label1", lines[testDbg.deepest[0].FrameLine])
+	require.Equal(t, "label1", testDbg.deepest[0].LabelName)
+	require.Equal(t, "callsub label2", lines[testDbg.deepest[1].FrameLine])
+	require.Equal(t, "label2", testDbg.deepest[1].LabelName)
+	require.Empty(t, testDbg.state.CallStack)
+}
+
 func TestLineToPC(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -97,6 +97,8 @@ var opDocByName = map[string]string{
 	"gtxnsas": "pop an index A and an index B. push Bth value of the array field F from the Ath transaction in the current group",
 	"itxn":    "push field F of the last inner transaction to stack",
 	"itxna":   "push Ith value of the array field F of the last inner transaction to stack",
+	"gitxn":   "push field F of the Tth transaction in the last inner group submitted",
+	"gitxna":  "push Ith value of the array field F from the Tth transaction in the last inner group submitted",
 
 	"global": "push value from globals to stack",
 	"load":   "copy a value from scratch space to the stack. All scratch spaces are 0 at program start.",
@@ -149,9 +151,18 @@ var opDocByName = map[string]string{
 	"asset_holding_get": "read from account A and asset B holding field X (imm arg) => {0 or 1 (top), value}",
 	"asset_params_get":  "read from asset A params field X (imm arg) => {0 or 1 (top), value}",
 	"app_params_get":    "read from app A params field X (imm arg) => {0 or 1 (top), value}",
+	"acct_params_get":   "read from account A params field X (imm arg) => {0 or 1 (top), value}",
 	"assert":            "immediately fail unless value X is a non-zero number",
 	"callsub":           "branch unconditionally to TARGET, saving the next instruction on the call stack",
 	"retsub":            "pop the top instruction from the call stack and branch to it",
+	"proto":             "Prepare top call frame for a retsub that will assume A args and R return values.",
+	"frame_dig":         "Nth (signed) value from the frame pointer.",
+	"frame_bury":        "replace the Nth (signed) value from the frame pointer in the stack with A",
+	"switch":            "branch to the Ath label. Continue at following instruction if index A exceeds the number of labels.",
+	"match":             "given match cases from A[1] to A[N], branch to the Ith label where A[I] = B. Continue to the following instruction if no matches are found.",
+	"bury":              "replace the Nth value from the top of the stack with A. bury 0 fails.",
+	"popn":              "remove N values from the top of the stack",
+	"dupn":              "duplicate A, N times",
 
 	"b+":  "A plus B, where A and B are byte-arrays interpreted as big-endian unsigned integers",
 	"b-":  "A minus B, where A and B are byte-arrays interpreted as big-endian unsigned integers. Fail on underflow.",
@@ -174,6 +185,16 @@ var opDocByName = map[string]string{
 	"itxn_next":   "begin preparation of a new inner transaction in the same transaction group",
 	"itxn_field":  "set field F of the current inner transaction to X",
 	"itxn_submit": "execute the current inner transaction group. Fail if executing this group would exceed 16 total inner transactions, or if any transaction in the group fails.",
+
+	"box_create":  "create a box named A, of length B. Fail if A is empty or B exceeds 32,768. => {0 or 1}",
+	"box_extract": "read C bytes from box A, starting at offset B. Fail if A does not exist, or the byte range is outside A's size.",
+	"box_replace": "write byte-array C into box A, starting at offset B. Fail if A does not exist, or the byte range is outside A's size.",
+	"box_del":     "delete box named A if it exists. => {0 or 1}",
+	"box_len":     "X is the length of box A if A exists, else 0. Y is 1 if A exists, else 0.",
+	"box_get":     "X is the contents of box A if A exists, else ''. Y is 1 if A exists, else 0.",
+	"box_put":     "replaces the contents of box A with byte-array B. Fail if A exists and len(B) != len(box A). Creates A if it does not exist",
+
+	"increase_budget": "add A app call budgets to the opcode budget of the group. Fail if this program would request more than 16 increases, or if the group's fee credit can not pay for them.",
 }
 
 // OpDoc returns a description of the op
@@ -206,6 +227,12 @@ var opcodeImmediateNotes = map[string]string{
 	"bz":      "{int16 branch offset, big endian}",
 	"b":       "{int16 branch offset, big endian}",
 	"callsub": "{int16 branch offset, big endian}",
+	"switch":  "{uint8 branch count} [{int16 branch offset, big endian}, ...]",
+	"match":   "{uint8 branch count} [{int16 branch offset, big endian}, ...]",
+
+	"proto":      "{uint8 arguments} {uint8 return values}",
+	"frame_dig":  "{int8 frame slot}",
+	"frame_bury": "{int8 frame slot}",
 
 	"load":   "{uint8 position in scratch space to load from}",
 	"store":  "{uint8 position in scratch space to store to}",
@@ -218,14 +245,20 @@ var opcodeImmediateNotes = map[string]string{
 	"dig":       "{uint8 depth}",
 	"cover":     "{uint8 depth}",
 	"uncover":   "{uint8 depth}",
+	"bury":      "{uint8 depth}",
+	"popn":      "{uint8 stack depth}",
+	"dupn":      "{uint8 copy count}",
 
 	"asset_holding_get": "{uint8 asset holding field index}",
 	"asset_params_get":  "{uint8 asset params field index}",
 	"app_params_get":    "{uint8 app params field index}",
+	"acct_params_get":   "{uint8 account params field index}",
 
 	"itxn_field": "{uint8 transaction field index}",
 	"itxn":       "{uint8 transaction field index}",
 	"itxna":      "{uint8 transaction field index} {uint8 transaction field array index}",
+	"gitxn":      "{uint8 transaction group index} {uint8 transaction field index}",
+	"gitxna":     "{uint8 transaction group index} {uint8 transaction field index} {uint8 transaction field array index}",
 
 	"ecdsa_verify":        "{uint8 curve index}",
 	"ecdsa_pk_decompress": "{uint8 curve index}",
@@ -248,8 +281,13 @@ var opDocExtras = map[string]string{
 	"bnz":                 "The `bnz` instruction opcode 0x40 is followed by two immediate data bytes which are a high byte first and low byte second which together form a 16 bit offset which the instruction may branch to. For a bnz instruction at `pc`, if the last element of the stack is not zero then branch to instruction at `pc + 3 + N`, else proceed to next instruction at `pc + 3`. Branch targets must be aligned instructions. (e.g. Branching to the second byte of a 2 byte op will be rejected.) Starting at v4, the offset is treated as a signed 16 bit integer allowing for backward branches and looping. In prior version (v1 to v3), branch offsets are limited to forward branches only, 0-0x7fff.\n\nAt v2 it became allowed to branch to the end of the program exactly after the last instruction: bnz to byte N (with 0-indexing) was illegal for a TEAL program with N bytes before v2, and is legal after it. This change eliminates the need for a last instruction of no-op as a branch target at the end. (Branching beyond the end--in other words, to a byte larger than N--is still illegal and will cause the program to fail.)",
 	"bz":                  "See `bnz` for details on how branches work. `bz` inverts the behavior of `bnz`.",
 	"b":                   "See `bnz` for details on how branches work. `b` always jumps to the offset.",
-	"callsub":             "The call stack is separate from the data stack. Only `callsub` and `retsub` manipulate it.",
-	"retsub":              "The call stack is separate from the data stack. Only `callsub` and `retsub` manipulate it.",
+	"callsub":             "The call stack is separate from the data stack. Only `callsub`, `retsub`, and `proto` manipulate it.",
+	"retsub":              "If the current frame was prepared by `proto A R`, `retsub` will remove the 'A' arguments from the stack, move the `R` return values down, and pop any stack locations above the relocated return values.",
+	"proto":               "Fails unless the last instruction executed was a `callsub`.",
+	"frame_dig":           "The frame pointer is the stack height when the subroutine was called. Negative values refer to the arguments, and non-negative values to locals pushed after `proto`. Fails if the slot is outside the frame prepared by `proto`.",
+	"frame_bury":          "See `frame_dig` for the meaning of N.",
+	"switch":              "The `switch` instruction opcode 0x8d is followed by a count of labels, and that many int16 offsets, each relative to the instruction following the `switch`.",
+	"match":               "`match` consumes N+1 values from the stack. Let the top stack value be B. The following N values represent an ordered list of match cases/constants (A), where the first value (A[0]) is the deepest in the stack. The immediate arguments are an ordered list of N labels (T). `match` will branch to target T[I], where A[I] = B. If there are no matches then execution continues on to the next instruction.",
 	"intcblock":           "`intcblock` loads following program bytes into an array of integer constants in the evaluator. These integer constants can be referred to by `intc` and `intc_*` which will push the value onto the stack. Subsequent calls to `intcblock` reset and replace the integer constants available to the script.",
 	"bytecblock":          "`bytecblock` loads the following program bytes into an array of byte-array constants in the evaluator. These constants can be referred to by `bytec` and `bytec_*` which will push the value onto the stack. Subsequent calls to `bytecblock` reset and replace the bytes constants available to the script.",
 	"*":                   "Overflow is an error condition which halts execution and fails the transaction. Full precision is available from `mulw`.",
@@ -282,10 +320,16 @@ var opDocExtras = map[string]string{
 	"asset_holding_get":   "params: Txn.Accounts offset (or, since v4, an account address that appears in Txn.Accounts or is Txn.Sender), asset id (or, since v4, a Txn.ForeignAssets offset). Return: did_exist flag (1 if the asset existed and 0 otherwise), value.",
 	"asset_params_get":    "params: Before v4, Txn.ForeignAssets offset. Since v4, Txn.ForeignAssets offset or an asset id that appears in Txn.ForeignAssets. Return: did_exist flag (1 if the asset existed and 0 otherwise), value.",
 	"app_params_get":      "params: Txn.ForeignApps offset or an app id that appears in Txn.ForeignApps. Return: did_exist flag (1 if the application existed and 0 otherwise), value.",
+	"acct_params_get":     "params: Txn.Accounts offset (or, since v4, an _available_ address). Return: did_exist flag (1 if the account has a non-zero balance and 0 otherwise), value.",
 	"log":                 "`log` fails if called more than MaxLogCalls times in a program, or if the sum of logged bytes exceeds 1024 bytes.",
+	"gitxn":               "for notes on transaction fields available, see `txn`. If the last inner group submitted has _n_ transactions, _T_ must be less than _n_.",
 	"itxn_begin":          "`itxn_begin` initializes Sender to the application address; Fee to the minimum allowable, taking into account MinTxnFee and credit from overpaying in earlier transactions; FirstValid/LastValid to the values in the top-level transaction, and all other fields to zero values.",
-	"itxn_field":          "`itxn_field` fails if X is of the wrong type for F, including a byte array of the wrong size for use as an address when F is an address field. `itxn_field` also fails if X is an account or asset that does not appear in `txn.Accounts` or `txn.ForeignAssets` of the top-level transaction. (Setting addresses in asset creation are exempted from this requirement.)",
-	"itxn_submit":         "`itxn_submit` resets the current transaction so that it can not be resubmitted. A new `itxn_begin` is required to prepare another inner transaction.",
+	"itxn_field":          "`itxn_field` fails if X is of the wrong type for F, including a byte array of the wrong size for use as an address when F is an address field. `itxn_field` also fails if X is an account or asset that does not appear in `txn.Accounts` or `txn.ForeignAssets` of the top-level transaction. (Setting addresses in asset creation are exempted from this requirement.) Setting an array field, such as `ApplicationArgs` or `Accounts`, appends X to the array. An inner application call may only name an `ApplicationID` that appears in `txn.Applications` or was created by an earlier inner transaction.",
+	"itxn_submit":         "`itxn_submit` resets the current transaction so that it can not be resubmitted. A new `itxn_begin` is required to prepare another inner transaction. Inner application calls share the opcode budget of the top-level group, and each one adds the budget of an app call to the pool. An inner application call fails if it would re-enter an app that is already executing, or if app calls would be nested more than 8 deep.",
+	"box_create":          "Newly created boxes are filled with 0 bytes. `box_create` will fail if the referenced box already exists with a different size. Otherwise, existing boxes are unchanged by `box_create`. Boxes may only be accessed if they are named by a box reference of an application call in the transaction group.",
+	"box_get":             "For boxes that exceed 4,096 bytes, consider `box_create`, `box_extract`, and `box_replace`",
+	"box_put":             "For boxes that exceed 4,096 bytes, consider `box_create`, `box_extract`, and `box_replace`",
+	"increase_budget":     "Each increase costs the minimum transaction fee, which is taken from the credit of fees overpaid earlier in the group, and adds 700 to the pooled budget, exactly as an inner application call would. This allows a program to raise its budget without submitting inner transactions for that purpose.",
 	"base64_decode":       "Decodes X using the base64 encoding E. Specify the encoding with an immediate arg either as URL and Filename Safe (`URLEncoding`) or Standard (`StdEncoding`). See <a href=\"https://rfc-editor.org/rfc/rfc4648.html#section-4\">RFC 4648</a> (sections 4 and 5). It is assumed that the encoding ends with the exact number of `=` padding characters as required by the RFC. When padding occurs, any unused pad bits in the encoding must be set to zero or the decoding will fail. The special cases of `\\n` and `\\r` are allowed but completely ignored. An error will result when attempting to decode a string with a character that is not in the encoding alphabet or not one of `=`, `\\r`, or `\\n`.",
 }
 
@@ -303,9 +347,10 @@ var OpGroups = map[string][]string{
 	"Byte Array Arithmetic":   {"b+", "b-", "b/", "b*", "b<", "b>", "b<=", "b>=", "b==", "b!=", "b%"},
 	"Byte Array Logic":        {"b|", "b&", "b^", "b~"},
 	"Loading Values":          {"intcblock", "intc", "intc_0", "intc_1", "intc_2", "intc_3", "pushint", "bytecblock", "bytec", "bytec_0", "bytec_1", "bytec_2", "bytec_3", "pushbytes", "bzero", "arg", "arg_0", "arg_1", "arg_2", "arg_3", "args", "txn", "gtxn", "txna", "txnas", "gtxna", "gtxnas", "gtxns", "gtxnsa", "gtxnsas", "global", "load", "loads", "store", "stores", "gload", "gloads", "gaid", "gaids"},
-	"Flow Control":            {"err", "bnz", "bz", "b", "return", "pop", "dup", "dup2", "dig", "cover", "uncover", "swap", "select", "assert", "callsub", "retsub"},
-	"State Access":            {"balance", "min_balance", "app_opted_in", "app_local_get", "app_local_get_ex", "app_global_get", "app_global_get_ex", "app_local_put", "app_global_put", "app_local_del", "app_global_del", "asset_holding_get", "asset_params_get", "app_params_get", "log"},
-	"Inner Transactions":      {"itxn_begin", "itxn_next", "itxn_field", "itxn_submit", "itxn", "itxna"},
+	"Flow Control":            {"err", "bnz", "bz", "b", "return", "pop", "dup", "dup2", "dig", "cover", "uncover", "swap", "select", "assert", "callsub", "retsub", "increase_budget", "proto", "frame_dig", "frame_bury", "switch", "match", "bury", "popn", "dupn"},
+	"State Access":            {"balance", "min_balance", "app_opted_in", "app_local_get", "app_local_get_ex", "app_global_get", "app_global_get_ex", "app_local_put", "app_global_put", "app_local_del", "app_global_del", "asset_holding_get", "asset_params_get", "app_params_get", "acct_params_get", "log"},
+	"Inner Transactions":      {"itxn_begin", "itxn_next", "itxn_field", "itxn_submit", "itxn", "itxna", "gitxn", "gitxna"},
+	"Box Access":              {"box_create", "box_extract", "box_replace", "box_del", "box_len", "box_get", "box_put"},
 }
 
 // OpCost indicates the cost of an operation over the range of
@@ -538,6 +583,27 @@ func AppParamsFieldDocs() map[string]string {
 	return fieldsDocWithExtra(appParamsFieldDocs, appParamsFieldSpecByName)
 }
 
+// acctParamsFieldDocs are notes on fields available in `acct_params_get`
+var acctParamsFieldDocs = map[string]string{
+	"AcctBalance":            "Account balance in microalgos",
+	"AcctMinBalance":         "Minimum required balance for account, in microalgos",
+	"AcctAuthAddr":           "Address the account is rekeyed to.",
+	"AcctTotalNumUint":       "The total number of uint64 values allocated by this account in Global and Local States.",
+	"AcctTotalNumByteSlice":  "The total number of byte array values allocated by this account in Global and Local States.",
+	"AcctTotalExtraAppPages": "The number of extra app code pages used by this account.",
+	"AcctTotalAppsCreated":   "The number of existing apps created by this account.",
+	"AcctTotalAppsOptedIn":   "The number of apps this account is opted into.",
+	"AcctTotalAssetsCreated": "The number of existing ASAs created by this account.",
+	"AcctTotalAssets":        "The numbers of ASAs held by this account (including ASAs this account created).",
+	"AcctTotalBoxes":         "The number of existing boxes created by this account's app.",
+	"AcctTotalBoxBytes":      "The total number of bytes used by this account's app's box keys and values.",
+}
+
+// AcctParamsFieldDocs are notes on fields available in `acct_params_get` with extra versioning info if any
+func AcctParamsFieldDocs() map[string]string {
+	return fieldsDocWithExtra(acctParamsFieldDocs, acctParamsFieldSpecByName)
+}
+
 // EcdsaCurveDocs are notes on curves available in `ecdsa_` opcodes
 var EcdsaCurveDocs = map[string]string{
 	"Secp256k1": "secp256k1 curve",
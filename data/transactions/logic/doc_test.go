@@ -50,6 +50,7 @@ func TestOpDocs(t *testing.T) {
 	require.Len(t, AssetHoldingFieldDocs, len(AssetHoldingFieldNames))
 	require.Len(t, assetParamsFieldDocs, len(AssetParamsFieldNames))
 	require.Len(t, appParamsFieldDocs, len(AppParamsFieldNames))
+	require.Len(t, acctParamsFieldDocs, len(AcctParamsFieldNames))
 	require.Len(t, TypeNameDescriptions, len(TxnTypeNames))
 	require.Len(t, EcdsaCurveDocs, len(EcdsaCurveNames))
 }
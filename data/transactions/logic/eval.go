@@ -188,6 +188,7 @@ type LedgerForLogic interface {
 	Balance(addr basics.Address) (basics.MicroAlgos, error)
 	MinBalance(addr basics.Address, proto *config.ConsensusParams) (basics.MicroAlgos, error)
 	Authorizer(addr basics.Address) (basics.Address, error)
+	AccountData(addr basics.Address) (basics.AccountData, error)
 	Round() basics.Round
 	LatestTimestamp() int64
 
@@ -206,11 +207,23 @@ type LedgerForLogic interface {
 	SetGlobal(key string, value basics.TealValue) error
 	DelGlobal(key string) error
 
+	NewBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error
+	GetBox(appIdx basics.AppIndex, key string) ([]byte, bool, error)
+	SetBox(appIdx basics.AppIndex, key string, value []byte) error
+	DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error)
+
 	GetDelta(txn *transactions.Transaction) (evalDelta transactions.EvalDelta, err error)
 
 	Perform(txn *transactions.Transaction, spec transactions.SpecialAddresses) (transactions.ApplyData, error)
 }
 
+// AppCallLedger is implemented by a LedgerForLogic that can also evaluate
+// inner application calls. ep describes the inner group, and ep.Txn is the
+// app call to perform.
+type AppCallLedger interface {
+	PerformAppCall(ep *EvalParams) (transactions.ApplyData, error)
+}
+
 // EvalSideEffects contains data returned from evaluation
 type EvalSideEffects struct {
 	scratchSpace scratchSpace
@@ -277,6 +290,14 @@ type EvalParams struct {
 
 	// Total pool of app call budget in a group transaction
 	PooledApplicationBudget *uint64
+
+	// Budget added to the pool by increase_budget and inner app calls,
+	// across the group. Shared value across a group's txns, so that it can
+	// be reported. nil is interpretted as 0.
+	BudgetAdded *uint64
+
+	// the app that submitted this (inner) transaction group, nil for top-level
+	caller *EvalContext
 }
 
 type opEvalFunc func(cx *EvalContext)
@@ -339,7 +360,7 @@ type EvalContext struct {
 	EvalParams
 
 	stack     []stackValue
-	callstack []int
+	callstack []frame
 
 	program []byte
 	pc      int
@@ -353,10 +374,13 @@ type EvalContext struct {
 	subtxns []transactions.SignedTxn // place to build for itxn_submit
 	// Previous transactions Performed() and their effects
 	InnerTxns []transactions.SignedTxnWithAD
+	// index in InnerTxns of the first transaction of the last group submitted
+	lastInnerGroup int
 
-	cost    int // cost incurred so far
-	Logs    []string
-	logSize int // total log size so far
+	cost            int // cost incurred so far
+	budgetIncreases int // budget increases requested so far
+	Logs            []string
+	logSize         int // total log size so far
 
 	// Set of PC values that branches we've seen so far might
 	// go. So, if checkStep() skips one, that branch is trying to
@@ -372,6 +396,9 @@ type EvalContext struct {
 	txidCache         map[uint64]transactions.Txid
 	appAddrCache      map[basics.AppIndex]basics.Address
 
+	// boxes made available by the group's box references, see availableBoxes
+	boxes map[boxRef]bool
+
 	// Stores state & disassembly for the optional debugger
 	debugState DebugState
 }
@@ -528,6 +555,10 @@ func eval(program []byte, cx *EvalContext) (pass bool, err error) {
 		cx.err = fmt.Errorf("program version must be >= %d for this transaction group, but have version %d", minVersion, version)
 		return false, cx.err
 	}
+	if cx.caller != nil && version < cx.Proto.MinInnerApplVersion {
+		cx.err = fmt.Errorf("inner app call with version %d < %d", version, cx.Proto.MinInnerApplVersion)
+		return false, cx.err
+	}
 
 	cx.version = version
 	cx.pc = vlen
@@ -732,7 +763,7 @@ func (cx *EvalContext) step() {
 
 	if cx.err == nil {
 		postheight := len(cx.stack)
-		if spec.Name != "return" && postheight-preheight != len(spec.Returns)-len(spec.Args) {
+		if spec.Name != "return" && !deets.varyingStack && postheight-preheight != len(spec.Returns)-len(spec.Args) {
 			cx.err = fmt.Errorf("%s changed stack height improperly %d != %d",
 				spec.Name, postheight-preheight, len(spec.Returns)-len(spec.Args))
 			return
@@ -1646,11 +1677,17 @@ func opArgs(cx *EvalContext) {
 }
 
 func branchTarget(cx *EvalContext) (int, error) {
-	offset := int16(uint16(cx.program[cx.pc+1])<<8 | uint16(cx.program[cx.pc+2]))
+	return offsetTarget(cx, cx.pc+1, cx.pc+3)
+}
+
+// offsetTarget computes the destination of the int16 big-endian offset found
+// at pos, which is relative to base, the end of the branching instruction.
+func offsetTarget(cx *EvalContext, pos int, base int) (int, error) {
+	offset := int16(uint16(cx.program[pos])<<8 | uint16(cx.program[pos+1]))
 	if offset < 0 && cx.version < backBranchEnabledVersion {
 		return 0, fmt.Errorf("negative branch offset %x", offset)
 	}
-	target := cx.pc + 3 + int(offset)
+	target := base + int(offset)
 	var branchTooFar bool
 	if cx.version >= 2 {
 		// branching to exactly the end of the program (target == len(cx.program)), the next pc after the last instruction, is okay and ends normally
@@ -1672,6 +1709,12 @@ func checkBranch(cx *EvalContext) error {
 	if err != nil {
 		return err
 	}
+	return cx.checkTarget(target)
+}
+
+// checkTarget confirms that a branch target, which has been found to be
+// inside the program, is acceptable, and records it for later alignment checks.
+func (cx *EvalContext) checkTarget(target int) error {
 	if target < cx.nextpc {
 		// If a branch goes backwards, we should have already noted that an instruction began at that location.
 		if _, ok := cx.instructionStarts[target]; !ok {
@@ -1681,6 +1724,45 @@ func checkBranch(cx *EvalContext) error {
 	cx.branchTargets[target] = true
 	return nil
 }
+
+// switchEnd returns the pc following a switch or match instruction, which
+// is {op} {uint8 count} [{int16 be offset}, ...]
+func switchEnd(cx *EvalContext) (int, error) {
+	if cx.pc+1 >= len(cx.program) {
+		return 0, fmt.Errorf("%3d %s program ends short of immediate values", cx.pc, opsByOpcode[cx.version][cx.program[cx.pc]].Name)
+	}
+	end := cx.pc + 2 + 2*int(cx.program[cx.pc+1])
+	if end > len(cx.program) {
+		return 0, fmt.Errorf("%3d %s program ends short of immediate values", cx.pc, opsByOpcode[cx.version][cx.program[cx.pc]].Name)
+	}
+	return end, nil
+}
+
+// switchTarget returns the destination of label i of a switch or match
+func switchTarget(cx *EvalContext, i int, end int) (int, error) {
+	return offsetTarget(cx, cx.pc+2+2*i, end)
+}
+
+// checks switch and match, which have a count of labels followed by their offsets
+func checkSwitch(cx *EvalContext) error {
+	end, err := switchEnd(cx)
+	if err != nil {
+		return err
+	}
+	cx.nextpc = end
+	for i := 0; i < int(cx.program[cx.pc+1]); i++ {
+		target, err := switchTarget(cx, i, end)
+		if err != nil {
+			return err
+		}
+		err = cx.checkTarget(target)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func opBnz(cx *EvalContext) {
 	last := len(cx.stack) - 1
 	cx.nextpc = cx.pc + 3
@@ -1720,9 +1802,25 @@ func opB(cx *EvalContext) {
 	cx.nextpc = target
 }
 
+// frame is an entry on the call stack. It remembers where to return, and
+// once proto has executed, the shape of the subroutine's frame on the stack.
+type frame struct {
+	retpc  int
+	entry  int // pc of the first instruction of the subroutine
+	height int // stack height when the subroutine was called
+
+	clear   bool // proto executed, so retsub tidies the stack
+	args    int
+	returns int
+}
+
 func opCallSub(cx *EvalContext) {
-	cx.callstack = append(cx.callstack, cx.pc+3)
+	cx.callstack = append(cx.callstack, frame{
+		retpc:  cx.pc + 3,
+		height: len(cx.stack),
+	})
 	opB(cx)
+	cx.callstack[len(cx.callstack)-1].entry = cx.nextpc
 }
 
 func opRetSub(cx *EvalContext) {
@@ -1731,9 +1829,120 @@ func opRetSub(cx *EvalContext) {
 		cx.err = errors.New("retsub with empty callstack")
 		return
 	}
-	target := cx.callstack[top]
+	frame := cx.callstack[top]
+	if frame.clear {
+		if len(cx.stack) < frame.height+frame.returns {
+			cx.err = errors.New("retsub executed with stack below frame. Did you pop args?")
+			return
+		}
+		argstart := frame.height - frame.args
+		copy(cx.stack[argstart:], cx.stack[len(cx.stack)-frame.returns:])
+		cx.stack = cx.stack[:argstart+frame.returns]
+	}
 	cx.callstack = cx.callstack[:top]
-	cx.nextpc = target
+	cx.nextpc = frame.retpc
+}
+
+func opProto(cx *EvalContext) {
+	top := len(cx.callstack) - 1
+	if top < 0 || cx.callstack[top].entry != cx.pc || cx.callstack[top].clear {
+		cx.err = errors.New("proto was executed without a callsub")
+		return
+	}
+	args := int(cx.program[cx.pc+1])
+	if args > cx.callstack[top].height {
+		cx.err = fmt.Errorf("callsub to proto that requires %d args with stack height %d",
+			args, cx.callstack[top].height)
+		return
+	}
+	cx.callstack[top].clear = true
+	cx.callstack[top].args = args
+	cx.callstack[top].returns = int(cx.program[cx.pc+2])
+}
+
+// frameIndex finds the stack index of the frame relative immediate of
+// frame_dig or frame_bury. above reports how many values must remain above
+// the index.
+func frameIndex(cx *EvalContext, name string, above int) (int, error) {
+	i := int(int8(cx.program[cx.pc+1]))
+	top := len(cx.callstack) - 1
+	if top < 0 {
+		return 0, fmt.Errorf("%s with empty callstack", name)
+	}
+	frame := cx.callstack[top]
+	if !frame.clear {
+		return 0, fmt.Errorf("%s %d in sub with no proto", name, i)
+	}
+	idx := frame.height + i
+	if idx >= len(cx.stack)-above {
+		return 0, fmt.Errorf("%s %d above stack", name, i)
+	}
+	if idx < frame.height-frame.args {
+		return 0, fmt.Errorf("%s %d in sub with %d args", name, i, frame.args)
+	}
+	return idx, nil
+}
+
+func opFrameDig(cx *EvalContext) {
+	idx, err := frameIndex(cx, "frame_dig", 0)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	cx.stack = append(cx.stack, cx.stack[idx])
+}
+
+func opFrameBury(cx *EvalContext) {
+	last := len(cx.stack) - 1
+	idx, err := frameIndex(cx, "frame_bury", 1)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	cx.stack[idx] = cx.stack[last]
+	cx.stack = cx.stack[:last]
+}
+
+func opSwitch(cx *EvalContext) {
+	last := len(cx.stack) - 1
+	branch := cx.stack[last].Uint
+	cx.stack = cx.stack[:last]
+
+	end, err := switchEnd(cx)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	cx.nextpc = end
+	if branch < uint64(cx.program[cx.pc+1]) {
+		cx.nextpc, cx.err = switchTarget(cx, int(branch), end)
+	}
+}
+
+func opMatch(cx *EvalContext) {
+	end, err := switchEnd(cx)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	n := int(cx.program[cx.pc+1])
+	// Need to check stack size explicitly here because checkArgs() doesn't understand match
+	if len(cx.stack) < n+1 {
+		cx.err = fmt.Errorf("match %d with stack size = %d", n, len(cx.stack))
+		return
+	}
+	last := len(cx.stack) - 1
+	first := last - n
+	matching := cx.stack[last]
+	cx.nextpc = end
+	for i := 0; i < n; i++ {
+		sv := cx.stack[first+i]
+		if sv.argType() == matching.argType() && sv.Uint == matching.Uint && bytes.Equal(sv.Bytes, matching.Bytes) {
+			cx.nextpc, cx.err = switchTarget(cx, i, end)
+			break
+		}
+	}
+	cx.stack = cx.stack[:first]
 }
 
 func opPop(cx *EvalContext) {
@@ -1797,6 +2006,37 @@ func opUncover(cx *EvalContext) {
 	cx.stack[topIdx] = sv
 }
 
+func opBury(cx *EvalContext) {
+	depth := int(cx.program[cx.pc+1])
+	topIdx := len(cx.stack) - 1
+	idx := topIdx - depth
+	// Need to check stack size explicitly here because checkArgs() doesn't understand bury
+	if depth == 0 || idx < 0 {
+		cx.err = fmt.Errorf("bury %d with stack size = %d", depth, len(cx.stack))
+		return
+	}
+	cx.stack[idx] = cx.stack[topIdx]
+	cx.stack = cx.stack[:topIdx]
+}
+
+func opPopN(cx *EvalContext) {
+	n := int(cx.program[cx.pc+1])
+	top := len(cx.stack) - n
+	if top < 0 {
+		cx.err = fmt.Errorf("popn %d with stack size = %d", n, len(cx.stack))
+		return
+	}
+	cx.stack = cx.stack[:top]
+}
+
+func opDupN(cx *EvalContext) {
+	last := len(cx.stack) - 1
+	n := int(cx.program[cx.pc+1])
+	for i := 0; i < n; i++ {
+		cx.stack = append(cx.stack, cx.stack[last])
+	}
+}
+
 func (cx *EvalContext) assetHoldingToValue(holding *basics.AssetHolding, fs assetHoldingFieldSpec) (sv stackValue, err error) {
 	switch fs.field {
 	case AssetBalance:
@@ -2402,6 +2642,65 @@ func opItxna(cx *EvalContext) {
 	cx.stack = append(cx.stack, sv)
 }
 
+func opGitxn(cx *EvalContext) {
+	lastInnerGroup := cx.InnerTxns[cx.lastInnerGroup:]
+	gi := int(cx.program[cx.pc+1])
+	if gi >= len(lastInnerGroup) {
+		cx.err = fmt.Errorf("gitxn %d ... but last inner group has %d", gi, len(lastInnerGroup))
+		return
+	}
+	itxn := &lastInnerGroup[gi]
+
+	field := TxnField(cx.program[cx.pc+2])
+	fs, ok := txnFieldSpecByField[field]
+	if !ok || fs.version > cx.version {
+		cx.err = fmt.Errorf("invalid gitxn field %d", field)
+		return
+	}
+	_, ok = txnaFieldSpecByField[field]
+	if ok {
+		cx.err = fmt.Errorf("invalid gitxn field %d", field)
+		return
+	}
+
+	sv, err := cx.itxnFieldToStack(itxn, fs, 0)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	cx.stack = append(cx.stack, sv)
+}
+
+func opGitxna(cx *EvalContext) {
+	lastInnerGroup := cx.InnerTxns[cx.lastInnerGroup:]
+	gi := int(cx.program[cx.pc+1])
+	if gi >= len(lastInnerGroup) {
+		cx.err = fmt.Errorf("gitxna %d ... but last inner group has %d", gi, len(lastInnerGroup))
+		return
+	}
+	itxn := &lastInnerGroup[gi]
+
+	field := TxnField(cx.program[cx.pc+2])
+	fs, ok := txnFieldSpecByField[field]
+	if !ok || fs.version > cx.version {
+		cx.err = fmt.Errorf("invalid gitxna field %d", field)
+		return
+	}
+	_, ok = txnaFieldSpecByField[field]
+	if !ok {
+		cx.err = fmt.Errorf("gitxna unsupported field %d", field)
+		return
+	}
+	arrayFieldIdx := uint64(cx.program[cx.pc+3])
+
+	sv, err := cx.itxnFieldToStack(itxn, fs, arrayFieldIdx)
+	if err != nil {
+		cx.err = err
+		return
+	}
+	cx.stack = append(cx.stack, sv)
+}
+
 func opGaidImpl(cx *EvalContext, groupIdx uint64, opName string) (sv stackValue, err error) {
 	if groupIdx >= uint64(len(cx.TxnGroup)) {
 		err = fmt.Errorf("%s lookup TxnGroup[%d] but it only has %d", opName, groupIdx, len(cx.TxnGroup))
@@ -3649,6 +3948,75 @@ func opAppParamsGet(cx *EvalContext) {
 	cx.stack = append(cx.stack, stackValue{Uint: exist})
 }
 
+func opAcctParamsGet(cx *EvalContext) {
+	last := len(cx.stack) - 1 // acct
+
+	if cx.Ledger == nil {
+		cx.err = fmt.Errorf("ledger not available")
+		return
+	}
+
+	addr, _, err := cx.accountReference(cx.stack[last])
+	if err != nil {
+		cx.err = err
+		return
+	}
+
+	paramField := AcctParamsField(cx.program[cx.pc+1])
+	fs, ok := acctParamsFieldSpecByField[paramField]
+	if !ok || fs.version > cx.version {
+		cx.err = fmt.Errorf("invalid acct_params_get field %d", paramField)
+		return
+	}
+
+	account, err := cx.Ledger.AccountData(addr)
+	if err != nil {
+		cx.err = err
+		return
+	}
+
+	exist := boolToUint(account.MicroAlgos.Raw > 0)
+
+	var value stackValue
+
+	switch fs.field {
+	case AcctBalance:
+		value.Uint = account.MicroAlgos.Raw
+	case AcctMinBalance:
+		var minBalance basics.MicroAlgos
+		minBalance, err = cx.Ledger.MinBalance(addr, cx.Proto)
+		value.Uint = minBalance.Raw
+	case AcctAuthAddr:
+		value.Bytes = account.AuthAddr[:]
+	case AcctTotalNumUint:
+		value.Uint = account.TotalAppSchema.NumUint
+	case AcctTotalNumByteSlice:
+		value.Uint = account.TotalAppSchema.NumByteSlice
+	case AcctTotalExtraAppPages:
+		value.Uint = uint64(account.TotalExtraAppPages)
+	case AcctTotalAppsCreated:
+		value.Uint = uint64(len(account.AppParams))
+	case AcctTotalAppsOptedIn:
+		value.Uint = uint64(len(account.AppLocalStates))
+	case AcctTotalAssetsCreated:
+		value.Uint = uint64(len(account.AssetParams))
+	case AcctTotalAssets:
+		value.Uint = uint64(len(account.Assets))
+	case AcctTotalBoxes:
+		value.Uint = account.TotalBoxes
+	case AcctTotalBoxBytes:
+		value.Uint = account.TotalBoxBytes
+	default:
+		err = fmt.Errorf("invalid acct_params_get field %d", fs.field)
+	}
+	if err != nil {
+		cx.err = err
+		return
+	}
+	cx.stack[last] = value
+	cx.stack = append(cx.stack, stackValue{Uint: exist})
+}
+
 func opLog(cx *EvalContext) {
 	last := len(cx.stack) - 1
 
@@ -3771,6 +4139,29 @@ func (cx *EvalContext) availableAsset(sv stackValue) (basics.AssetIndex, error)
 	return basics.AssetIndex(0), fmt.Errorf("invalid Asset reference %d", aid)
 }
 
+// availableApp is used instead of appReference for more recent (stateful)
+// opcodes that don't need (or want!) to allow low numbers to represent the
+// app at that index in ForeignApps array.
+func (cx *EvalContext) availableApp(sv stackValue) (basics.AppIndex, error) {
+	aid, err := sv.uint()
+	if err != nil {
+		return basics.AppIndex(0), err
+	}
+	// Ensure that aid is in Foreign Apps
+	for _, appID := range cx.Txn.Txn.ForeignApps {
+		if appID == basics.AppIndex(aid) {
+			return basics.AppIndex(aid), nil
+		}
+	}
+	// or was created by an earlier inner transaction
+	for _, itxn := range cx.InnerTxns {
+		if itxn.ApplyData.ApplicationID == basics.AppIndex(aid) && aid != 0 {
+			return basics.AppIndex(aid), nil
+		}
+	}
+	return basics.AppIndex(0), fmt.Errorf("invalid App reference %d", aid)
+}
+
 func (cx *EvalContext) stackIntoTxnField(sv stackValue, fs txnFieldSpec, txn *transactions.Transaction) (err error) {
 	switch fs.field {
 	case Type:
@@ -3912,7 +4303,86 @@ func (cx *EvalContext) stackIntoTxnField(sv stackValue, fs txnFieldSpec, txn *tr
 	case FreezeAssetFrozen:
 		txn.AssetFrozen, err = sv.bool()
 
-	// appl needs to wait. Can't call AVM from AVM.
+	// ApplicationCall
+	case ApplicationID:
+		txn.ApplicationID, err = cx.availableApp(sv)
+	case OnCompletion:
+		var onc uint64
+		onc, err = sv.uint()
+		if err == nil {
+			if onc > uint64(transactions.DeleteApplicationOC) {
+				err = fmt.Errorf("%d is larger than max=%d for %s", onc, transactions.DeleteApplicationOC, fs.field)
+			} else {
+				txn.OnCompletion = transactions.OnCompletion(onc)
+			}
+		}
+	case ApplicationArgs:
+		if len(txn.ApplicationArgs) >= cx.Proto.MaxAppArgs {
+			return errors.New("too many application args")
+		}
+		if sv.Bytes == nil {
+			return fmt.Errorf("%s arg not a byte array", fs.field)
+		}
+		arg := make([]byte, len(sv.Bytes))
+		copy(arg, sv.Bytes)
+		txn.ApplicationArgs = append(txn.ApplicationArgs, arg)
+	case Accounts:
+		if len(txn.Accounts) >= cx.Proto.MaxAppTxnAccounts {
+			return errors.New("too many foreign accounts")
+		}
+		var addr basics.Address
+		addr, err = cx.availableAccount(sv)
+		if err == nil {
+			txn.Accounts = append(txn.Accounts, addr)
+		}
+	case ApprovalProgram, ClearStateProgram:
+		maxLen := cx.Proto.MaxAppProgramLen * (1 + cx.Proto.MaxExtraAppProgramPages)
+		if sv.Bytes == nil || len(sv.Bytes) > maxLen {
+			return fmt.Errorf("%s must be a byte array of at most %d bytes", fs.field, maxLen)
+		}
+		program := make([]byte, len(sv.Bytes))
+		copy(program, sv.Bytes)
+		if fs.field == ApprovalProgram {
+			txn.ApprovalProgram = program
+		} else {
+			txn.ClearStateProgram = program
+		}
+	case Assets:
+		if len(txn.ForeignAssets) >= cx.Proto.MaxAppTxnForeignAssets {
+			return errors.New("too many foreign assets")
+		}
+		var aid basics.AssetIndex
+		aid, err = cx.availableAsset(sv)
+		if err == nil {
+			txn.ForeignAssets = append(txn.ForeignAssets, aid)
+		}
+	case Applications:
+		if len(txn.ForeignApps) >= cx.Proto.MaxAppTxnForeignApps {
+			return errors.New("too many foreign apps")
+		}
+		var aid basics.AppIndex
+		aid, err = cx.availableApp(sv)
+		if err == nil {
+			txn.ForeignApps = append(txn.ForeignApps, aid)
+		}
+	case GlobalNumUint:
+		txn.GlobalStateSchema.NumUint, err = sv.uint()
+	case GlobalNumByteSlice:
+		txn.GlobalStateSchema.NumByteSlice, err = sv.uint()
+	case LocalNumUint:
+		txn.LocalStateSchema.NumUint, err = sv.uint()
+	case LocalNumByteSlice:
+		txn.LocalStateSchema.NumByteSlice, err = sv.uint()
+	case ExtraProgramPages:
+		var epp uint64
+		epp, err = sv.uint()
+		if err == nil {
+			if epp > uint64(cx.Proto.MaxExtraAppProgramPages) {
+				err = fmt.Errorf("too many extra program pages (%d)", epp)
+			} else {
+				txn.ExtraProgramPages = uint32(epp)
+			}
+		}
 
 	default:
 		return fmt.Errorf("invalid itxn_field %s", fs.field)
@@ -3978,6 +4448,8 @@ func opTxSubmit(cx *EvalContext) {
 		*cx.FeeCredit = basics.AddSaturate(*cx.FeeCredit, overpay)
 	}
 
+	var ep *EvalParams // created lazily, only needed for inner app calls
+	cx.lastInnerGroup = len(cx.InnerTxns)
 	for itx := range cx.subtxns {
 		// The goal is to follow the same invariants used by the
 		// transaction pool. Namely that any transaction that makes it
@@ -3995,7 +4467,16 @@ func opTxSubmit(cx *EvalContext) {
 			return
 		}
 
-		ad, err := cx.Ledger.Perform(&cx.subtxns[itx].Txn, *cx.Specials)
+		var ad transactions.ApplyData
+		var err error
+		if cx.subtxns[itx].Txn.Type == protocol.ApplicationCallTx {
+			if ep == nil {
+				ep = cx.innerEvalParams(cx.subtxns)
+			}
+			ad, err = cx.performAppCall(ep, itx)
+		} else {
+			ad, err = cx.Ledger.Perform(&cx.subtxns[itx].Txn, *cx.Specials)
+		}
 		if err != nil {
 			cx.err = err
 			return
@@ -4009,6 +4490,96 @@ func opTxSubmit(cx *EvalContext) {
 	cx.subtxns = nil
 }
 
+func opIncreaseBudget(cx *EvalContext) {
+	last := len(cx.stack) - 1
+	n := cx.stack[last].Uint
+	cx.stack = cx.stack[:last]
+
+	if !cx.Proto.EnableAppCostPooling || cx.PooledApplicationBudget == nil {
+		cx.err = errors.New("increase_budget without a pooled budget")
+		return
+	}
+	if n > uint64(cx.Proto.MaxInnerTransactions-cx.budgetIncreases) {
+		cx.err = fmt.Errorf("too many budget increases (%d)", uint64(cx.budgetIncreases)+n)
+		return
+	}
+	// Each increase costs what an inner app call would, and is paid from
+	// the fee credit of the group, so it must have been overpaid.
+	fee := n * cx.Proto.MinTxnFee
+	if cx.FeeCredit == nil || *cx.FeeCredit < fee {
+		cx.err = fmt.Errorf("fee credit too small to increase budget by %d", n)
+		return
+	}
+	*cx.FeeCredit -= fee
+	cx.budgetIncreases += int(n)
+
+	added := n * uint64(cx.Proto.MaxAppProgramCost)
+	*cx.PooledApplicationBudget += added
+	if cx.BudgetAdded == nil {
+		cx.BudgetAdded = new(uint64)
+	}
+	*cx.BudgetAdded += added
+}
+
+// innerEvalParams creates the EvalParams for app calls in an inner group
+// submitted by cx. The app calls share the budget and fee credit of cx, and
+// each adds the budget of an app call to the pool.
+func (cx *EvalContext) innerEvalParams(group []transactions.SignedTxn) *EvalParams {
+	if cx.Proto.EnableAppCostPooling && cx.PooledApplicationBudget != nil {
+		for _, stxn := range group {
+			if stxn.Txn.Type == protocol.ApplicationCallTx {
+				*cx.PooledApplicationBudget += uint64(cx.Proto.MaxAppProgramCost)
+				if cx.BudgetAdded == nil {
+					cx.BudgetAdded = new(uint64)
+				}
+				*cx.BudgetAdded += uint64(cx.Proto.MaxAppProgramCost)
+			}
+		}
+	}
+
+	minTealVersion := ComputeMinTealVersion(group)
+	return &EvalParams{
+		Proto:                   cx.Proto,
+		Trace:                   cx.Trace,
+		TxnGroup:                group,
+		PastSideEffects:         MakePastSideEffects(len(group)),
+		Logger:                  cx.Logger,
+		Debugger:                cx.Debugger,
+		MinTealVersion:          &minTealVersion,
+		FeeCredit:               cx.FeeCredit,
+		Specials:                cx.Specials,
+		PooledApplicationBudget: cx.PooledApplicationBudget,
+		BudgetAdded:             cx.BudgetAdded,
+		caller:                  cx,
+	}
+}
+
+// performAppCall executes the app call at index gi of the inner group
+// described by ep, after ensuring that it would neither re-enter an app
+// that is already running, nor nest app calls too deeply.
+func (cx *EvalContext) performAppCall(ep *EvalParams, gi int) (transactions.ApplyData, error) {
+	appID := ep.TxnGroup[gi].Txn.ApplicationID
+	depth := 0
+	for parent := cx; parent != nil; parent = parent.caller {
+		if appID != 0 && parent.Ledger.ApplicationID() == appID {
+			return transactions.ApplyData{}, fmt.Errorf("attempt to re-enter %d", appID)
+		}
+		depth++
+	}
+	if depth >= cx.Proto.MaxAppCallDepth {
+		return transactions.ApplyData{}, fmt.Errorf("appl depth (%d) exceeded", depth)
+	}
+
+	ledger, ok := cx.Ledger.(AppCallLedger)
+	if !ok {
+		return transactions.ApplyData{}, errors.New("ledger does not support inner app calls")
+	}
+
+	ep.Txn = &ep.TxnGroup[gi]
+	ep.GroupIndex = uint64(gi)
+	return ledger.PerformAppCall(ep)
+}
+
 // PcDetails return PC and disassembled instructions at PC up to 2 opcodes back
 func (cx *EvalContext) PcDetails() (pc int, dis string) {
 	const maxNumAdditionalOpcodes = 2
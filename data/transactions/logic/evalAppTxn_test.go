@@ -18,6 +18,7 @@ package logic
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/algorand/go-algorand/data/basics"
@@ -30,6 +31,9 @@ func TestInnerTypesV5(t *testing.T) {
 	// not alllowed in v5
 	testApp(t, "itxn_begin; byte \"keyreg\"; itxn_field Type; itxn_submit; int 1;", v5, "keyreg is not a valid Type for itxn_field")
 	testApp(t, "itxn_begin; int keyreg; itxn_field TypeEnum; itxn_submit; int 1;", v5, "keyreg is not a valid Type for itxn_field")
+	testApp(t, "itxn_begin; byte \"appl\"; itxn_field Type; itxn_submit; int 1;", v5, "appl is not a valid Type for itxn_field")
+	testApp(t, "itxn_begin; int appl; itxn_field TypeEnum; itxn_submit; int 1;", v5, "appl is not a valid Type for itxn_field")
+	testApp(t, "itxn_begin; int 7; itxn_field ApplicationID; int 1;", v5, "invalid itxn_field ApplicationID")
 }
 
 func TestCurrentInnerTypes(t *testing.T) {
@@ -44,10 +48,10 @@ func TestCurrentInnerTypes(t *testing.T) {
 	// or vice versa
 	testApp(t, obfuscate("itxn_begin; byte \"pay\"; itxn_field TypeEnum; itxn_submit; int 1;"), ep, "not a uint64")
 
-	// good types, not allowed yet
-	testApp(t, "itxn_begin; byte \"appl\"; itxn_field Type; itxn_submit; int 1;", ep, "appl is not a valid Type for itxn_field")
+	// appl is allowed, but this ledger can't perform it
+	testApp(t, "itxn_begin; byte \"appl\"; itxn_field Type; itxn_submit; int 1;", ep, "ledger does not support inner app calls")
 	// same, as enums
-	testApp(t, "itxn_begin; int appl; itxn_field TypeEnum; itxn_submit; int 1;", ep, "appl is not a valid Type for itxn_field")
+	testApp(t, "itxn_begin; int appl; itxn_field TypeEnum; itxn_submit; int 1;", ep, "ledger does not support inner app calls")
 	testApp(t, "itxn_begin; int 42; itxn_field TypeEnum; itxn_submit; int 1;", ep, "42 is not a valid TypeEnum")
 	testApp(t, "itxn_begin; int 0; itxn_field TypeEnum; itxn_submit; int 1;", ep, "0 is not a valid TypeEnum")
 
@@ -519,6 +523,72 @@ func TestFieldSetting(t *testing.T) {
 		"value is too long")
 }
 
+func TestAppFieldSetting(t *testing.T) {
+	ep, ledger := makeSampleEnv()
+	ledger.NewApp(ep.Txn.Txn.Receiver, 888, basics.AppParams{})
+	ep.Txn.Txn.ForeignApps = []basics.AppIndex{basics.AppIndex(999)}
+
+	testApp(t, "itxn_begin; int 999; itxn_field ApplicationID; int 1", ep)
+	testApp(t, "itxn_begin; int 998; itxn_field ApplicationID; int 1", ep,
+		"invalid App reference 998")
+	testApp(t, "itxn_begin; int 999; itxn_field Applications; int 1", ep)
+	testApp(t, "itxn_begin; int 998; itxn_field Applications; int 1", ep,
+		"invalid App reference 998")
+
+	// Array fields append
+	testApp(t, "itxn_begin; byte 0x01; itxn_field ApplicationArgs; byte 0x02; itxn_field ApplicationArgs; int 1", ep)
+	testApp(t, "itxn_begin;"+strings.Repeat("byte 0x01; itxn_field ApplicationArgs;", 4)+"int 1", ep)
+	testApp(t, "itxn_begin;"+strings.Repeat("byte 0x01; itxn_field ApplicationArgs;", 5)+"int 1", ep,
+		"too many application args")
+	testApp(t, "itxn_begin;"+strings.Repeat("txn Sender; itxn_field Accounts;", 4)+"int 1", ep,
+		"too many foreign accounts")
+
+	testApp(t, "itxn_begin; int DeleteApplication; itxn_field OnCompletion; int 1", ep)
+	testApp(t, "itxn_begin; int 6; itxn_field OnCompletion; int 1", ep,
+		"6 is larger than max=5 for OnCompletion")
+
+	testApp(t, "itxn_begin; int 500; bzero; itxn_field ApprovalProgram; int 1", ep)
+	testApp(t, "itxn_begin; int 501; bzero; itxn_field ApprovalProgram; int 1", ep,
+		"ApprovalProgram must be a byte array of at most 500 bytes")
+
+	testApp(t, "itxn_begin; int 0; itxn_field ExtraProgramPages; int 1", ep)
+	testApp(t, "itxn_begin; int 1; itxn_field ExtraProgramPages; int 1", ep,
+		"too many extra program pages (1)")
+}
+
+func TestInnerAppReentrancy(t *testing.T) {
+	ep, ledger := makeSampleEnv()
+	ledger.NewApp(ep.Txn.Txn.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(ledger.ApplicationID().Address(), 10*defaultEvalProto().MinTxnFee)
+	call := "itxn_begin; int appl; itxn_field TypeEnum; int 888; itxn_field ApplicationID; itxn_submit; int 1"
+	testApp(t, call, ep, "invalid App reference 888")
+	ep.Txn.Txn.ForeignApps = []basics.AppIndex{basics.AppIndex(888)}
+	testApp(t, call, ep, "attempt to re-enter 888")
+}
+
+func TestGitxn(t *testing.T) {
+	ep, ledger := makeSampleEnv()
+	ledger.NewApp(ep.Txn.Txn.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(ledger.ApplicationID().Address(), 1_000_000)
+	pay := `
+int pay;    itxn_field TypeEnum;
+int 500;    itxn_field Amount;
+txn Sender; itxn_field Receiver;
+`
+	group := "itxn_begin" + pay + "itxn_next" + pay + "int 700; itxn_field Amount; itxn_submit;"
+
+	testApp(t, "gitxn 0 Amount; int 500; ==", ep, "gitxn 0 ... but last inner group has 0")
+	testApp(t, group+"gitxn 0 Amount; int 500; ==; gitxn 1 Amount; int 700; ==; &&", ep)
+	testApp(t, group+"gitxn 1 Amount; itxn Amount; ==", ep)
+	testApp(t, group+"gitxn 2 Amount; int 1", ep, "gitxn 2 ... but last inner group has 2")
+	testApp(t, group+"gitxna 1 Accounts 0; global CurrentApplicationAddress; ==", ep)
+
+	// Only the most recent group is visible
+	single := "itxn_begin" + pay + "itxn_submit;"
+	testApp(t, group+single+"gitxn 0 Amount; int 500; ==", ep)
+	testApp(t, group+single+"gitxn 1 Amount; int 1", ep, "gitxn 1 ... but last inner group has 1")
+}
+
 func TestInnerGroup(t *testing.T) {
 	ep, ledger := makeSampleEnv()
 	ledger.NewApp(ep.Txn.Txn.Receiver, 888, basics.AppParams{})
@@ -1053,6 +1053,87 @@ func TestAppParams(t *testing.T) {
 	testApp(t, source, ep)
 }
 
+func TestAcctParams(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	ep, ledger := makeSampleEnv()
+
+	source := "int 0; acct_params_get AcctBalance; !; assert; int 0; =="
+	testApp(t, source, ep)
+
+	source = "int 0; acct_params_get AcctMinBalance; !; assert; int 1001; =="
+	testApp(t, source, ep)
+
+	ledger.NewAccount(ep.Txn.Txn.Sender, 42)
+
+	source = "int 0; acct_params_get AcctBalance; assert; int 42; =="
+	testApp(t, source, ep)
+
+	source = "int 0; acct_params_get AcctMinBalance; assert; int 1001; =="
+	testApp(t, source, ep)
+
+	source = "int 0; acct_params_get AcctAuthAddr; assert; global ZeroAddress; =="
+	testApp(t, source, ep)
+
+	ledger.Rekey(ep.Txn.Txn.Sender, ep.Txn.Txn.Receiver)
+	source = "int 0; acct_params_get AcctAuthAddr; assert; txn Receiver; =="
+	testApp(t, source, ep)
+
+	// No apps or schema at first
+	source = "int 0; acct_params_get AcctTotalAppsCreated; assert; !"
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalNumUint; assert; !"
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalNumByteSlice; assert; !"
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalExtraAppPages; assert; !"
+	testApp(t, source, ep)
+	ledger.NewApp(ep.Txn.Txn.Sender, 2000, basics.AppParams{
+		StateSchemas: basics.StateSchemas{
+			LocalStateSchema: basics.StateSchema{
+				NumUint:      6,
+				NumByteSlice: 7,
+			},
+			GlobalStateSchema: basics.StateSchema{
+				NumUint:      8,
+				NumByteSlice: 9,
+			},
+		},
+		ExtraProgramPages: 2,
+	})
+	// The test ledger opts the creator in, so both schemas count
+	source = "int 0; acct_params_get AcctTotalAppsCreated; assert; int 1; =="
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalAppsOptedIn; assert; int 1; =="
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalNumUint; assert; int 8; int 6; +; =="
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalNumByteSlice; assert; int 9; int 7; +; =="
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalExtraAppPages; assert; int 2; =="
+	testApp(t, source, ep)
+
+	// No assets at first, then 1 created and 1 held
+	source = "int 0; acct_params_get AcctTotalAssetsCreated; assert; !"
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalAssets; assert; !"
+	testApp(t, source, ep)
+	ledger.NewAsset(ep.Txn.Txn.Sender, 3000, basics.AssetParams{})
+	source = "int 0; acct_params_get AcctTotalAssetsCreated; assert; int 1; =="
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalAssets; assert; int 1; =="
+	testApp(t, source, ep)
+
+	source = "int 0; acct_params_get AcctTotalBoxes; assert; !"
+	testApp(t, source, ep)
+	source = "int 0; acct_params_get AcctTotalBoxBytes; assert; !"
+	testApp(t, source, ep)
+
+	testProg(t, "int 0; acct_params_get AcctBalance", 6, expect{2, "acct_params_get opcode was introduced..."})
+	testProg(t, "int 0; acct_params_get AcctMinBalance; int 1", 7)
+	testProg(t, "int 0; acct_params_get AcctNoSuchField", 7, expect{2, "acct_params_get unknown field..."})
+}
+
 func TestAppLocalReadWriteDeleteErrors(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -2348,6 +2429,8 @@ func TestReturnTypes(t *testing.T) {
 	algoValue := basics.TealValue{Type: basics.TealUintType, Uint: 0x77}
 	ledger.NewLocal(txn.Txn.Receiver, 1, string(key), algoValue)
 	ledger.NewAccount(basics.AppIndex(1).Address(), 1000000)
+	ep.Txn.Txn.Boxes = []transactions.BoxRef{{Name: key}, {Name: []byte("77")}}
+	ledger.NewBox(1, string(key), make([]byte, 4), basics.AppIndex(1).Address())
 
 	ep.Ledger = ledger
 
@@ -2357,6 +2440,9 @@ func TestReturnTypes(t *testing.T) {
 		"gtxn":              "gtxn 0 Sender",
 		"gtxna":             "gtxna 0 ApplicationArgs 0",
 		"global":            "global MinTxnFee",
+		"acct_params_get":   "acct_params_get AcctMinBalance",
+		"gitxn":             "itxn_begin; int pay; itxn_field TypeEnum; itxn_submit; gitxn 0 Sender",
+		"gitxna":            "itxn_begin; int pay; itxn_field TypeEnum; itxn_submit; gitxna 0 Accounts 0",
 		"arg":               "arg 0",
 		"load":              "load 0",
 		"store":             "store 0",
@@ -2366,6 +2452,7 @@ func TestReturnTypes(t *testing.T) {
 		"dig":               "dig 0",
 		"cover":             "cover 0",
 		"uncover":           "uncover 0",
+		"frame_dig":         "int 6; callsub f; b done; f: proto 1 1; frame_dig -1; retsub; done:",
 		"intc":              "intcblock 0; intc 0",
 		"intc_0":            "intcblock 0; intc_0",
 		"intc_1":            "intcblock 0 0; intc_1",
@@ -2392,6 +2479,8 @@ func TestReturnTypes(t *testing.T) {
 		"itxn":              "itxn_begin; int pay; itxn_field TypeEnum; itxn_submit; itxn CreatedAssetID",
 		// This next one is a cop out.  Can't use itxna Logs until we have inner appl
 		"itxna":         "itxn_begin; int pay; itxn_field TypeEnum; itxn_submit; itxn NumLogs",
+		"box_create":    "pop; int 4; box_create",
+		"box_del":       `pop; byte "77"; box_del`,
 		"base64_decode": `pushbytes "YWJjMTIzIT8kKiYoKSctPUB+"; base64_decode StdEncoding; pushbytes "abc123!?$*&()'-=@~"; ==; pushbytes "YWJjMTIzIT8kKiYoKSctPUB-"; base64_decode URLEncoding; pushbytes "abc123!?$*&()'-=@~"; ==; &&; assert`,
 	}
 
@@ -2440,7 +2529,7 @@ func TestReturnTypes(t *testing.T) {
 				for i := 0; i < len(spec.Returns); i++ {
 					sp := len(cx.stack) - 1 - i
 					stackType := cx.stack[sp].argType()
-					retType := spec.Returns[i]
+					retType := spec.Returns[len(spec.Returns)-1-i]
 					require.True(
 						t, typecheck(retType, stackType),
 						fmt.Sprintf("%s expected to return %s but actual is %s", spec.Name, retType.String(), stackType.String()),
@@ -2516,6 +2605,47 @@ func TestPooledAppCallsVerifyOp(t *testing.T) {
 	testApp(t, source, ep)
 }
 
+func TestIncreaseBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, _ := makeSampleEnv()
+	ep.Proto.EnableAppCostPooling = true
+	ep.PooledApplicationBudget = new(uint64)
+	ep.FeeCredit = new(uint64)
+	ep.BudgetAdded = new(uint64)
+	fee := ep.Proto.MinTxnFee
+	reset := func(credit uint64) {
+		*ep.PooledApplicationBudget = uint64(ep.Proto.MaxAppProgramCost)
+		*ep.FeeCredit = credit
+		*ep.BudgetAdded = 0
+	}
+
+	// costs about 1200, more than a single app call may use
+	loop := "global CurrentApplicationID; pop; int 1; loop: int 1; +; dup; int 200; <; bnz loop; pop; int 1"
+	reset(0)
+	testApp(t, loop, ep, "dynamic cost budget exceeded")
+	reset(fee - 1)
+	testApp(t, "int 1; increase_budget;"+loop, ep, "fee credit too small to increase budget by 1")
+
+	reset(fee + 5)
+	testApp(t, "int 1; increase_budget;"+loop, ep)
+	require.Equal(t, uint64(5), *ep.FeeCredit)
+	require.Equal(t, uint64(ep.Proto.MaxAppProgramCost), *ep.BudgetAdded)
+	require.Less(t, *ep.PooledApplicationBudget, uint64(ep.Proto.MaxAppProgramCost))
+
+	// In the sample proto, MaxInnerTransactions = 4
+	reset(5 * fee)
+	testApp(t, "int 4; increase_budget; int 1", ep)
+	reset(5 * fee)
+	testApp(t, "int 5; increase_budget; int 1", ep, "too many budget increases (5)")
+	reset(5 * fee)
+	testApp(t, "int 3; increase_budget; int 2; increase_budget; int 1", ep, "too many budget increases (5)")
+
+	ep.PooledApplicationBudget = nil
+	testApp(t, "int 1; increase_budget; int 1", ep, "increase_budget without a pooled budget")
+}
+
 func TestAppAddress(t *testing.T) {
 	ep, ledger := makeSampleEnv()
 	ledger.NewApp(ep.Txn.Txn.Receiver, 888, basics.AppParams{})
@@ -82,6 +82,20 @@ func defaultEvalProtoWithVersion(version uint64) config.ConsensusParams {
 		MaxAppTxnAccounts:      3,
 		MaxAppTxnForeignApps:   5,
 		MaxAppTxnForeignAssets: 6,
+
+		// Enough to build inner app calls
+		MaxAppArgs:               4,
+		MaxAppTotalArgLen:        100,
+		MaxAppProgramLen:         500,
+		MaxAppTotalTxnReferences: 8,
+		MaxAppCallDepth:          8,
+		MinInnerApplVersion:      4,
+
+		EnableBoxes:         version >= boxVersion,
+		MaxBoxSize:          1000,
+		MaxAppBoxReferences: 8,
+		BoxFlatMinBalance:   1006,
+		BoxByteMinBalance:   1007,
 	}
 }
 
@@ -1012,6 +1026,10 @@ const globalV6TestProgram = globalV5TestProgram + `
 // No new globals in v6
 `
 
+const globalV7TestProgram = globalV6TestProgram + `
+// No new globals in v7
+`
+
 func TestGlobal(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -1045,6 +1063,10 @@ func TestGlobal(t *testing.T) {
 			GroupID, globalV6TestProgram,
 			EvalStateful, CheckStateful,
 		},
+		7: {
+			GroupID, globalV7TestProgram,
+			EvalStateful, CheckStateful,
+		},
 	}
 	// tests keys are versions so they must be in a range 1..AssemblerMaxVersion plus zero version
 	require.LessOrEqual(t, len(tests), AssemblerMaxVersion+1)
@@ -4466,6 +4488,95 @@ func TestUncover(t *testing.T) {
 	testPanics(t, obfuscate("int 4; int 3; int 2; int 1; uncover 4; int 2; ==; return"), 5)
 }
 
+func TestBury(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testAccepts(t, "int 4; int 3; int 2; int 1; bury 1; int 1; ==; assert; int 3; ==; assert; int 4; ==", 7)
+	testAccepts(t, "int 4; int 3; int 2; int 1; bury 3; int 2; ==; assert; int 3; ==; assert; int 1; ==", 7)
+	testPanics(t, "int 4; int 3; bury 0; int 1", 7)
+	testPanics(t, obfuscate("int 4; int 3; bury 2; int 1"), 7)
+}
+
+func TestPopN(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testAccepts(t, "int 1; int 0; int 0; popn 2", 7)
+	testAccepts(t, "int 1; popn 0", 7)
+	testPanics(t, obfuscate("int 1; int 0; popn 3; int 1"), 7)
+}
+
+func TestDupN(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testAccepts(t, "int 1; dupn 2; +; +; int 3; ==", 7)
+	testAccepts(t, "int 1; dupn 0", 7)
+	testPanics(t, obfuscate("dupn 2; int 1"), 7)
+}
+
+func TestSwitch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testAccepts(t, "int 0; switch zero one; err; zero: int 1; return; one: err", 7)
+	testAccepts(t, "int 1; switch zero one; err; zero: err; one: int 1", 7)
+	// out of range indexes fall through
+	testAccepts(t, "int 2; switch zero one; int 1; return; zero: err; one: err", 7)
+	testAccepts(t, "int 0; switch; int 1", 7)
+	// back branches are allowed
+	testAccepts(t, "int 0; b start; loop: int 1; return; start: switch loop; err", 7)
+	testPanics(t, obfuscate(`byte "x"; switch zero; zero: int 1`), 7)
+}
+
+func TestMatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	testAccepts(t, "int 1; int 2; int 3; int 2; match one two three; err; one: err; two: int 1; return; three: err", 7)
+	testAccepts(t, `byte "a"; byte "b"; byte "b"; match a b; err; a: err; b: int 1`, 7)
+	// types must match as well as values
+	testAccepts(t, `int 1; byte 0x01; match one; int 1; return; one: err`, 7)
+	// first match wins
+	testAccepts(t, "int 5; int 5; int 5; match first second; err; first: int 1; return; second: err", 7)
+	testAccepts(t, "int 1; int 2; int 9; match one two; int 1; return; one: err; two: err", 7)
+	testAccepts(t, "int 1; match; int 1", 7)
+	testPanics(t, obfuscate("int 1; match one two; one:; two:; int 1"), 7)
+}
+
+func TestFrames(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	// a subroutine that adds its two arguments
+	testAccepts(t, `int 3; int 4; callsub add; int 7; ==; return
+add: proto 2 1; frame_dig -2; frame_dig -1; +; retsub`, 7)
+	// retsub removes the arguments and any locals
+	testAccepts(t, `int 9; int 3; int 4; callsub add; int 7; ==; assert; int 9; ==; return
+add: proto 2 1; int 10; int 11; frame_dig -2; frame_dig -1; +; retsub`, 7)
+	// locals can be changed, and multiple values returned
+	testAccepts(t, `int 3; callsub double; +; int 12; ==; return
+double: proto 1 2; int 0; frame_dig -1; dup; +; frame_bury 0; frame_dig 0; frame_dig 0; retsub`, 7)
+	// arguments can be replaced
+	testAccepts(t, `int 3; callsub inc; int 4; ==; return
+inc: proto 1 1; frame_dig -1; int 1; +; frame_bury -1; frame_dig -1; retsub`, 7)
+	// without proto, retsub leaves the stack alone
+	testAccepts(t, `int 3; callsub f; +; int 8; ==; return
+f: int 5; retsub`, 4)
+	// recursion
+	testAccepts(t, `int 5; callsub fact; int 120; ==; return
+fact: proto 1 1
+ frame_dig -1; int 1; <=; bz recurse; int 1; retsub
+recurse: frame_dig -1; dup; int 1; -; callsub fact; *; retsub`, 7)
+
+	testPanics(t, "proto 0 0; int 1", 7)
+	testPanics(t, "b main; f: int 1; proto 0 0; retsub; main: callsub f; int 1", 7)
+	testPanics(t, "b main; f: proto 0 0; b f; main: callsub f; int 1", 7)
+	testPanics(t, "b main; f: proto 1 0; retsub; main: callsub f; int 1", 7)
+	testPanics(t, "b main; f: frame_dig -1; retsub; main: int 1; callsub f", 7)
+	testPanics(t, "int 1; frame_dig -1", 7)
+	testPanics(t, "b main; f: proto 1 1; frame_dig -2; retsub; main: int 1; callsub f", 7)
+	testPanics(t, "b main; f: proto 1 1; frame_dig 0; retsub; main: int 1; callsub f", 7)
+	testPanics(t, "b main; f: proto 1 1; frame_dig -1; frame_bury 0; retsub; main: int 1; callsub f", 7)
+	testPanics(t, "b main; f: proto 1 1; int 2; frame_bury -2; retsub; main: int 1; callsub f", 7)
+	testPanics(t, "b main; f: proto 1 1; pop; retsub; main: int 1; callsub f", 7)
+}
+
 func TestPush(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
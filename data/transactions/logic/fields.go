@@ -23,7 +23,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
-//go:generate stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding -output=fields_string.go
+//go:generate stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding -output=fields_string.go
 
 // TxnField is an enum type for `txn` and `gtxn`
 type TxnField int
@@ -216,14 +216,14 @@ var txnFieldSpecs = []txnFieldSpec{
 	{AssetCloseTo, StackBytes, 0, 5, false},
 	{GroupIndex, StackUint64, 0, 0, false},
 	{TxID, StackBytes, 0, 0, false},
-	{ApplicationID, StackUint64, 2, 0, false},
-	{OnCompletion, StackUint64, 2, 0, false},
-	{ApplicationArgs, StackBytes, 2, 0, false},
+	{ApplicationID, StackUint64, 2, innerAppsEnabledVersion, false},
+	{OnCompletion, StackUint64, 2, innerAppsEnabledVersion, false},
+	{ApplicationArgs, StackBytes, 2, innerAppsEnabledVersion, false},
 	{NumAppArgs, StackUint64, 2, 0, false},
-	{Accounts, StackBytes, 2, 0, false},
+	{Accounts, StackBytes, 2, innerAppsEnabledVersion, false},
 	{NumAccounts, StackUint64, 2, 0, false},
-	{ApprovalProgram, StackBytes, 2, 0, false},
-	{ClearStateProgram, StackBytes, 2, 0, false},
+	{ApprovalProgram, StackBytes, 2, innerAppsEnabledVersion, false},
+	{ClearStateProgram, StackBytes, 2, innerAppsEnabledVersion, false},
 	{RekeyTo, StackBytes, 2, 6, false},
 	{ConfigAsset, StackUint64, 2, 5, false},
 	{ConfigAssetTotal, StackUint64, 2, 5, false},
@@ -240,15 +240,15 @@ var txnFieldSpecs = []txnFieldSpec{
 	{FreezeAsset, StackUint64, 2, 5, false},
 	{FreezeAssetAccount, StackBytes, 2, 5, false},
 	{FreezeAssetFrozen, StackUint64, 2, 5, false},
-	{Assets, StackUint64, 3, 0, false},
+	{Assets, StackUint64, 3, innerAppsEnabledVersion, false},
 	{NumAssets, StackUint64, 3, 0, false},
-	{Applications, StackUint64, 3, 0, false},
+	{Applications, StackUint64, 3, innerAppsEnabledVersion, false},
 	{NumApplications, StackUint64, 3, 0, false},
-	{GlobalNumUint, StackUint64, 3, 0, false},
-	{GlobalNumByteSlice, StackUint64, 3, 0, false},
-	{LocalNumUint, StackUint64, 3, 0, false},
-	{LocalNumByteSlice, StackUint64, 3, 0, false},
-	{ExtraProgramPages, StackUint64, 4, 0, false},
+	{GlobalNumUint, StackUint64, 3, innerAppsEnabledVersion, false},
+	{GlobalNumByteSlice, StackUint64, 3, innerAppsEnabledVersion, false},
+	{LocalNumUint, StackUint64, 3, innerAppsEnabledVersion, false},
+	{LocalNumByteSlice, StackUint64, 3, innerAppsEnabledVersion, false},
+	{ExtraProgramPages, StackUint64, 4, innerAppsEnabledVersion, false},
 	{Nonparticipation, StackUint64, 5, 6, false},
 
 	{Logs, StackBytes, 5, 5, true},
@@ -285,6 +285,7 @@ var innerTxnTypes = map[string]uint64{
 	string(protocol.AssetTransferTx):   5,
 	string(protocol.AssetConfigTx):     5,
 	string(protocol.AssetFreezeTx):     5,
+	string(protocol.ApplicationCallTx): innerAppsEnabledVersion,
 }
 
 // TxnTypeNames is the values of Txn.Type in enum order
@@ -668,6 +669,81 @@ func (s appNameSpecMap) getExtraFor(name string) (extra string) {
 	return
 }
 
+// AcctParamsField is an enum for `acct_params_get` opcode
+type AcctParamsField int
+
+const (
+	// AcctBalance is the balance, with pending rewards
+	AcctBalance AcctParamsField = iota
+	// AcctMinBalance is algos needed for this accounts apps and assets
+	AcctMinBalance
+	// AcctAuthAddr is the rekeyed address if any, else ZeroAddress
+	AcctAuthAddr
+
+	// AcctTotalNumUint is the count of all uints from created global apps or opted in locals
+	AcctTotalNumUint
+	// AcctTotalNumByteSlice is the count of all byte slices from created global apps or opted in locals
+	AcctTotalNumByteSlice
+	// AcctTotalExtraAppPages is the extra code pages across all apps
+	AcctTotalExtraAppPages
+
+	// AcctTotalAppsCreated is the number of apps created by this account
+	AcctTotalAppsCreated
+	// AcctTotalAppsOptedIn is the number of apps opted in by this account
+	AcctTotalAppsOptedIn
+	// AcctTotalAssetsCreated is the number of ASAs created by this account
+	AcctTotalAssetsCreated
+	// AcctTotalAssets is the number of ASAs opted in by this account (always includes AcctTotalAssetsCreated)
+	AcctTotalAssets
+	// AcctTotalBoxes is the number of boxes created by the app this account is associated with
+	AcctTotalBoxes
+	// AcctTotalBoxBytes is the number of bytes in all boxes of this app account
+	AcctTotalBoxBytes
+
+	invalidAcctParamsField
+)
+
+// AcctParamsFieldNames are arguments to the 'acct_params_get' opcode
+var AcctParamsFieldNames []string
+
+// AcctParamsFieldTypes is StackUint64 StackBytes in parallel with AcctParamsFieldNames
+var AcctParamsFieldTypes []StackType
+
+type acctParamsFieldSpec struct {
+	field   AcctParamsField
+	ftype   StackType
+	version uint64
+}
+
+var acctParamsFieldSpecs = []acctParamsFieldSpec{
+	{AcctBalance, StackUint64, 7},
+	{AcctMinBalance, StackUint64, 7},
+	{AcctAuthAddr, StackBytes, 7},
+	{AcctTotalNumUint, StackUint64, 7},
+	{AcctTotalNumByteSlice, StackUint64, 7},
+	{AcctTotalExtraAppPages, StackUint64, 7},
+	{AcctTotalAppsCreated, StackUint64, 7},
+	{AcctTotalAppsOptedIn, StackUint64, 7},
+	{AcctTotalAssetsCreated, StackUint64, 7},
+	{AcctTotalAssets, StackUint64, 7},
+	{AcctTotalBoxes, StackUint64, boxVersion},
+	{AcctTotalBoxBytes, StackUint64, boxVersion},
+}
+
+var acctParamsFieldSpecByField map[AcctParamsField]acctParamsFieldSpec
+var acctParamsFieldSpecByName acctNameSpecMap
+
+// simple interface used by doc generator for fields versioning
+type acctNameSpecMap map[string]acctParamsFieldSpec
+
+func (s acctNameSpecMap) getExtraFor(name string) (extra string) {
+	// Uses 7 here because acct fields were introduced in 7
+	if s[name].version > 7 {
+		extra = fmt.Sprintf("LogicSigVersion >= %d.", s[name].version)
+	}
+	return
+}
+
 func init() {
 	TxnFieldNames = make([]string, int(invalidTxnField))
 	for fi := Sender; fi < invalidTxnField; fi++ {
@@ -774,6 +850,21 @@ func init() {
 		appParamsFieldSpecByName[apfn] = appParamsFieldSpecByField[AppParamsField(i)]
 	}
 
+	AcctParamsFieldNames = make([]string, int(invalidAcctParamsField))
+	for i := AcctBalance; i < invalidAcctParamsField; i++ {
+		AcctParamsFieldNames[int(i)] = i.String()
+	}
+	AcctParamsFieldTypes = make([]StackType, len(AcctParamsFieldNames))
+	acctParamsFieldSpecByField = make(map[AcctParamsField]acctParamsFieldSpec, len(AcctParamsFieldNames))
+	for _, s := range acctParamsFieldSpecs {
+		AcctParamsFieldTypes[int(s.field)] = s.ftype
+		acctParamsFieldSpecByField[s.field] = s
+	}
+	acctParamsFieldSpecByName = make(acctNameSpecMap, len(AcctParamsFieldNames))
+	for i, apfn := range AcctParamsFieldNames {
+		acctParamsFieldSpecByName[apfn] = acctParamsFieldSpecByField[AcctParamsField(i)]
+	}
+
 	txnTypeIndexes = make(map[string]uint64, len(TxnTypeNames))
 	for i, tt := range TxnTypeNames {
 		txnTypeIndexes[tt] = uint64(i)
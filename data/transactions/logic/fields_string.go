@@ -1,4 +1,4 @@
-// Code generated by "stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding -output=fields_string.go"; DO NOT EDIT.
+// Code generated by "stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding -output=fields_string.go"; DO NOT EDIT.
 
 package logic
 
@@ -167,6 +167,35 @@ func (i AppParamsField) String() string {
 	}
 	return _AppParamsField_name[_AppParamsField_index[i]:_AppParamsField_index[i+1]]
 }
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[AcctBalance-0]
+	_ = x[AcctMinBalance-1]
+	_ = x[AcctAuthAddr-2]
+	_ = x[AcctTotalNumUint-3]
+	_ = x[AcctTotalNumByteSlice-4]
+	_ = x[AcctTotalExtraAppPages-5]
+	_ = x[AcctTotalAppsCreated-6]
+	_ = x[AcctTotalAppsOptedIn-7]
+	_ = x[AcctTotalAssetsCreated-8]
+	_ = x[AcctTotalAssets-9]
+	_ = x[AcctTotalBoxes-10]
+	_ = x[AcctTotalBoxBytes-11]
+	_ = x[invalidAcctParamsField-12]
+}
+
+const _AcctParamsField_name = "AcctBalanceAcctMinBalanceAcctAuthAddrAcctTotalNumUintAcctTotalNumByteSliceAcctTotalExtraAppPagesAcctTotalAppsCreatedAcctTotalAppsOptedInAcctTotalAssetsCreatedAcctTotalAssetsAcctTotalBoxesAcctTotalBoxBytesinvalidAcctParamsField"
+
+var _AcctParamsField_index = [...]uint8{0, 11, 25, 37, 53, 74, 96, 116, 136, 158, 173, 187, 204, 226}
+
+func (i AcctParamsField) String() string {
+	if i < 0 || i >= AcctParamsField(len(_AcctParamsField_index)-1) {
+		return "AcctParamsField(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _AcctParamsField_name[_AcctParamsField_index[i]:_AcctParamsField_index[i+1]]
+}
 func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
 	// Re-run the stringer command to generate them again.
@@ -21,7 +21,7 @@ import (
 )
 
 // LogicVersion defines default assembler and max eval versions
-const LogicVersion = 6
+const LogicVersion = 7
 
 // rekeyingEnabledVersion is the version of TEAL where RekeyTo functionality
 // was enabled. This is important to remember so that old TEAL accounts cannot
@@ -42,6 +42,14 @@ const backBranchEnabledVersion = 4
 // using an index into arrays.
 const directRefEnabledVersion = 4
 
+// innerAppsEnabledVersion is the first version of TEAL in which inner
+// transactions may be application calls
+const innerAppsEnabledVersion = 6
+
+// boxVersion is the first version of TEAL in which applications can
+// store data in boxes
+const boxVersion = 7
+
 // opDetails records details such as non-standard costs, immediate
 // arguments, or dynamic layout controlled by a check function.
 type opDetails struct {
@@ -50,13 +58,16 @@ type opDetails struct {
 	checkFunc  opCheckFunc
 	Immediates []immediate
 	typeFunc   opTypeFunc
+	// varyingStack is set for opcodes whose effect on the stack height
+	// depends on immediates or runtime values, rather than Args and Returns
+	varyingStack bool
 }
 
-var opDefault = opDetails{1, 1, nil, nil, nil}
-var opBranch = opDetails{1, 3, checkBranch, []immediate{{"target", immLabel}}, nil}
+var opDefault = opDetails{1, 1, nil, nil, nil, false}
+var opBranch = opDetails{1, 3, checkBranch, []immediate{{"target", immLabel}}, nil, false}
 
 func costly(cost int) opDetails {
-	return opDetails{cost, 1, nil, nil, nil}
+	return opDetails{cost, 1, nil, nil, nil, false}
 }
 
 func immediates(names ...string) opDetails {
@@ -64,7 +75,16 @@ func immediates(names ...string) opDetails {
 	for i, name := range names {
 		immediates[i] = immediate{name, immByte}
 	}
-	return opDetails{1, 1 + len(immediates), nil, immediates, nil}
+	return opDetails{1, 1 + len(immediates), nil, immediates, nil, false}
+}
+
+// signed is like immediates, but the immediates are signed bytes
+func signed(names ...string) opDetails {
+	d := immediates(names...)
+	for i := range d.Immediates {
+		d.Immediates[i].kind = immInt8
+	}
+	return d
 }
 
 func stacky(typer opTypeFunc, imms ...string) opDetails {
@@ -74,7 +94,23 @@ func stacky(typer opTypeFunc, imms ...string) opDetails {
 }
 
 func varies(checker opCheckFunc, name string, kind immKind) opDetails {
-	return opDetails{1, 0, checker, []immediate{{name, kind}}, nil}
+	return opDetails{1, 0, checker, []immediate{{name, kind}}, nil, false}
+}
+
+// varying marks an opcode as having a stack effect that is not described
+// by its Args and Returns
+func (d opDetails) varying() opDetails {
+	d.varyingStack = true
+	return d
+}
+
+// switched turns d into the details of an opcode followed by a count and
+// that many label offsets
+func (d opDetails) switched() opDetails {
+	d.Size = 0
+	d.checkFunc = checkSwitch
+	d.Immediates = []immediate{{"target ...", immLabels}}
+	return d
 }
 
 func costlyImm(cost int, names ...string) opDetails {
@@ -93,6 +129,8 @@ const (
 	immBytes
 	immInts
 	immBytess // "ss" not a typo.  Multiple "bytes"
+	immLabels
+	immInt8
 )
 
 type immediate struct {
@@ -119,6 +157,7 @@ var twoBytes = StackTypes{StackBytes, StackBytes}
 var threeBytes = StackTypes{StackBytes, StackBytes, StackBytes}
 var byteInt = StackTypes{StackBytes, StackUint64}
 var byteIntInt = StackTypes{StackBytes, StackUint64, StackUint64}
+var byteIntBytes = StackTypes{StackBytes, StackUint64, StackBytes}
 var oneInt = StackTypes{StackUint64}
 var twoInts = StackTypes{StackUint64, StackUint64}
 var oneAny = StackTypes{StackAny}
@@ -226,6 +265,9 @@ var OpSpecs = []OpSpec{
 	{0x42, "b", opB, assembleBranch, disBranch, nil, nil, 2, modeAny, opBranch},
 	{0x43, "return", opReturn, asmDefault, disDefault, oneInt, nil, 2, modeAny, opDefault},
 	{0x44, "assert", opAssert, asmDefault, disDefault, oneInt, nil, 3, modeAny, opDefault},
+	{0x45, "bury", opBury, asmDefault, disDefault, oneAny, nil, 7, modeAny, stacky(typeBury, "n")},
+	{0x46, "popn", opPopN, asmDefault, disDefault, nil, nil, 7, modeAny, stacky(typePopN, "n").varying()},
+	{0x47, "dupn", opDupN, asmDefault, disDefault, oneAny, nil, 7, modeAny, stacky(typeDupN, "n").varying()},
 	{0x48, "pop", opPop, asmDefault, disDefault, oneAny, nil, 1, modeAny, opDefault},
 	{0x49, "dup", opDup, asmDefault, disDefault, oneAny, twoAny, 1, modeAny, stacky(typeDup)},
 	{0x4a, "dup2", opDup2, asmDefault, disDefault, twoAny, twoAny.plus(twoAny), 2, modeAny, stacky(typeDupTwo)},
@@ -273,6 +315,7 @@ var OpSpecs = []OpSpec{
 	{0x70, "asset_holding_get", opAssetHoldingGet, assembleAssetHolding, disAssetHolding, oneAny.plus(oneInt), oneAny.plus(oneInt), directRefEnabledVersion, runModeApplication, immediates("i")},
 	{0x71, "asset_params_get", opAssetParamsGet, assembleAssetParams, disAssetParams, oneInt, oneAny.plus(oneInt), 2, runModeApplication, immediates("i")},
 	{0x72, "app_params_get", opAppParamsGet, assembleAppParams, disAppParams, oneInt, oneAny.plus(oneInt), 5, runModeApplication, immediates("i")},
+	{0x73, "acct_params_get", opAcctParamsGet, assembleAcctParams, disAcctParams, oneAny, oneAny.plus(oneInt), 7, runModeApplication, immediates("i")},
 
 	{0x78, "min_balance", opMinBalance, asmDefault, disDefault, oneInt, oneInt, 3, runModeApplication, opDefault},
 	{0x78, "min_balance", opMinBalance, asmDefault, disDefault, oneAny, oneInt, directRefEnabledVersion, runModeApplication, opDefault},
@@ -283,7 +326,12 @@ var OpSpecs = []OpSpec{
 
 	// "Function oriented"
 	{0x88, "callsub", opCallSub, assembleBranch, disBranch, nil, nil, 4, modeAny, opBranch},
-	{0x89, "retsub", opRetSub, asmDefault, disDefault, nil, nil, 4, modeAny, opDefault},
+	{0x89, "retsub", opRetSub, asmDefault, disDefault, nil, nil, 4, modeAny, opDefault.varying()},
+	{0x8a, "proto", opProto, asmProto, disDefault, nil, nil, 7, modeAny, immediates("a", "r")},
+	{0x8b, "frame_dig", opFrameDig, asmDefault, disDefault, nil, oneAny, 7, modeAny, signed("i")},
+	{0x8c, "frame_bury", opFrameBury, asmDefault, disDefault, oneAny, nil, 7, modeAny, signed("i")},
+	{0x8d, "switch", opSwitch, asmSwitch, disSwitch, oneInt, nil, 7, modeAny, opDefault.switched()},
+	{0x8e, "match", opMatch, asmSwitch, disSwitch, nil, nil, 7, modeAny, stacky(typeMatch).switched().varying()},
 	// Leave a little room for indirect function calls, or similar
 
 	// More math
@@ -320,12 +368,26 @@ var OpSpecs = []OpSpec{
 	{0xb4, "itxn", opItxn, asmItxn, disTxn, nil, oneAny, 5, runModeApplication, immediates("f")},
 	{0xb5, "itxna", opItxna, asmItxna, disTxna, nil, oneAny, 5, runModeApplication, immediates("f", "i")},
 	{0xb6, "itxn_next", opTxNext, asmDefault, disDefault, nil, nil, 6, runModeApplication, opDefault},
+	{0xb7, "gitxn", opGitxn, asmGitxn, disGtxn, nil, oneAny, 6, runModeApplication, immediates("t", "f")},
+	{0xb8, "gitxna", opGitxna, assembleGtxna, disGtxna, nil, oneAny, 6, runModeApplication, immediates("t", "f", "i")},
+
+	// Application boxes
+	{0xb9, "box_create", opBoxCreate, asmDefault, disDefault, byteInt, oneInt, boxVersion, runModeApplication, opDefault},
+	{0xba, "box_extract", opBoxExtract, asmDefault, disDefault, byteIntInt, oneBytes, boxVersion, runModeApplication, opDefault},
+	{0xbb, "box_replace", opBoxReplace, asmDefault, disDefault, byteIntBytes, nil, boxVersion, runModeApplication, opDefault},
+	{0xbc, "box_del", opBoxDel, asmDefault, disDefault, oneBytes, oneInt, boxVersion, runModeApplication, opDefault},
+	{0xbd, "box_len", opBoxLen, asmDefault, disDefault, oneBytes, twoInts, boxVersion, runModeApplication, opDefault},
+	{0xbe, "box_get", opBoxGet, asmDefault, disDefault, oneBytes, oneBytes.plus(oneInt), boxVersion, runModeApplication, opDefault},
+	{0xbf, "box_put", opBoxPut, asmDefault, disDefault, twoBytes, nil, boxVersion, runModeApplication, opDefault},
 
 	// Dynamic indexing
 	{0xc0, "txnas", opTxnas, assembleTxnas, disTxn, oneInt, oneAny, 5, modeAny, immediates("f")},
 	{0xc1, "gtxnas", opGtxnas, assembleGtxnas, disGtxn, oneInt, oneAny, 5, modeAny, immediates("t", "f")},
 	{0xc2, "gtxnsas", opGtxnsas, assembleGtxnsas, disTxn, twoInts, oneAny, 5, modeAny, immediates("f")},
 	{0xc3, "args", opArgs, asmDefault, disDefault, oneInt, oneBytes, 5, runModeSignature, opDefault},
+
+	// Budget
+	{0xd0, "increase_budget", opIncreaseBudget, asmDefault, disDefault, oneInt, nil, 7, runModeApplication, opDefault},
 }
 
 type sortByOpcode []OpSpec
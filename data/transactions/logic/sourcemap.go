@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+// sourceMapVersion is the version of the source map format produced.
+const sourceMapVersion = 3
+
+// SourceMap is a version 3 source map of an assembled program. Each line of
+// the generated "file" is a byte offset into the program, so the mappings
+// hold one group per program byte, empty for the bytes that do not start an
+// opcode, and the source line of a program counter is found by decoding the
+// group at that index.
+type SourceMap struct {
+	Version    int      `json:"version"`
+	File       string   `json:"file,omitempty"`
+	SourceRoot string   `json:"sourceRoot,omitempty"`
+	Sources    []string `json:"sources"`
+	Names      []string `json:"names"`
+	Mappings   string   `json:"mappings"`
+}
+
+// GetSourceMap builds the source map of the program assembled by ops from the
+// source named sourceName. The sources it included follow in the Sources.
+func (ops *OpStream) GetSourceMap(sourceName string) SourceMap {
+	offsets := make([]int, 0, len(ops.OffsetToLine))
+	for pc := range ops.OffsetToLine {
+		offsets = append(offsets, pc)
+	}
+	sort.Ints(offsets)
+
+	groups := len(ops.Program)
+	if len(offsets) > 0 && offsets[len(offsets)-1] >= groups {
+		groups = offsets[len(offsets)-1] + 1
+	}
+	pcToMapping := make([]string, groups)
+	prevSource, prevLine, prevColumn := 0, 0, 0
+	for _, pc := range offsets {
+		source, line, column := ops.OffsetToSource[pc], ops.OffsetToLine[pc], ops.OffsetToColumn[pc]
+		pcToMapping[pc] = MakeSourceMapLine(0, source-prevSource, line-prevLine, column-prevColumn)
+		prevSource, prevLine, prevColumn = source, line, column
+	}
+
+	return SourceMap{
+		Version:  sourceMapVersion,
+		Sources:  append([]string{sourceName}, ops.IncludedSources...),
+		Names:    []string{},
+		Mappings: strings.Join(pcToMapping, ";"),
+	}
+}
+
+const b64table string = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// IntToVLQ writes out value to bytes.Buffer
+func IntToVLQ(v int, buf *bytes.Buffer) {
+	v <<= 1
+	if v < 0 {
+		v = -v
+		v |= 1
+	}
+	for v >= 32 {
+		buf.WriteByte(b64table[32|(v&31)])
+		v >>= 5
+	}
+	buf.WriteByte(b64table[v])
+}
+
+// MakeSourceMapLine creates source map mapping's line entry
+func MakeSourceMapLine(tcol, sindex, sline, scol int) string {
+	buf := bytes.NewBuffer(nil)
+	IntToVLQ(tcol, buf)
+	IntToVLQ(sindex, buf)
+	IntToVLQ(sline, buf)
+	IntToVLQ(scol, buf)
+	return buf.String()
+}
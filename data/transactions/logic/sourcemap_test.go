@@ -14,13 +14,16 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
 
-package main
+package logic
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
-	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
 func TestVLQ(t *testing.T) {
@@ -34,3 +37,43 @@ func TestVLQ(t *testing.T) {
 	a.Equal("AAggBA", MakeSourceMapLine(0, 0, 512, 0))
 	a.Equal("ADggBD", MakeSourceMapLine(0, -1, 512, -1))
 }
+
+func TestGetSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source := `#pragma version 4
+int 1
+  int 2
+// comment
+done: +
+`
+	ops, err := AssembleString(source)
+	require.NoError(t, err)
+	// pushint 1, pushint 2, +
+	require.Equal(t, []byte{0x04, 0x81, 0x01, 0x81, 0x02, 0x08}, ops.Program)
+	require.Equal(t, map[int]int{1: 1, 3: 2, 5: 4}, ops.OffsetToLine)
+	require.Equal(t, map[int]int{1: 0, 3: 2, 5: 6}, ops.OffsetToColumn)
+
+	sm := ops.GetSourceMap("sum.teal")
+	require.Equal(t, 3, sm.Version)
+	require.Equal(t, []string{"sum.teal"}, sm.Sources)
+
+	groups := strings.Split(sm.Mappings, ";")
+	require.Len(t, groups, len(ops.Program))
+	for pc, group := range groups {
+		switch pc {
+		case 1:
+			require.Equal(t, MakeSourceMapLine(0, 0, 1, 0), group)
+		case 3:
+			require.Equal(t, MakeSourceMapLine(0, 0, 1, 2), group)
+		case 5:
+			require.Equal(t, MakeSourceMapLine(0, 0, 2, 4), group)
+		default:
+			require.Empty(t, group)
+		}
+	}
+
+	data, err := json.Marshal(&sm)
+	require.NoError(t, err)
+	require.Equal(t, `{"version":3,"sources":["sum.teal"],"names":[],"mappings":";AACA;;AACE;;AAEI"}`, string(data))
+}
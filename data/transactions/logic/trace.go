@@ -0,0 +1,450 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/algorand/go-codec/codec"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// Trace event kinds
+const (
+	// TraceStart is recorded when a program starts
+	TraceStart = "start"
+	// TraceStep is recorded for every executed opcode
+	TraceStep = "step"
+	// TraceEnd is recorded when a program exits
+	TraceEnd = "end"
+)
+
+// ScratchWrite is a change of a scratch space slot
+type ScratchWrite struct {
+	Slot  int              `codec:"slot"`
+	Value basics.TealValue `codec:"value"`
+}
+
+// TraceEvent is a single line of an execution trace written by TraceRecorder
+type TraceEvent struct {
+	Event      string `codec:"event"`
+	ExecID     string `codec:"execid"`
+	Caller     string `codec:"caller,omitempty"`
+	GroupIndex int    `codec:"gindex"`
+	Depth      int    `codec:"depth"`
+	// Path identifies the program among all the ones running: the group
+	// index and ExecID of every program that led to it, outermost first.
+	// The path of the caller is Path without its last element.
+	Path string `codec:"path"`
+
+	// State is the registered state of the program, recorded on start
+	State *DebugState `codec:"state,omitempty"`
+
+	// step events describe the opcode at PC and its effects
+	PC          int                          `codec:"pc"`
+	Op          string                       `codec:"op,omitempty"`
+	Popped      int                          `codec:"popped,omitempty"`
+	Pushed      []basics.TealValue           `codec:"pushed,omitempty"`
+	Scratch     []ScratchWrite               `codec:"scratch,omitempty"`
+	GlobalDelta basics.StateDelta            `codec:"gd,omitempty"`
+	LocalDeltas map[uint64]basics.StateDelta `codec:"ld,omitempty"`
+	Logs        []string                     `codec:"logs,omitempty"`
+	Boxes       []BoxChange                  `codec:"boxes,omitempty"`
+	// CallStack is the subroutine call stack after the opcode
+	CallStack []CallFrame `codec:"callstack,omitempty"`
+
+	// Error is the evaluation error recorded on end
+	Error string `codec:"error,omitempty"`
+}
+
+// traceHandle encodes events on a single line
+var traceHandle *codec.JsonHandle
+
+func init() {
+	traceHandle = new(codec.JsonHandle)
+	traceHandle.Canonical = true
+	traceHandle.RecursiveEmptyCheck = true
+	traceHandle.HTMLCharsAsIs = true
+	traceHandle.MapKeyAsString = true
+}
+
+// traceExec is the last seen state of a program being recorded
+type traceExec struct {
+	lines   []string
+	state   DebugState
+	stepped bool
+}
+
+// TraceRecorder is a DebuggerHook that writes an execution trace of all
+// programs it sees, including the ones of inner app calls, as JSON lines
+// of TraceEvent. The effects of an opcode are computed from the states
+// before and after its execution.
+type TraceRecorder struct {
+	// Next, if set, receives all states after they are recorded
+	Next DebuggerHook
+
+	w     *bufio.Writer
+	enc   *codec.Encoder
+	execs map[string]*traceExec // by call path, as the same program may call itself
+	err   error
+}
+
+// MakeTraceRecorder creates a TraceRecorder writing to w
+func MakeTraceRecorder(w io.Writer) *TraceRecorder {
+	bw := bufio.NewWriter(w)
+	return &TraceRecorder{
+		w:     bw,
+		enc:   codec.NewEncoder(bw, traceHandle),
+		execs: make(map[string]*traceExec),
+	}
+}
+
+// Err returns the first error met while writing the trace
+func (r *TraceRecorder) Err() error {
+	return r.err
+}
+
+func (r *TraceRecorder) write(ev *TraceEvent) {
+	if r.err != nil {
+		return
+	}
+	if r.err = r.enc.Encode(ev); r.err != nil {
+		return
+	}
+	if r.err = r.w.WriteByte('\n'); r.err != nil {
+		return
+	}
+	// flush once the top level program exits
+	if ev.Event == TraceEnd && ev.Depth == 0 {
+		r.err = r.w.Flush()
+	}
+}
+
+// callPath identifies the program of state among the running ones
+func callPath(state *DebugState) string {
+	if len(state.Contexts) == 0 {
+		return fmt.Sprintf("%d:%s", state.GroupIndex, state.ExecID)
+	}
+	elems := make([]string, len(state.Contexts))
+	for i, pc := range state.Contexts {
+		elems[i] = fmt.Sprintf("%d:%s", pc.GroupIndex, pc.ExecID)
+	}
+	return strings.Join(elems, "/")
+}
+
+func makeTraceEvent(event string, state *DebugState) TraceEvent {
+	ev := TraceEvent{
+		Event:      event,
+		ExecID:     state.ExecID,
+		GroupIndex: state.GroupIndex,
+		Depth:      state.CallDepth,
+		Path:       callPath(state),
+		PC:         state.PC,
+	}
+	if len(state.Contexts) > 1 {
+		ev.Caller = state.Contexts[len(state.Contexts)-2].ExecID
+	}
+	return ev
+}
+
+// step records the effects of the opcode executed between the last seen
+// state of the program and the new one
+func (r *TraceRecorder) step(exec *traceExec, state *DebugState) {
+	prev := &exec.state
+	ev := makeTraceEvent(TraceStep, prev)
+	if prev.Line < len(exec.lines) {
+		ev.Op = exec.lines[prev.Line]
+	}
+
+	// values below the deepest changed one were not touched
+	same := 0
+	for same < len(prev.Stack) && same < len(state.Stack) && prev.Stack[same] == state.Stack[same] {
+		same++
+	}
+	ev.Popped = len(prev.Stack) - same
+	ev.Pushed = state.Stack[same:]
+
+	for i := range state.Scratch {
+		if i >= len(prev.Scratch) || prev.Scratch[i] != state.Scratch[i] {
+			ev.Scratch = append(ev.Scratch, ScratchWrite{Slot: i, Value: state.Scratch[i]})
+		}
+	}
+
+	ev.GlobalDelta = diffStateDelta(prev.GlobalDelta, state.GlobalDelta)
+	for idx, sd := range state.LocalDeltas {
+		if delta := diffStateDelta(prev.LocalDeltas[idx], sd); len(delta) > 0 {
+			if ev.LocalDeltas == nil {
+				ev.LocalDeltas = make(map[uint64]basics.StateDelta)
+			}
+			ev.LocalDeltas[idx] = delta
+		}
+	}
+	if len(state.Logs) > len(prev.Logs) {
+		ev.Logs = state.Logs[len(prev.Logs):]
+	}
+	if len(state.BoxChanges) > len(prev.BoxChanges) {
+		ev.Boxes = state.BoxChanges[len(prev.BoxChanges):]
+	}
+	ev.CallStack = state.CallStack
+	r.write(&ev)
+}
+
+// diffStateDelta returns the entries of cur that are not in prev, with bytes
+// in base64 like the rest of the debug state
+func diffStateDelta(prev, cur basics.StateDelta) basics.StateDelta {
+	var delta basics.StateDelta
+	for key, vd := range cur {
+		if pvd, ok := prev[key]; ok && pvd == vd {
+			continue
+		}
+		if delta == nil {
+			delta = make(basics.StateDelta)
+		}
+		delta[key] = valueDeltaToValueDelta(&vd)
+	}
+	return delta
+}
+
+// remember keeps a copy of state to compare the next one with,
+// since ledgers might keep updating the deltas they return
+func (exec *traceExec) remember(state *DebugState) {
+	exec.state = *state
+	exec.state.GlobalDelta = cloneStateDelta(state.GlobalDelta)
+	exec.state.LocalDeltas = make(map[uint64]basics.StateDelta, len(state.LocalDeltas))
+	for idx, sd := range state.LocalDeltas {
+		exec.state.LocalDeltas[idx] = cloneStateDelta(sd)
+	}
+}
+
+func cloneStateDelta(sd basics.StateDelta) basics.StateDelta {
+	clone := make(basics.StateDelta, len(sd))
+	for key, vd := range sd {
+		clone[key] = vd
+	}
+	return clone
+}
+
+// Register records the start of a program
+func (r *TraceRecorder) Register(state *DebugState) error {
+	exec := &traceExec{lines: strings.Split(state.Disassembly, "\n")}
+	exec.remember(state)
+	r.execs[callPath(state)] = exec
+	ev := makeTraceEvent(TraceStart, state)
+	ev.State = state
+	r.write(&ev)
+	if r.Next != nil {
+		return r.Next.Register(state)
+	}
+	return nil
+}
+
+// Update records the opcode executed since the previous update
+func (r *TraceRecorder) Update(state *DebugState) error {
+	if exec, ok := r.execs[callPath(state)]; ok {
+		// the first update repeats the registered state
+		if exec.stepped {
+			r.step(exec, state)
+		}
+		exec.remember(state)
+		exec.stepped = true
+	}
+	if r.Next != nil {
+		return r.Next.Update(state)
+	}
+	return nil
+}
+
+// Complete records the last opcode executed and the end of the program
+func (r *TraceRecorder) Complete(state *DebugState) error {
+	path := callPath(state)
+	if exec, ok := r.execs[path]; ok {
+		if exec.stepped {
+			r.step(exec, state)
+		}
+		delete(r.execs, path)
+	}
+	ev := makeTraceEvent(TraceEnd, state)
+	ev.Error = state.Error
+	r.write(&ev)
+	if r.Next != nil {
+		return r.Next.Complete(state)
+	}
+	return nil
+}
+
+// ReplayTrace reads an execution trace written by TraceRecorder, and passes
+// the states it describes to hook in the order the programs went through
+// them, so that a debugger can step through a recorded execution
+func ReplayTrace(r io.Reader, hook DebuggerHook) error {
+	var events []TraceEvent
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var ev TraceEvent
+			if derr := codec.NewDecoderBytes(line, traceHandle).Decode(&ev); derr != nil {
+				return fmt.Errorf("trace event %d: %w", len(events)+1, derr)
+			}
+			events = append(events, ev)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// the state after a step is at the pc of the next event of its program
+	next := make([]int, len(events))
+	following := make(map[string]int)
+	for i := len(events) - 1; i >= 0; i-- {
+		next[i] = -1
+		if j, ok := following[events[i].Path]; ok {
+			next[i] = j
+		}
+		following[events[i].Path] = i
+	}
+
+	states := make(map[string]DebugState)
+	for i := range events {
+		ev := &events[i]
+		switch ev.Event {
+		case TraceStart:
+			if ev.State == nil {
+				return fmt.Errorf("trace event %d: start of %s without a state", i+1, ev.Path)
+			}
+			state := *ev.State
+			states[ev.Path] = state
+			if err := hook.Register(&state); err != nil {
+				return err
+			}
+			// the first update repeats the registered state
+			if err := hook.Update(&state); err != nil {
+				return err
+			}
+		case TraceStep:
+			state, ok := states[ev.Path]
+			if !ok {
+				return fmt.Errorf("trace event %d: step of %s before its start", i+1, ev.Path)
+			}
+			state, err := applyTraceStep(state, ev)
+			if err != nil {
+				return fmt.Errorf("trace event %d: %w", i+1, err)
+			}
+			states[ev.Path] = state
+			j := next[i]
+			if j < 0 {
+				return fmt.Errorf("trace ends before %s completes", ev.Path)
+			}
+			if events[j].Event == TraceEnd {
+				// the state after the last step is the completed one
+				continue
+			}
+			state.PC = events[j].PC
+			state.Line = state.PCToLine(state.PC)
+			if err := hook.Update(&state); err != nil {
+				return err
+			}
+		case TraceEnd:
+			state, ok := states[ev.Path]
+			if !ok {
+				// the program failed before it started
+				continue
+			}
+			delete(states, ev.Path)
+			state.PC = ev.PC
+			state.Line = state.PCToLine(state.PC)
+			state.Error = ev.Error
+			if err := hook.Complete(&state); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("trace event %d: unknown event %s", i+1, ev.Event)
+		}
+	}
+	return nil
+}
+
+// applyTraceStep returns state with the effects of the step applied. Slices
+// and maps are copied, since hooks might keep the states they are passed.
+func applyTraceStep(state DebugState, ev *TraceEvent) (DebugState, error) {
+	keep := len(state.Stack) - ev.Popped
+	if keep < 0 {
+		return state, fmt.Errorf("%s pops %d values from a stack of %d", ev.Op, ev.Popped, len(state.Stack))
+	}
+	stack := make([]basics.TealValue, 0, keep+len(ev.Pushed))
+	stack = append(stack, state.Stack[:keep]...)
+	state.Stack = append(stack, ev.Pushed...)
+
+	if len(ev.Scratch) > 0 {
+		scratch := append([]basics.TealValue(nil), state.Scratch...)
+		for _, sw := range ev.Scratch {
+			if sw.Slot < 0 || sw.Slot >= len(scratch) {
+				return state, fmt.Errorf("%s writes scratch slot %d out of %d", ev.Op, sw.Slot, len(scratch))
+			}
+			scratch[sw.Slot] = sw.Value
+		}
+		state.Scratch = scratch
+	}
+
+	// the deltas of the debug state hold raw bytes, unlike trace events
+	var err error
+	if len(ev.GlobalDelta) > 0 {
+		state.GlobalDelta, err = mergeTraceDelta(state.GlobalDelta, ev.GlobalDelta)
+		if err != nil {
+			return state, err
+		}
+	}
+	if len(ev.LocalDeltas) > 0 {
+		locals := make(map[uint64]basics.StateDelta, len(state.LocalDeltas))
+		for idx, sd := range state.LocalDeltas {
+			locals[idx] = sd
+		}
+		for idx, sd := range ev.LocalDeltas {
+			locals[idx], err = mergeTraceDelta(locals[idx], sd)
+			if err != nil {
+				return state, err
+			}
+		}
+		state.LocalDeltas = locals
+	}
+
+	state.Logs = append(state.Logs[:len(state.Logs):len(state.Logs)], ev.Logs...)
+	state.BoxChanges = append(state.BoxChanges[:len(state.BoxChanges):len(state.BoxChanges)], ev.Boxes...)
+	state.CallStack = ev.CallStack
+	return state, nil
+}
+
+func mergeTraceDelta(sd basics.StateDelta, changes basics.StateDelta) (basics.StateDelta, error) {
+	merged := cloneStateDelta(sd)
+	for key, vd := range changes {
+		raw, err := base64.StdEncoding.DecodeString(vd.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("bytes of key %s: %w", key, err)
+		}
+		vd.Bytes = string(raw)
+		merged[key] = vd
+	}
+	return merged, nil
+}
@@ -0,0 +1,290 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTraceRecorder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ep, ledger := makeSampleEnv()
+	ledger.NewAccount(ep.Txn.Txn.Sender, 1)
+	ep.Txn.Txn.ApplicationID = 100
+	ledger.NewApp(ep.Txn.Txn.Sender, 100, basics.AppParams{})
+
+	ops := testProg(t, `int 5
+store 1
+byte "k"
+load 1
+app_global_put
+byte "hi"
+log
+int 1
+`, LogicVersion)
+
+	var buf bytes.Buffer
+	next := testDbgHook{}
+	recorder := MakeTraceRecorder(&buf)
+	recorder.Next = &next
+	ep.Debugger = recorder
+	pass, err := EvalStateful(ops.Program, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.NoError(t, recorder.Err())
+
+	// all states are passed on
+	require.Equal(t, 1, next.register)
+	require.Greater(t, next.update, 1)
+	require.Equal(t, 1, next.complete)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	events := make([]TraceEvent, len(lines))
+	steps := make(map[string]TraceEvent)
+	for i, line := range lines {
+		err = protocol.DecodeJSON([]byte(line), &events[i])
+		require.NoError(t, err, line)
+		require.Equal(t, GetProgramID(ops.Program), events[i].ExecID)
+		require.Zero(t, events[i].Depth)
+		if events[i].Event == TraceStep {
+			steps[events[i].Op] = events[i]
+		}
+	}
+	require.Equal(t, TraceStart, events[0].Event)
+	require.NotEmpty(t, events[0].State.Disassembly)
+	require.Equal(t, TraceEnd, events[len(events)-1].Event)
+	require.Empty(t, events[len(events)-1].Error)
+	// the first update repeats the start
+	require.Equal(t, next.update, len(events)-2)
+
+	store := steps["store 1"]
+	require.Equal(t, 1, store.Popped)
+	require.Empty(t, store.Pushed)
+	require.Equal(t, []ScratchWrite{{Slot: 1, Value: basics.TealValue{Type: basics.TealUintType, Uint: 5}}}, store.Scratch)
+
+	load := steps["load 1"]
+	require.Zero(t, load.Popped)
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType, Uint: 5}}, load.Pushed)
+	require.Empty(t, load.Scratch)
+
+	put := steps["app_global_put"]
+	require.Equal(t, 2, put.Popped)
+	require.Equal(t, basics.StateDelta{"k": {Action: basics.SetUintAction, Uint: 5}}, put.GlobalDelta)
+
+	log := steps["log"]
+	require.Equal(t, 1, log.Popped)
+	require.Empty(t, log.GlobalDelta)
+	require.Equal(t, []string{"hi"}, log.Logs)
+
+	// the last step leaves the result on the stack
+	last := events[len(events)-2]
+	require.Equal(t, TraceStep, last.Event)
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType, Uint: 1}}, last.Pushed)
+
+	// evaluation errors are recorded at the end
+	buf.Reset()
+	ep, _ = makeSampleEnv()
+	ep.Debugger = MakeTraceRecorder(&buf)
+	pass, err = Eval(testProg(t, "byte 0x01; btoi; err", LogicVersion).Program, ep)
+	require.Error(t, err)
+	require.False(t, pass)
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	var end TraceEvent
+	err = protocol.DecodeJSON([]byte(lines[len(lines)-1]), &end)
+	require.NoError(t, err)
+	require.Equal(t, TraceEnd, end.Event)
+	require.Contains(t, end.Error, "err opcode")
+
+	var failed TraceEvent
+	err = protocol.DecodeJSON([]byte(lines[len(lines)-2]), &failed)
+	require.NoError(t, err)
+	require.Equal(t, "err", failed.Op)
+	require.Empty(t, failed.Pushed)
+}
+
+func TestTraceRecorderSameProgram(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// a caller and its callee running the same program may report the same
+	// ExecID, so only their call paths tell them apart
+	outer := DebugState{
+		ExecID:      "prog",
+		Disassembly: "int 1\nint 2\nitxn_submit\nint 3",
+		Contexts:    []ProgramContext{{ExecID: "prog", GroupIndex: 1}},
+		GroupIndex:  1,
+	}
+	inner := outer
+	inner.GroupIndex = 0
+	inner.CallDepth = 1
+	inner.Contexts = []ProgramContext{{ExecID: "prog", GroupIndex: 1}, {ExecID: "prog", GroupIndex: 0, Depth: 1}}
+
+	var buf bytes.Buffer
+	r := MakeTraceRecorder(&buf)
+	push := func(state DebugState, line int, values ...uint64) DebugState {
+		state.Line = line
+		state.Stack = nil
+		for _, v := range values {
+			state.Stack = append(state.Stack, basics.TealValue{Type: basics.TealUintType, Uint: v})
+		}
+		return state
+	}
+	require.NoError(t, r.Register(&outer))
+	s := push(outer, 0)
+	require.NoError(t, r.Update(&s))
+	s = push(outer, 1, 1)
+	require.NoError(t, r.Update(&s))
+	s = push(outer, 2, 1, 2)
+	require.NoError(t, r.Update(&s))
+
+	require.NoError(t, r.Register(&inner))
+	s = push(inner, 0)
+	require.NoError(t, r.Update(&s))
+	s = push(inner, 1, 1)
+	require.NoError(t, r.Complete(&s))
+
+	// the caller goes on after its callee completed
+	s = push(outer, 3, 1, 2)
+	require.NoError(t, r.Update(&s))
+	s = push(outer, 4, 1, 2, 3)
+	require.NoError(t, r.Complete(&s))
+	require.NoError(t, r.Err())
+
+	var outerOps, innerOps []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var ev TraceEvent
+		require.NoError(t, protocol.DecodeJSON([]byte(line), &ev))
+		if ev.Event != TraceStep {
+			continue
+		}
+		switch ev.Path {
+		case "1:prog":
+			outerOps = append(outerOps, ev.Op)
+		case "1:prog/0:prog":
+			require.Equal(t, "prog", ev.Caller)
+			innerOps = append(innerOps, ev.Op)
+		default:
+			t.Fatalf("unexpected path %s", ev.Path)
+		}
+	}
+	require.Equal(t, []string{"int 1", "int 2", "itxn_submit", "int 3"}, outerOps)
+	require.Equal(t, []string{"int 1"}, innerOps)
+}
+
+// stateLog keeps a copy of every state it is passed
+type stateLog struct {
+	calls  []string
+	states []DebugState
+}
+
+func (l *stateLog) add(call string, state *DebugState) error {
+	l.calls = append(l.calls, call)
+	l.states = append(l.states, *state)
+	return nil
+}
+
+func (l *stateLog) Register(state *DebugState) error { return l.add("register", state) }
+func (l *stateLog) Update(state *DebugState) error   { return l.add("update", state) }
+func (l *stateLog) Complete(state *DebugState) error { return l.add("complete", state) }
+
+func TestReplayTrace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ep, _ := makeBoxEnv(transactions.BoxRef{Name: []byte("self")}, transactions.BoxRef{Name: []byte("gone")})
+	ops := testProg(t, `byte "self"; int 4; box_create; pop
+callsub put
+byte "self"; int 1; byte 0x01; box_replace
+byte "gone"; int 1; box_create; pop
+byte "gone"; box_del; pop
+int 9; store 3
+byte "k"; byte "v"; app_global_put
+byte "hi"; log
+int 1
+return
+put:
+byte "self"; byte "abcd"; box_put
+retsub
+`, LogicVersion)
+
+	var buf bytes.Buffer
+	live := stateLog{}
+	recorder := MakeTraceRecorder(&buf)
+	recorder.Next = &live
+	ep.Debugger = recorder
+	pass, err := EvalStateful(ops.Program, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.NoError(t, recorder.Err())
+
+	// box writes are recorded by the steps that make them
+	var boxes []BoxChange
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var ev TraceEvent
+		require.NoError(t, protocol.DecodeJSON([]byte(line), &ev))
+		if len(ev.Boxes) > 0 {
+			require.Len(t, ev.Boxes, 1)
+			require.Contains(t, []string{"box_create", "box_put", "box_replace", "box_del"}, ev.Op)
+			boxes = append(boxes, ev.Boxes[0])
+		}
+	}
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	require.Equal(t, []BoxChange{
+		{App: 888, Name: b64("self"), Value: b64("\x00\x00\x00\x00")},
+		{App: 888, Name: b64("self"), Value: b64("abcd")},
+		{App: 888, Name: b64("self"), Value: b64("a\x01cd")},
+		{App: 888, Name: b64("gone"), Value: b64("\x00")},
+		{App: 888, Name: b64("gone"), Deleted: true},
+	}, boxes)
+
+	// the replayed states are the ones the program went through, up to
+	// empty collections decoded as nil
+	replayed := stateLog{}
+	require.NoError(t, ReplayTrace(&buf, &replayed))
+	require.Equal(t, live.calls, replayed.calls)
+	same := func(expected, actual interface{}, i int) {
+		if reflect.ValueOf(expected).Len() == 0 {
+			require.Empty(t, actual, i)
+			return
+		}
+		require.Equal(t, expected, actual, i)
+	}
+	for i := range live.states {
+		expected, actual := live.states[i], replayed.states[i]
+		require.Equal(t, expected.ExecID, actual.ExecID, i)
+		require.Equal(t, expected.PC, actual.PC, i)
+		require.Equal(t, expected.Line, actual.Line, i)
+		require.Equal(t, expected.Error, actual.Error, i)
+		same(expected.Stack, actual.Stack, i)
+		same(expected.Scratch, actual.Scratch, i)
+		same(expected.CallStack, actual.CallStack, i)
+		same(expected.GlobalDelta, actual.GlobalDelta, i)
+		same(expected.Logs, actual.Logs, i)
+		same(expected.BoxChanges, actual.BoxChanges, i)
+	}
+	require.Len(t, replayed.states[len(replayed.states)-1].BoxChanges, 5)
+}
@@ -73,6 +73,7 @@ type Ledger struct {
 	trackedCreatables map[int]basics.CreatableIndex
 	appID             basics.AppIndex
 	mods              map[basics.AppIndex]map[string]basics.ValueDelta
+	boxes             map[basics.AppIndex]map[string][]byte
 	rnd               basics.Round
 }
 
@@ -87,6 +88,7 @@ func MakeLedger(balances map[basics.Address]uint64) *Ledger {
 	l.assets = make(map[basics.AssetIndex]asaParams)
 	l.trackedCreatables = make(map[int]basics.CreatableIndex)
 	l.mods = make(map[basics.AppIndex]map[string]basics.ValueDelta)
+	l.boxes = make(map[basics.AppIndex]map[string][]byte)
 	return l
 }
 
@@ -249,6 +251,17 @@ func (l *Ledger) MinBalance(addr basics.Address, proto *config.ConsensusParams)
 		min = basics.AddSaturate(min, l.applications[idx].LocalStateSchema.MinBalance(proto).Raw)
 	}
 
+	// Box MinBalance for each box of the application whose account this is
+	for appIdx, boxes := range l.boxes {
+		if appIdx.Address() != addr {
+			continue
+		}
+		for name, value := range boxes {
+			min = basics.AddSaturate(min, proto.BoxFlatMinBalance)
+			min = basics.AddSaturate(min, basics.MulSaturate(proto.BoxByteMinBalance, uint64(len(name)+len(value))))
+		}
+	}
+
 	return basics.MicroAlgos{Raw: min}, nil
 }
 
@@ -266,6 +279,58 @@ func (l *Ledger) Authorizer(addr basics.Address) (basics.Address, error) {
 	return br.addr, nil
 }
 
+// AccountData returns a version of the account that is good enough for
+// satisfying AVM needs. (Calc'ing min balance, checking authorization, and
+// reporting the account parameters.)
+func (l *Ledger) AccountData(addr basics.Address) (basics.AccountData, error) {
+	br, ok := l.balances[addr]
+	if !ok {
+		br = makeBalanceRecord(addr, 0)
+	}
+
+	var data basics.AccountData
+	data.MicroAlgos = basics.MicroAlgos{Raw: br.balance}
+	data.AuthAddr = br.auth
+
+	data.Assets = make(map[basics.AssetIndex]basics.AssetHolding, len(br.holdings))
+	for idx, holding := range br.holdings {
+		data.Assets[idx] = holding
+	}
+	data.AssetParams = make(map[basics.AssetIndex]basics.AssetParams)
+	for idx, params := range l.assets {
+		if params.Creator == addr {
+			data.AssetParams[idx] = params.AssetParams
+		}
+	}
+
+	data.AppParams = make(map[basics.AppIndex]basics.AppParams)
+	for idx, params := range l.applications {
+		if params.Creator == addr {
+			data.AppParams[idx] = params.AppParams
+			data.TotalAppSchema = data.TotalAppSchema.AddSchema(params.GlobalStateSchema)
+			data.TotalExtraAppPages += params.ExtraProgramPages
+		}
+	}
+	data.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState, len(br.locals))
+	for idx, kv := range br.locals {
+		schema := l.applications[idx].LocalStateSchema
+		data.AppLocalStates[idx] = basics.AppLocalState{Schema: schema, KeyValue: kv}
+		data.TotalAppSchema = data.TotalAppSchema.AddSchema(schema)
+	}
+
+	for appIdx, boxes := range l.boxes {
+		if appIdx.Address() != addr {
+			continue
+		}
+		for name, value := range boxes {
+			data.TotalBoxes++
+			data.TotalBoxBytes += uint64(len(name) + len(value))
+		}
+	}
+
+	return data, nil
+}
+
 // GetGlobal returns the current value of a global in an app, taking
 // into account the mods created by earlier teal execution.
 func (l *Ledger) GetGlobal(appIdx basics.AppIndex, key string) (basics.TealValue, bool, error) {
@@ -804,3 +869,42 @@ func (l *Ledger) round() basics.Round {
 	}
 	return l.rnd
 }
+
+// NewBox creates a box of application appIdx. The box's MinBalance is
+// computed from the boxes themselves, so appAddr is not used.
+func (l *Ledger) NewBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error {
+	if _, ok := l.boxes[appIdx][key]; ok {
+		return fmt.Errorf("box already exists")
+	}
+	if l.boxes[appIdx] == nil {
+		l.boxes[appIdx] = make(map[string][]byte)
+	}
+	l.boxes[appIdx][key] = append([]byte{}, value...)
+	return nil
+}
+
+// GetBox returns the contents of a box of application appIdx
+func (l *Ledger) GetBox(appIdx basics.AppIndex, key string) ([]byte, bool, error) {
+	value, ok := l.boxes[appIdx][key]
+	return value, ok, nil
+}
+
+// SetBox replaces the contents of an existing box of application appIdx
+func (l *Ledger) SetBox(appIdx basics.AppIndex, key string, value []byte) error {
+	old, ok := l.boxes[appIdx][key]
+	if !ok {
+		return fmt.Errorf("no such box")
+	}
+	if len(old) != len(value) {
+		return fmt.Errorf("box size mismatch %d %d", len(old), len(value))
+	}
+	l.boxes[appIdx][key] = append([]byte{}, value...)
+	return nil
+}
+
+// DelBox deletes a box of application appIdx, reporting whether it existed
+func (l *Ledger) DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error) {
+	_, ok := l.boxes[appIdx][key]
+	delete(l.boxes[appIdx], key)
+	return ok, nil
+}
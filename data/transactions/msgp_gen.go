@@ -52,6 +52,14 @@ import (
 //            |-----> (*) Msgsize
 //            |-----> (*) MsgIsZero
 //
+// BoxRef
+//    |-----> (*) MarshalMsg
+//    |-----> (*) CanMarshalMsg
+//    |-----> (*) UnmarshalMsg
+//    |-----> (*) CanUnmarshalMsg
+//    |-----> (*) Msgsize
+//    |-----> (*) MsgIsZero
+//
 // CompactCertTxnFields
 //           |-----> (*) MarshalMsg
 //           |-----> (*) CanMarshalMsg
@@ -177,56 +185,60 @@ import (
 func (z *ApplicationCallTxnFields) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0005Len := uint32(11)
-	var zb0005Mask uint16 /* 12 bits */
+	zb0006Len := uint32(12)
+	var zb0006Mask uint16 /* 13 bits */
 	if len((*z).ApplicationArgs) == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x2
+		zb0006Len--
+		zb0006Mask |= 0x2
 	}
 	if (*z).OnCompletion == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x4
+		zb0006Len--
+		zb0006Mask |= 0x4
 	}
 	if len((*z).ApprovalProgram) == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x8
+		zb0006Len--
+		zb0006Mask |= 0x8
 	}
 	if len((*z).ForeignAssets) == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x10
+		zb0006Len--
+		zb0006Mask |= 0x10
 	}
 	if len((*z).Accounts) == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x20
+		zb0006Len--
+		zb0006Mask |= 0x20
+	}
+	if len((*z).Boxes) == 0 {
+		zb0006Len--
+		zb0006Mask |= 0x40
 	}
 	if (*z).ExtraProgramPages == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x40
+		zb0006Len--
+		zb0006Mask |= 0x80
 	}
 	if len((*z).ForeignApps) == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x80
+		zb0006Len--
+		zb0006Mask |= 0x100
 	}
 	if (*z).GlobalStateSchema.MsgIsZero() {
-		zb0005Len--
-		zb0005Mask |= 0x100
+		zb0006Len--
+		zb0006Mask |= 0x200
 	}
 	if (*z).ApplicationID.MsgIsZero() {
-		zb0005Len--
-		zb0005Mask |= 0x200
+		zb0006Len--
+		zb0006Mask |= 0x400
 	}
 	if (*z).LocalStateSchema.MsgIsZero() {
-		zb0005Len--
-		zb0005Mask |= 0x400
+		zb0006Len--
+		zb0006Mask |= 0x800
 	}
 	if len((*z).ClearStateProgram) == 0 {
-		zb0005Len--
-		zb0005Mask |= 0x800
+		zb0006Len--
+		zb0006Mask |= 0x1000
 	}
-	// variable map header, size zb0005Len
-	o = append(o, 0x80|uint8(zb0005Len))
-	if zb0005Len != 0 {
-		if (zb0005Mask & 0x2) == 0 { // if not empty
+	// variable map header, size zb0006Len
+	o = append(o, 0x80|uint8(zb0006Len))
+	if zb0006Len != 0 {
+		if (zb0006Mask & 0x2) == 0 { // if not empty
 			// string "apaa"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x61)
 			if (*z).ApplicationArgs == nil {
@@ -238,17 +250,17 @@ func (z *ApplicationCallTxnFields) MarshalMsg(b []byte) (o []byte) {
 				o = msgp.AppendBytes(o, (*z).ApplicationArgs[zb0001])
 			}
 		}
-		if (zb0005Mask & 0x4) == 0 { // if not empty
+		if (zb0006Mask & 0x4) == 0 { // if not empty
 			// string "apan"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x6e)
 			o = msgp.AppendUint64(o, uint64((*z).OnCompletion))
 		}
-		if (zb0005Mask & 0x8) == 0 { // if not empty
+		if (zb0006Mask & 0x8) == 0 { // if not empty
 			// string "apap"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x70)
 			o = msgp.AppendBytes(o, (*z).ApprovalProgram)
 		}
-		if (zb0005Mask & 0x10) == 0 { // if not empty
+		if (zb0006Mask & 0x10) == 0 { // if not empty
 			// string "apas"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x73)
 			if (*z).ForeignAssets == nil {
@@ -260,7 +272,7 @@ func (z *ApplicationCallTxnFields) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).ForeignAssets[zb0004].MarshalMsg(o)
 			}
 		}
-		if (zb0005Mask & 0x20) == 0 { // if not empty
+		if (zb0006Mask & 0x20) == 0 { // if not empty
 			// string "apat"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x74)
 			if (*z).Accounts == nil {
@@ -272,12 +284,46 @@ func (z *ApplicationCallTxnFields) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).Accounts[zb0002].MarshalMsg(o)
 			}
 		}
-		if (zb0005Mask & 0x40) == 0 { // if not empty
+		if (zb0006Mask & 0x40) == 0 { // if not empty
+			// string "apbx"
+			o = append(o, 0xa4, 0x61, 0x70, 0x62, 0x78)
+			if (*z).Boxes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Boxes)))
+			}
+			for zb0005 := range (*z).Boxes {
+				// omitempty: check for empty values
+				zb0007Len := uint32(2)
+				var zb0007Mask uint8 /* 3 bits */
+				if (*z).Boxes[zb0005].Index == 0 {
+					zb0007Len--
+					zb0007Mask |= 0x2
+				}
+				if len((*z).Boxes[zb0005].Name) == 0 {
+					zb0007Len--
+					zb0007Mask |= 0x4
+				}
+				// variable map header, size zb0007Len
+				o = append(o, 0x80|uint8(zb0007Len))
+				if (zb0007Mask & 0x2) == 0 { // if not empty
+					// string "i"
+					o = append(o, 0xa1, 0x69)
+					o = msgp.AppendUint64(o, (*z).Boxes[zb0005].Index)
+				}
+				if (zb0007Mask & 0x4) == 0 { // if not empty
+					// string "n"
+					o = append(o, 0xa1, 0x6e)
+					o = msgp.AppendBytes(o, (*z).Boxes[zb0005].Name)
+				}
+			}
+		}
+		if (zb0006Mask & 0x80) == 0 { // if not empty
 			// string "apep"
 			o = append(o, 0xa4, 0x61, 0x70, 0x65, 0x70)
 			o = msgp.AppendUint32(o, (*z).ExtraProgramPages)
 		}
-		if (zb0005Mask & 0x80) == 0 { // if not empty
+		if (zb0006Mask & 0x100) == 0 { // if not empty
 			// string "apfa"
 			o = append(o, 0xa4, 0x61, 0x70, 0x66, 0x61)
 			if (*z).ForeignApps == nil {
@@ -289,22 +335,22 @@ func (z *ApplicationCallTxnFields) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).ForeignApps[zb0003].MarshalMsg(o)
 			}
 		}
-		if (zb0005Mask & 0x100) == 0 { // if not empty
+		if (zb0006Mask & 0x200) == 0 { // if not empty
 			// string "apgs"
 			o = append(o, 0xa4, 0x61, 0x70, 0x67, 0x73)
 			o = (*z).GlobalStateSchema.MarshalMsg(o)
 		}
-		if (zb0005Mask & 0x200) == 0 { // if not empty
+		if (zb0006Mask & 0x400) == 0 { // if not empty
 			// string "apid"
 			o = append(o, 0xa4, 0x61, 0x70, 0x69, 0x64)
 			o = (*z).ApplicationID.MarshalMsg(o)
 		}
-		if (zb0005Mask & 0x400) == 0 { // if not empty
+		if (zb0006Mask & 0x800) == 0 { // if not empty
 			// string "apls"
 			o = append(o, 0xa4, 0x61, 0x70, 0x6c, 0x73)
 			o = (*z).LocalStateSchema.MarshalMsg(o)
 		}
-		if (zb0005Mask & 0x800) == 0 { // if not empty
+		if (zb0006Mask & 0x1000) == 0 { // if not empty
 			// string "apsu"
 			o = append(o, 0xa4, 0x61, 0x70, 0x73, 0x75)
 			o = msgp.AppendBytes(o, (*z).ClearStateProgram)
@@ -322,55 +368,55 @@ func (_ *ApplicationCallTxnFields) CanMarshalMsg(z interface{}) bool {
 func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
-	var zb0005 int
-	var zb0006 bool
-	zb0005, zb0006, bts, err = msgp.ReadMapHeaderBytes(bts)
+	var zb0006 int
+	var zb0007 bool
+	zb0006, zb0007, bts, err = msgp.ReadMapHeaderBytes(bts)
 	if _, ok := err.(msgp.TypeError); ok {
-		zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0005 > 0 {
-			zb0005--
+		if zb0006 > 0 {
+			zb0006--
 			bts, err = (*z).ApplicationID.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ApplicationID")
 				return
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
+		if zb0006 > 0 {
+			zb0006--
 			{
-				var zb0007 uint64
-				zb0007, bts, err = msgp.ReadUint64Bytes(bts)
+				var zb0008 uint64
+				zb0008, bts, err = msgp.ReadUint64Bytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "struct-from-array", "OnCompletion")
 					return
 				}
-				(*z).OnCompletion = OnCompletion(zb0007)
+				(*z).OnCompletion = OnCompletion(zb0008)
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
-			var zb0008 int
-			var zb0009 bool
-			zb0008, zb0009, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0006 > 0 {
+			zb0006--
+			var zb0009 int
+			var zb0010 bool
+			zb0009, zb0010, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ApplicationArgs")
 				return
 			}
-			if zb0008 > encodedMaxApplicationArgs {
-				err = msgp.ErrOverflow(uint64(zb0008), uint64(encodedMaxApplicationArgs))
+			if zb0009 > encodedMaxApplicationArgs {
+				err = msgp.ErrOverflow(uint64(zb0009), uint64(encodedMaxApplicationArgs))
 				err = msgp.WrapError(err, "struct-from-array", "ApplicationArgs")
 				return
 			}
-			if zb0009 {
+			if zb0010 {
 				(*z).ApplicationArgs = nil
-			} else if (*z).ApplicationArgs != nil && cap((*z).ApplicationArgs) >= zb0008 {
-				(*z).ApplicationArgs = ((*z).ApplicationArgs)[:zb0008]
+			} else if (*z).ApplicationArgs != nil && cap((*z).ApplicationArgs) >= zb0009 {
+				(*z).ApplicationArgs = ((*z).ApplicationArgs)[:zb0009]
 			} else {
-				(*z).ApplicationArgs = make([][]byte, zb0008)
+				(*z).ApplicationArgs = make([][]byte, zb0009)
 			}
 			for zb0001 := range (*z).ApplicationArgs {
 				(*z).ApplicationArgs[zb0001], bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationArgs[zb0001])
@@ -380,26 +426,26 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 				}
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
-			var zb0010 int
-			var zb0011 bool
-			zb0010, zb0011, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0006 > 0 {
+			zb0006--
+			var zb0011 int
+			var zb0012 bool
+			zb0011, zb0012, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Accounts")
 				return
 			}
-			if zb0010 > encodedMaxAccounts {
-				err = msgp.ErrOverflow(uint64(zb0010), uint64(encodedMaxAccounts))
+			if zb0011 > encodedMaxAccounts {
+				err = msgp.ErrOverflow(uint64(zb0011), uint64(encodedMaxAccounts))
 				err = msgp.WrapError(err, "struct-from-array", "Accounts")
 				return
 			}
-			if zb0011 {
+			if zb0012 {
 				(*z).Accounts = nil
-			} else if (*z).Accounts != nil && cap((*z).Accounts) >= zb0010 {
-				(*z).Accounts = ((*z).Accounts)[:zb0010]
+			} else if (*z).Accounts != nil && cap((*z).Accounts) >= zb0011 {
+				(*z).Accounts = ((*z).Accounts)[:zb0011]
 			} else {
-				(*z).Accounts = make([]basics.Address, zb0010)
+				(*z).Accounts = make([]basics.Address, zb0011)
 			}
 			for zb0002 := range (*z).Accounts {
 				bts, err = (*z).Accounts[zb0002].UnmarshalMsg(bts)
@@ -409,26 +455,26 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 				}
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
-			var zb0012 int
-			var zb0013 bool
-			zb0012, zb0013, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0006 > 0 {
+			zb0006--
+			var zb0013 int
+			var zb0014 bool
+			zb0013, zb0014, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ForeignApps")
 				return
 			}
-			if zb0012 > encodedMaxForeignApps {
-				err = msgp.ErrOverflow(uint64(zb0012), uint64(encodedMaxForeignApps))
+			if zb0013 > encodedMaxForeignApps {
+				err = msgp.ErrOverflow(uint64(zb0013), uint64(encodedMaxForeignApps))
 				err = msgp.WrapError(err, "struct-from-array", "ForeignApps")
 				return
 			}
-			if zb0013 {
+			if zb0014 {
 				(*z).ForeignApps = nil
-			} else if (*z).ForeignApps != nil && cap((*z).ForeignApps) >= zb0012 {
-				(*z).ForeignApps = ((*z).ForeignApps)[:zb0012]
+			} else if (*z).ForeignApps != nil && cap((*z).ForeignApps) >= zb0013 {
+				(*z).ForeignApps = ((*z).ForeignApps)[:zb0013]
 			} else {
-				(*z).ForeignApps = make([]basics.AppIndex, zb0012)
+				(*z).ForeignApps = make([]basics.AppIndex, zb0013)
 			}
 			for zb0003 := range (*z).ForeignApps {
 				bts, err = (*z).ForeignApps[zb0003].UnmarshalMsg(bts)
@@ -438,26 +484,26 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 				}
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
-			var zb0014 int
-			var zb0015 bool
-			zb0014, zb0015, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0006 > 0 {
+			zb0006--
+			var zb0015 int
+			var zb0016 bool
+			zb0015, zb0016, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ForeignAssets")
 				return
 			}
-			if zb0014 > encodedMaxForeignAssets {
-				err = msgp.ErrOverflow(uint64(zb0014), uint64(encodedMaxForeignAssets))
+			if zb0015 > encodedMaxForeignAssets {
+				err = msgp.ErrOverflow(uint64(zb0015), uint64(encodedMaxForeignAssets))
 				err = msgp.WrapError(err, "struct-from-array", "ForeignAssets")
 				return
 			}
-			if zb0015 {
+			if zb0016 {
 				(*z).ForeignAssets = nil
-			} else if (*z).ForeignAssets != nil && cap((*z).ForeignAssets) >= zb0014 {
-				(*z).ForeignAssets = ((*z).ForeignAssets)[:zb0014]
+			} else if (*z).ForeignAssets != nil && cap((*z).ForeignAssets) >= zb0015 {
+				(*z).ForeignAssets = ((*z).ForeignAssets)[:zb0015]
 			} else {
-				(*z).ForeignAssets = make([]basics.AssetIndex, zb0014)
+				(*z).ForeignAssets = make([]basics.AssetIndex, zb0015)
 			}
 			for zb0004 := range (*z).ForeignAssets {
 				bts, err = (*z).ForeignAssets[zb0004].UnmarshalMsg(bts)
@@ -467,32 +513,145 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 				}
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
+		if zb0006 > 0 {
+			zb0006--
+			var zb0017 int
+			var zb0018 bool
+			zb0017, zb0018, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0017 > encodedMaxBoxes {
+				err = msgp.ErrOverflow(uint64(zb0017), uint64(encodedMaxBoxes))
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0018 {
+				(*z).Boxes = nil
+			} else if (*z).Boxes != nil && cap((*z).Boxes) >= zb0017 {
+				(*z).Boxes = ((*z).Boxes)[:zb0017]
+			} else {
+				(*z).Boxes = make([]BoxRef, zb0017)
+			}
+			for zb0005 := range (*z).Boxes {
+				var zb0019 int
+				var zb0020 bool
+				zb0019, zb0020, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if _, ok := err.(msgp.TypeError); ok {
+					zb0019, zb0020, bts, err = msgp.ReadArrayHeaderBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005)
+						return
+					}
+					if zb0019 > 0 {
+						zb0019--
+						(*z).Boxes[zb0005].Index, bts, err = msgp.ReadUint64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005, "struct-from-array", "Index")
+							return
+						}
+					}
+					if zb0019 > 0 {
+						zb0019--
+						var zb0021 int
+						zb0021, err = msgp.ReadBytesBytesHeader(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005, "struct-from-array", "Name")
+							return
+						}
+						if zb0021 > config.MaxBytesKeyValueLen {
+							err = msgp.ErrOverflow(uint64(zb0021), uint64(config.MaxBytesKeyValueLen))
+							return
+						}
+						(*z).Boxes[zb0005].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Boxes[zb0005].Name)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005, "struct-from-array", "Name")
+							return
+						}
+					}
+					if zb0019 > 0 {
+						err = msgp.ErrTooManyArrayFields(zb0019)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005, "struct-from-array")
+							return
+						}
+					}
+				} else {
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005)
+						return
+					}
+					if zb0020 {
+						(*z).Boxes[zb0005] = BoxRef{}
+					}
+					for zb0019 > 0 {
+						zb0019--
+						field, bts, err = msgp.ReadMapKeyZC(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005)
+							return
+						}
+						switch string(field) {
+						case "i":
+							(*z).Boxes[zb0005].Index, bts, err = msgp.ReadUint64Bytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005, "Index")
+								return
+							}
+						case "n":
+							var zb0022 int
+							zb0022, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005, "Name")
+								return
+							}
+							if zb0022 > config.MaxBytesKeyValueLen {
+								err = msgp.ErrOverflow(uint64(zb0022), uint64(config.MaxBytesKeyValueLen))
+								return
+							}
+							(*z).Boxes[zb0005].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Boxes[zb0005].Name)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005, "Name")
+								return
+							}
+						default:
+							err = msgp.ErrNoField(string(field))
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0005)
+								return
+							}
+						}
+					}
+				}
+			}
+		}
+		if zb0006 > 0 {
+			zb0006--
 			bts, err = (*z).LocalStateSchema.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "LocalStateSchema")
 				return
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
+		if zb0006 > 0 {
+			zb0006--
 			bts, err = (*z).GlobalStateSchema.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "GlobalStateSchema")
 				return
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
-			var zb0016 int
-			zb0016, err = msgp.ReadBytesBytesHeader(bts)
+		if zb0006 > 0 {
+			zb0006--
+			var zb0023 int
+			zb0023, err = msgp.ReadBytesBytesHeader(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ApprovalProgram")
 				return
 			}
-			if zb0016 > config.MaxAvailableAppProgramLen {
-				err = msgp.ErrOverflow(uint64(zb0016), uint64(config.MaxAvailableAppProgramLen))
+			if zb0023 > config.MaxAvailableAppProgramLen {
+				err = msgp.ErrOverflow(uint64(zb0023), uint64(config.MaxAvailableAppProgramLen))
 				return
 			}
 			(*z).ApprovalProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ApprovalProgram)
@@ -501,16 +660,16 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 				return
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
-			var zb0017 int
-			zb0017, err = msgp.ReadBytesBytesHeader(bts)
+		if zb0006 > 0 {
+			zb0006--
+			var zb0024 int
+			zb0024, err = msgp.ReadBytesBytesHeader(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ClearStateProgram")
 				return
 			}
-			if zb0017 > config.MaxAvailableAppProgramLen {
-				err = msgp.ErrOverflow(uint64(zb0017), uint64(config.MaxAvailableAppProgramLen))
+			if zb0024 > config.MaxAvailableAppProgramLen {
+				err = msgp.ErrOverflow(uint64(zb0024), uint64(config.MaxAvailableAppProgramLen))
 				return
 			}
 			(*z).ClearStateProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ClearStateProgram)
@@ -519,16 +678,16 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 				return
 			}
 		}
-		if zb0005 > 0 {
-			zb0005--
+		if zb0006 > 0 {
+			zb0006--
 			(*z).ExtraProgramPages, bts, err = msgp.ReadUint32Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ExtraProgramPages")
 				return
 			}
 		}
-		if zb0005 > 0 {
-			err = msgp.ErrTooManyArrayFields(zb0005)
+		if zb0006 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0006)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array")
 				return
@@ -539,11 +698,11 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0006 {
+		if zb0007 {
 			(*z) = ApplicationCallTxnFields{}
 		}
-		for zb0005 > 0 {
-			zb0005--
+		for zb0006 > 0 {
+			zb0006--
 			field, bts, err = msgp.ReadMapKeyZC(bts)
 			if err != nil {
 				err = msgp.WrapError(err)
@@ -558,33 +717,33 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 				}
 			case "apan":
 				{
-					var zb0018 uint64
-					zb0018, bts, err = msgp.ReadUint64Bytes(bts)
+					var zb0025 uint64
+					zb0025, bts, err = msgp.ReadUint64Bytes(bts)
 					if err != nil {
 						err = msgp.WrapError(err, "OnCompletion")
 						return
 					}
-					(*z).OnCompletion = OnCompletion(zb0018)
+					(*z).OnCompletion = OnCompletion(zb0025)
 				}
 			case "apaa":
-				var zb0019 int
-				var zb0020 bool
-				zb0019, zb0020, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0026 int
+				var zb0027 bool
+				zb0026, zb0027, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ApplicationArgs")
 					return
 				}
-				if zb0019 > encodedMaxApplicationArgs {
-					err = msgp.ErrOverflow(uint64(zb0019), uint64(encodedMaxApplicationArgs))
+				if zb0026 > encodedMaxApplicationArgs {
+					err = msgp.ErrOverflow(uint64(zb0026), uint64(encodedMaxApplicationArgs))
 					err = msgp.WrapError(err, "ApplicationArgs")
 					return
 				}
-				if zb0020 {
+				if zb0027 {
 					(*z).ApplicationArgs = nil
-				} else if (*z).ApplicationArgs != nil && cap((*z).ApplicationArgs) >= zb0019 {
-					(*z).ApplicationArgs = ((*z).ApplicationArgs)[:zb0019]
+				} else if (*z).ApplicationArgs != nil && cap((*z).ApplicationArgs) >= zb0026 {
+					(*z).ApplicationArgs = ((*z).ApplicationArgs)[:zb0026]
 				} else {
-					(*z).ApplicationArgs = make([][]byte, zb0019)
+					(*z).ApplicationArgs = make([][]byte, zb0026)
 				}
 				for zb0001 := range (*z).ApplicationArgs {
 					(*z).ApplicationArgs[zb0001], bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationArgs[zb0001])
@@ -594,24 +753,24 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 					}
 				}
 			case "apat":
-				var zb0021 int
-				var zb0022 bool
-				zb0021, zb0022, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0028 int
+				var zb0029 bool
+				zb0028, zb0029, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Accounts")
 					return
 				}
-				if zb0021 > encodedMaxAccounts {
-					err = msgp.ErrOverflow(uint64(zb0021), uint64(encodedMaxAccounts))
+				if zb0028 > encodedMaxAccounts {
+					err = msgp.ErrOverflow(uint64(zb0028), uint64(encodedMaxAccounts))
 					err = msgp.WrapError(err, "Accounts")
 					return
 				}
-				if zb0022 {
+				if zb0029 {
 					(*z).Accounts = nil
-				} else if (*z).Accounts != nil && cap((*z).Accounts) >= zb0021 {
-					(*z).Accounts = ((*z).Accounts)[:zb0021]
+				} else if (*z).Accounts != nil && cap((*z).Accounts) >= zb0028 {
+					(*z).Accounts = ((*z).Accounts)[:zb0028]
 				} else {
-					(*z).Accounts = make([]basics.Address, zb0021)
+					(*z).Accounts = make([]basics.Address, zb0028)
 				}
 				for zb0002 := range (*z).Accounts {
 					bts, err = (*z).Accounts[zb0002].UnmarshalMsg(bts)
@@ -621,24 +780,24 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 					}
 				}
 			case "apfa":
-				var zb0023 int
-				var zb0024 bool
-				zb0023, zb0024, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0030 int
+				var zb0031 bool
+				zb0030, zb0031, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ForeignApps")
 					return
 				}
-				if zb0023 > encodedMaxForeignApps {
-					err = msgp.ErrOverflow(uint64(zb0023), uint64(encodedMaxForeignApps))
+				if zb0030 > encodedMaxForeignApps {
+					err = msgp.ErrOverflow(uint64(zb0030), uint64(encodedMaxForeignApps))
 					err = msgp.WrapError(err, "ForeignApps")
 					return
 				}
-				if zb0024 {
+				if zb0031 {
 					(*z).ForeignApps = nil
-				} else if (*z).ForeignApps != nil && cap((*z).ForeignApps) >= zb0023 {
-					(*z).ForeignApps = ((*z).ForeignApps)[:zb0023]
+				} else if (*z).ForeignApps != nil && cap((*z).ForeignApps) >= zb0030 {
+					(*z).ForeignApps = ((*z).ForeignApps)[:zb0030]
 				} else {
-					(*z).ForeignApps = make([]basics.AppIndex, zb0023)
+					(*z).ForeignApps = make([]basics.AppIndex, zb0030)
 				}
 				for zb0003 := range (*z).ForeignApps {
 					bts, err = (*z).ForeignApps[zb0003].UnmarshalMsg(bts)
@@ -648,24 +807,24 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 					}
 				}
 			case "apas":
-				var zb0025 int
-				var zb0026 bool
-				zb0025, zb0026, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0032 int
+				var zb0033 bool
+				zb0032, zb0033, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ForeignAssets")
 					return
 				}
-				if zb0025 > encodedMaxForeignAssets {
-					err = msgp.ErrOverflow(uint64(zb0025), uint64(encodedMaxForeignAssets))
+				if zb0032 > encodedMaxForeignAssets {
+					err = msgp.ErrOverflow(uint64(zb0032), uint64(encodedMaxForeignAssets))
 					err = msgp.WrapError(err, "ForeignAssets")
 					return
 				}
-				if zb0026 {
+				if zb0033 {
 					(*z).ForeignAssets = nil
-				} else if (*z).ForeignAssets != nil && cap((*z).ForeignAssets) >= zb0025 {
-					(*z).ForeignAssets = ((*z).ForeignAssets)[:zb0025]
+				} else if (*z).ForeignAssets != nil && cap((*z).ForeignAssets) >= zb0032 {
+					(*z).ForeignAssets = ((*z).ForeignAssets)[:zb0032]
 				} else {
-					(*z).ForeignAssets = make([]basics.AssetIndex, zb0025)
+					(*z).ForeignAssets = make([]basics.AssetIndex, zb0032)
 				}
 				for zb0004 := range (*z).ForeignAssets {
 					bts, err = (*z).ForeignAssets[zb0004].UnmarshalMsg(bts)
@@ -674,6 +833,117 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 						return
 					}
 				}
+			case "apbx":
+				var zb0034 int
+				var zb0035 bool
+				zb0034, zb0035, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0034 > encodedMaxBoxes {
+					err = msgp.ErrOverflow(uint64(zb0034), uint64(encodedMaxBoxes))
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0035 {
+					(*z).Boxes = nil
+				} else if (*z).Boxes != nil && cap((*z).Boxes) >= zb0034 {
+					(*z).Boxes = ((*z).Boxes)[:zb0034]
+				} else {
+					(*z).Boxes = make([]BoxRef, zb0034)
+				}
+				for zb0005 := range (*z).Boxes {
+					var zb0036 int
+					var zb0037 bool
+					zb0036, zb0037, bts, err = msgp.ReadMapHeaderBytes(bts)
+					if _, ok := err.(msgp.TypeError); ok {
+						zb0036, zb0037, bts, err = msgp.ReadArrayHeaderBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Boxes", zb0005)
+							return
+						}
+						if zb0036 > 0 {
+							zb0036--
+							(*z).Boxes[zb0005].Index, bts, err = msgp.ReadUint64Bytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0005, "struct-from-array", "Index")
+								return
+							}
+						}
+						if zb0036 > 0 {
+							zb0036--
+							var zb0038 int
+							zb0038, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0005, "struct-from-array", "Name")
+								return
+							}
+							if zb0038 > config.MaxBytesKeyValueLen {
+								err = msgp.ErrOverflow(uint64(zb0038), uint64(config.MaxBytesKeyValueLen))
+								return
+							}
+							(*z).Boxes[zb0005].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Boxes[zb0005].Name)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0005, "struct-from-array", "Name")
+								return
+							}
+						}
+						if zb0036 > 0 {
+							err = msgp.ErrTooManyArrayFields(zb0036)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0005, "struct-from-array")
+								return
+							}
+						}
+					} else {
+						if err != nil {
+							err = msgp.WrapError(err, "Boxes", zb0005)
+							return
+						}
+						if zb0037 {
+							(*z).Boxes[zb0005] = BoxRef{}
+						}
+						for zb0036 > 0 {
+							zb0036--
+							field, bts, err = msgp.ReadMapKeyZC(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0005)
+								return
+							}
+							switch string(field) {
+							case "i":
+								(*z).Boxes[zb0005].Index, bts, err = msgp.ReadUint64Bytes(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0005, "Index")
+									return
+								}
+							case "n":
+								var zb0039 int
+								zb0039, err = msgp.ReadBytesBytesHeader(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0005, "Name")
+									return
+								}
+								if zb0039 > config.MaxBytesKeyValueLen {
+									err = msgp.ErrOverflow(uint64(zb0039), uint64(config.MaxBytesKeyValueLen))
+									return
+								}
+								(*z).Boxes[zb0005].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Boxes[zb0005].Name)
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0005, "Name")
+									return
+								}
+							default:
+								err = msgp.ErrNoField(string(field))
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0005)
+									return
+								}
+							}
+						}
+					}
+				}
 			case "apls":
 				bts, err = (*z).LocalStateSchema.UnmarshalMsg(bts)
 				if err != nil {
@@ -687,14 +957,14 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 					return
 				}
 			case "apap":
-				var zb0027 int
-				zb0027, err = msgp.ReadBytesBytesHeader(bts)
+				var zb0040 int
+				zb0040, err = msgp.ReadBytesBytesHeader(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ApprovalProgram")
 					return
 				}
-				if zb0027 > config.MaxAvailableAppProgramLen {
-					err = msgp.ErrOverflow(uint64(zb0027), uint64(config.MaxAvailableAppProgramLen))
+				if zb0040 > config.MaxAvailableAppProgramLen {
+					err = msgp.ErrOverflow(uint64(zb0040), uint64(config.MaxAvailableAppProgramLen))
 					return
 				}
 				(*z).ApprovalProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ApprovalProgram)
@@ -703,14 +973,14 @@ func (z *ApplicationCallTxnFields) UnmarshalMsg(bts []byte) (o []byte, err error
 					return
 				}
 			case "apsu":
-				var zb0028 int
-				zb0028, err = msgp.ReadBytesBytesHeader(bts)
+				var zb0041 int
+				zb0041, err = msgp.ReadBytesBytesHeader(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ClearStateProgram")
 					return
 				}
-				if zb0028 > config.MaxAvailableAppProgramLen {
-					err = msgp.ErrOverflow(uint64(zb0028), uint64(config.MaxAvailableAppProgramLen))
+				if zb0041 > config.MaxAvailableAppProgramLen {
+					err = msgp.ErrOverflow(uint64(zb0041), uint64(config.MaxAvailableAppProgramLen))
 					return
 				}
 				(*z).ClearStateProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ClearStateProgram)
@@ -760,13 +1030,17 @@ func (z *ApplicationCallTxnFields) Msgsize() (s int) {
 	for zb0004 := range (*z).ForeignAssets {
 		s += (*z).ForeignAssets[zb0004].Msgsize()
 	}
+	s += 5 + msgp.ArrayHeaderSize
+	for zb0005 := range (*z).Boxes {
+		s += 1 + 2 + msgp.Uint64Size + 2 + msgp.BytesPrefixSize + len((*z).Boxes[zb0005].Name)
+	}
 	s += 5 + (*z).LocalStateSchema.Msgsize() + 5 + (*z).GlobalStateSchema.Msgsize() + 5 + msgp.BytesPrefixSize + len((*z).ApprovalProgram) + 5 + msgp.BytesPrefixSize + len((*z).ClearStateProgram) + 5 + msgp.Uint32Size
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *ApplicationCallTxnFields) MsgIsZero() bool {
-	return ((*z).ApplicationID.MsgIsZero()) && ((*z).OnCompletion == 0) && (len((*z).ApplicationArgs) == 0) && (len((*z).Accounts) == 0) && (len((*z).ForeignApps) == 0) && (len((*z).ForeignAssets) == 0) && ((*z).LocalStateSchema.MsgIsZero()) && ((*z).GlobalStateSchema.MsgIsZero()) && (len((*z).ApprovalProgram) == 0) && (len((*z).ClearStateProgram) == 0) && ((*z).ExtraProgramPages == 0)
+	return ((*z).ApplicationID.MsgIsZero()) && ((*z).OnCompletion == 0) && (len((*z).ApplicationArgs) == 0) && (len((*z).Accounts) == 0) && (len((*z).ForeignApps) == 0) && (len((*z).ForeignAssets) == 0) && (len((*z).Boxes) == 0) && ((*z).LocalStateSchema.MsgIsZero()) && ((*z).GlobalStateSchema.MsgIsZero()) && (len((*z).ApprovalProgram) == 0) && (len((*z).ClearStateProgram) == 0) && ((*z).ExtraProgramPages == 0)
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -1515,6 +1789,155 @@ func (z *AssetTransferTxnFields) MsgIsZero() bool {
 	return ((*z).XferAsset.MsgIsZero()) && ((*z).AssetAmount == 0) && ((*z).AssetSender.MsgIsZero()) && ((*z).AssetReceiver.MsgIsZero()) && ((*z).AssetCloseTo.MsgIsZero())
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *BoxRef) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 3 bits */
+	if (*z).Index == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if len((*z).Name) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "i"
+			o = append(o, 0xa1, 0x69)
+			o = msgp.AppendUint64(o, (*z).Index)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "n"
+			o = append(o, 0xa1, 0x6e)
+			o = msgp.AppendBytes(o, (*z).Name)
+		}
+	}
+	return
+}
+
+func (_ *BoxRef) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*BoxRef)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *BoxRef) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Index, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Index")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+			if zb0003 > config.MaxBytesKeyValueLen {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(config.MaxBytesKeyValueLen))
+				return
+			}
+			(*z).Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Name)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = BoxRef{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "i":
+				(*z).Index, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Index")
+					return
+				}
+			case "n":
+				var zb0004 int
+				zb0004, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+				if zb0004 > config.MaxBytesKeyValueLen {
+					err = msgp.ErrOverflow(uint64(zb0004), uint64(config.MaxBytesKeyValueLen))
+					return
+				}
+				(*z).Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Name)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *BoxRef) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*BoxRef)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *BoxRef) Msgsize() (s int) {
+	s = 1 + 2 + msgp.Uint64Size + 2 + msgp.BytesPrefixSize + len((*z).Name)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *BoxRef) MsgIsZero() bool {
+	return ((*z).Index == 0) && (len((*z).Name) == 0)
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *CompactCertTxnFields) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
@@ -4176,208 +4599,212 @@ func (z *SignedTxnWithAD) MsgIsZero() bool {
 func (z *Transaction) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0006Len := uint32(44)
-	var zb0006Mask uint64 /* 53 bits */
+	zb0007Len := uint32(45)
+	var zb0007Mask uint64 /* 54 bits */
 	if (*z).AssetTransferTxnFields.AssetAmount == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x200
+		zb0007Len--
+		zb0007Mask |= 0x200
 	}
 	if (*z).AssetTransferTxnFields.AssetCloseTo.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x400
+		zb0007Len--
+		zb0007Mask |= 0x400
 	}
 	if (*z).AssetFreezeTxnFields.AssetFrozen == false {
-		zb0006Len--
-		zb0006Mask |= 0x800
+		zb0007Len--
+		zb0007Mask |= 0x800
 	}
 	if (*z).PaymentTxnFields.Amount.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x1000
+		zb0007Len--
+		zb0007Mask |= 0x1000
 	}
 	if len((*z).ApplicationCallTxnFields.ApplicationArgs) == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x2000
+		zb0007Len--
+		zb0007Mask |= 0x2000
 	}
 	if (*z).ApplicationCallTxnFields.OnCompletion == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x4000
+		zb0007Len--
+		zb0007Mask |= 0x4000
 	}
 	if len((*z).ApplicationCallTxnFields.ApprovalProgram) == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x8000
+		zb0007Len--
+		zb0007Mask |= 0x8000
 	}
 	if (*z).AssetConfigTxnFields.AssetParams.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x10000
+		zb0007Len--
+		zb0007Mask |= 0x10000
 	}
 	if len((*z).ApplicationCallTxnFields.ForeignAssets) == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x20000
+		zb0007Len--
+		zb0007Mask |= 0x20000
 	}
 	if len((*z).ApplicationCallTxnFields.Accounts) == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x40000
+		zb0007Len--
+		zb0007Mask |= 0x40000
+	}
+	if len((*z).ApplicationCallTxnFields.Boxes) == 0 {
+		zb0007Len--
+		zb0007Mask |= 0x80000
 	}
 	if (*z).ApplicationCallTxnFields.ExtraProgramPages == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x80000
+		zb0007Len--
+		zb0007Mask |= 0x100000
 	}
 	if len((*z).ApplicationCallTxnFields.ForeignApps) == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x100000
+		zb0007Len--
+		zb0007Mask |= 0x200000
 	}
 	if (*z).ApplicationCallTxnFields.GlobalStateSchema.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x200000
+		zb0007Len--
+		zb0007Mask |= 0x400000
 	}
 	if (*z).ApplicationCallTxnFields.ApplicationID.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x400000
+		zb0007Len--
+		zb0007Mask |= 0x800000
 	}
 	if (*z).ApplicationCallTxnFields.LocalStateSchema.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x800000
+		zb0007Len--
+		zb0007Mask |= 0x1000000
 	}
 	if len((*z).ApplicationCallTxnFields.ClearStateProgram) == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x1000000
+		zb0007Len--
+		zb0007Mask |= 0x2000000
 	}
 	if (*z).AssetTransferTxnFields.AssetReceiver.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x2000000
+		zb0007Len--
+		zb0007Mask |= 0x4000000
 	}
 	if (*z).AssetTransferTxnFields.AssetSender.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x4000000
+		zb0007Len--
+		zb0007Mask |= 0x8000000
 	}
 	if (*z).AssetConfigTxnFields.ConfigAsset.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x8000000
+		zb0007Len--
+		zb0007Mask |= 0x10000000
 	}
 	if (*z).CompactCertTxnFields.Cert.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x10000000
+		zb0007Len--
+		zb0007Mask |= 0x20000000
 	}
 	if (*z).CompactCertTxnFields.CertRound.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x20000000
+		zb0007Len--
+		zb0007Mask |= 0x40000000
 	}
 	if (*z).CompactCertTxnFields.CertType.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x40000000
+		zb0007Len--
+		zb0007Mask |= 0x80000000
 	}
 	if (*z).PaymentTxnFields.CloseRemainderTo.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x80000000
+		zb0007Len--
+		zb0007Mask |= 0x100000000
 	}
 	if (*z).AssetFreezeTxnFields.FreezeAccount.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x100000000
+		zb0007Len--
+		zb0007Mask |= 0x200000000
 	}
 	if (*z).AssetFreezeTxnFields.FreezeAsset.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x200000000
+		zb0007Len--
+		zb0007Mask |= 0x400000000
 	}
 	if (*z).Header.Fee.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x400000000
+		zb0007Len--
+		zb0007Mask |= 0x800000000
 	}
 	if (*z).Header.FirstValid.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x800000000
+		zb0007Len--
+		zb0007Mask |= 0x1000000000
 	}
 	if (*z).Header.GenesisID == "" {
-		zb0006Len--
-		zb0006Mask |= 0x1000000000
+		zb0007Len--
+		zb0007Mask |= 0x2000000000
 	}
 	if (*z).Header.GenesisHash.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x2000000000
+		zb0007Len--
+		zb0007Mask |= 0x4000000000
 	}
 	if (*z).Header.Group.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x4000000000
+		zb0007Len--
+		zb0007Mask |= 0x8000000000
 	}
 	if (*z).Header.LastValid.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x8000000000
+		zb0007Len--
+		zb0007Mask |= 0x10000000000
 	}
 	if (*z).Header.Lease == ([32]byte{}) {
-		zb0006Len--
-		zb0006Mask |= 0x10000000000
+		zb0007Len--
+		zb0007Mask |= 0x20000000000
 	}
 	if (*z).KeyregTxnFields.Nonparticipation == false {
-		zb0006Len--
-		zb0006Mask |= 0x20000000000
+		zb0007Len--
+		zb0007Mask |= 0x40000000000
 	}
 	if len((*z).Header.Note) == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x40000000000
+		zb0007Len--
+		zb0007Mask |= 0x80000000000
 	}
 	if (*z).PaymentTxnFields.Receiver.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x80000000000
+		zb0007Len--
+		zb0007Mask |= 0x100000000000
 	}
 	if (*z).Header.RekeyTo.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x100000000000
+		zb0007Len--
+		zb0007Mask |= 0x200000000000
 	}
 	if (*z).KeyregTxnFields.SelectionPK.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x200000000000
+		zb0007Len--
+		zb0007Mask |= 0x400000000000
 	}
 	if (*z).Header.Sender.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x400000000000
+		zb0007Len--
+		zb0007Mask |= 0x800000000000
 	}
 	if (*z).Type.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x800000000000
+		zb0007Len--
+		zb0007Mask |= 0x1000000000000
 	}
 	if (*z).KeyregTxnFields.VoteFirst.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x1000000000000
+		zb0007Len--
+		zb0007Mask |= 0x2000000000000
 	}
 	if (*z).KeyregTxnFields.VoteKeyDilution == 0 {
-		zb0006Len--
-		zb0006Mask |= 0x2000000000000
+		zb0007Len--
+		zb0007Mask |= 0x4000000000000
 	}
 	if (*z).KeyregTxnFields.VotePK.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x4000000000000
+		zb0007Len--
+		zb0007Mask |= 0x8000000000000
 	}
 	if (*z).KeyregTxnFields.VoteLast.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x8000000000000
+		zb0007Len--
+		zb0007Mask |= 0x10000000000000
 	}
 	if (*z).AssetTransferTxnFields.XferAsset.MsgIsZero() {
-		zb0006Len--
-		zb0006Mask |= 0x10000000000000
+		zb0007Len--
+		zb0007Mask |= 0x20000000000000
 	}
-	// variable map header, size zb0006Len
-	o = msgp.AppendMapHeader(o, zb0006Len)
-	if zb0006Len != 0 {
-		if (zb0006Mask & 0x200) == 0 { // if not empty
+	// variable map header, size zb0007Len
+	o = msgp.AppendMapHeader(o, zb0007Len)
+	if zb0007Len != 0 {
+		if (zb0007Mask & 0x200) == 0 { // if not empty
 			// string "aamt"
 			o = append(o, 0xa4, 0x61, 0x61, 0x6d, 0x74)
 			o = msgp.AppendUint64(o, (*z).AssetTransferTxnFields.AssetAmount)
 		}
-		if (zb0006Mask & 0x400) == 0 { // if not empty
+		if (zb0007Mask & 0x400) == 0 { // if not empty
 			// string "aclose"
 			o = append(o, 0xa6, 0x61, 0x63, 0x6c, 0x6f, 0x73, 0x65)
 			o = (*z).AssetTransferTxnFields.AssetCloseTo.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x800) == 0 { // if not empty
+		if (zb0007Mask & 0x800) == 0 { // if not empty
 			// string "afrz"
 			o = append(o, 0xa4, 0x61, 0x66, 0x72, 0x7a)
 			o = msgp.AppendBool(o, (*z).AssetFreezeTxnFields.AssetFrozen)
 		}
-		if (zb0006Mask & 0x1000) == 0 { // if not empty
+		if (zb0007Mask & 0x1000) == 0 { // if not empty
 			// string "amt"
 			o = append(o, 0xa3, 0x61, 0x6d, 0x74)
 			o = (*z).PaymentTxnFields.Amount.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x2000) == 0 { // if not empty
+		if (zb0007Mask & 0x2000) == 0 { // if not empty
 			// string "apaa"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x61)
 			if (*z).ApplicationCallTxnFields.ApplicationArgs == nil {
@@ -4389,22 +4816,22 @@ func (z *Transaction) MarshalMsg(b []byte) (o []byte) {
 				o = msgp.AppendBytes(o, (*z).ApplicationCallTxnFields.ApplicationArgs[zb0002])
 			}
 		}
-		if (zb0006Mask & 0x4000) == 0 { // if not empty
+		if (zb0007Mask & 0x4000) == 0 { // if not empty
 			// string "apan"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x6e)
 			o = msgp.AppendUint64(o, uint64((*z).ApplicationCallTxnFields.OnCompletion))
 		}
-		if (zb0006Mask & 0x8000) == 0 { // if not empty
+		if (zb0007Mask & 0x8000) == 0 { // if not empty
 			// string "apap"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x70)
 			o = msgp.AppendBytes(o, (*z).ApplicationCallTxnFields.ApprovalProgram)
 		}
-		if (zb0006Mask & 0x10000) == 0 { // if not empty
+		if (zb0007Mask & 0x10000) == 0 { // if not empty
 			// string "apar"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x72)
 			o = (*z).AssetConfigTxnFields.AssetParams.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x20000) == 0 { // if not empty
+		if (zb0007Mask & 0x20000) == 0 { // if not empty
 			// string "apas"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x73)
 			if (*z).ApplicationCallTxnFields.ForeignAssets == nil {
@@ -4416,7 +4843,7 @@ func (z *Transaction) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).ApplicationCallTxnFields.ForeignAssets[zb0005].MarshalMsg(o)
 			}
 		}
-		if (zb0006Mask & 0x40000) == 0 { // if not empty
+		if (zb0007Mask & 0x40000) == 0 { // if not empty
 			// string "apat"
 			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x74)
 			if (*z).ApplicationCallTxnFields.Accounts == nil {
@@ -4428,12 +4855,46 @@ func (z *Transaction) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).ApplicationCallTxnFields.Accounts[zb0003].MarshalMsg(o)
 			}
 		}
-		if (zb0006Mask & 0x80000) == 0 { // if not empty
+		if (zb0007Mask & 0x80000) == 0 { // if not empty
+			// string "apbx"
+			o = append(o, 0xa4, 0x61, 0x70, 0x62, 0x78)
+			if (*z).ApplicationCallTxnFields.Boxes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).ApplicationCallTxnFields.Boxes)))
+			}
+			for zb0006 := range (*z).ApplicationCallTxnFields.Boxes {
+				// omitempty: check for empty values
+				zb0008Len := uint32(2)
+				var zb0008Mask uint8 /* 3 bits */
+				if (*z).ApplicationCallTxnFields.Boxes[zb0006].Index == 0 {
+					zb0008Len--
+					zb0008Mask |= 0x2
+				}
+				if len((*z).ApplicationCallTxnFields.Boxes[zb0006].Name) == 0 {
+					zb0008Len--
+					zb0008Mask |= 0x4
+				}
+				// variable map header, size zb0008Len
+				o = append(o, 0x80|uint8(zb0008Len))
+				if (zb0008Mask & 0x2) == 0 { // if not empty
+					// string "i"
+					o = append(o, 0xa1, 0x69)
+					o = msgp.AppendUint64(o, (*z).ApplicationCallTxnFields.Boxes[zb0006].Index)
+				}
+				if (zb0008Mask & 0x4) == 0 { // if not empty
+					// string "n"
+					o = append(o, 0xa1, 0x6e)
+					o = msgp.AppendBytes(o, (*z).ApplicationCallTxnFields.Boxes[zb0006].Name)
+				}
+			}
+		}
+		if (zb0007Mask & 0x100000) == 0 { // if not empty
 			// string "apep"
 			o = append(o, 0xa4, 0x61, 0x70, 0x65, 0x70)
 			o = msgp.AppendUint32(o, (*z).ApplicationCallTxnFields.ExtraProgramPages)
 		}
-		if (zb0006Mask & 0x100000) == 0 { // if not empty
+		if (zb0007Mask & 0x200000) == 0 { // if not empty
 			// string "apfa"
 			o = append(o, 0xa4, 0x61, 0x70, 0x66, 0x61)
 			if (*z).ApplicationCallTxnFields.ForeignApps == nil {
@@ -4445,162 +4906,162 @@ func (z *Transaction) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).ApplicationCallTxnFields.ForeignApps[zb0004].MarshalMsg(o)
 			}
 		}
-		if (zb0006Mask & 0x200000) == 0 { // if not empty
+		if (zb0007Mask & 0x400000) == 0 { // if not empty
 			// string "apgs"
 			o = append(o, 0xa4, 0x61, 0x70, 0x67, 0x73)
 			o = (*z).ApplicationCallTxnFields.GlobalStateSchema.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x400000) == 0 { // if not empty
+		if (zb0007Mask & 0x800000) == 0 { // if not empty
 			// string "apid"
 			o = append(o, 0xa4, 0x61, 0x70, 0x69, 0x64)
 			o = (*z).ApplicationCallTxnFields.ApplicationID.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x800000) == 0 { // if not empty
+		if (zb0007Mask & 0x1000000) == 0 { // if not empty
 			// string "apls"
 			o = append(o, 0xa4, 0x61, 0x70, 0x6c, 0x73)
 			o = (*z).ApplicationCallTxnFields.LocalStateSchema.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x1000000) == 0 { // if not empty
+		if (zb0007Mask & 0x2000000) == 0 { // if not empty
 			// string "apsu"
 			o = append(o, 0xa4, 0x61, 0x70, 0x73, 0x75)
 			o = msgp.AppendBytes(o, (*z).ApplicationCallTxnFields.ClearStateProgram)
 		}
-		if (zb0006Mask & 0x2000000) == 0 { // if not empty
+		if (zb0007Mask & 0x4000000) == 0 { // if not empty
 			// string "arcv"
 			o = append(o, 0xa4, 0x61, 0x72, 0x63, 0x76)
 			o = (*z).AssetTransferTxnFields.AssetReceiver.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x4000000) == 0 { // if not empty
+		if (zb0007Mask & 0x8000000) == 0 { // if not empty
 			// string "asnd"
 			o = append(o, 0xa4, 0x61, 0x73, 0x6e, 0x64)
 			o = (*z).AssetTransferTxnFields.AssetSender.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x8000000) == 0 { // if not empty
+		if (zb0007Mask & 0x10000000) == 0 { // if not empty
 			// string "caid"
 			o = append(o, 0xa4, 0x63, 0x61, 0x69, 0x64)
 			o = (*z).AssetConfigTxnFields.ConfigAsset.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x10000000) == 0 { // if not empty
+		if (zb0007Mask & 0x20000000) == 0 { // if not empty
 			// string "cert"
 			o = append(o, 0xa4, 0x63, 0x65, 0x72, 0x74)
 			o = (*z).CompactCertTxnFields.Cert.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x20000000) == 0 { // if not empty
+		if (zb0007Mask & 0x40000000) == 0 { // if not empty
 			// string "certrnd"
 			o = append(o, 0xa7, 0x63, 0x65, 0x72, 0x74, 0x72, 0x6e, 0x64)
 			o = (*z).CompactCertTxnFields.CertRound.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x40000000) == 0 { // if not empty
+		if (zb0007Mask & 0x80000000) == 0 { // if not empty
 			// string "certtype"
 			o = append(o, 0xa8, 0x63, 0x65, 0x72, 0x74, 0x74, 0x79, 0x70, 0x65)
 			o = (*z).CompactCertTxnFields.CertType.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x80000000) == 0 { // if not empty
+		if (zb0007Mask & 0x100000000) == 0 { // if not empty
 			// string "close"
 			o = append(o, 0xa5, 0x63, 0x6c, 0x6f, 0x73, 0x65)
 			o = (*z).PaymentTxnFields.CloseRemainderTo.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x100000000) == 0 { // if not empty
+		if (zb0007Mask & 0x200000000) == 0 { // if not empty
 			// string "fadd"
 			o = append(o, 0xa4, 0x66, 0x61, 0x64, 0x64)
 			o = (*z).AssetFreezeTxnFields.FreezeAccount.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x200000000) == 0 { // if not empty
+		if (zb0007Mask & 0x400000000) == 0 { // if not empty
 			// string "faid"
 			o = append(o, 0xa4, 0x66, 0x61, 0x69, 0x64)
 			o = (*z).AssetFreezeTxnFields.FreezeAsset.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x400000000) == 0 { // if not empty
+		if (zb0007Mask & 0x800000000) == 0 { // if not empty
 			// string "fee"
 			o = append(o, 0xa3, 0x66, 0x65, 0x65)
 			o = (*z).Header.Fee.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x800000000) == 0 { // if not empty
+		if (zb0007Mask & 0x1000000000) == 0 { // if not empty
 			// string "fv"
 			o = append(o, 0xa2, 0x66, 0x76)
 			o = (*z).Header.FirstValid.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x1000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x2000000000) == 0 { // if not empty
 			// string "gen"
 			o = append(o, 0xa3, 0x67, 0x65, 0x6e)
 			o = msgp.AppendString(o, (*z).Header.GenesisID)
 		}
-		if (zb0006Mask & 0x2000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x4000000000) == 0 { // if not empty
 			// string "gh"
 			o = append(o, 0xa2, 0x67, 0x68)
 			o = (*z).Header.GenesisHash.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x4000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x8000000000) == 0 { // if not empty
 			// string "grp"
 			o = append(o, 0xa3, 0x67, 0x72, 0x70)
 			o = (*z).Header.Group.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x8000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x10000000000) == 0 { // if not empty
 			// string "lv"
 			o = append(o, 0xa2, 0x6c, 0x76)
 			o = (*z).Header.LastValid.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x10000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x20000000000) == 0 { // if not empty
 			// string "lx"
 			o = append(o, 0xa2, 0x6c, 0x78)
 			o = msgp.AppendBytes(o, ((*z).Header.Lease)[:])
 		}
-		if (zb0006Mask & 0x20000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x40000000000) == 0 { // if not empty
 			// string "nonpart"
 			o = append(o, 0xa7, 0x6e, 0x6f, 0x6e, 0x70, 0x61, 0x72, 0x74)
 			o = msgp.AppendBool(o, (*z).KeyregTxnFields.Nonparticipation)
 		}
-		if (zb0006Mask & 0x40000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x80000000000) == 0 { // if not empty
 			// string "note"
 			o = append(o, 0xa4, 0x6e, 0x6f, 0x74, 0x65)
 			o = msgp.AppendBytes(o, (*z).Header.Note)
 		}
-		if (zb0006Mask & 0x80000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x100000000000) == 0 { // if not empty
 			// string "rcv"
 			o = append(o, 0xa3, 0x72, 0x63, 0x76)
 			o = (*z).PaymentTxnFields.Receiver.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x100000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x200000000000) == 0 { // if not empty
 			// string "rekey"
 			o = append(o, 0xa5, 0x72, 0x65, 0x6b, 0x65, 0x79)
 			o = (*z).Header.RekeyTo.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x200000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x400000000000) == 0 { // if not empty
 			// string "selkey"
 			o = append(o, 0xa6, 0x73, 0x65, 0x6c, 0x6b, 0x65, 0x79)
 			o = (*z).KeyregTxnFields.SelectionPK.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x400000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x800000000000) == 0 { // if not empty
 			// string "snd"
 			o = append(o, 0xa3, 0x73, 0x6e, 0x64)
 			o = (*z).Header.Sender.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x800000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x1000000000000) == 0 { // if not empty
 			// string "type"
 			o = append(o, 0xa4, 0x74, 0x79, 0x70, 0x65)
 			o = (*z).Type.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x1000000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x2000000000000) == 0 { // if not empty
 			// string "votefst"
 			o = append(o, 0xa7, 0x76, 0x6f, 0x74, 0x65, 0x66, 0x73, 0x74)
 			o = (*z).KeyregTxnFields.VoteFirst.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x2000000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x4000000000000) == 0 { // if not empty
 			// string "votekd"
 			o = append(o, 0xa6, 0x76, 0x6f, 0x74, 0x65, 0x6b, 0x64)
 			o = msgp.AppendUint64(o, (*z).KeyregTxnFields.VoteKeyDilution)
 		}
-		if (zb0006Mask & 0x4000000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x8000000000000) == 0 { // if not empty
 			// string "votekey"
 			o = append(o, 0xa7, 0x76, 0x6f, 0x74, 0x65, 0x6b, 0x65, 0x79)
 			o = (*z).KeyregTxnFields.VotePK.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x8000000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x10000000000000) == 0 { // if not empty
 			// string "votelst"
 			o = append(o, 0xa7, 0x76, 0x6f, 0x74, 0x65, 0x6c, 0x73, 0x74)
 			o = (*z).KeyregTxnFields.VoteLast.MarshalMsg(o)
 		}
-		if (zb0006Mask & 0x10000000000000) == 0 { // if not empty
+		if (zb0007Mask & 0x20000000000000) == 0 { // if not empty
 			// string "xaid"
 			o = append(o, 0xa4, 0x78, 0x61, 0x69, 0x64)
 			o = (*z).AssetTransferTxnFields.XferAsset.MarshalMsg(o)
@@ -4618,65 +5079,65 @@ func (_ *Transaction) CanMarshalMsg(z interface{}) bool {
 func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
-	var zb0006 int
-	var zb0007 bool
-	zb0006, zb0007, bts, err = msgp.ReadMapHeaderBytes(bts)
+	var zb0007 int
+	var zb0008 bool
+	zb0007, zb0008, bts, err = msgp.ReadMapHeaderBytes(bts)
 	if _, ok := err.(msgp.TypeError); ok {
-		zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		zb0007, zb0008, bts, err = msgp.ReadArrayHeaderBytes(bts)
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Type.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Type")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Header.Sender.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Sender")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Header.Fee.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Fee")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Header.FirstValid.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "FirstValid")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Header.LastValid.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "LastValid")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
-			var zb0008 int
-			zb0008, err = msgp.ReadBytesBytesHeader(bts)
+		if zb0007 > 0 {
+			zb0007--
+			var zb0009 int
+			zb0009, err = msgp.ReadBytesBytesHeader(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Note")
 				return
 			}
-			if zb0008 > config.MaxTxnNoteBytes {
-				err = msgp.ErrOverflow(uint64(zb0008), uint64(config.MaxTxnNoteBytes))
+			if zb0009 > config.MaxTxnNoteBytes {
+				err = msgp.ErrOverflow(uint64(zb0009), uint64(config.MaxTxnNoteBytes))
 				return
 			}
 			(*z).Header.Note, bts, err = msgp.ReadBytesBytes(bts, (*z).Header.Note)
@@ -4685,238 +5146,238 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			(*z).Header.GenesisID, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "GenesisID")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Header.GenesisHash.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "GenesisHash")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Header.Group.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Group")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = msgp.ReadExactBytes(bts, ((*z).Header.Lease)[:])
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Lease")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).Header.RekeyTo.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "RekeyTo")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).KeyregTxnFields.VotePK.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VotePK")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).KeyregTxnFields.SelectionPK.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "SelectionPK")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).KeyregTxnFields.VoteFirst.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VoteFirst")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).KeyregTxnFields.VoteLast.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VoteLast")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			(*z).KeyregTxnFields.VoteKeyDilution, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VoteKeyDilution")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			(*z).KeyregTxnFields.Nonparticipation, bts, err = msgp.ReadBoolBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Nonparticipation")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).PaymentTxnFields.Receiver.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Receiver")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).PaymentTxnFields.Amount.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Amount")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).PaymentTxnFields.CloseRemainderTo.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "CloseRemainderTo")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetConfigTxnFields.ConfigAsset.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ConfigAsset")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetConfigTxnFields.AssetParams.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "AssetParams")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetTransferTxnFields.XferAsset.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "XferAsset")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			(*z).AssetTransferTxnFields.AssetAmount, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "AssetAmount")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetTransferTxnFields.AssetSender.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "AssetSender")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetTransferTxnFields.AssetReceiver.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "AssetReceiver")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetTransferTxnFields.AssetCloseTo.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "AssetCloseTo")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetFreezeTxnFields.FreezeAccount.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "FreezeAccount")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).AssetFreezeTxnFields.FreezeAsset.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "FreezeAsset")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			(*z).AssetFreezeTxnFields.AssetFrozen, bts, err = msgp.ReadBoolBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "AssetFrozen")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).ApplicationCallTxnFields.ApplicationID.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ApplicationID")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			{
-				var zb0009 uint64
-				zb0009, bts, err = msgp.ReadUint64Bytes(bts)
+				var zb0010 uint64
+				zb0010, bts, err = msgp.ReadUint64Bytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "struct-from-array", "OnCompletion")
 					return
 				}
-				(*z).ApplicationCallTxnFields.OnCompletion = OnCompletion(zb0009)
+				(*z).ApplicationCallTxnFields.OnCompletion = OnCompletion(zb0010)
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
-			var zb0010 int
-			var zb0011 bool
-			zb0010, zb0011, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0007 > 0 {
+			zb0007--
+			var zb0011 int
+			var zb0012 bool
+			zb0011, zb0012, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ApplicationArgs")
 				return
 			}
-			if zb0010 > encodedMaxApplicationArgs {
-				err = msgp.ErrOverflow(uint64(zb0010), uint64(encodedMaxApplicationArgs))
+			if zb0011 > encodedMaxApplicationArgs {
+				err = msgp.ErrOverflow(uint64(zb0011), uint64(encodedMaxApplicationArgs))
 				err = msgp.WrapError(err, "struct-from-array", "ApplicationArgs")
 				return
 			}
-			if zb0011 {
+			if zb0012 {
 				(*z).ApplicationCallTxnFields.ApplicationArgs = nil
-			} else if (*z).ApplicationCallTxnFields.ApplicationArgs != nil && cap((*z).ApplicationCallTxnFields.ApplicationArgs) >= zb0010 {
-				(*z).ApplicationCallTxnFields.ApplicationArgs = ((*z).ApplicationCallTxnFields.ApplicationArgs)[:zb0010]
+			} else if (*z).ApplicationCallTxnFields.ApplicationArgs != nil && cap((*z).ApplicationCallTxnFields.ApplicationArgs) >= zb0011 {
+				(*z).ApplicationCallTxnFields.ApplicationArgs = ((*z).ApplicationCallTxnFields.ApplicationArgs)[:zb0011]
 			} else {
-				(*z).ApplicationCallTxnFields.ApplicationArgs = make([][]byte, zb0010)
+				(*z).ApplicationCallTxnFields.ApplicationArgs = make([][]byte, zb0011)
 			}
 			for zb0002 := range (*z).ApplicationCallTxnFields.ApplicationArgs {
 				(*z).ApplicationCallTxnFields.ApplicationArgs[zb0002], bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.ApplicationArgs[zb0002])
@@ -4926,26 +5387,26 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
-			var zb0012 int
-			var zb0013 bool
-			zb0012, zb0013, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0007 > 0 {
+			zb0007--
+			var zb0013 int
+			var zb0014 bool
+			zb0013, zb0014, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Accounts")
 				return
 			}
-			if zb0012 > encodedMaxAccounts {
-				err = msgp.ErrOverflow(uint64(zb0012), uint64(encodedMaxAccounts))
+			if zb0013 > encodedMaxAccounts {
+				err = msgp.ErrOverflow(uint64(zb0013), uint64(encodedMaxAccounts))
 				err = msgp.WrapError(err, "struct-from-array", "Accounts")
 				return
 			}
-			if zb0013 {
+			if zb0014 {
 				(*z).ApplicationCallTxnFields.Accounts = nil
-			} else if (*z).ApplicationCallTxnFields.Accounts != nil && cap((*z).ApplicationCallTxnFields.Accounts) >= zb0012 {
-				(*z).ApplicationCallTxnFields.Accounts = ((*z).ApplicationCallTxnFields.Accounts)[:zb0012]
+			} else if (*z).ApplicationCallTxnFields.Accounts != nil && cap((*z).ApplicationCallTxnFields.Accounts) >= zb0013 {
+				(*z).ApplicationCallTxnFields.Accounts = ((*z).ApplicationCallTxnFields.Accounts)[:zb0013]
 			} else {
-				(*z).ApplicationCallTxnFields.Accounts = make([]basics.Address, zb0012)
+				(*z).ApplicationCallTxnFields.Accounts = make([]basics.Address, zb0013)
 			}
 			for zb0003 := range (*z).ApplicationCallTxnFields.Accounts {
 				bts, err = (*z).ApplicationCallTxnFields.Accounts[zb0003].UnmarshalMsg(bts)
@@ -4955,26 +5416,26 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
-			var zb0014 int
-			var zb0015 bool
-			zb0014, zb0015, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0007 > 0 {
+			zb0007--
+			var zb0015 int
+			var zb0016 bool
+			zb0015, zb0016, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ForeignApps")
 				return
 			}
-			if zb0014 > encodedMaxForeignApps {
-				err = msgp.ErrOverflow(uint64(zb0014), uint64(encodedMaxForeignApps))
+			if zb0015 > encodedMaxForeignApps {
+				err = msgp.ErrOverflow(uint64(zb0015), uint64(encodedMaxForeignApps))
 				err = msgp.WrapError(err, "struct-from-array", "ForeignApps")
 				return
 			}
-			if zb0015 {
+			if zb0016 {
 				(*z).ApplicationCallTxnFields.ForeignApps = nil
-			} else if (*z).ApplicationCallTxnFields.ForeignApps != nil && cap((*z).ApplicationCallTxnFields.ForeignApps) >= zb0014 {
-				(*z).ApplicationCallTxnFields.ForeignApps = ((*z).ApplicationCallTxnFields.ForeignApps)[:zb0014]
+			} else if (*z).ApplicationCallTxnFields.ForeignApps != nil && cap((*z).ApplicationCallTxnFields.ForeignApps) >= zb0015 {
+				(*z).ApplicationCallTxnFields.ForeignApps = ((*z).ApplicationCallTxnFields.ForeignApps)[:zb0015]
 			} else {
-				(*z).ApplicationCallTxnFields.ForeignApps = make([]basics.AppIndex, zb0014)
+				(*z).ApplicationCallTxnFields.ForeignApps = make([]basics.AppIndex, zb0015)
 			}
 			for zb0004 := range (*z).ApplicationCallTxnFields.ForeignApps {
 				bts, err = (*z).ApplicationCallTxnFields.ForeignApps[zb0004].UnmarshalMsg(bts)
@@ -4984,26 +5445,26 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
-			var zb0016 int
-			var zb0017 bool
-			zb0016, zb0017, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0007 > 0 {
+			zb0007--
+			var zb0017 int
+			var zb0018 bool
+			zb0017, zb0018, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ForeignAssets")
 				return
 			}
-			if zb0016 > encodedMaxForeignAssets {
-				err = msgp.ErrOverflow(uint64(zb0016), uint64(encodedMaxForeignAssets))
+			if zb0017 > encodedMaxForeignAssets {
+				err = msgp.ErrOverflow(uint64(zb0017), uint64(encodedMaxForeignAssets))
 				err = msgp.WrapError(err, "struct-from-array", "ForeignAssets")
 				return
 			}
-			if zb0017 {
+			if zb0018 {
 				(*z).ApplicationCallTxnFields.ForeignAssets = nil
-			} else if (*z).ApplicationCallTxnFields.ForeignAssets != nil && cap((*z).ApplicationCallTxnFields.ForeignAssets) >= zb0016 {
-				(*z).ApplicationCallTxnFields.ForeignAssets = ((*z).ApplicationCallTxnFields.ForeignAssets)[:zb0016]
+			} else if (*z).ApplicationCallTxnFields.ForeignAssets != nil && cap((*z).ApplicationCallTxnFields.ForeignAssets) >= zb0017 {
+				(*z).ApplicationCallTxnFields.ForeignAssets = ((*z).ApplicationCallTxnFields.ForeignAssets)[:zb0017]
 			} else {
-				(*z).ApplicationCallTxnFields.ForeignAssets = make([]basics.AssetIndex, zb0016)
+				(*z).ApplicationCallTxnFields.ForeignAssets = make([]basics.AssetIndex, zb0017)
 			}
 			for zb0005 := range (*z).ApplicationCallTxnFields.ForeignAssets {
 				bts, err = (*z).ApplicationCallTxnFields.ForeignAssets[zb0005].UnmarshalMsg(bts)
@@ -5013,32 +5474,145 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
+			var zb0019 int
+			var zb0020 bool
+			zb0019, zb0020, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0019 > encodedMaxBoxes {
+				err = msgp.ErrOverflow(uint64(zb0019), uint64(encodedMaxBoxes))
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0020 {
+				(*z).ApplicationCallTxnFields.Boxes = nil
+			} else if (*z).ApplicationCallTxnFields.Boxes != nil && cap((*z).ApplicationCallTxnFields.Boxes) >= zb0019 {
+				(*z).ApplicationCallTxnFields.Boxes = ((*z).ApplicationCallTxnFields.Boxes)[:zb0019]
+			} else {
+				(*z).ApplicationCallTxnFields.Boxes = make([]BoxRef, zb0019)
+			}
+			for zb0006 := range (*z).ApplicationCallTxnFields.Boxes {
+				var zb0021 int
+				var zb0022 bool
+				zb0021, zb0022, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if _, ok := err.(msgp.TypeError); ok {
+					zb0021, zb0022, bts, err = msgp.ReadArrayHeaderBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006)
+						return
+					}
+					if zb0021 > 0 {
+						zb0021--
+						(*z).ApplicationCallTxnFields.Boxes[zb0006].Index, bts, err = msgp.ReadUint64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006, "struct-from-array", "Index")
+							return
+						}
+					}
+					if zb0021 > 0 {
+						zb0021--
+						var zb0023 int
+						zb0023, err = msgp.ReadBytesBytesHeader(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006, "struct-from-array", "Name")
+							return
+						}
+						if zb0023 > config.MaxBytesKeyValueLen {
+							err = msgp.ErrOverflow(uint64(zb0023), uint64(config.MaxBytesKeyValueLen))
+							return
+						}
+						(*z).ApplicationCallTxnFields.Boxes[zb0006].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.Boxes[zb0006].Name)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006, "struct-from-array", "Name")
+							return
+						}
+					}
+					if zb0021 > 0 {
+						err = msgp.ErrTooManyArrayFields(zb0021)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006, "struct-from-array")
+							return
+						}
+					}
+				} else {
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006)
+						return
+					}
+					if zb0022 {
+						(*z).ApplicationCallTxnFields.Boxes[zb0006] = BoxRef{}
+					}
+					for zb0021 > 0 {
+						zb0021--
+						field, bts, err = msgp.ReadMapKeyZC(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006)
+							return
+						}
+						switch string(field) {
+						case "i":
+							(*z).ApplicationCallTxnFields.Boxes[zb0006].Index, bts, err = msgp.ReadUint64Bytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006, "Index")
+								return
+							}
+						case "n":
+							var zb0024 int
+							zb0024, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006, "Name")
+								return
+							}
+							if zb0024 > config.MaxBytesKeyValueLen {
+								err = msgp.ErrOverflow(uint64(zb0024), uint64(config.MaxBytesKeyValueLen))
+								return
+							}
+							(*z).ApplicationCallTxnFields.Boxes[zb0006].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.Boxes[zb0006].Name)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006, "Name")
+								return
+							}
+						default:
+							err = msgp.ErrNoField(string(field))
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0006)
+								return
+							}
+						}
+					}
+				}
+			}
+		}
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).ApplicationCallTxnFields.LocalStateSchema.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "LocalStateSchema")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).ApplicationCallTxnFields.GlobalStateSchema.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "GlobalStateSchema")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
-			var zb0018 int
-			zb0018, err = msgp.ReadBytesBytesHeader(bts)
+		if zb0007 > 0 {
+			zb0007--
+			var zb0025 int
+			zb0025, err = msgp.ReadBytesBytesHeader(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ApprovalProgram")
 				return
 			}
-			if zb0018 > config.MaxAvailableAppProgramLen {
-				err = msgp.ErrOverflow(uint64(zb0018), uint64(config.MaxAvailableAppProgramLen))
+			if zb0025 > config.MaxAvailableAppProgramLen {
+				err = msgp.ErrOverflow(uint64(zb0025), uint64(config.MaxAvailableAppProgramLen))
 				return
 			}
 			(*z).ApplicationCallTxnFields.ApprovalProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.ApprovalProgram)
@@ -5047,16 +5621,16 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
-			var zb0019 int
-			zb0019, err = msgp.ReadBytesBytesHeader(bts)
+		if zb0007 > 0 {
+			zb0007--
+			var zb0026 int
+			zb0026, err = msgp.ReadBytesBytesHeader(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ClearStateProgram")
 				return
 			}
-			if zb0019 > config.MaxAvailableAppProgramLen {
-				err = msgp.ErrOverflow(uint64(zb0019), uint64(config.MaxAvailableAppProgramLen))
+			if zb0026 > config.MaxAvailableAppProgramLen {
+				err = msgp.ErrOverflow(uint64(zb0026), uint64(config.MaxAvailableAppProgramLen))
 				return
 			}
 			(*z).ApplicationCallTxnFields.ClearStateProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.ClearStateProgram)
@@ -5065,40 +5639,40 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			(*z).ApplicationCallTxnFields.ExtraProgramPages, bts, err = msgp.ReadUint32Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "ExtraProgramPages")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).CompactCertTxnFields.CertRound.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "CertRound")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).CompactCertTxnFields.CertType.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "CertType")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			zb0006--
+		if zb0007 > 0 {
+			zb0007--
 			bts, err = (*z).CompactCertTxnFields.Cert.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Cert")
 				return
 			}
 		}
-		if zb0006 > 0 {
-			err = msgp.ErrTooManyArrayFields(zb0006)
+		if zb0007 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0007)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array")
 				return
@@ -5109,11 +5683,11 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0007 {
+		if zb0008 {
 			(*z) = Transaction{}
 		}
-		for zb0006 > 0 {
-			zb0006--
+		for zb0007 > 0 {
+			zb0007--
 			field, bts, err = msgp.ReadMapKeyZC(bts)
 			if err != nil {
 				err = msgp.WrapError(err)
@@ -5151,14 +5725,14 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			case "note":
-				var zb0020 int
-				zb0020, err = msgp.ReadBytesBytesHeader(bts)
+				var zb0027 int
+				zb0027, err = msgp.ReadBytesBytesHeader(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Note")
 					return
 				}
-				if zb0020 > config.MaxTxnNoteBytes {
-					err = msgp.ErrOverflow(uint64(zb0020), uint64(config.MaxTxnNoteBytes))
+				if zb0027 > config.MaxTxnNoteBytes {
+					err = msgp.ErrOverflow(uint64(zb0027), uint64(config.MaxTxnNoteBytes))
 					return
 				}
 				(*z).Header.Note, bts, err = msgp.ReadBytesBytes(bts, (*z).Header.Note)
@@ -5318,33 +5892,33 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			case "apan":
 				{
-					var zb0021 uint64
-					zb0021, bts, err = msgp.ReadUint64Bytes(bts)
+					var zb0028 uint64
+					zb0028, bts, err = msgp.ReadUint64Bytes(bts)
 					if err != nil {
 						err = msgp.WrapError(err, "OnCompletion")
 						return
 					}
-					(*z).ApplicationCallTxnFields.OnCompletion = OnCompletion(zb0021)
+					(*z).ApplicationCallTxnFields.OnCompletion = OnCompletion(zb0028)
 				}
 			case "apaa":
-				var zb0022 int
-				var zb0023 bool
-				zb0022, zb0023, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0029 int
+				var zb0030 bool
+				zb0029, zb0030, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ApplicationArgs")
 					return
 				}
-				if zb0022 > encodedMaxApplicationArgs {
-					err = msgp.ErrOverflow(uint64(zb0022), uint64(encodedMaxApplicationArgs))
+				if zb0029 > encodedMaxApplicationArgs {
+					err = msgp.ErrOverflow(uint64(zb0029), uint64(encodedMaxApplicationArgs))
 					err = msgp.WrapError(err, "ApplicationArgs")
 					return
 				}
-				if zb0023 {
+				if zb0030 {
 					(*z).ApplicationCallTxnFields.ApplicationArgs = nil
-				} else if (*z).ApplicationCallTxnFields.ApplicationArgs != nil && cap((*z).ApplicationCallTxnFields.ApplicationArgs) >= zb0022 {
-					(*z).ApplicationCallTxnFields.ApplicationArgs = ((*z).ApplicationCallTxnFields.ApplicationArgs)[:zb0022]
+				} else if (*z).ApplicationCallTxnFields.ApplicationArgs != nil && cap((*z).ApplicationCallTxnFields.ApplicationArgs) >= zb0029 {
+					(*z).ApplicationCallTxnFields.ApplicationArgs = ((*z).ApplicationCallTxnFields.ApplicationArgs)[:zb0029]
 				} else {
-					(*z).ApplicationCallTxnFields.ApplicationArgs = make([][]byte, zb0022)
+					(*z).ApplicationCallTxnFields.ApplicationArgs = make([][]byte, zb0029)
 				}
 				for zb0002 := range (*z).ApplicationCallTxnFields.ApplicationArgs {
 					(*z).ApplicationCallTxnFields.ApplicationArgs[zb0002], bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.ApplicationArgs[zb0002])
@@ -5354,24 +5928,24 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					}
 				}
 			case "apat":
-				var zb0024 int
-				var zb0025 bool
-				zb0024, zb0025, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0031 int
+				var zb0032 bool
+				zb0031, zb0032, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Accounts")
 					return
 				}
-				if zb0024 > encodedMaxAccounts {
-					err = msgp.ErrOverflow(uint64(zb0024), uint64(encodedMaxAccounts))
+				if zb0031 > encodedMaxAccounts {
+					err = msgp.ErrOverflow(uint64(zb0031), uint64(encodedMaxAccounts))
 					err = msgp.WrapError(err, "Accounts")
 					return
 				}
-				if zb0025 {
+				if zb0032 {
 					(*z).ApplicationCallTxnFields.Accounts = nil
-				} else if (*z).ApplicationCallTxnFields.Accounts != nil && cap((*z).ApplicationCallTxnFields.Accounts) >= zb0024 {
-					(*z).ApplicationCallTxnFields.Accounts = ((*z).ApplicationCallTxnFields.Accounts)[:zb0024]
+				} else if (*z).ApplicationCallTxnFields.Accounts != nil && cap((*z).ApplicationCallTxnFields.Accounts) >= zb0031 {
+					(*z).ApplicationCallTxnFields.Accounts = ((*z).ApplicationCallTxnFields.Accounts)[:zb0031]
 				} else {
-					(*z).ApplicationCallTxnFields.Accounts = make([]basics.Address, zb0024)
+					(*z).ApplicationCallTxnFields.Accounts = make([]basics.Address, zb0031)
 				}
 				for zb0003 := range (*z).ApplicationCallTxnFields.Accounts {
 					bts, err = (*z).ApplicationCallTxnFields.Accounts[zb0003].UnmarshalMsg(bts)
@@ -5381,24 +5955,24 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					}
 				}
 			case "apfa":
-				var zb0026 int
-				var zb0027 bool
-				zb0026, zb0027, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0033 int
+				var zb0034 bool
+				zb0033, zb0034, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ForeignApps")
 					return
 				}
-				if zb0026 > encodedMaxForeignApps {
-					err = msgp.ErrOverflow(uint64(zb0026), uint64(encodedMaxForeignApps))
+				if zb0033 > encodedMaxForeignApps {
+					err = msgp.ErrOverflow(uint64(zb0033), uint64(encodedMaxForeignApps))
 					err = msgp.WrapError(err, "ForeignApps")
 					return
 				}
-				if zb0027 {
+				if zb0034 {
 					(*z).ApplicationCallTxnFields.ForeignApps = nil
-				} else if (*z).ApplicationCallTxnFields.ForeignApps != nil && cap((*z).ApplicationCallTxnFields.ForeignApps) >= zb0026 {
-					(*z).ApplicationCallTxnFields.ForeignApps = ((*z).ApplicationCallTxnFields.ForeignApps)[:zb0026]
+				} else if (*z).ApplicationCallTxnFields.ForeignApps != nil && cap((*z).ApplicationCallTxnFields.ForeignApps) >= zb0033 {
+					(*z).ApplicationCallTxnFields.ForeignApps = ((*z).ApplicationCallTxnFields.ForeignApps)[:zb0033]
 				} else {
-					(*z).ApplicationCallTxnFields.ForeignApps = make([]basics.AppIndex, zb0026)
+					(*z).ApplicationCallTxnFields.ForeignApps = make([]basics.AppIndex, zb0033)
 				}
 				for zb0004 := range (*z).ApplicationCallTxnFields.ForeignApps {
 					bts, err = (*z).ApplicationCallTxnFields.ForeignApps[zb0004].UnmarshalMsg(bts)
@@ -5408,24 +5982,24 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					}
 				}
 			case "apas":
-				var zb0028 int
-				var zb0029 bool
-				zb0028, zb0029, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0035 int
+				var zb0036 bool
+				zb0035, zb0036, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ForeignAssets")
 					return
 				}
-				if zb0028 > encodedMaxForeignAssets {
-					err = msgp.ErrOverflow(uint64(zb0028), uint64(encodedMaxForeignAssets))
+				if zb0035 > encodedMaxForeignAssets {
+					err = msgp.ErrOverflow(uint64(zb0035), uint64(encodedMaxForeignAssets))
 					err = msgp.WrapError(err, "ForeignAssets")
 					return
 				}
-				if zb0029 {
+				if zb0036 {
 					(*z).ApplicationCallTxnFields.ForeignAssets = nil
-				} else if (*z).ApplicationCallTxnFields.ForeignAssets != nil && cap((*z).ApplicationCallTxnFields.ForeignAssets) >= zb0028 {
-					(*z).ApplicationCallTxnFields.ForeignAssets = ((*z).ApplicationCallTxnFields.ForeignAssets)[:zb0028]
+				} else if (*z).ApplicationCallTxnFields.ForeignAssets != nil && cap((*z).ApplicationCallTxnFields.ForeignAssets) >= zb0035 {
+					(*z).ApplicationCallTxnFields.ForeignAssets = ((*z).ApplicationCallTxnFields.ForeignAssets)[:zb0035]
 				} else {
-					(*z).ApplicationCallTxnFields.ForeignAssets = make([]basics.AssetIndex, zb0028)
+					(*z).ApplicationCallTxnFields.ForeignAssets = make([]basics.AssetIndex, zb0035)
 				}
 				for zb0005 := range (*z).ApplicationCallTxnFields.ForeignAssets {
 					bts, err = (*z).ApplicationCallTxnFields.ForeignAssets[zb0005].UnmarshalMsg(bts)
@@ -5434,6 +6008,117 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 						return
 					}
 				}
+			case "apbx":
+				var zb0037 int
+				var zb0038 bool
+				zb0037, zb0038, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0037 > encodedMaxBoxes {
+					err = msgp.ErrOverflow(uint64(zb0037), uint64(encodedMaxBoxes))
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0038 {
+					(*z).ApplicationCallTxnFields.Boxes = nil
+				} else if (*z).ApplicationCallTxnFields.Boxes != nil && cap((*z).ApplicationCallTxnFields.Boxes) >= zb0037 {
+					(*z).ApplicationCallTxnFields.Boxes = ((*z).ApplicationCallTxnFields.Boxes)[:zb0037]
+				} else {
+					(*z).ApplicationCallTxnFields.Boxes = make([]BoxRef, zb0037)
+				}
+				for zb0006 := range (*z).ApplicationCallTxnFields.Boxes {
+					var zb0039 int
+					var zb0040 bool
+					zb0039, zb0040, bts, err = msgp.ReadMapHeaderBytes(bts)
+					if _, ok := err.(msgp.TypeError); ok {
+						zb0039, zb0040, bts, err = msgp.ReadArrayHeaderBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Boxes", zb0006)
+							return
+						}
+						if zb0039 > 0 {
+							zb0039--
+							(*z).ApplicationCallTxnFields.Boxes[zb0006].Index, bts, err = msgp.ReadUint64Bytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0006, "struct-from-array", "Index")
+								return
+							}
+						}
+						if zb0039 > 0 {
+							zb0039--
+							var zb0041 int
+							zb0041, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0006, "struct-from-array", "Name")
+								return
+							}
+							if zb0041 > config.MaxBytesKeyValueLen {
+								err = msgp.ErrOverflow(uint64(zb0041), uint64(config.MaxBytesKeyValueLen))
+								return
+							}
+							(*z).ApplicationCallTxnFields.Boxes[zb0006].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.Boxes[zb0006].Name)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0006, "struct-from-array", "Name")
+								return
+							}
+						}
+						if zb0039 > 0 {
+							err = msgp.ErrTooManyArrayFields(zb0039)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0006, "struct-from-array")
+								return
+							}
+						}
+					} else {
+						if err != nil {
+							err = msgp.WrapError(err, "Boxes", zb0006)
+							return
+						}
+						if zb0040 {
+							(*z).ApplicationCallTxnFields.Boxes[zb0006] = BoxRef{}
+						}
+						for zb0039 > 0 {
+							zb0039--
+							field, bts, err = msgp.ReadMapKeyZC(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Boxes", zb0006)
+								return
+							}
+							switch string(field) {
+							case "i":
+								(*z).ApplicationCallTxnFields.Boxes[zb0006].Index, bts, err = msgp.ReadUint64Bytes(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0006, "Index")
+									return
+								}
+							case "n":
+								var zb0042 int
+								zb0042, err = msgp.ReadBytesBytesHeader(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0006, "Name")
+									return
+								}
+								if zb0042 > config.MaxBytesKeyValueLen {
+									err = msgp.ErrOverflow(uint64(zb0042), uint64(config.MaxBytesKeyValueLen))
+									return
+								}
+								(*z).ApplicationCallTxnFields.Boxes[zb0006].Name, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.Boxes[zb0006].Name)
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0006, "Name")
+									return
+								}
+							default:
+								err = msgp.ErrNoField(string(field))
+								if err != nil {
+									err = msgp.WrapError(err, "Boxes", zb0006)
+									return
+								}
+							}
+						}
+					}
+				}
 			case "apls":
 				bts, err = (*z).ApplicationCallTxnFields.LocalStateSchema.UnmarshalMsg(bts)
 				if err != nil {
@@ -5447,14 +6132,14 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			case "apap":
-				var zb0030 int
-				zb0030, err = msgp.ReadBytesBytesHeader(bts)
+				var zb0043 int
+				zb0043, err = msgp.ReadBytesBytesHeader(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ApprovalProgram")
 					return
 				}
-				if zb0030 > config.MaxAvailableAppProgramLen {
-					err = msgp.ErrOverflow(uint64(zb0030), uint64(config.MaxAvailableAppProgramLen))
+				if zb0043 > config.MaxAvailableAppProgramLen {
+					err = msgp.ErrOverflow(uint64(zb0043), uint64(config.MaxAvailableAppProgramLen))
 					return
 				}
 				(*z).ApplicationCallTxnFields.ApprovalProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.ApprovalProgram)
@@ -5463,14 +6148,14 @@ func (z *Transaction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			case "apsu":
-				var zb0031 int
-				zb0031, err = msgp.ReadBytesBytesHeader(bts)
+				var zb0044 int
+				zb0044, err = msgp.ReadBytesBytesHeader(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "ClearStateProgram")
 					return
 				}
-				if zb0031 > config.MaxAvailableAppProgramLen {
-					err = msgp.ErrOverflow(uint64(zb0031), uint64(config.MaxAvailableAppProgramLen))
+				if zb0044 > config.MaxAvailableAppProgramLen {
+					err = msgp.ErrOverflow(uint64(zb0044), uint64(config.MaxAvailableAppProgramLen))
 					return
 				}
 				(*z).ApplicationCallTxnFields.ClearStateProgram, bts, err = msgp.ReadBytesBytes(bts, (*z).ApplicationCallTxnFields.ClearStateProgram)
@@ -5538,13 +6223,17 @@ func (z *Transaction) Msgsize() (s int) {
 	for zb0005 := range (*z).ApplicationCallTxnFields.ForeignAssets {
 		s += (*z).ApplicationCallTxnFields.ForeignAssets[zb0005].Msgsize()
 	}
+	s += 5 + msgp.ArrayHeaderSize
+	for zb0006 := range (*z).ApplicationCallTxnFields.Boxes {
+		s += 1 + 2 + msgp.Uint64Size + 2 + msgp.BytesPrefixSize + len((*z).ApplicationCallTxnFields.Boxes[zb0006].Name)
+	}
 	s += 5 + (*z).ApplicationCallTxnFields.LocalStateSchema.Msgsize() + 5 + (*z).ApplicationCallTxnFields.GlobalStateSchema.Msgsize() + 5 + msgp.BytesPrefixSize + len((*z).ApplicationCallTxnFields.ApprovalProgram) + 5 + msgp.BytesPrefixSize + len((*z).ApplicationCallTxnFields.ClearStateProgram) + 5 + msgp.Uint32Size + 8 + (*z).CompactCertTxnFields.CertRound.Msgsize() + 9 + (*z).CompactCertTxnFields.CertType.Msgsize() + 5 + (*z).CompactCertTxnFields.Cert.Msgsize()
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *Transaction) MsgIsZero() bool {
-	return ((*z).Type.MsgIsZero()) && ((*z).Header.Sender.MsgIsZero()) && ((*z).Header.Fee.MsgIsZero()) && ((*z).Header.FirstValid.MsgIsZero()) && ((*z).Header.LastValid.MsgIsZero()) && (len((*z).Header.Note) == 0) && ((*z).Header.GenesisID == "") && ((*z).Header.GenesisHash.MsgIsZero()) && ((*z).Header.Group.MsgIsZero()) && ((*z).Header.Lease == ([32]byte{})) && ((*z).Header.RekeyTo.MsgIsZero()) && ((*z).KeyregTxnFields.VotePK.MsgIsZero()) && ((*z).KeyregTxnFields.SelectionPK.MsgIsZero()) && ((*z).KeyregTxnFields.VoteFirst.MsgIsZero()) && ((*z).KeyregTxnFields.VoteLast.MsgIsZero()) && ((*z).KeyregTxnFields.VoteKeyDilution == 0) && ((*z).KeyregTxnFields.Nonparticipation == false) && ((*z).PaymentTxnFields.Receiver.MsgIsZero()) && ((*z).PaymentTxnFields.Amount.MsgIsZero()) && ((*z).PaymentTxnFields.CloseRemainderTo.MsgIsZero()) && ((*z).AssetConfigTxnFields.ConfigAsset.MsgIsZero()) && ((*z).AssetConfigTxnFields.AssetParams.MsgIsZero()) && ((*z).AssetTransferTxnFields.XferAsset.MsgIsZero()) && ((*z).AssetTransferTxnFields.AssetAmount == 0) && ((*z).AssetTransferTxnFields.AssetSender.MsgIsZero()) && ((*z).AssetTransferTxnFields.AssetReceiver.MsgIsZero()) && ((*z).AssetTransferTxnFields.AssetCloseTo.MsgIsZero()) && ((*z).AssetFreezeTxnFields.FreezeAccount.MsgIsZero()) && ((*z).AssetFreezeTxnFields.FreezeAsset.MsgIsZero()) && ((*z).AssetFreezeTxnFields.AssetFrozen == false) && ((*z).ApplicationCallTxnFields.ApplicationID.MsgIsZero()) && ((*z).ApplicationCallTxnFields.OnCompletion == 0) && (len((*z).ApplicationCallTxnFields.ApplicationArgs) == 0) && (len((*z).ApplicationCallTxnFields.Accounts) == 0) && (len((*z).ApplicationCallTxnFields.ForeignApps) == 0) && (len((*z).ApplicationCallTxnFields.ForeignAssets) == 0) && ((*z).ApplicationCallTxnFields.LocalStateSchema.MsgIsZero()) && ((*z).ApplicationCallTxnFields.GlobalStateSchema.MsgIsZero()) && (len((*z).ApplicationCallTxnFields.ApprovalProgram) == 0) && (len((*z).ApplicationCallTxnFields.ClearStateProgram) == 0) && ((*z).ApplicationCallTxnFields.ExtraProgramPages == 0) && ((*z).CompactCertTxnFields.CertRound.MsgIsZero()) && ((*z).CompactCertTxnFields.CertType.MsgIsZero()) && ((*z).CompactCertTxnFields.Cert.MsgIsZero())
+	return ((*z).Type.MsgIsZero()) && ((*z).Header.Sender.MsgIsZero()) && ((*z).Header.Fee.MsgIsZero()) && ((*z).Header.FirstValid.MsgIsZero()) && ((*z).Header.LastValid.MsgIsZero()) && (len((*z).Header.Note) == 0) && ((*z).Header.GenesisID == "") && ((*z).Header.GenesisHash.MsgIsZero()) && ((*z).Header.Group.MsgIsZero()) && ((*z).Header.Lease == ([32]byte{})) && ((*z).Header.RekeyTo.MsgIsZero()) && ((*z).KeyregTxnFields.VotePK.MsgIsZero()) && ((*z).KeyregTxnFields.SelectionPK.MsgIsZero()) && ((*z).KeyregTxnFields.VoteFirst.MsgIsZero()) && ((*z).KeyregTxnFields.VoteLast.MsgIsZero()) && ((*z).KeyregTxnFields.VoteKeyDilution == 0) && ((*z).KeyregTxnFields.Nonparticipation == false) && ((*z).PaymentTxnFields.Receiver.MsgIsZero()) && ((*z).PaymentTxnFields.Amount.MsgIsZero()) && ((*z).PaymentTxnFields.CloseRemainderTo.MsgIsZero()) && ((*z).AssetConfigTxnFields.ConfigAsset.MsgIsZero()) && ((*z).AssetConfigTxnFields.AssetParams.MsgIsZero()) && ((*z).AssetTransferTxnFields.XferAsset.MsgIsZero()) && ((*z).AssetTransferTxnFields.AssetAmount == 0) && ((*z).AssetTransferTxnFields.AssetSender.MsgIsZero()) && ((*z).AssetTransferTxnFields.AssetReceiver.MsgIsZero()) && ((*z).AssetTransferTxnFields.AssetCloseTo.MsgIsZero()) && ((*z).AssetFreezeTxnFields.FreezeAccount.MsgIsZero()) && ((*z).AssetFreezeTxnFields.FreezeAsset.MsgIsZero()) && ((*z).AssetFreezeTxnFields.AssetFrozen == false) && ((*z).ApplicationCallTxnFields.ApplicationID.MsgIsZero()) && ((*z).ApplicationCallTxnFields.OnCompletion == 0) && (len((*z).ApplicationCallTxnFields.ApplicationArgs) == 0) && (len((*z).ApplicationCallTxnFields.Accounts) == 0) && (len((*z).ApplicationCallTxnFields.ForeignApps) == 0) && (len((*z).ApplicationCallTxnFields.ForeignAssets) == 0) && (len((*z).ApplicationCallTxnFields.Boxes) == 0) && ((*z).ApplicationCallTxnFields.LocalStateSchema.MsgIsZero()) && ((*z).ApplicationCallTxnFields.GlobalStateSchema.MsgIsZero()) && (len((*z).ApplicationCallTxnFields.ApprovalProgram) == 0) && (len((*z).ApplicationCallTxnFields.ClearStateProgram) == 0) && ((*z).ApplicationCallTxnFields.ExtraProgramPages == 0) && ((*z).CompactCertTxnFields.CertRound.MsgIsZero()) && ((*z).CompactCertTxnFields.CertType.MsgIsZero()) && ((*z).CompactCertTxnFields.Cert.MsgIsZero())
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -403,8 +403,26 @@ func (tx Transaction) WellFormed(spec SpecialAddresses, proto config.ConsensusPa
 			return fmt.Errorf("tx.ForeignAssets too long, max number of foreign assets is %d", proto.MaxAppTxnForeignAssets)
 		}
 
+		if len(tx.Boxes) > 0 && !proto.EnableBoxes {
+			return fmt.Errorf("tx.Boxes not supported")
+		}
+
+		if len(tx.Boxes) > proto.MaxAppBoxReferences {
+			return fmt.Errorf("tx.Boxes too long, max number of box references is %d", proto.MaxAppBoxReferences)
+		}
+
+		for i, br := range tx.Boxes {
+			// Index 0 is the app being called, so ForeignApps are 1-based
+			if br.Index > uint64(len(tx.ForeignApps)) {
+				return fmt.Errorf("tx.Boxes[%d].Index is %d. Exceeds len(tx.ForeignApps)", i, br.Index)
+			}
+			if len(br.Name) > proto.MaxAppKeyLen {
+				return fmt.Errorf("tx.Boxes[%d].Name too long, max len %d bytes", i, proto.MaxAppKeyLen)
+			}
+		}
+
 		// Limit the sum of all types of references that bring in account records
-		if len(tx.Accounts)+len(tx.ForeignApps)+len(tx.ForeignAssets) > proto.MaxAppTotalTxnReferences {
+		if len(tx.Accounts)+len(tx.ForeignApps)+len(tx.ForeignAssets)+len(tx.Boxes) > proto.MaxAppTotalTxnReferences {
 			return fmt.Errorf("tx has too many references, max is %d", proto.MaxAppTotalTxnReferences)
 		}
 
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package verify
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// CompactCertResult describes what a valid compact certificate proves.
+type CompactCertResult struct {
+	// VotersRound is the round of the header committing to the voters
+	// that signed the certificate.
+	VotersRound basics.Round
+
+	// CertRound is the round of the header signed by the certificate.
+	CertRound basics.Round
+
+	// FirstAttested and LastAttested are the rounds of the headers
+	// attested to by the certificate, through the hash chain ending
+	// in the signed header.
+	FirstAttested basics.Round
+	LastAttested  basics.Round
+
+	// SignedWeight is the weight of the signatures in the certificate,
+	// which is more than the ProvenWeight required by the protocol out
+	// of the TotalWeight of the voters.
+	SignedWeight uint64
+	ProvenWeight uint64
+	TotalWeight  uint64
+}
+
+// CompactCertParams computes the parameters for building or verifying
+// a compact cert for block hdr, using voters from block votersHdr.
+func CompactCertParams(votersHdr bookkeeping.BlockHeader, hdr bookkeeping.BlockHeader) (res compactcert.Params, err error) {
+	proto := config.Consensus[votersHdr.CurrentProtocol]
+
+	if proto.CompactCertRounds == 0 {
+		err = fmt.Errorf("compact certs not enabled")
+		return
+	}
+
+	if votersHdr.Round%basics.Round(proto.CompactCertRounds) != 0 {
+		err = fmt.Errorf("votersHdr %d not a multiple of %d",
+			votersHdr.Round, proto.CompactCertRounds)
+		return
+	}
+
+	if hdr.Round != votersHdr.Round+basics.Round(proto.CompactCertRounds) {
+		err = fmt.Errorf("certifying block %d not %d ahead of voters %d",
+			hdr.Round, proto.CompactCertRounds, votersHdr.Round)
+		return
+	}
+
+	totalWeight := votersHdr.CompactCert[protocol.CompactCertBasic].CompactCertVotersTotal.ToUint64()
+	provenWeight, overflowed := basics.Muldiv(totalWeight, uint64(proto.CompactCertWeightThreshold), 1<<32)
+	if overflowed {
+		err = fmt.Errorf("overflow computing provenWeight[%d]: %d * %d / (1<<32)",
+			hdr.Round, totalWeight, proto.CompactCertWeightThreshold)
+		return
+	}
+
+	res = compactcert.Params{
+		Msg:          hdr,
+		ProvenWeight: provenWeight,
+		SigRound:     hdr.Round + 1,
+		SecKQ:        proto.CompactCertSecKQ,
+
+		EnableBatchVerification: proto.EnableBatchVerification,
+	}
+	return
+}
+
+// CompactCert checks that txn carries a valid compact certificate for the
+// last of headers, signed by the voters committed to in the first of headers.
+// The headers must be consecutive, each one linked to the previous one by its
+// branch, so that the certificate attests to all of them but the first, whose
+// voters commitment the caller trusts (e.g. from the previous certificate).
+//
+// Unlike the ledger, CompactCert does not check that the signed weight was
+// acceptable at the round the transaction was confirmed in; any weight above
+// the proven weight of the protocol makes for a valid certificate.
+func CompactCert(headers []bookkeeping.BlockHeader, txn transactions.Transaction) (res CompactCertResult, err error) {
+	if txn.Type != protocol.CompactCertTx {
+		err = fmt.Errorf("transaction type %s is not %s", txn.Type, protocol.CompactCertTx)
+		return
+	}
+	if txn.CertType != protocol.CompactCertBasic {
+		err = fmt.Errorf("compact cert type %d not supported", txn.CertType)
+		return
+	}
+	if len(headers) < 2 {
+		err = fmt.Errorf("need the voters and certified headers, got %d headers", len(headers))
+		return
+	}
+
+	votersHdr := headers[0]
+	certHdr := headers[len(headers)-1]
+	if txn.CertRound != certHdr.Round {
+		err = fmt.Errorf("cert is for round %d, but the last header is for round %d", txn.CertRound, certHdr.Round)
+		return
+	}
+
+	for i := 1; i < len(headers); i++ {
+		if headers[i].Round != headers[i-1].Round+1 {
+			err = fmt.Errorf("header for round %d follows header for round %d", headers[i].Round, headers[i-1].Round)
+			return
+		}
+		if headers[i].Branch != headers[i-1].Hash() {
+			err = fmt.Errorf("header for round %d does not follow the previous header: branch %v != %v",
+				headers[i].Round, headers[i].Branch, headers[i-1].Hash())
+			return
+		}
+	}
+
+	params, err := CompactCertParams(votersHdr, certHdr)
+	if err != nil {
+		return
+	}
+
+	voters := votersHdr.CompactCert[protocol.CompactCertBasic]
+	verifier := compactcert.MkVerifier(params, voters.CompactCertVoters)
+	err = verifier.Verify(&txn.Cert)
+	if err != nil {
+		return
+	}
+
+	res = CompactCertResult{
+		VotersRound:   votersHdr.Round,
+		CertRound:     certHdr.Round,
+		FirstAttested: votersHdr.Round + 1,
+		LastAttested:  certHdr.Round,
+		SignedWeight:  txn.Cert.SignedWeight,
+		ProvenWeight:  params.ProvenWeight,
+		TotalWeight:   voters.CompactCertVotersTotal.ToUint64(),
+	}
+	return
+}
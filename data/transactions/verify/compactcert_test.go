@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package verify
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type participants []basics.Participant
+
+func (p participants) Length() uint64 {
+	return uint64(len(p))
+}
+
+func (p participants) GetHash(pos uint64) (crypto.Digest, error) {
+	if pos >= uint64(len(p)) {
+		return crypto.Digest{}, fmt.Errorf("pos %d >= len %d", pos, len(p))
+	}
+	return crypto.HashObj(p[pos]), nil
+}
+
+// makeCompactCertChain returns the headers from a voters round to the round
+// it certifies, and a compact cert transaction signed by all the voters.
+func makeCompactCertChain(t *testing.T) ([]bookkeeping.BlockHeader, transactions.Transaction) {
+	proto := config.Consensus[protocol.ConsensusFuture]
+	key := crypto.GenerateOneTimeSignatureSecrets(0, 1)
+	parts := make(participants, 10)
+	for i := range parts {
+		parts[i] = basics.Participant{
+			PK:          key.OneTimeSignatureVerifier,
+			Weight:      1000000,
+			KeyDilution: 10000,
+		}
+	}
+	partcom, err := merklearray.Build(parts)
+	require.NoError(t, err)
+
+	votersRound := basics.Round(proto.CompactCertRounds)
+	headers := make([]bookkeeping.BlockHeader, proto.CompactCertRounds+1)
+	headers[0].CompactCert = map[protocol.CompactCertType]bookkeeping.CompactCertState{
+		protocol.CompactCertBasic: {
+			CompactCertVoters:      partcom.Root(),
+			CompactCertVotersTotal: basics.MicroAlgos{Raw: 10000000},
+		},
+	}
+	for i := range headers {
+		headers[i].Round = votersRound + basics.Round(i)
+		headers[i].CurrentProtocol = protocol.ConsensusFuture
+		if i > 0 {
+			headers[i].Branch = headers[i-1].Hash()
+		}
+	}
+
+	params, err := CompactCertParams(headers[0], headers[len(headers)-1])
+	require.NoError(t, err)
+	builder, err := compactcert.MkBuilder(params, parts, partcom)
+	require.NoError(t, err)
+	sig := key.Sign(basics.OneTimeIDForRound(params.SigRound, parts[0].KeyDilution), params.Msg)
+	for i := range parts {
+		require.NoError(t, builder.Add(uint64(i), sig, true))
+	}
+	cert, err := builder.Build()
+	require.NoError(t, err)
+
+	var txn transactions.Transaction
+	txn.Type = protocol.CompactCertTx
+	txn.Sender = transactions.CompactCertSender
+	txn.CertRound = headers[len(headers)-1].Round
+	txn.CertType = protocol.CompactCertBasic
+	txn.Cert = *cert
+	return headers, txn
+}
+
+func TestCompactCert(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	headers, txn := makeCompactCertChain(t)
+	res, err := CompactCert(headers, txn)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(128), res.VotersRound)
+	require.Equal(t, basics.Round(256), res.CertRound)
+	require.Equal(t, basics.Round(129), res.FirstAttested)
+	require.Equal(t, basics.Round(256), res.LastAttested)
+	require.Equal(t, uint64(10000000), res.SignedWeight)
+	// the threshold of 30% rounds down
+	require.Equal(t, uint64(2999999), res.ProvenWeight)
+	require.Equal(t, uint64(10000000), res.TotalWeight)
+
+	// the certificate survives both encodings of the REST API
+	var decoded transactions.Transaction
+	require.NoError(t, protocol.Decode(protocol.Encode(&txn), &decoded))
+	_, err = CompactCert(headers, decoded)
+	require.NoError(t, err)
+	decoded = transactions.Transaction{}
+	require.NoError(t, protocol.DecodeJSON(protocol.EncodeJSON(&txn), &decoded))
+	_, err = CompactCert(headers, decoded)
+	require.NoError(t, err)
+
+	_, err = CompactCert(headers[1:], txn)
+	require.Error(t, err)
+
+	_, err = CompactCert(headers[len(headers)-1:], txn)
+	require.Error(t, err)
+
+	// every header must follow the previous one
+	broken := append([]bookkeeping.BlockHeader(nil), headers...)
+	broken[50].TimeStamp++
+	_, err = CompactCert(broken, txn)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not follow")
+
+	// the voters commitment must match the certificate
+	forged := append([]bookkeeping.BlockHeader(nil), headers...)
+	forged[0].CompactCert = map[protocol.CompactCertType]bookkeeping.CompactCertState{
+		protocol.CompactCertBasic: {
+			CompactCertVotersTotal: basics.MicroAlgos{Raw: 10000000},
+		},
+	}
+	for i := 1; i < len(forged); i++ {
+		forged[i].Branch = forged[i-1].Hash()
+	}
+	_, err = CompactCert(forged, txn)
+	require.Error(t, err)
+
+	wrongRound := txn
+	wrongRound.CertRound = 384
+	_, err = CompactCert(headers, wrongRound)
+	require.Error(t, err)
+
+	notCert := txn
+	notCert.Type = protocol.PaymentTx
+	_, err = CompactCert(headers, notCert)
+	require.Error(t, err)
+}
@@ -91,6 +91,7 @@ type Txn struct {
 	Accounts          []basics.Address
 	ForeignApps       []basics.AppIndex
 	ForeignAssets     []basics.AssetIndex
+	Boxes             []transactions.BoxRef
 	LocalStateSchema  basics.StateSchema
 	GlobalStateSchema basics.StateSchema
 	ApprovalProgram   string
@@ -208,6 +209,7 @@ func (tx Txn) Txn() transactions.Transaction {
 			Accounts:          tx.Accounts,
 			ForeignApps:       tx.ForeignApps,
 			ForeignAssets:     tx.ForeignAssets,
+			Boxes:             tx.Boxes,
 			LocalStateSchema:  tx.LocalStateSchema,
 			GlobalStateSchema: tx.GlobalStateSchema,
 			ApprovalProgram:   assemble(tx.ApprovalProgram),
@@ -244,7 +244,7 @@ func generateGenesisFiles(outDir string, protoVersion protocol.ConsensusVersion,
 						return
 					}
 
-					part, err = account.FillDBWithParticipationKeys(partDB, root.Address(), basics.Round(firstWalletValid), basics.Round(lastWalletValid), partKeyDilution)
+					part, err = account.FillDBWithParticipationKeys(partDB, root.Address(), basics.Round(firstWalletValid), basics.Round(lastWalletValid), partKeyDilution, protoParams.CompactCertRounds)
 					if err != nil {
 						err = fmt.Errorf("could not generate new participation file %s: %v", pfilename, err)
 						os.Remove(pfilename)
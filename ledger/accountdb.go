@@ -38,6 +38,8 @@ type accountsDbQueries struct {
 	listCreatablesStmt          *sql.Stmt
 	lookupStmt                  *sql.Stmt
 	lookupCreatorStmt           *sql.Stmt
+	lookupKvPairStmt            *sql.Stmt
+	lookupKeysByRangeStmt       *sql.Stmt
 	deleteStoredCatchpoint      *sql.Stmt
 	insertStoredCatchpoint      *sql.Stmt
 	selectOldestCatchpointFiles *sql.Stmt
@@ -101,6 +103,14 @@ var createOnlineAccountIndex = []string{
 	createNormalizedOnlineBalanceIndex("onlineaccountbals", "accountbase"),
 }
 
+// kvstoreSchema holds the key/value store of the ledger, in which the
+// application boxes are kept.
+var kvstoreSchema = []string{
+	`CREATE TABLE IF NOT EXISTS kvstore (
+		key blob primary key,
+		value blob)`,
+}
+
 var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS acctrounds`,
 	`DROP TABLE IF EXISTS accounttotals`,
@@ -109,12 +119,13 @@ var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS storedcatchpoints`,
 	`DROP TABLE IF EXISTS catchpointstate`,
 	`DROP TABLE IF EXISTS accounthashes`,
+	`DROP TABLE IF EXISTS kvstore`,
 }
 
 // accountDBVersion is the database version that this binary would know how to support and how to upgrade to.
 // details about the content of each of the versions can be found in the upgrade functions upgradeDatabaseSchemaXXXX
 // and their descriptions.
-var accountDBVersion = int32(5)
+var accountDBVersion = int32(6)
 
 // persistedAccountData is used for representing a single account stored on the disk. In addition to the
 // basics.AccountData, it also stores complete referencing information used to maintain the base accounts
@@ -491,6 +502,11 @@ func applyCatchpointStagingBalances(ctx context.Context, tx *sql.Tx, balancesRou
 		"DROP TABLE IF EXISTS accountbase_old",
 		"DROP TABLE IF EXISTS assetcreators_old",
 		"DROP TABLE IF EXISTS accounthashes_old",
+
+		// catchpoints do not carry the key/value store yet, which is why
+		// VerifyCatchpoint refuses catchpoints of protocols enabling boxes;
+		// drop whatever the previous round had rather than keeping it stale.
+		"DELETE FROM kvstore",
 	}
 
 	for _, stmt := range stmts {
@@ -581,6 +597,18 @@ func accountsInit(tx *sql.Tx, initAccounts map[basics.Address]basics.AccountData
 	return newDatabase, nil
 }
 
+// accountsCreateKvstore creates the kvstore table, which holds the
+// application boxes.
+func accountsCreateKvstore(tx *sql.Tx) error {
+	for _, stmt := range kvstoreSchema {
+		_, err := tx.Exec(stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // accountsAddNormalizedBalance adds the normalizedonlinebalance column
 // to the accountbase table.
 func accountsAddNormalizedBalance(tx *sql.Tx, proto config.ConsensusParams) error {
@@ -750,6 +778,16 @@ func accountsInitDbQueries(r db.Queryable, w db.Queryable) (*accountsDbQueries,
 		return nil, err
 	}
 
+	qs.lookupKvPairStmt, err = r.Prepare("SELECT acctrounds.rnd, kvstore.key IS NOT NULL, kvstore.value FROM acctrounds LEFT JOIN kvstore ON key = ? WHERE id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupKeysByRangeStmt, err = r.Prepare("SELECT acctrounds.rnd, kvstore.key FROM acctrounds LEFT JOIN kvstore ON kvstore.key >= ? AND kvstore.key < ? WHERE id='acctbase' ORDER BY kvstore.key")
+	if err != nil {
+		return nil, err
+	}
+
 	qs.deleteStoredCatchpoint, err = w.Prepare("DELETE FROM storedcatchpoints WHERE round=?")
 	if err != nil {
 		return nil, err
@@ -849,6 +887,78 @@ func (qs *accountsDbQueries) lookupCreator(cidx basics.CreatableIndex, ctype bas
 	return
 }
 
+// lookupKeyValue returns the value stored under key in the key/value store,
+// along with the database round. A nil value means that the key does not exist.
+func (qs *accountsDbQueries) lookupKeyValue(key string) (value []byte, dbRound basics.Round, err error) {
+	err = db.Retry(func() error {
+		var exists bool
+		err := qs.lookupKvPairStmt.QueryRow([]byte(key)).Scan(&dbRound, &exists, &value)
+
+		// this shouldn't happen unless we can't figure the round number.
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("lookupKeyValue was unable to retrieve round number")
+		}
+		// sqlite hands back empty blobs as nil, which stands for a missing key.
+		if err == nil && exists && value == nil {
+			value = []byte{}
+		}
+		return err
+	})
+	return
+}
+
+// lookupKeysByPrefix returns up to maxKeys keys of the key/value store that
+// start with prefix, in ascending order, along with the database round. A
+// maxKeys of zero means no limit.
+func (qs *accountsDbQueries) lookupKeysByPrefix(prefix string, maxKeys uint64) (keys []string, dbRound basics.Round, err error) {
+	start, end := keyPrefixIntervalPreprocessing([]byte(prefix))
+	err = db.Retry(func() error {
+		keys = nil
+		rows, err := qs.lookupKeysByRangeStmt.Query(start, end)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var key []byte
+		for rows.Next() {
+			err = rows.Scan(&dbRound, &key)
+			if err != nil {
+				return err
+			}
+			if key == nil {
+				// the round-only row we get when no key matches.
+				break
+			}
+			keys = append(keys, string(key))
+			if maxKeys > 0 && uint64(len(keys)) >= maxKeys {
+				break
+			}
+		}
+		return rows.Err()
+	})
+	return
+}
+
+// keyPrefixIntervalPreprocessing returns the [start, end) interval of keys
+// sharing the given prefix: end is the prefix with its last byte incremented,
+// after dropping any trailing 0xff bytes. An all-0xff prefix has no upper
+// bound, which is expressed by an end past every possible key.
+func keyPrefixIntervalPreprocessing(prefix []byte) (start []byte, end []byte) {
+	start = append([]byte{}, prefix...)
+	end = append([]byte{}, prefix...)
+	for len(end) > 0 {
+		if end[len(end)-1] != 0xff {
+			end[len(end)-1]++
+			return
+		}
+		end = end[:len(end)-1]
+	}
+	// no finite upper bound; blobs compare lexicographically, and no key
+	// exceeds MaxAppKeyLen plus the box key prefix.
+	return start, bytes.Repeat([]byte{0xff}, 1024)
+}
+
 // lookup looks up for a the account data given it's address. It returns the persistedAccountData, which includes the current database round and the matching
 // account data, if such was found. If no matching account data could be found for the given address, an empty account data would
 // be retrieved.
@@ -987,6 +1097,8 @@ func (qs *accountsDbQueries) close() {
 		&qs.listCreatablesStmt,
 		&qs.lookupStmt,
 		&qs.lookupCreatorStmt,
+		&qs.lookupKvPairStmt,
+		&qs.lookupKeysByRangeStmt,
 		&qs.deleteStoredCatchpoint,
 		&qs.insertStoredCatchpoint,
 		&qs.selectOldestCatchpointFiles,
@@ -1185,6 +1297,37 @@ func accountsNewRound(tx *sql.Tx, updates compactAccountDeltas, creatables map[b
 	return
 }
 
+// kvstoreNewRound applies the compacted key/value store deltas to the kvstore table.
+func kvstoreNewRound(tx *sql.Tx, kvs map[string]modifiedKvValue) (err error) {
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	upsertStmt, err := tx.Prepare("INSERT OR REPLACE INTO kvstore (key, value) VALUES (?, ?)")
+	if err != nil {
+		return
+	}
+	defer upsertStmt.Close()
+
+	deleteStmt, err := tx.Prepare("DELETE FROM kvstore WHERE key=?")
+	if err != nil {
+		return
+	}
+	defer deleteStmt.Close()
+
+	for key, mv := range kvs {
+		if mv.data == nil {
+			_, err = deleteStmt.Exec([]byte(key))
+		} else {
+			_, err = upsertStmt.Exec([]byte(key), mv.data)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
 // updates the round number associated with the current account data.
 func updateAccountsRound(tx *sql.Tx, rnd basics.Round) (err error) {
 	res, err := tx.Exec("UPDATE acctrounds SET rnd=? WHERE id='acctbase' AND rnd<?", rnd, rnd)
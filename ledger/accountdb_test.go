@@ -149,6 +149,8 @@ func TestAccountDBInit(t *testing.T) {
 	accts := ledgertesting.RandomAccounts(20, true)
 	newDB, err := accountsInit(tx, accts, proto)
 	require.NoError(t, err)
+	err = accountsCreateKvstore(tx)
+	require.NoError(t, err)
 	require.True(t, newDB)
 	checkAccounts(t, tx, 0, accts)
 
@@ -242,6 +244,8 @@ func TestAccountDBRound(t *testing.T) {
 	accts := ledgertesting.RandomAccounts(20, true)
 	_, err = accountsInit(tx, accts, proto)
 	require.NoError(t, err)
+	err = accountsCreateKvstore(tx)
+	require.NoError(t, err)
 	checkAccounts(t, tx, 0, accts)
 	totals, err := accountsTotals(tx, false)
 	require.NoError(t, err)
@@ -463,6 +467,8 @@ func benchmarkInitBalances(b testing.TB, numAccounts int, dbs db.Pair, proto con
 
 	_, err = accountsInit(tx, updates, proto)
 	require.NoError(b, err)
+	err = accountsCreateKvstore(tx)
+	require.NoError(b, err)
 	err = accountsAddNormalizedBalance(tx, proto)
 	require.NoError(b, err)
 	err = tx.Commit()
@@ -688,6 +694,10 @@ func TestAccountsReencoding(t *testing.T) {
 		if err != nil {
 			return err
 		}
+		err = accountsCreateKvstore(tx)
+		if err != nil {
+			return err
+		}
 
 		for _, oldAccData := range oldEncodedAccountsData {
 			addr := ledgertesting.RandomAddress()
@@ -768,6 +778,10 @@ func TestAccountsDbQueriesCreateClose(t *testing.T) {
 		if err != nil {
 			return err
 		}
+		err = accountsCreateKvstore(tx)
+		if err != nil {
+			return err
+		}
 		return nil
 	})
 	require.NoError(t, err)
@@ -780,6 +794,85 @@ func TestAccountsDbQueriesCreateClose(t *testing.T) {
 	require.Nil(t, qs.listCreatablesStmt)
 }
 
+// TestKvstoreNewRound tests that the key/value pairs written by kvstoreNewRound
+// can be read back by key and by key prefix.
+func TestKvstoreNewRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbs, _ := dbOpenTest(t, true)
+	setDbLogging(t, dbs)
+	defer dbs.Close()
+
+	err := dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		_, err = accountsInit(tx, make(map[basics.Address]basics.AccountData), config.Consensus[protocol.ConsensusCurrentVersion])
+		if err != nil {
+			return err
+		}
+		err = accountsCreateKvstore(tx)
+		if err != nil {
+			return err
+		}
+		return kvstoreNewRound(tx, map[string]modifiedKvValue{
+			"a:1":   {data: []byte("one")},
+			"a:2":   {data: []byte{}},
+			"a:3":   {data: []byte("three")},
+			"b:1":   {data: []byte("other")},
+			"a\xff": {data: []byte("last")},
+		})
+	})
+	require.NoError(t, err)
+
+	qs, err := accountsInitDbQueries(dbs.Rdb.Handle, dbs.Wdb.Handle)
+	require.NoError(t, err)
+	defer qs.close()
+
+	value, rnd, err := qs.lookupKeyValue("a:1")
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), rnd)
+	require.Equal(t, []byte("one"), value)
+
+	// an empty value is distinct from a missing key
+	value, _, err = qs.lookupKeyValue("a:2")
+	require.NoError(t, err)
+	require.NotNil(t, value)
+	require.Empty(t, value)
+
+	value, _, err = qs.lookupKeyValue("a:4")
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	keys, _, err := qs.lookupKeysByPrefix("a:", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a:1", "a:2", "a:3"}, keys)
+
+	keys, _, err = qs.lookupKeysByPrefix("a", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a:1", "a:2"}, keys)
+
+	keys, _, err = qs.lookupKeysByPrefix("c", 0)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	err = dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		return kvstoreNewRound(tx, map[string]modifiedKvValue{
+			"a:1": {data: nil},
+			"a:3": {data: []byte("THREE")},
+		})
+	})
+	require.NoError(t, err)
+
+	value, _, err = qs.lookupKeyValue("a:1")
+	require.NoError(t, err)
+	require.Nil(t, value)
+	value, _, err = qs.lookupKeyValue("a:3")
+	require.NoError(t, err)
+	require.Equal(t, []byte("THREE"), value)
+
+	keys, _, err = qs.lookupKeysByPrefix("a", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a:2", "a:3", "a\xff"}, keys)
+}
+
 func benchmarkWriteCatchpointStagingBalancesSub(b *testing.B, ascendingOrder bool) {
 	proto := config.Consensus[protocol.ConsensusCurrentVersion]
 	genesisInitState, _ := ledgertesting.GenerateInitState(b, protocol.ConsensusCurrentVersion, 100)
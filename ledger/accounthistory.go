@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrAccountHistoryDisabled is returned by historical account lookups on a
+// ledger that does not keep the account history.
+var ErrAccountHistoryDisabled = errors.New("the ledger does not keep the account history")
+
+// AccountHistoryRangeError is returned when the requested round precedes the
+// first round of the account history.
+type AccountHistoryRangeError struct {
+	Round basics.Round
+	First basics.Round
+}
+
+func (e *AccountHistoryRangeError) Error() string {
+	return fmt.Sprintf("round %d is before the first round of the account history %d", e.Round, e.First)
+}
+
+var accountHistorySchema = []string{
+	`CREATE TABLE IF NOT EXISTS accounthistory (
+		address blob,
+		rnd integer,
+		data blob,
+		PRIMARY KEY (address, rnd))`,
+}
+
+// accountHistory persists, for every round, the new state of each account
+// modified by that round. It rides on the account updates commits, so the
+// history always extends up to the accounts database round, and older
+// rounds are looked up from the database while the recent ones are still
+// served by the accountUpdates tracker.
+//
+// The history begins with a snapshot of all accounts at the round it was
+// enabled on (the genesis round for a new ledger), recorded in acctrounds
+// as 'histbase'; 'histlast' records the last round written so that a
+// replaced accounts database, such as after a catchpoint catchup, is
+// detected and the history started over.
+type accountHistory struct {
+	// enabled is set for archival ledgers configured to keep the account history.
+	enabled bool
+
+	dbs db.Pair
+	log logging.Logger
+
+	// first is the round of the snapshot the history starts from.
+	first basics.Round
+}
+
+func (ah *accountHistory) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	if !ah.enabled {
+		return nil
+	}
+	ah.dbs = l.trackerDB()
+	ah.log = l.trackerLog()
+
+	return ah.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range accountHistorySchema {
+			_, err := tx.ExecContext(ctx, stmt)
+			if err != nil {
+				return err
+			}
+		}
+
+		var first, last basics.Round
+		err := tx.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id='histbase'").Scan(&first)
+		if err == nil {
+			err = tx.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id='histlast'").Scan(&last)
+		}
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == nil && last == dbRound {
+			ah.first = first
+			return nil
+		}
+
+		if err == nil {
+			ah.log.Warnf("accountHistory: history ends at round %d but the accounts are at round %d; starting over", last, dbRound)
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM accounthistory")
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "INSERT INTO accounthistory (address, rnd, data) SELECT address, ?, data FROM accountbase", dbRound)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "INSERT OR REPLACE INTO acctrounds (id, rnd) VALUES ('histbase', ?), ('histlast', ?)", dbRound, dbRound)
+		if err != nil {
+			return err
+		}
+		ah.first = dbRound
+		return nil
+	})
+}
+
+func (ah *accountHistory) close() {
+}
+
+func (ah *accountHistory) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+}
+
+func (ah *accountHistory) committedUpTo(rnd basics.Round) (retRound, lookback basics.Round) {
+	return rnd, basics.Round(0)
+}
+
+func (ah *accountHistory) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (ah *accountHistory) prepareCommit(dcc *deferredCommitContext) error {
+	return nil
+}
+
+// commitRound writes the account deltas of the committed rounds, which the
+// accountUpdates tracker has copied into the deferredCommitContext.
+func (ah *accountHistory) commitRound(ctx context.Context, tx *sql.Tx, dcc *deferredCommitContext) error {
+	if !ah.enabled {
+		return nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, "INSERT OR REPLACE INTO accounthistory (address, rnd, data) VALUES (?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for i, deltas := range dcc.deltas {
+		rnd := dcc.oldBase + basics.Round(i) + 1
+		for j := 0; j < deltas.Len(); j++ {
+			addr, data := deltas.GetByIdx(j)
+			_, err = stmt.ExecContext(ctx, addr[:], rnd, protocol.Encode(&data))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE acctrounds SET rnd=? WHERE id='histlast'", dcc.newBase)
+	return err
+}
+
+func (ah *accountHistory) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (ah *accountHistory) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (ah *accountHistory) handleUnorderedCommit(uint64, basics.Round, basics.Round) {
+}
+
+// lookup returns the data of addr as of the end of round rnd, without
+// applying pending rewards. Only rounds already committed to the accounts
+// database are answered.
+func (ah *accountHistory) lookup(rnd basics.Round, addr basics.Address) (data basics.AccountData, err error) {
+	if !ah.enabled {
+		return basics.AccountData{}, ErrAccountHistoryDisabled
+	}
+	if rnd < ah.first {
+		return basics.AccountData{}, &AccountHistoryRangeError{Round: rnd, First: ah.first}
+	}
+
+	var buf []byte
+	err = ah.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT data FROM accounthistory WHERE address=? AND rnd<=? ORDER BY rnd DESC LIMIT 1", addr[:], rnd).Scan(&buf)
+	})
+	if err == sql.ErrNoRows {
+		// the account did not exist at that round.
+		return basics.AccountData{}, nil
+	}
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	err = protocol.Decode(buf, &data)
+	return data, err
+}
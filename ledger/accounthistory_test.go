@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAccountHistoryLookup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, enabled := range []bool{true, false} {
+		genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+		cfg := config.GetDefaultLocal()
+		cfg.Archival = true
+		cfg.EnableAccountHistory = enabled
+		l := newTestLedgerWithConfig(t, genBalances, cfg)
+		defer l.Close()
+
+		// a fresh address, funded in round 3
+		newAddr := basics.Address{0x42}
+
+		const rounds = 5
+		expected := make(map[basics.Round]basics.AccountData)
+		for rnd := basics.Round(1); rnd <= rounds; rnd++ {
+			eval := testingEvaluator{l.nextBlock(t), l}
+			eval.txn(t, &txntest.Txn{
+				Type:     "pay",
+				Sender:   addrs[0],
+				Receiver: addrs[1],
+				Amount:   uint64(rnd) * 1000,
+			})
+			if rnd == 3 {
+				eval.txn(t, &txntest.Txn{
+					Type:     "pay",
+					Sender:   addrs[0],
+					Receiver: newAddr,
+					Amount:   1000000,
+				})
+			}
+			l.endBlock(t, eval)
+
+			data, err := l.Lookup(rnd, addrs[1])
+			require.NoError(t, err)
+			expected[rnd] = data
+		}
+
+		commitRound(rounds, 0, l)
+		require.Equal(t, basics.Round(rounds), l.trackers.dbRound)
+
+		for rnd := basics.Round(1); rnd < rounds; rnd++ {
+			data, err := l.Lookup(rnd, addrs[1])
+			if !enabled {
+				var roundOffsetErr *RoundOffsetError
+				require.True(t, errors.As(err, &roundOffsetErr))
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected[rnd], data)
+
+			data, err = l.Lookup(rnd, newAddr)
+			require.NoError(t, err)
+			if rnd < 3 {
+				require.Equal(t, basics.AccountData{}, data)
+			} else {
+				require.Equal(t, uint64(1000000), data.MicroAlgos.Raw)
+			}
+		}
+		if !enabled {
+			continue
+		}
+
+		// the genesis state is part of the history
+		data, err := l.Lookup(0, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, genBalances.Balances[addrs[1]].MicroAlgos, data.MicroAlgos)
+
+		// reloading the ledger keeps the history
+		require.NoError(t, l.reloadLedger())
+		require.Equal(t, basics.Round(0), l.acctHistory.first)
+		data, err = l.Lookup(2, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, expected[2], data)
+	}
+}
+
+func TestAccountHistoryStartsOver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.EnableAccountHistory = true
+	l := newTestLedgerWithConfig(t, genBalances, cfg)
+	defer l.Close()
+
+	for rnd := 1; rnd <= 3; rnd++ {
+		eval := testingEvaluator{l.nextBlock(t), l}
+		eval.txn(t, &txntest.Txn{Type: "pay", Sender: addrs[0], Receiver: addrs[1], Amount: 1000})
+		l.endBlock(t, eval)
+	}
+	commitRound(3, 0, l)
+
+	// pretend that the accounts were replaced without the history following
+	_, err := l.trackerDBs.Wdb.Handle.Exec("UPDATE acctrounds SET rnd=1 WHERE id='histlast'")
+	require.NoError(t, err)
+	require.NoError(t, l.reloadLedger())
+	require.Equal(t, basics.Round(3), l.acctHistory.first)
+
+	_, err = l.acctHistory.lookup(2, addrs[1])
+	var rangeErr *AccountHistoryRangeError
+	require.True(t, errors.As(err, &rangeErr))
+	require.Equal(t, basics.Round(3), rangeErr.First)
+
+	data, err := l.acctHistory.lookup(3, addrs[1])
+	require.NoError(t, err)
+	latest, _, err := l.LookupWithoutRewards(3, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, latest, data)
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -93,6 +94,18 @@ type modifiedAccount struct {
 	ndeltas int
 }
 
+// A modifiedKvValue represents a key in the key/value store that has been
+// modified since the persistent state stored in the account DB.
+type modifiedKvValue struct {
+	// data stores the most recent value; nil means the key was deleted.
+	data []byte
+
+	// ndeltas keeps track of how many times the key appears in
+	// accountUpdates.kvDeltas, so that the entry can be evicted once
+	// all its changes have been flushed to the account DB.
+	ndeltas int
+}
+
 type accountUpdates struct {
 	// constant variables ( initialized on initialize, and never changed afterward )
 
@@ -125,6 +138,12 @@ type accountUpdates struct {
 	// appears in creatableDeltas
 	creatables map[basics.CreatableIndex]ledgercore.ModifiedCreatable
 
+	// kvDeltas stores key/value store updates for every round after dbRound.
+	kvDeltas []map[string]ledgercore.KvValueDelta
+
+	// kvStore stores the most recent value of every key that appears in kvDeltas.
+	kvStore map[string]modifiedKvValue
+
 	// versions stores consensus version dbRound and every
 	// round after it; i.e., versions is one longer than deltas.
 	versions []protocol.ConsensusVersion
@@ -479,6 +498,19 @@ func (au *accountUpdates) GetCreatorForRound(rnd basics.Round, cidx basics.Creat
 	return au.getCreatorForRound(rnd, cidx, ctype, true /* take the lock */)
 }
 
+// LookupKv returns the value of key in the key/value store at round rnd; a nil
+// value means the key does not exist.
+func (au *accountUpdates) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return au.lookupKv(rnd, key, true /* take the lock */)
+}
+
+// LookupKeysByPrefix returns up to maxKeys keys of the key/value store that
+// start with prefix at round rnd, in ascending order. A maxKeys of zero means
+// no limit.
+func (au *accountUpdates) LookupKeysByPrefix(rnd basics.Round, prefix string, maxKeys uint64) ([]string, error) {
+	return au.lookupKeysByPrefix(rnd, prefix, maxKeys)
+}
+
 // committedUpTo implements the ledgerTracker interface for accountUpdates.
 // The method informs the tracker that committedRound and all it's previous rounds have
 // been committed to the block database. The method returns what is the oldest round
@@ -659,6 +691,11 @@ func (aul *accountUpdatesLedgerEvaluator) GetCreatorForRound(rnd basics.Round, c
 	return aul.au.getCreatorForRound(rnd, cidx, ctype, false /* don't sync */)
 }
 
+// LookupKv returns the value of key in the key/value store at a given round
+func (aul *accountUpdatesLedgerEvaluator) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return aul.au.lookupKv(rnd, key, false /* don't sync */)
+}
+
 // totalsImpl returns the totals for a given round
 func (au *accountUpdates) totalsImpl(rnd basics.Round) (totals ledgercore.AccountTotals, err error) {
 	offset, err := au.roundOffset(rnd)
@@ -716,6 +753,8 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 	au.creatableDeltas = nil
 	au.accounts = make(map[basics.Address]modifiedAccount)
 	au.creatables = make(map[basics.CreatableIndex]ledgercore.ModifiedCreatable)
+	au.kvDeltas = nil
+	au.kvStore = make(map[string]modifiedKvValue)
 	au.deltasAccum = []int{0}
 
 	au.baseAccounts.init(au.log, baseAccountsPendingAccountsBufferSize, baseAccountsPendingAccountsWarnThreshold)
@@ -738,6 +777,7 @@ func (au *accountUpdates) newBlockImpl(blk bookkeeping.Block, delta ledgercore.S
 	au.deltas = append(au.deltas, delta.Accts)
 	au.versions = append(au.versions, blk.CurrentProtocol)
 	au.creatableDeltas = append(au.creatableDeltas, delta.Creatables)
+	au.kvDeltas = append(au.kvDeltas, delta.KvMods)
 	au.deltasAccum = append(au.deltasAccum, delta.Accts.Len()+au.deltasAccum[len(au.deltasAccum)-1])
 
 	au.baseAccounts.flushPendingWrites()
@@ -759,6 +799,13 @@ func (au *accountUpdates) newBlockImpl(blk bookkeeping.Block, delta ledgercore.S
 		au.creatables[cidx] = mcreat
 	}
 
+	for key, kvDelta := range delta.KvMods {
+		mval := au.kvStore[key]
+		mval.data = kvDelta.Data
+		mval.ndeltas++
+		au.kvStore[key] = mval
+	}
+
 	au.roundTotals = append(au.roundTotals, delta.Totals)
 
 	// calling prune would drop old entries from the base accounts.
@@ -1027,6 +1074,146 @@ func (au *accountUpdates) getCreatorForRound(rnd basics.Round, cidx basics.Creat
 	}
 }
 
+// lookupKv returns the value of key in the key/value store at round rnd
+func (au *accountUpdates) lookupKv(rnd basics.Round, key string, synchronized bool) ([]byte, error) {
+	needUnlock := false
+	if synchronized {
+		au.accountsMu.RLock()
+		needUnlock = true
+	}
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		offset, err := au.roundOffset(rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		// If this is the most recent round, au.kvStore has the latest
+		// state and we can skip scanning backwards over kvDeltas
+		if offset == uint64(len(au.deltas)) {
+			if mval, ok := au.kvStore[key]; ok {
+				return mval.data, nil
+			}
+		} else {
+			for offset > 0 {
+				offset--
+				if kvDelta, ok := au.kvDeltas[offset][key]; ok {
+					return kvDelta.Data, nil
+				}
+			}
+		}
+
+		if synchronized {
+			au.accountsMu.RUnlock()
+			needUnlock = false
+		}
+
+		// Check the database
+		value, dbRound, err := au.accountsq.lookupKeyValue(key)
+		if err != nil {
+			return nil, err
+		}
+		if dbRound == currentDbRound {
+			return value, nil
+		}
+		if synchronized {
+			if dbRound < currentDbRound {
+				au.log.Errorf("accountUpdates.lookupKv: database round %d is behind in-memory round %d", dbRound, currentDbRound)
+				return nil, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+			}
+			au.accountsMu.RLock()
+			needUnlock = true
+			for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+				au.accountsReadCond.Wait()
+			}
+		} else {
+			au.log.Errorf("accountUpdates.lookupKv: database round %d mismatching in-memory round %d", dbRound, currentDbRound)
+			return nil, &MismatchingDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+		}
+	}
+}
+
+// lookupKeysByPrefix returns the keys starting with prefix at round rnd, by
+// overlaying the in-memory deltas up to rnd over the keys in the database.
+func (au *accountUpdates) lookupKeysByPrefix(rnd basics.Round, prefix string, maxKeys uint64) ([]string, error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		offset, err := au.roundOffset(rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		// collect the most recent state, up to rnd, of the modified keys
+		// matching the prefix; later rounds override earlier ones.
+		modified := make(map[string]bool)
+		for _, kvDeltas := range au.kvDeltas[:offset] {
+			for key, kvDelta := range kvDeltas {
+				if strings.HasPrefix(key, prefix) {
+					modified[key] = kvDelta.Data != nil
+				}
+			}
+		}
+
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		// the modified keys may hide keys from the database, so the database
+		// results can only be limited when nothing was modified.
+		dbMax := maxKeys
+		if len(modified) > 0 {
+			dbMax = 0
+		}
+		dbKeys, dbRound, err := au.accountsq.lookupKeysByPrefix(prefix, dbMax)
+		if err != nil {
+			return nil, err
+		}
+		if dbRound == currentDbRound {
+			keys := make([]string, 0, len(dbKeys)+len(modified))
+			for _, key := range dbKeys {
+				if _, ok := modified[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			for key, exists := range modified {
+				if exists {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			if maxKeys > 0 && uint64(len(keys)) > maxKeys {
+				keys = keys[:maxKeys]
+			}
+			return keys, nil
+		}
+
+		if dbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupKeysByPrefix: database round %d is behind in-memory round %d", dbRound, currentDbRound)
+			return nil, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+		}
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
 // roundOffset calculates the offset of the given round compared to the current dbRound. Requires that the lock would be taken.
 func (au *accountUpdates) roundOffset(rnd basics.Round) (offset uint64, err error) {
 	if rnd < au.cachedDBRound {
@@ -1070,6 +1257,8 @@ func (au *accountUpdates) prepareCommit(dcc *deferredCommitContext) error {
 	dcc.roundTotals = au.roundTotals[offset]
 	copy(dcc.deltas, au.deltas[:offset])
 	copy(creatableDeltas, au.creatableDeltas[:offset])
+	kvDeltas := make([]map[string]ledgercore.KvValueDelta, offset)
+	copy(kvDeltas, au.kvDeltas[:offset])
 
 	// verify version correctness : all the entries in the au.versions[1:offset+1] should have the *same* version, and the committedUpTo should be enforcing that.
 	if au.versions[1] != au.versions[offset] {
@@ -1089,6 +1278,7 @@ func (au *accountUpdates) prepareCommit(dcc *deferredCommitContext) error {
 	// being updated multiple times. When that happen, we can safely omit the intermediate updates.
 	dcc.compactAccountDeltas = makeCompactAccountDeltas(dcc.deltas, au.baseAccounts)
 	dcc.compactCreatableDeltas = compactCreatableDeltas(creatableDeltas)
+	dcc.compactKvDeltas = compactKvDeltas(kvDeltas)
 
 	au.accountsMu.RUnlock()
 
@@ -1149,6 +1339,11 @@ func (au *accountUpdates) commitRound(ctx context.Context, tx *sql.Tx, dcc *defe
 		return err
 	}
 
+	err = kvstoreNewRound(tx, dcc.compactKvDeltas)
+	if err != nil {
+		return err
+	}
+
 	if dcc.updateStats {
 		dcc.stats.AccountsWritingDuration = time.Duration(time.Now().UnixNano()) - dcc.stats.AccountsWritingDuration
 	}
@@ -1214,11 +1409,29 @@ func (au *accountUpdates) postCommit(ctx context.Context, dcc *deferredCommitCon
 		}
 	}
 
+	for key, mval := range dcc.compactKvDeltas {
+		cnt := mval.ndeltas
+		mkv, ok := au.kvStore[key]
+		if !ok {
+			au.log.Panicf("inconsistency: flushed %d changes to key %x, but not in au.kvStore", cnt, key)
+		}
+
+		if cnt > mkv.ndeltas {
+			au.log.Panicf("inconsistency: flushed %d changes to key %x, but au.kvStore had %d", cnt, key, mkv.ndeltas)
+		} else if cnt == mkv.ndeltas {
+			delete(au.kvStore, key)
+		} else {
+			mkv.ndeltas -= cnt
+			au.kvStore[key] = mkv
+		}
+	}
+
 	au.deltas = au.deltas[offset:]
 	au.deltasAccum = au.deltasAccum[offset:]
 	au.versions = au.versions[offset:]
 	au.roundTotals = au.roundTotals[offset:]
 	au.creatableDeltas = au.creatableDeltas[offset:]
+	au.kvDeltas = au.kvDeltas[offset:]
 	au.cachedDBRound = newBase
 
 	au.accountsMu.Unlock()
@@ -1275,6 +1488,24 @@ func compactCreatableDeltas(creatableDeltas []map[basics.CreatableIndex]ledgerco
 	return
 }
 
+// compactKvDeltas takes an array of key/value store deltas ( one array entry per round ), and compacts it into a single
+// map holding the most recent value of each key, counting the number of rounds in which each key was modified.
+func compactKvDeltas(kvDeltas []map[string]ledgercore.KvValueDelta) (outKvDeltas map[string]modifiedKvValue) {
+	if len(kvDeltas) == 0 {
+		return
+	}
+	outKvDeltas = make(map[string]modifiedKvValue, 1+len(kvDeltas[0])*len(kvDeltas))
+	for _, roundKv := range kvDeltas {
+		for key, kvDelta := range roundKv {
+			mval := outKvDeltas[key]
+			mval.data = kvDelta.Data
+			mval.ndeltas++
+			outKvDeltas[key] = mval
+		}
+	}
+	return
+}
+
 // latest returns the latest round
 func (au *accountUpdates) latest() basics.Round {
 	return au.cachedDBRound + basics.Round(len(au.deltas))
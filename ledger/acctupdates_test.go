@@ -1075,6 +1075,8 @@ func TestListCreatables(t *testing.T) {
 	accts := make(map[basics.Address]basics.AccountData)
 	_, err = accountsInit(tx, accts, proto)
 	require.NoError(t, err)
+	err = accountsCreateKvstore(tx)
+	require.NoError(t, err)
 
 	err = accountsAddNormalizedBalance(tx, proto)
 	require.NoError(t, err)
@@ -101,6 +101,11 @@ func Payment(payment transactions.PaymentTxnFields, header transactions.Header,
 			return fmt.Errorf("cannot close: %d outstanding created applications", len(rec.AppParams))
 		}
 
+		// An application account must delete its boxes first
+		if rec.TotalBoxes > 0 {
+			return fmt.Errorf("cannot close: %d outstanding boxes", rec.TotalBoxes)
+		}
+
 		// Clear out entire account record, to allow the DB to GC it
 		rec = basics.AccountData{}
 		err = balances.Put(header.Sender, rec)
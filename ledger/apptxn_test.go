@@ -51,6 +51,13 @@ func main(source string) string {
 // newTestLedger creates a in memory Ledger that is as realistic as
 // possible.  It has Rewards and FeeSink properly configured.
 func newTestLedger(t testing.TB, balances bookkeeping.GenesisBalances) *Ledger {
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	return newTestLedgerWithConfig(t, balances, cfg)
+}
+
+// newTestLedgerWithConfig is like newTestLedger, with the given local configuration.
+func newTestLedgerWithConfig(t testing.TB, balances bookkeeping.GenesisBalances, cfg config.Local) *Ledger {
 	var genHash crypto.Digest
 	crypto.RandBytes(genHash[:])
 	genBlock, err := bookkeeping.MakeGenesisBlock(protocol.ConsensusFuture, balances, "test", genHash)
@@ -58,8 +65,6 @@ func newTestLedger(t testing.TB, balances bookkeeping.GenesisBalances) *Ledger {
 	require.False(t, genBlock.FeeSink.IsZero())
 	require.False(t, genBlock.RewardsPool.IsZero())
 	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
-	cfg := config.GetDefaultLocal()
-	cfg.Archival = true
 	l, err := OpenLedger(logging.Base(), dbName, true, ledgercore.InitState{
 		Block:       genBlock,
 		Accounts:    balances.Balances,
@@ -1337,3 +1342,404 @@ nonpart:
 	// Ppaid fee and 1.  Did not get rewards
 	require.Equal(t, 999_998_998, int(l.micros(t, appIndex.Address())))
 }
+
+// TestBoxes ensures boxes written by an app are visible in later blocks, and
+// charged to the app account's min balance.
+func TestBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	create := txntest.Txn{
+		Type:   "appl",
+		Sender: addrs[0],
+		ApprovalProgram: main(`
+         txn ApplicationArgs 0
+         byte "create"
+         ==
+         bz put
+         byte "x"
+         int 10
+         box_create
+         assert
+         b end
+put:     txn ApplicationArgs 0
+         byte "put"
+         ==
+         bz del
+         byte "x"
+         int 2
+         byte "hi"
+         box_replace
+         b end
+del:     byte "x"
+         box_del
+         assert
+`),
+	}
+
+	ai := basics.AppIndex(1)
+	fund := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: ai.Address(),
+		Amount:   100_000,
+	}
+
+	call := txntest.Txn{
+		Type:            "appl",
+		Sender:          addrs[0],
+		ApplicationID:   ai,
+		ApplicationArgs: [][]byte{[]byte("create")},
+		Boxes:           []transactions.BoxRef{{Name: []byte("x")}},
+	}
+
+	eval := testingEvaluator{l.nextBlock(t), l}
+	eval.txns(t, &create, &fund)
+	// The app account can't afford the box yet
+	eval.txn(t, &call, "balance 100000 below min")
+	l.endBlock(t, eval)
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	boxCost := proto.BoxFlatMinBalance + proto.BoxByteMinBalance*(1+10)
+	eval = testingEvaluator{l.nextBlock(t), l}
+	eval.txns(t, fund.Noted("more"), &call)
+	l.endBlock(t, eval)
+
+	app := l.lookup(t, ai.Address())
+	require.Equal(t, uint64(1), app.TotalBoxes)
+	require.Equal(t, uint64(11), app.TotalBoxBytes)
+	require.Equal(t, proto.MinBalance+boxCost, app.MinBalance(&proto).Raw)
+
+	key := ledgercore.MakeBoxKey(ai, "x")
+	value, err := l.LookupKv(l.Latest(), key)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, 10), value)
+
+	put := call
+	put.ApplicationArgs = [][]byte{[]byte("put")}
+	eval = testingEvaluator{l.nextBlock(t), l}
+	eval.txn(t, &put)
+	l.endBlock(t, eval)
+
+	value, err = l.LookupKv(l.Latest(), key)
+	require.NoError(t, err)
+	require.Equal(t, []byte("\x00\x00hi\x00\x00\x00\x00\x00\x00"), value)
+	// Older rounds still see the old contents
+	value, err = l.LookupKv(l.Latest()-1, key)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, 10), value)
+
+	keys, err := l.LookupKeysByPrefix(l.Latest(), ledgercore.BoxKeyPrefix(ai), 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{key}, keys)
+
+	del := call
+	del.ApplicationArgs = [][]byte{[]byte("del")}
+	eval = testingEvaluator{l.nextBlock(t), l}
+	eval.txn(t, &del)
+	l.endBlock(t, eval)
+
+	value, err = l.LookupKv(l.Latest(), key)
+	require.NoError(t, err)
+	require.Nil(t, value)
+	keys, err = l.LookupKeysByPrefix(l.Latest(), ledgercore.BoxKeyPrefix(ai), 0)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	app = l.lookup(t, ai.Address())
+	require.Zero(t, app.TotalBoxes)
+	require.Zero(t, app.TotalBoxBytes)
+}
+
+// TestInnerAppCall ensures an app can call another app, see its logs, and
+// that the callee's effects are recorded in the callee's inner EvalDelta.
+func TestInnerAppCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	callee := txntest.Txn{
+		Type:   "appl",
+		Sender: addrs[0],
+		ApprovalProgram: main(`
+         byte "called"
+         log
+         byte "count"
+         dup
+         app_global_get
+         int 1
+         +
+         app_global_put
+`),
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+	}
+
+	// The caller spends more than a single app's budget after the inner
+	// call, which only works because the callee's budget is pooled.
+	caller := txntest.Txn{
+		Type:   "appl",
+		Sender: addrs[0],
+		ApprovalProgram: main(`
+         itxn_begin
+         int appl
+         itxn_field TypeEnum
+         txn Applications 1
+         itxn_field ApplicationID
+         byte "hello"
+         itxn_field ApplicationArgs
+         itxn_submit
+         itxn NumLogs
+         int 1
+         ==
+         assert
+         gitxna 0 Logs 0
+         byte "called"
+         ==
+         assert
+         int 0
+         store 0
+loop:    load 0
+         int 1
+         +
+         dup
+         store 0
+         int 150
+         <
+         bnz loop
+`),
+	}
+
+	calleeIndex := basics.AppIndex(1)
+	callerIndex := basics.AppIndex(2)
+	fund := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: callerIndex.Address(),
+		Amount:   200_000,
+	}
+
+	call := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[0],
+		ApplicationID: callerIndex,
+	}
+
+	eval := testingEvaluator{l.nextBlock(t), l}
+	eval.txns(t, &callee, &caller, &fund)
+	// The callee must be in the foreign apps array
+	eval.txn(t, &call, "invalid Applications index 1")
+	call.ForeignApps = []basics.AppIndex{calleeIndex}
+	eval.txn(t, &call)
+	vb := l.endBlock(t, eval)
+
+	inners := vb.Block().Payset[3].EvalDelta.InnerTxns
+	require.Len(t, inners, 1)
+	require.Equal(t, calleeIndex, inners[0].Txn.ApplicationID)
+	require.Equal(t, callerIndex.Address(), inners[0].Txn.Sender)
+	require.Equal(t, [][]byte{[]byte("hello")}, inners[0].Txn.ApplicationArgs)
+	require.Equal(t, []string{"called"}, inners[0].EvalDelta.Logs)
+	require.Equal(t, basics.ValueDelta{Action: basics.SetUintAction, Uint: 1},
+		inners[0].EvalDelta.GlobalDelta["count"])
+	// The caller's own delta does not repeat the callee's state changes
+	require.Empty(t, vb.Block().Payset[3].EvalDelta.GlobalDelta)
+
+	params := l.lookup(t, addrs[0]).AppParams[calleeIndex]
+	require.Equal(t, uint64(1), params.GlobalState["count"].Uint)
+}
+
+// TestInnerAppCallLimits checks call depth, reentrancy and minimum version
+// of inner app calls by chaining together copies of a relay app.
+func TestInnerAppCallLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	// relay calls Applications 1, passing along the rest of its Applications
+	relay := txntest.Txn{
+		Type:   "appl",
+		Sender: addrs[0],
+		ApprovalProgram: main(`
+         txn NumApplications
+         bz end
+         itxn_begin
+         int appl
+         itxn_field TypeEnum
+         txn Applications 1
+         itxn_field ApplicationID
+         int 2
+         store 0
+fwd:     load 0
+         txn NumApplications
+         >
+         bnz submit
+         load 0
+         txnas Applications
+         itxn_field Applications
+         load 0
+         int 1
+         +
+         store 0
+         b fwd
+submit:  itxn_submit
+`),
+	}
+
+	eval := testingEvaluator{l.nextBlock(t), l}
+	apps := make([]basics.AppIndex, 9)
+	for i := range apps {
+		apps[i] = basics.AppIndex(i + 1)
+		eval.txn(t, relay.Noted(fmt.Sprintf("%d", i)))
+	}
+	old := txntest.Txn{
+		Type:            "appl",
+		Sender:          addrs[0],
+		ApprovalProgram: "#pragma version 3\nint 1",
+	}
+	eval.txn(t, &old)
+	oldIndex := basics.AppIndex(10)
+	for i, app := range apps {
+		eval.txn(t, &txntest.Txn{
+			Type:     "pay",
+			Sender:   addrs[0],
+			Receiver: app.Address(),
+			Amount:   200_000,
+			Note:     []byte(fmt.Sprintf("%d", i)),
+		})
+	}
+	l.endBlock(t, eval)
+
+	call := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[0],
+		ApplicationID: apps[0],
+	}
+
+	eval = testingEvaluator{l.nextBlock(t), l}
+	// A chain of eight apps is allowed
+	call.ForeignApps = apps[1:8]
+	eval.txn(t, call.Noted("8"))
+	// but a ninth is too deep
+	call.ForeignApps = apps[1:9]
+	eval.txn(t, call.Noted("9"), "appl depth (8) exceeded")
+	// An app can not be called while it is already executing
+	call.ForeignApps = []basics.AppIndex{apps[1], apps[0]}
+	eval.txn(t, call.Noted("reenter"), fmt.Sprintf("attempt to re-enter %d", apps[0]))
+	// Old programs can not be called from an inner transaction
+	call.ForeignApps = []basics.AppIndex{oldIndex}
+	eval.txn(t, call.Noted("old"), "inner app call with version 3 < 4")
+	vb := l.endBlock(t, eval)
+
+	// Each call is recorded inside the EvalDelta of its caller
+	depth := 0
+	for inners := vb.Block().Payset[0].EvalDelta.InnerTxns; len(inners) > 0; inners = inners[0].EvalDelta.InnerTxns {
+		require.Len(t, inners, 1)
+		depth++
+		require.Equal(t, apps[depth], inners[0].Txn.ApplicationID)
+	}
+	require.Equal(t, 7, depth)
+}
+
+// TestInnerAppCreate ensures an app can create another app.
+func TestInnerAppCreate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	// 0x068101 is "#pragma version 6; int 1"
+	app := txntest.Txn{
+		Type:   "appl",
+		Sender: addrs[0],
+		ApprovalProgram: main(`
+         itxn_begin
+         int appl
+         itxn_field TypeEnum
+         byte 0x068101
+         itxn_field ApprovalProgram
+         byte 0x068101
+         itxn_field ClearStateProgram
+         itxn_submit
+         itxn CreatedApplicationID
+         int 4
+         ==
+         assert
+`),
+	}
+
+	appIndex := basics.AppIndex(1)
+	fund := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: appIndex.Address(),
+		Amount:   300_000, // enough for the min balance of an app creator
+	}
+
+	call := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[0],
+		ApplicationID: appIndex,
+	}
+
+	eval := testingEvaluator{l.nextBlock(t), l}
+	eval.txns(t, &app, &fund, &call)
+	vb := l.endBlock(t, eval)
+
+	require.Equal(t, basics.AppIndex(4), vb.Block().Payset[2].EvalDelta.InnerTxns[0].ApplicationID)
+	params, ok := l.lookup(t, appIndex.Address()).AppParams[4]
+	require.True(t, ok)
+	require.Equal(t, []byte{0x06, 0x81, 0x01}, params.ApprovalProgram)
+}
+
+// TestIncreaseBudget ensures that an app can raise the budget of its group
+// by paying for it with overpaid fees, rather than with inner transactions.
+func TestIncreaseBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	// The loop costs about 1200, more than a single app call may use
+	app := txntest.Txn{
+		Type:   "appl",
+		Sender: addrs[0],
+		ApprovalProgram: main(`
+         int 1
+         increase_budget
+         int 0
+         store 0
+loop:    load 0
+         int 1
+         +
+         dup
+         store 0
+         int 150
+         <
+         bnz loop
+`),
+	}
+
+	appIndex := basics.AppIndex(1)
+	call := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[0],
+		ApplicationID: appIndex,
+	}
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	eval := testingEvaluator{l.nextBlock(t), l}
+	eval.txn(t, &app)
+	// The increase must be paid for by overpaying the fee
+	eval.txn(t, &call, "fee credit too small to increase budget by 1")
+	call.Fee = 2 * proto.MinTxnFee
+	eval.txn(t, &call)
+	l.endBlock(t, eval)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -594,8 +595,17 @@ func (c *CatchpointCatchupAccessorImpl) GetCatchupBlockRound(ctx context.Context
 	return basics.Round(iRound), nil
 }
 
+// ErrCatchpointBoxesNotSupported is returned when verifying a catchpoint of a
+// protocol enabling boxes. Catchpoints do not carry the boxes yet, so catching
+// up would drop them while the accounts keep counting them.
+var ErrCatchpointBoxesNotSupported = errors.New("catchpoint catchup is not supported on protocols enabling boxes")
+
 // VerifyCatchpoint verifies that the catchpoint is valid by reconstructing the label.
 func (c *CatchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, blk *bookkeeping.Block) (err error) {
+	if config.Consensus[blk.CurrentProtocol].EnableBoxes {
+		return fmt.Errorf("protocol %s of round %d: %w", blk.CurrentProtocol, blk.Round(), ErrCatchpointBoxesNotSupported)
+	}
+
 	rdb := c.ledger.trackerDB().Rdb
 	var balancesHash crypto.Digest
 	var blockRound basics.Round
@@ -350,6 +350,12 @@ func TestVerifyCatchpoint(t *testing.T) {
 	require.Error(t, err)
 	// TODO: verify a catchpoint block that is valid
 
+	// catchpoints do not carry boxes, so protocols enabling them are refused
+	boxesBlk := blk
+	boxesBlk.CurrentProtocol = protocol.ConsensusFuture
+	err = catchpointAccessor.VerifyCatchpoint(ctx, &boxesBlk)
+	require.ErrorIs(t, err, ErrCatchpointBoxesNotSupported)
+
 	// StoreBalancesRound assumes things are valid, so just does the db put
 	err = catchpointAccessor.StoreBalancesRound(ctx, &blk)
 	require.NoError(t, err)
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// deltaTailRounds is the number of most recent rounds for which the
+// deltaTail keeps the state delta in memory.
+const deltaTailRounds = 320
+
+// deltaTail keeps the ledgercore.StateDelta produced by the evaluator for
+// the most recent rounds, so that external consumers can follow the ledger
+// state without re-evaluating blocks.
+type deltaTail struct {
+	deltas map[basics.Round]ledgercore.StateDelta
+
+	// latest is the most recent round for which a delta was received.
+	latest basics.Round
+}
+
+func (d *deltaTail) loadFromDisk(l ledgerForTracker, _ basics.Round) error {
+	// deltas for rounds past the accounts database round are replayed
+	// through newBlock by the tracker registry once all trackers are loaded.
+	d.deltas = make(map[basics.Round]ledgercore.StateDelta)
+	d.latest = l.Latest()
+	return nil
+}
+
+func (d *deltaTail) close() {
+}
+
+func (d *deltaTail) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	rnd := blk.Round()
+	d.deltas[rnd] = delta
+	if rnd > d.latest {
+		d.latest = rnd
+	}
+
+	old := d.latest.SubSaturate(deltaTailRounds)
+	for r := range d.deltas {
+		if r <= old {
+			delete(d.deltas, r)
+		}
+	}
+}
+
+func (d *deltaTail) committedUpTo(rnd basics.Round) (retRound, lookback basics.Round) {
+	return rnd, basics.Round(0)
+}
+
+func (d *deltaTail) prepareCommit(dcc *deferredCommitContext) error {
+	return nil
+}
+
+func (d *deltaTail) commitRound(context.Context, *sql.Tx, *deferredCommitContext) error {
+	return nil
+}
+
+func (d *deltaTail) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (d *deltaTail) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (d *deltaTail) handleUnorderedCommit(uint64, basics.Round, basics.Round) {
+}
+
+func (d *deltaTail) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+// getDelta returns the state delta produced by round rnd, or
+// ledgercore.ErrNoEntry if that round is not (or no longer) tracked.
+func (d *deltaTail) getDelta(rnd basics.Round) (ledgercore.StateDelta, error) {
+	delta, ok := d.deltas[rnd]
+	if !ok {
+		return ledgercore.StateDelta{}, ledgercore.ErrNoEntry{Round: rnd, Latest: d.latest}
+	}
+	return delta, nil
+}
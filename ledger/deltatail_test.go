@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDeltaTailRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var d deltaTail
+	d.deltas = make(map[basics.Round]ledgercore.StateDelta)
+
+	last := basics.Round(deltaTailRounds + 10)
+	for rnd := basics.Round(1); rnd <= last; rnd++ {
+		var blk bookkeeping.Block
+		blk.BlockHeader.Round = rnd
+		d.newBlock(blk, ledgercore.StateDelta{Hdr: &blk.BlockHeader})
+	}
+	require.Len(t, d.deltas, deltaTailRounds)
+
+	delta, err := d.getDelta(last)
+	require.NoError(t, err)
+	require.Equal(t, last, delta.Hdr.Round)
+
+	_, err = d.getDelta(last - deltaTailRounds + 1)
+	require.NoError(t, err)
+
+	_, err = d.getDelta(last - deltaTailRounds)
+	require.Equal(t, ledgercore.ErrNoEntry{Round: last - deltaTailRounds, Latest: last}, err)
+
+	_, err = d.getDelta(last + 1)
+	require.Error(t, err)
+}
+
+func TestLedgerGetStateDeltaForRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	pay := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   100000,
+	}
+
+	eval := testingEvaluator{l.nextBlock(t), l}
+	eval.txn(t, &pay)
+	vb := l.endBlock(t, eval)
+
+	delta, err := l.GetStateDeltaForRound(vb.Block().Round())
+	require.NoError(t, err)
+	require.Equal(t, vb.Block().Round(), delta.Hdr.Round)
+
+	receiver, ok := delta.Accts.Get(addrs[1])
+	require.True(t, ok)
+	require.Equal(t, l.micros(t, addrs[1]), receiver.MicroAlgos.Raw)
+
+	_, err = l.GetStateDeltaForRound(vb.Block().Round() + 1)
+	require.ErrorAs(t, err, &ledgercore.ErrNoEntry{})
+}
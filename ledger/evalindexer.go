@@ -40,6 +40,8 @@ type indexerLedgerForEval interface {
 	GetAssetCreator(map[basics.AssetIndex]struct{}) (map[basics.AssetIndex]FoundAddress, error)
 	GetAppCreator(map[basics.AppIndex]struct{}) (map[basics.AppIndex]FoundAddress, error)
 	LatestTotals() (ledgercore.AccountTotals, error)
+	// The returned value is nil iff the key was not found.
+	LookupKv(basics.Round, string) ([]byte, error)
 }
 
 // FoundAddress is a wrapper for an address and a boolean.
@@ -142,6 +144,11 @@ func (l indexerLedgerConnector) GetCreatorForRound(_ basics.Round, cindex basics
 	return foundAddress.Address, foundAddress.Exists, nil
 }
 
+// LookupKv is part of LedgerForEvaluator interface.
+func (l indexerLedgerConnector) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return l.il.LookupKv(rnd, key)
+}
+
 // GenesisHash is part of LedgerForEvaluator interface.
 func (l indexerLedgerConnector) GenesisHash() crypto.Digest {
 	return l.genesisHash
@@ -84,6 +84,10 @@ func (il indexerLedgerForEvalImpl) LatestTotals() (totals ledgercore.AccountTota
 	return
 }
 
+func (il indexerLedgerForEvalImpl) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return il.l.LookupKv(rnd, key)
+}
+
 // Test that overriding the consensus parameters effects the generated apply data.
 func TestEvalForIndexerCustomProtocolParams(t *testing.T) {
 	partitiontest.PartitionTest(t)
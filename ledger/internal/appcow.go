@@ -514,6 +514,11 @@ func (cb *roundCowState) BuildEvalDelta(aidx basics.AppIndex, txn *transactions.
 	foundGlobal := false
 	for addr, smod := range cb.sdeltas {
 		for aapp, sdelta := range smod {
+			// Deltas for other apps were made by inner app calls, and
+			// are reported in the EvalDeltas of those inner transactions.
+			if aapp.aidx != aidx && cb.proto.MaxAppCallDepth > 0 {
+				continue
+			}
 			// Check that all of these deltas are for the correct app
 			if aapp.aidx != aidx {
 				err = fmt.Errorf("found storage delta for different app during StatefulEval/BuildDelta: %d != %d", aapp.aidx, aidx)
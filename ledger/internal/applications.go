@@ -22,6 +22,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/apply"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -42,6 +43,11 @@ type cowForLogicLedger interface {
 	SetKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, value basics.TealValue, accountIdx uint64) error
 	DelKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, accountIdx uint64) error
 
+	NewBox(appIdx basics.AppIndex, name string, value []byte, appAddr basics.Address) error
+	GetBox(appIdx basics.AppIndex, name string) ([]byte, bool, error)
+	SetBox(appIdx basics.AppIndex, name string, value []byte) error
+	DelBox(appIdx basics.AppIndex, name string, appAddr basics.Address) (bool, error)
+
 	round() basics.Round
 	prevTimestamp() int64
 	allocated(addr basics.Address, aidx basics.AppIndex, global bool) (bool, error)
@@ -100,6 +106,14 @@ func (al *logicLedger) Authorizer(addr basics.Address) (basics.Address, error) {
 	return addr, nil
 }
 
+func (al *logicLedger) AccountData(addr basics.Address) (basics.AccountData, error) {
+	record, err := al.cow.Get(addr, true)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	return record, nil
+}
+
 func (al *logicLedger) GetCreatableID(groupIdx int) basics.CreatableIndex {
 	return al.cow.GetCreatableID(groupIdx)
 }
@@ -247,6 +261,22 @@ func (al *logicLedger) DelGlobal(key string) error {
 	return al.cow.DelKey(al.creator, al.aidx, true, key, 0)
 }
 
+func (al *logicLedger) NewBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error {
+	return al.cow.NewBox(appIdx, key, value, appAddr)
+}
+
+func (al *logicLedger) GetBox(appIdx basics.AppIndex, key string) ([]byte, bool, error) {
+	return al.cow.GetBox(appIdx, key)
+}
+
+func (al *logicLedger) SetBox(appIdx basics.AppIndex, key string, value []byte) error {
+	return al.cow.SetBox(appIdx, key, value)
+}
+
+func (al *logicLedger) DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error) {
+	return al.cow.DelBox(appIdx, key, appAddr)
+}
+
 func (al *logicLedger) GetDelta(txn *transactions.Transaction) (evalDelta transactions.EvalDelta, err error) {
 	return al.cow.BuildEvalDelta(al.aidx, txn)
 }
@@ -260,6 +290,16 @@ func (al *logicLedger) balances() (apply.Balances, error) {
 }
 
 func (al *logicLedger) Perform(tx *transactions.Transaction, spec transactions.SpecialAddresses) (transactions.ApplyData, error) {
+	return al.perform(tx, spec, nil)
+}
+
+// PerformAppCall performs the inner app call ep.Txn, evaluating its
+// programs with the EvalParams prepared by the calling app.
+func (al *logicLedger) PerformAppCall(ep *logic.EvalParams) (transactions.ApplyData, error) {
+	return al.perform(&ep.Txn.Txn, *ep.Specials, ep)
+}
+
+func (al *logicLedger) perform(tx *transactions.Transaction, spec transactions.SpecialAddresses, ep *logic.EvalParams) (transactions.ApplyData, error) {
 	var ad transactions.ApplyData
 
 	balances, err := al.balances()
@@ -304,6 +344,9 @@ func (al *logicLedger) Perform(tx *transactions.Transaction, spec transactions.S
 	case protocol.AssetFreezeTx:
 		err = apply.AssetFreeze(tx.AssetFreezeTxnFields, tx.Header, balances, spec, &ad)
 
+	case protocol.ApplicationCallTx:
+		err = apply.ApplicationCall(tx.ApplicationCallTxnFields, tx.Header, balances, &ad, ep, al.cow.txnCounter())
+
 	default:
 		err = fmt.Errorf("%s tx in AVM", tx.Type)
 	}
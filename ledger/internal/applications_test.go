@@ -97,6 +97,22 @@ func (c *mockCowForLogicLedger) DelKey(addr basics.Address, aidx basics.AppIndex
 	return nil
 }
 
+func (c *mockCowForLogicLedger) NewBox(appIdx basics.AppIndex, name string, value []byte, appAddr basics.Address) error {
+	return fmt.Errorf("boxes are not supported by the mock cow")
+}
+
+func (c *mockCowForLogicLedger) GetBox(appIdx basics.AppIndex, name string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
+func (c *mockCowForLogicLedger) SetBox(appIdx basics.AppIndex, name string, value []byte) error {
+	return fmt.Errorf("boxes are not supported by the mock cow")
+}
+
+func (c *mockCowForLogicLedger) DelBox(appIdx basics.AppIndex, name string, appAddr basics.Address) (bool, error) {
+	return false, nil
+}
+
 func (c *mockCowForLogicLedger) round() basics.Round {
 	return c.rnd
 }
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+func (cb *roundCowState) kvGet(key string) ([]byte, bool, error) {
+	value, ok := cb.mods.KvMods[key]
+	if ok {
+		return value.Data, value.Data != nil, nil
+	}
+	return cb.lookupParent.kvGet(key)
+}
+
+func (cb *roundCowState) kvPut(key string, value []byte) {
+	cb.mods.KvMods[key] = ledgercore.KvValueDelta{Data: value}
+}
+
+func (cb *roundCowState) kvDel(key string) {
+	cb.mods.KvMods[key] = ledgercore.KvValueDelta{Data: nil}
+}
+
+// NewBox creates the box name of application appIdx with the given
+// contents, charging its MinBalance to the application account appAddr.
+func (cb *roundCowState) NewBox(appIdx basics.AppIndex, name string, value []byte, appAddr basics.Address) error {
+	key := ledgercore.MakeBoxKey(appIdx, name)
+	_, exists, err := cb.kvGet(key)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("attempt to recreate box %#x of app %d", name, appIdx)
+	}
+
+	record, err := cb.Get(appAddr, false)
+	if err != nil {
+		return err
+	}
+	record.TotalBoxes = basics.AddSaturate(record.TotalBoxes, 1)
+	record.TotalBoxBytes = basics.AddSaturate(record.TotalBoxBytes, uint64(len(name)+len(value)))
+	err = cb.Put(appAddr, record)
+	if err != nil {
+		return err
+	}
+
+	// copy, so that the caller cannot modify the stored value
+	cb.kvPut(key, append(make([]byte, 0, len(value)), value...))
+	return nil
+}
+
+// GetBox returns the contents of the box name of application appIdx, and
+// whether the box exists.
+func (cb *roundCowState) GetBox(appIdx basics.AppIndex, name string) ([]byte, bool, error) {
+	return cb.kvGet(ledgercore.MakeBoxKey(appIdx, name))
+}
+
+// SetBox replaces the contents of the existing box name of application
+// appIdx. Boxes cannot change size, so value must be as long as the box.
+func (cb *roundCowState) SetBox(appIdx basics.AppIndex, name string, value []byte) error {
+	key := ledgercore.MakeBoxKey(appIdx, name)
+	old, exists, err := cb.kvGet(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("box %#x of app %d does not exist", name, appIdx)
+	}
+	if len(old) != len(value) {
+		return fmt.Errorf("box %#x of app %d is %d bytes, cannot set %d bytes", name, appIdx, len(old), len(value))
+	}
+	cb.kvPut(key, append(make([]byte, 0, len(value)), value...))
+	return nil
+}
+
+// DelBox deletes the box name of application appIdx, releasing its
+// MinBalance from the application account appAddr. It reports whether the
+// box existed.
+func (cb *roundCowState) DelBox(appIdx basics.AppIndex, name string, appAddr basics.Address) (bool, error) {
+	key := ledgercore.MakeBoxKey(appIdx, name)
+	value, exists, err := cb.kvGet(key)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+
+	record, err := cb.Get(appAddr, false)
+	if err != nil {
+		return false, err
+	}
+	size := uint64(len(name) + len(value))
+	if record.TotalBoxes == 0 || record.TotalBoxBytes < size {
+		return false, fmt.Errorf("inconsistent box totals for app %d: %d boxes, %d bytes", appIdx, record.TotalBoxes, record.TotalBoxBytes)
+	}
+	record.TotalBoxes--
+	record.TotalBoxBytes -= size
+	err = cb.Put(appAddr, record)
+	if err != nil {
+		return false, err
+	}
+
+	cb.kvDel(key)
+	return true, nil
+}
@@ -50,6 +50,7 @@ type roundCowParent interface {
 	getStorageLimits(addr basics.Address, aidx basics.AppIndex, global bool) (basics.StateSchema, error)
 	allocated(addr basics.Address, aidx basics.AppIndex, global bool) (bool, error)
 	getKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, accountIdx uint64) (basics.TealValue, bool, error)
+	kvGet(key string) ([]byte, bool, error)
 }
 
 type roundCowState struct {
@@ -286,6 +287,9 @@ func (cb *roundCowState) commitToParent() {
 	for index, created := range cb.mods.ModifiedAppLocalStates {
 		cb.commitParent.mods.ModifiedAppLocalStates[index] = created
 	}
+	for key, value := range cb.mods.KvMods {
+		cb.commitParent.mods.KvMods[key] = value
+	}
 }
 
 func (cb *roundCowState) modifiedAccounts() []basics.Address {
@@ -73,6 +73,10 @@ func (ml *mockLedger) getKey(addr basics.Address, aidx basics.AppIndex, global b
 	return basics.TealValue{}, false, nil
 }
 
+func (ml *mockLedger) kvGet(key string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
 func (ml *mockLedger) txnCounter() uint64 {
 	return 0
 }
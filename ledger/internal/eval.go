@@ -43,6 +43,7 @@ type LedgerForCowBase interface {
 	CheckDup(config.ConsensusParams, basics.Round, basics.Round, basics.Round, transactions.Txid, ledgercore.Txlease) error
 	LookupWithoutRewards(basics.Round, basics.Address) (basics.AccountData, basics.Round, error)
 	GetCreatorForRound(basics.Round, basics.CreatableIndex, basics.CreatableType) (basics.Address, bool, error)
+	LookupKv(basics.Round, string) ([]byte, error)
 }
 
 // ErrRoundZero is self-explanatory
@@ -99,6 +100,9 @@ type roundCowBase struct {
 
 	// Similar cache for asset/app creators.
 	creators map[creatable]foundAddress
+
+	// Similar cache for the key/value store; a nil value is a missing key.
+	kvStore map[string][]byte
 }
 
 func makeRoundCowBase(l LedgerForCowBase, rnd basics.Round, txnCount uint64, compactCertNextRnd basics.Round, proto config.ConsensusParams) *roundCowBase {
@@ -110,6 +114,7 @@ func makeRoundCowBase(l LedgerForCowBase, rnd basics.Round, txnCount uint64, com
 		proto:              proto,
 		accounts:           make(map[basics.Address]basics.AccountData),
 		creators:           make(map[creatable]foundAddress),
+		kvStore:            make(map[string][]byte),
 	}
 }
 
@@ -145,6 +150,19 @@ func (x *roundCowBase) lookup(addr basics.Address) (basics.AccountData, error) {
 	return accountData, err
 }
 
+func (x *roundCowBase) kvGet(key string) ([]byte, bool, error) {
+	if value, found := x.kvStore[key]; found {
+		return value, value != nil, nil
+	}
+
+	value, err := x.l.LookupKv(x.rnd, key)
+	if err != nil {
+		return nil, false, err
+	}
+	x.kvStore[key] = value
+	return value, value != nil, nil
+}
+
 func (x *roundCowBase) checkDup(firstValid, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
 	return x.l.CheckDup(x.proto, x.rnd+1, firstValid, lastValid, txid, txl)
 }
@@ -725,6 +743,7 @@ func (eval *BlockEvaluator) prepareEvalParams(txgroup []transactions.SignedTxnWi
 	var pastSideEffects []logic.EvalSideEffects
 	var minTealVersion uint64
 	pooledApplicationBudget := uint64(0)
+	var budgetAdded uint64
 	var credit uint64
 	res := make([]*logic.EvalParams, len(txgroup))
 	for i, txn := range txgroup {
@@ -758,6 +777,7 @@ func (eval *BlockEvaluator) prepareEvalParams(txgroup []transactions.SignedTxnWi
 			PastSideEffects:         pastSideEffects,
 			MinTealVersion:          &minTealVersion,
 			PooledApplicationBudget: &pooledApplicationBudget,
+			BudgetAdded:             &budgetAdded,
 			FeeCredit:               &credit,
 			Specials:                &eval.specials,
 		}
@@ -769,13 +789,32 @@ func (eval *BlockEvaluator) prepareEvalParams(txgroup []transactions.SignedTxnWi
 // If the transaction group cannot be added to the block without violating some constraints,
 // an error is returned and the block evaluator state is unchanged.
 func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWithAD) error {
+	_, err := eval.TransactionGroupWithIndex(txgroup)
+	return err
+}
+
+// TransactionGroupWithIndex is like TransactionGroup, but on failure it also
+// returns the index within the group of the transaction that caused the
+// failure. The returned index is -1 if the group as a whole was rejected
+// (e.g. because of its size or an inconsistent group ID) rather than one of
+// its transactions.
+func (eval *BlockEvaluator) TransactionGroupWithIndex(txgroup []transactions.SignedTxnWithAD) (int, error) {
+	_, failedAt, err := eval.TransactionGroupWithApplyData(txgroup)
+	return failedAt, err
+}
+
+// TransactionGroupWithApplyData is like TransactionGroupWithIndex, but it also
+// returns the transactions that were evaluated, with their ApplyData. On failure,
+// these are the transactions evaluated before the failure, which are not added
+// to the block.
+func (eval *BlockEvaluator) TransactionGroupWithApplyData(txgroup []transactions.SignedTxnWithAD) ([]transactions.SignedTxnInBlock, int, error) {
 	// Nothing to do if there are no transactions.
 	if len(txgroup) == 0 {
-		return nil
+		return nil, -1, nil
 	}
 
 	if len(txgroup) > eval.proto.MaxTxGroupSize {
-		return fmt.Errorf("group size %d exceeds maximum %d", len(txgroup), eval.proto.MaxTxGroupSize)
+		return nil, -1, fmt.Errorf("group size %d exceeds maximum %d", len(txgroup), eval.proto.MaxTxGroupSize)
 	}
 
 	var txibs []transactions.SignedTxnInBlock
@@ -793,7 +832,7 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 		cow.setGroupIdx(gi)
 		err := eval.transaction(txad.SignedTxn, evalParams[gi], txad.ApplyData, cow, &txib)
 		if err != nil {
-			return err
+			return txibs, gi, err
 		}
 
 		txibs = append(txibs, txib)
@@ -801,13 +840,13 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 		if eval.validate {
 			groupTxBytes += txib.GetEncodedLength()
 			if eval.blockTxBytes+groupTxBytes > eval.maxTxnBytesPerBlock {
-				return ledgercore.ErrNoSpace
+				return txibs, -1, ledgercore.ErrNoSpace
 			}
 		}
 
 		// Make sure all transactions in group have the same group value
 		if txad.SignedTxn.Txn.Group != txgroup[0].SignedTxn.Txn.Group {
-			return fmt.Errorf("transactionGroup: inconsistent group values: %v != %v",
+			return txibs, gi, fmt.Errorf("transactionGroup: inconsistent group values: %v != %v",
 				txad.SignedTxn.Txn.Group, txgroup[0].SignedTxn.Txn.Group)
 		}
 
@@ -817,14 +856,14 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 
 			group.TxGroupHashes = append(group.TxGroupHashes, crypto.HashObj(txWithoutGroup))
 		} else if len(txgroup) > 1 {
-			return fmt.Errorf("transactionGroup: [%d] had zero Group but was submitted in a group of %d", gi, len(txgroup))
+			return txibs, gi, fmt.Errorf("transactionGroup: [%d] had zero Group but was submitted in a group of %d", gi, len(txgroup))
 		}
 	}
 
 	// If we had a non-zero Group value, check that all group members are present.
 	if group.TxGroupHashes != nil {
 		if txgroup[0].SignedTxn.Txn.Group != crypto.HashObj(group) {
-			return fmt.Errorf("transactionGroup: incomplete group: %v != %v (%v)",
+			return txibs, -1, fmt.Errorf("transactionGroup: incomplete group: %v != %v (%v)",
 				txgroup[0].SignedTxn.Txn.Group, crypto.HashObj(group), group)
 		}
 	}
@@ -833,7 +872,7 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 	eval.blockTxBytes += groupTxBytes
 	cow.commitToParent()
 
-	return nil
+	return txibs, -1, nil
 }
 
 // Check the minimum balance requirement for the modified accounts in `cow`.
@@ -953,10 +992,13 @@ func (eval *BlockEvaluator) transaction(txn transactions.SignedTxn, evalParams *
 		}
 	}
 
-	// We are not allowing InnerTxns to have InnerTxns yet.  Error if that happens.
-	for _, itx := range applyData.EvalDelta.InnerTxns {
-		if len(itx.ApplyData.EvalDelta.InnerTxns) > 0 {
-			return fmt.Errorf("inner transaction has inner transactions %v", itx)
+	// Unless apps may call other apps, InnerTxns can not have InnerTxns.
+	// Error if that happens.
+	if eval.proto.MaxAppCallDepth == 0 {
+		for _, itx := range applyData.EvalDelta.InnerTxns {
+			if len(itx.ApplyData.EvalDelta.InnerTxns) > 0 {
+				return fmt.Errorf("inner transaction has inner transactions %v", itx)
+			}
 		}
 	}
 
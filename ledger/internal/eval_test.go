@@ -488,6 +488,12 @@ func (ledger *evalTestLedger) GetCreatorForRound(rnd basics.Round, cidx basics.C
 	return basics.Address{}, false, nil
 }
 
+// LookupKv returns the value of key in the key/value store; the test ledger
+// has no boxes.
+func (ledger *evalTestLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return nil, nil
+}
+
 // LatestTotals returns the totals of all accounts for the most recent round, as well as the round number.
 func (ledger *evalTestLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
 	return basics.Round(len(ledger.blocks)).SubSaturate(1), ledger.latestTotals, nil
@@ -664,6 +670,10 @@ func (l *testCowBaseLedger) GetCreatorForRound(_ basics.Round, cindex basics.Cre
 	return res.address, res.exists, nil
 }
 
+func (l *testCowBaseLedger) LookupKv(basics.Round, string) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
 func TestCowBaseCreatorsCache(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -75,12 +76,14 @@ type Ledger struct {
 	genesisProto config.ConsensusParams
 
 	// State-machine trackers
-	accts      accountUpdates
-	catchpoint catchpointTracker
-	txTail     txTail
-	bulletin   bulletin
-	notifier   blockNotifier
-	metrics    metricsTracker
+	accts       accountUpdates
+	acctHistory accountHistory
+	catchpoint  catchpointTracker
+	txTail      txTail
+	deltaTail   deltaTail
+	bulletin    bulletin
+	notifier    blockNotifier
+	metrics     metricsTracker
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
@@ -155,6 +158,7 @@ func OpenLedger(
 	}
 
 	l.accts.initialize(cfg)
+	l.acctHistory.enabled = cfg.Archival && cfg.EnableAccountHistory
 	l.catchpoint.initialize(cfg, dbPathPrefix)
 
 	err = l.reloadLedger()
@@ -197,12 +201,14 @@ func (l *Ledger) reloadLedger() error {
 
 	// set account updates tracker as a driver to calculate tracker db round and committing offsets
 	trackers := []ledgerTracker{
-		&l.accts,      // update the balances
-		&l.catchpoint, // catchpoints tracker : update catchpoint labels, create catchpoint files
-		&l.txTail,     // update the transaction tail, tracking the recent 1000 txn
-		&l.bulletin,   // provide closed channel signaling support for completed rounds
-		&l.notifier,   // send OnNewBlocks to subscribers
-		&l.metrics,    // provides metrics reporting support
+		&l.accts,       // update the balances
+		&l.acctHistory, // persist the balances of every round, if enabled
+		&l.catchpoint,  // catchpoints tracker : update catchpoint labels, create catchpoint files
+		&l.txTail,      // update the transaction tail, tracking the recent 1000 txn
+		&l.deltaTail,   // keep the state deltas of the most recent rounds
+		&l.bulletin,    // provide closed channel signaling support for completed rounds
+		&l.notifier,    // send OnNewBlocks to subscribers
+		&l.metrics,     // provides metrics reporting support
 	}
 
 	err = l.trackers.initialize(l, trackers, l.cfg)
@@ -430,6 +436,23 @@ func (l *Ledger) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableTy
 	return l.accts.GetCreatorForRound(l.blockQ.latest(), cidx, ctype)
 }
 
+// LookupKv returns the value of key in the ledger's key/value store at round
+// rnd. A nil value means that the key does not exist.
+func (l *Ledger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.LookupKv(rnd, key)
+}
+
+// LookupKeysByPrefix returns up to maxKeys keys of the ledger's key/value
+// store that start with prefix at round rnd, in ascending order. A maxKeys of
+// zero means no limit.
+func (l *Ledger) LookupKeysByPrefix(rnd basics.Round, prefix string, maxKeys uint64) ([]string, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.LookupKeysByPrefix(rnd, prefix, maxKeys)
+}
+
 // CompactCertVoters returns the top online accounts at round rnd.
 // The result might be nil, even with err=nil, if there are no voters
 // for that round because compact certs were not enabled.
@@ -466,6 +489,10 @@ func (l *Ledger) Lookup(rnd basics.Round, addr basics.Address) (basics.AccountDa
 
 	// Intentionally apply (pending) rewards up to rnd.
 	data, err := l.accts.LookupWithRewards(rnd, addr)
+	var roundOffsetErr *RoundOffsetError
+	if errors.As(err, &roundOffsetErr) && l.acctHistory.enabled {
+		return l.lookupHistory(rnd, addr)
+	}
 	if err != nil {
 		return basics.AccountData{}, err
 	}
@@ -473,6 +500,21 @@ func (l *Ledger) Lookup(rnd basics.Round, addr basics.Address) (basics.AccountDa
 	return data, nil
 }
 
+// lookupHistory looks up the account data of a round older than the
+// accounts kept in memory from the account history, applying the rewards
+// pending at that round like Lookup does.
+func (l *Ledger) lookupHistory(rnd basics.Round, addr basics.Address) (basics.AccountData, error) {
+	data, err := l.acctHistory.lookup(rnd, addr)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	hdr, err := l.BlockHdr(rnd)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	return data.WithUpdatedRewards(config.Consensus[hdr.CurrentProtocol], hdr.RewardsLevel), nil
+}
+
 // LookupAgreement returns account data used by agreement.
 func (l *Ledger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
 	l.trackerMu.RLock()
@@ -494,6 +536,11 @@ func (l *Ledger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ba
 	defer l.trackerMu.RUnlock()
 
 	data, validThrough, err := l.accts.LookupWithoutRewards(rnd, addr)
+	var roundOffsetErr *RoundOffsetError
+	if errors.As(err, &roundOffsetErr) && l.acctHistory.enabled {
+		data, err = l.acctHistory.lookup(rnd, addr)
+		validThrough = rnd
+	}
 	if err != nil {
 		return basics.AccountData{}, basics.Round(0), err
 	}
@@ -526,6 +573,15 @@ func (l *Ledger) CheckDup(currentProto config.ConsensusParams, current basics.Ro
 	return l.txTail.checkDup(currentProto, current, firstValid, lastValid, txid, txl)
 }
 
+// GetStateDeltaForRound returns the state delta produced by the block of
+// round rnd. Only the most recent rounds are kept in memory; older rounds
+// yield a ledgercore.ErrNoEntry error.
+func (l *Ledger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.deltaTail.getDelta(rnd)
+}
+
 // Latest returns the latest known block round added to the ledger.
 func (l *Ledger) Latest() basics.Round {
 	return l.blockQ.latest()
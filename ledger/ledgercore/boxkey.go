@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// boxPrefix is the prefix of all box keys in the key/value store
+const boxPrefix = "bx:"
+
+// boxPrefixLength is the length of the box key prefix up to the app id
+const boxPrefixLength = len(boxPrefix) + 8
+
+// MakeBoxKey returns the key/value store key of the box named name, owned by
+// application appIdx.
+func MakeBoxKey(appIdx basics.AppIndex, name string) string {
+	return BoxKeyPrefix(appIdx) + name
+}
+
+// BoxKeyPrefix returns the prefix shared by the keys of all the boxes of
+// application appIdx.
+func BoxKeyPrefix(appIdx basics.AppIndex) string {
+	var key [boxPrefixLength]byte
+	copy(key[:], boxPrefix)
+	binary.BigEndian.PutUint64(key[len(boxPrefix):], uint64(appIdx))
+	return string(key[:])
+}
+
+// SplitBoxKey is the inverse of MakeBoxKey.
+func SplitBoxKey(key string) (basics.AppIndex, string, error) {
+	if len(key) < boxPrefixLength || !strings.HasPrefix(key, boxPrefix) {
+		return 0, "", fmt.Errorf("%q is not a box key", key)
+	}
+	appIdx := binary.BigEndian.Uint64([]byte(key[len(boxPrefix):boxPrefixLength]))
+	return basics.AppIndex(appIdx), key[boxPrefixLength:], nil
+}
@@ -59,6 +59,12 @@ type AccountApp struct {
 	App     basics.AppIndex
 }
 
+// KvValueDelta holds the new value of a key in the ledger's key/value store.
+// A nil Data means that the key was deleted.
+type KvValueDelta struct {
+	Data []byte
+}
+
 // A Txlease is a transaction (sender, lease) pair which uniquely specifies a
 // transaction lease.
 type Txlease struct {
@@ -95,6 +101,9 @@ type StateDelta struct {
 	ModifiedAssetHoldings  map[AccountAsset]bool
 	ModifiedAppLocalStates map[AccountApp]bool
 
+	// new values of modified keys in the key/value store (application boxes)
+	KvMods map[string]KvValueDelta
+
 	// initial hint for allocating data structures for StateDelta
 	initialTransactionsCount int
 
@@ -129,6 +138,7 @@ func MakeStateDelta(hdr *bookkeeping.BlockHeader, prevTimestamp int64, hint int,
 		PrevTimestamp:            prevTimestamp,
 		ModifiedAssetHoldings:    make(map[AccountAsset]bool),
 		ModifiedAppLocalStates:   make(map[AccountApp]bool),
+		KvMods:                   make(map[string]KvValueDelta),
 		initialTransactionsCount: hint,
 	}
 }
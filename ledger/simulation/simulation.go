@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/internal"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// LedgerForSimulator is the ledger interface needed by the Simulator.
+// data.Ledger and ledger.Ledger both implement it.
+type LedgerForSimulator interface {
+	Latest() basics.Round
+	BlockHdr(basics.Round) (bookkeeping.BlockHeader, error)
+	StartEvaluator(hdr bookkeeping.BlockHeader, paysetHint, maxTxnBytesPerBlock int) (*internal.BlockEvaluator, error)
+}
+
+// ErrEmptyGroup is returned when asked to simulate an empty transaction group.
+var ErrEmptyGroup = errors.New("simulation: empty transaction group")
+
+// TxnResult is the outcome of simulating a single transaction of a group.
+type TxnResult struct {
+	// Txn is the simulated transaction. If the transaction was applied,
+	// ApplyData holds its effects, including logs and inner transactions.
+	Txn transactions.SignedTxnWithAD
+
+	// Passed is true if the transaction was evaluated successfully. It is
+	// false for the failing transaction and for all the transactions that
+	// were not reached because of an earlier failure.
+	Passed bool
+
+	// MissingSignature is true if the transaction carried no signature and
+	// its signature check was skipped.
+	MissingSignature bool
+}
+
+// Result is the outcome of simulating a transaction group.
+type Result struct {
+	// Round is the last round of the ledger the group was simulated on top of.
+	Round basics.Round
+
+	// WouldSucceed is true if the group would be accepted by the ledger,
+	// assuming all missing signatures are supplied.
+	WouldSucceed bool
+
+	// FailedAt is the index within the group of the transaction that
+	// failed, or -1 if the group succeeded or was rejected as a whole.
+	FailedAt int
+
+	// FailureMessage describes why the group would be rejected.
+	FailureMessage string
+
+	// TxnResults holds one entry per transaction of the simulated group.
+	TxnResults []TxnResult
+
+	// Delta is the state delta the group would produce, including the
+	// end-of-block changes. It is only set if WouldSucceed is true.
+	Delta ledgercore.StateDelta
+}
+
+// Simulator evaluates transaction groups against the latest ledger state
+// without committing their effects.
+type Simulator struct {
+	ledger LedgerForSimulator
+}
+
+// MakeSimulator creates a Simulator on top of the given ledger.
+func MakeSimulator(ledger LedgerForSimulator) *Simulator {
+	return &Simulator{ledger: ledger}
+}
+
+// txnHasNoSignature returns true if the transaction is not signed in any way.
+func txnHasNoSignature(txn *transactions.SignedTxn) bool {
+	return txn.Sig == (crypto.Signature{}) && txn.Msig.Blank() && txn.Lsig.Blank()
+}
+
+// Simulate evaluates txgroup as if it was the only group in the block following
+// the latest round. Evaluation failures are reported in the returned Result; an
+// error is only returned if the simulation itself could not be carried out.
+// If allowEmptySignatures is set, transactions with no signature at all are
+// evaluated as if they were correctly signed.
+func (s *Simulator) Simulate(txgroup []transactions.SignedTxn, allowEmptySignatures bool) (Result, error) {
+	if len(txgroup) == 0 {
+		return Result{}, ErrEmptyGroup
+	}
+
+	latest := s.ledger.Latest()
+	prevHdr, err := s.ledger.BlockHdr(latest)
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation: unable to fetch header for round %d: %w", latest, err)
+	}
+	hdr := bookkeeping.MakeBlock(prevHdr).BlockHeader
+
+	result := Result{
+		Round:      latest,
+		FailedAt:   -1,
+		TxnResults: make([]TxnResult, len(txgroup)),
+	}
+	for i := range txgroup {
+		result.TxnResults[i].Txn.SignedTxn = txgroup[i]
+	}
+
+	failedAt, err := s.checkSignatures(txgroup, hdr, allowEmptySignatures, result.TxnResults)
+	if err != nil {
+		result.FailedAt = failedAt
+		result.FailureMessage = err.Error()
+		return result, nil
+	}
+
+	eval, err := s.ledger.StartEvaluator(hdr, len(txgroup), 0)
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation: unable to start evaluator for round %d: %w", hdr.Round, err)
+	}
+
+	txibs, failedAt, err := eval.TransactionGroupWithApplyData(transactions.WrapSignedTxnsWithAD(txgroup))
+	if err != nil {
+		result.FailedAt = failedAt
+		result.FailureMessage = err.Error()
+		// report the effects of the transactions evaluated before the failure
+		for i := range txibs {
+			result.TxnResults[i].Txn.ApplyData = txibs[i].ApplyData
+		}
+		for i := 0; i < failedAt; i++ {
+			result.TxnResults[i].Passed = true
+		}
+		return result, nil
+	}
+
+	vb, err := eval.GenerateBlock()
+	if err != nil {
+		result.FailureMessage = err.Error()
+		return result, nil
+	}
+
+	payset, err := vb.Block().DecodePaysetFlat()
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation: unable to decode simulated payset: %w", err)
+	}
+	for i := range result.TxnResults {
+		result.TxnResults[i].Txn.ApplyData = payset[i].ApplyData
+		result.TxnResults[i].Passed = true
+	}
+	result.WouldSucceed = true
+	result.Delta = vb.Delta()
+	return result, nil
+}
+
+// checkSignatures performs the stateless verification of txgroup, skipping the
+// signature check of unsigned transactions if allowEmptySignatures is set. On
+// failure, it returns the index of the offending transaction, or -1 if the
+// failure concerns the group as a whole.
+func (s *Simulator) checkSignatures(txgroup []transactions.SignedTxn, hdr bookkeeping.BlockHeader, allowEmptySignatures bool, results []TxnResult) (int, error) {
+	proto, ok := config.Consensus[hdr.CurrentProtocol]
+	if !ok {
+		return -1, protocol.Error(hdr.CurrentProtocol)
+	}
+	specials := transactions.SpecialAddresses{
+		FeeSink:     hdr.FeeSink,
+		RewardsPool: hdr.RewardsPool,
+	}
+
+	groupCtx, err := verify.PrepareGroupContext(txgroup, hdr)
+	if err != nil {
+		return -1, err
+	}
+
+	for i := range txgroup {
+		if allowEmptySignatures && txnHasNoSignature(&txgroup[i]) {
+			results[i].MissingSignature = true
+			err = txgroup[i].Txn.WellFormed(specials, proto)
+		} else {
+			err = verify.Txn(&txgroup[i], i, groupCtx)
+		}
+		if err != nil {
+			return i, fmt.Errorf("transaction %v invalid : %w", txgroup[i].ID(), err)
+		}
+	}
+
+	if _, err = transactions.FeeCredit(txgroup, proto.MinTxnFee); err != nil {
+		return -1, err
+	}
+	return -1, nil
+}
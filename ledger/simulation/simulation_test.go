@@ -0,0 +1,237 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func prepareSimulatorTest(t *testing.T) (*ledger.Ledger, []basics.Address, []*crypto.SignatureSecrets, transactions.Header) {
+	genesisInitState, addrs, keys := ledgertesting.Genesis(3)
+	l, err := ledger.OpenLedger(logging.TestingLog(t), t.Name(), true, genesisInitState, config.GetDefaultLocal())
+	require.NoError(t, err)
+
+	hdr := transactions.Header{
+		Fee:         basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+		FirstValid:  l.Latest(),
+		LastValid:   l.Latest() + 10,
+		GenesisID:   genesisInitState.Block.GenesisID(),
+		GenesisHash: genesisInitState.GenesisHash,
+	}
+	return l, addrs, keys, hdr
+}
+
+func makePayment(hdr transactions.Header, from, to basics.Address, amount uint64) transactions.Transaction {
+	hdr.Sender = from
+	return transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: hdr,
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: to,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+// signGroup groups txns and signs each of them with the key of the same index
+func signGroup(txns []transactions.Transaction, keys []*crypto.SignatureSecrets) []transactions.SignedTxn {
+	var group transactions.TxGroup
+	for _, txn := range txns {
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.HashObj(txn))
+	}
+	stxns := make([]transactions.SignedTxn, len(txns))
+	for i := range txns {
+		txns[i].Group = crypto.HashObj(group)
+		stxns[i] = txns[i].Sign(keys[i])
+	}
+	return stxns
+}
+
+func TestSimulatePayment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, addrs, keys, hdr := prepareSimulatorTest(t)
+	defer l.Close()
+
+	pay := makePayment(hdr, addrs[0], addrs[1], 1000)
+	res, err := MakeSimulator(l).Simulate([]transactions.SignedTxn{pay.Sign(keys[0])}, false)
+	require.NoError(t, err)
+	require.True(t, res.WouldSucceed)
+	require.Equal(t, -1, res.FailedAt)
+	require.Empty(t, res.FailureMessage)
+	require.Equal(t, l.Latest(), res.Round)
+	require.Len(t, res.TxnResults, 1)
+	require.True(t, res.TxnResults[0].Passed)
+	require.False(t, res.TxnResults[0].MissingSignature)
+
+	receiver, ok := res.Delta.Accts.Get(addrs[1])
+	require.True(t, ok)
+	before, err := l.Lookup(l.Latest(), addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, before.MicroAlgos.Raw+1000, receiver.MicroAlgos.Raw)
+
+	// nothing was committed
+	after, err := l.Lookup(l.Latest(), addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+}
+
+func TestSimulateFailureIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, addrs, keys, hdr := prepareSimulatorTest(t)
+	defer l.Close()
+
+	balance, err := l.Lookup(l.Latest(), addrs[2])
+	require.NoError(t, err)
+
+	txns := []transactions.Transaction{
+		makePayment(hdr, addrs[0], addrs[1], 1000),
+		makePayment(hdr, addrs[1], addrs[0], 1000),
+		makePayment(hdr, addrs[2], addrs[0], balance.MicroAlgos.Raw+1),
+	}
+	stxns := signGroup(txns, keys)
+
+	res, err := MakeSimulator(l).Simulate(stxns, false)
+	require.NoError(t, err)
+	require.False(t, res.WouldSucceed)
+	require.Equal(t, 2, res.FailedAt)
+	require.Contains(t, res.FailureMessage, "overspend")
+	require.True(t, res.TxnResults[0].Passed)
+	require.True(t, res.TxnResults[1].Passed)
+	require.False(t, res.TxnResults[2].Passed)
+	require.Zero(t, res.Delta.Accts.Len())
+}
+
+func TestSimulateMissingSignature(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, addrs, keys, hdr := prepareSimulatorTest(t)
+	defer l.Close()
+
+	signed := makePayment(hdr, addrs[0], addrs[1], 1000).Sign(keys[0])
+	unsigned := transactions.SignedTxn{Txn: makePayment(hdr, addrs[1], addrs[2], 1000)}
+
+	s := MakeSimulator(l)
+	res, err := s.Simulate([]transactions.SignedTxn{unsigned}, false)
+	require.NoError(t, err)
+	require.False(t, res.WouldSucceed)
+	require.Equal(t, 0, res.FailedAt)
+	require.Contains(t, res.FailureMessage, "signedtxn has no sig")
+
+	res, err = s.Simulate([]transactions.SignedTxn{unsigned}, true)
+	require.NoError(t, err)
+	require.True(t, res.WouldSucceed)
+	require.True(t, res.TxnResults[0].MissingSignature)
+
+	// a bad signature is never excused
+	badSig := signed
+	badSig.Sig[0]++
+	res, err = s.Simulate([]transactions.SignedTxn{badSig}, true)
+	require.NoError(t, err)
+	require.False(t, res.WouldSucceed)
+	require.Equal(t, 0, res.FailedAt)
+	require.False(t, res.TxnResults[0].MissingSignature)
+
+	_, err = s.Simulate(nil, true)
+	require.ErrorIs(t, err, ErrEmptyGroup)
+}
+
+func TestSimulateAppCallLogs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, addrs, keys, hdr := prepareSimulatorTest(t)
+	defer l.Close()
+
+	ops, err := logic.AssembleString("#pragma version 5\nbyte \"hello\"\nlog\nint 1")
+	require.NoError(t, err)
+	clear, err := logic.AssembleString("#pragma version 5\nint 1")
+	require.NoError(t, err)
+
+	hdr.Sender = addrs[0]
+	create := transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: hdr,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApprovalProgram:   ops.Program,
+			ClearStateProgram: clear.Program,
+		},
+	}
+
+	res, err := MakeSimulator(l).Simulate([]transactions.SignedTxn{create.Sign(keys[0])}, false)
+	require.NoError(t, err)
+	require.True(t, res.WouldSucceed, res.FailureMessage)
+	ad := res.TxnResults[0].Txn.ApplyData
+	require.Equal(t, []string{"hello"}, ad.EvalDelta.Logs)
+	require.NotZero(t, ad.ApplicationID)
+	require.Contains(t, res.Delta.Creatables, basics.CreatableIndex(ad.ApplicationID))
+}
+
+func TestSimulateFailedGroupLogs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, addrs, keys, hdr := prepareSimulatorTest(t)
+	defer l.Close()
+
+	ops, err := logic.AssembleString("#pragma version 5\nbyte \"hello\"\nlog\nint 1")
+	require.NoError(t, err)
+	clear, err := logic.AssembleString("#pragma version 5\nint 1")
+	require.NoError(t, err)
+
+	balance, err := l.Lookup(l.Latest(), addrs[2])
+	require.NoError(t, err)
+
+	appHdr := hdr
+	appHdr.Sender = addrs[0]
+	txns := []transactions.Transaction{
+		{
+			Type:   protocol.ApplicationCallTx,
+			Header: appHdr,
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApprovalProgram:   ops.Program,
+				ClearStateProgram: clear.Program,
+			},
+		},
+		makePayment(hdr, addrs[2], addrs[0], balance.MicroAlgos.Raw+1),
+	}
+	stxns := signGroup(txns, []*crypto.SignatureSecrets{keys[0], keys[2]})
+
+	// the app call passed before the payment failed, so its logs are reported
+	res, err := MakeSimulator(l).Simulate(stxns, false)
+	require.NoError(t, err)
+	require.False(t, res.WouldSucceed)
+	require.Equal(t, 1, res.FailedAt)
+	require.Contains(t, res.FailureMessage, "overspend")
+	require.True(t, res.TxnResults[0].Passed)
+	require.Equal(t, []string{"hello"}, res.TxnResults[0].Txn.ApplyData.EvalDelta.Logs)
+	require.NotZero(t, res.TxnResults[0].Txn.ApplyData.ApplicationID)
+	require.False(t, res.TxnResults[1].Passed)
+	require.Zero(t, res.Delta.Accts.Len())
+}
@@ -219,6 +219,7 @@ type deferredCommitContext struct {
 	roundTotals            ledgercore.AccountTotals
 	compactAccountDeltas   compactAccountDeltas
 	compactCreatableDeltas map[basics.CreatableIndex]ledgercore.ModifiedCreatable
+	compactKvDeltas        map[string]modifiedKvValue
 
 	updatedPersistedAccounts []persistedAccountData
 
@@ -157,6 +157,12 @@ func trackerDBInitializeImpl(ctx context.Context, tx *sql.Tx, params trackerDBPa
 					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 4 : %v", err)
 					return
 				}
+			case 5:
+				err = tu.upgradeDatabaseSchema5(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 5 : %v", err)
+					return
+				}
 			default:
 				return trackerDBInitParams{}, fmt.Errorf("trackerDBInitialize unable to upgrade database from schema version %d", tu.schemaVersion)
 			}
@@ -363,3 +369,15 @@ done:
 
 	return tu.setVersion(ctx, tx, 5)
 }
+
+// upgradeDatabaseSchema5 upgrades the database schema from version 5 to version 6,
+// adding the kvstore table that holds the application boxes.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema5(ctx context.Context, tx *sql.Tx) (err error) {
+	err = accountsCreateKvstore(tx)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema5 unable to create kvstore table : %v", err)
+	}
+
+	// update version
+	return tu.setVersion(ctx, tx, 6)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package libgoal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/abi"
+)
+
+// ErrNoABIReturn is returned when an application call did not log a method return value
+var ErrNoABIReturn = errors.New("application call did not log a return value")
+
+// ABIMethodReturn extracts the return value of an ARC-4 method call from the last log line of a
+// confirmed application call and decodes it as returnType. The raw encoding is returned as well,
+// including when it fails to decode.
+//
+// innerPath selects an inner transaction to read the return value from instead, which is how the
+// result of a method called by the application itself is found: each element indexes the inner
+// transactions of the transaction selected so far.
+func ABIMethodReturn(resp generatedV2.PendingTransactionResponse, returnType abi.Type, innerPath ...int) (value interface{}, raw []byte, err error) {
+	for depth, index := range innerPath {
+		if resp.InnerTxns == nil || index < 0 || index >= len(*resp.InnerTxns) {
+			return nil, nil, fmt.Errorf("no inner transaction %v", innerPath[:depth+1])
+		}
+		resp = (*resp.InnerTxns)[index]
+	}
+
+	if resp.Logs == nil || len(*resp.Logs) == 0 {
+		return nil, nil, ErrNoABIReturn
+	}
+	lastLog := (*resp.Logs)[len(*resp.Logs)-1]
+	if !bytes.HasPrefix(lastLog, abi.MethodReturnPrefix) {
+		return nil, nil, ErrNoABIReturn
+	}
+
+	raw = lastLog[len(abi.MethodReturnPrefix):]
+	value, err = returnType.Decode(raw)
+	if err != nil {
+		return nil, raw, err
+	}
+	return value, raw, nil
+}
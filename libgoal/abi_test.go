@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package libgoal
+
+import (
+	"testing"
+
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/abi"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestABIMethodReturn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	uint64Type, err := abi.TypeOf("uint64")
+	require.NoError(t, err)
+	stringType, err := abi.TypeOf("string")
+	require.NoError(t, err)
+
+	returnLog := func(value []byte) []byte {
+		return append(append([]byte{}, abi.MethodReturnPrefix...), value...)
+	}
+
+	inner := generatedV2.PendingTransactionResponse{
+		Logs: &[][]byte{returnLog([]byte{0, 2, 'h', 'i'})},
+	}
+	resp := generatedV2.PendingTransactionResponse{
+		Logs:      &[][]byte{[]byte("debug"), returnLog([]byte{0, 0, 0, 0, 0, 0, 0, 7})},
+		InnerTxns: &[]generatedV2.PendingTransactionResponse{{}, inner},
+	}
+
+	value, raw, err := ABIMethodReturn(resp, uint64Type)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), value)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 7}, raw)
+
+	value, _, err = ABIMethodReturn(resp, stringType, 1)
+	require.NoError(t, err)
+	require.Equal(t, "hi", value)
+
+	_, _, err = ABIMethodReturn(resp, stringType, 0)
+	require.Equal(t, ErrNoABIReturn, err)
+	_, _, err = ABIMethodReturn(resp, stringType, 2)
+	require.Error(t, err)
+	_, _, err = ABIMethodReturn(resp, stringType, 1, 0)
+	require.Error(t, err)
+
+	// the raw value is kept when it does not decode
+	_, raw, err = ABIMethodReturn(resp, stringType)
+	require.Error(t, err)
+	require.Len(t, raw, 8)
+
+	resp.Logs = &[][]byte{[]byte("no return")}
+	_, _, err = ABIMethodReturn(resp, uint64Type)
+	require.Equal(t, ErrNoABIReturn, err)
+}
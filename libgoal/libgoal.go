@@ -26,6 +26,7 @@ import (
 	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	kmdclient "github.com/algorand/go-algorand/daemon/kmd/client"
 	"github.com/algorand/go-algorand/rpcs"
 
@@ -664,6 +665,24 @@ func (c *Client) AccountInformationV2(account string) (resp generatedV2.Account,
 	return
 }
 
+// AccountAssetInformation takes an address and an asset index and returns the account's holding and parameters of the asset
+func (c *Client) AccountAssetInformation(account string, index uint64) (resp generatedV2.AccountAssetResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountAssetInformation(account, index)
+	}
+	return
+}
+
+// AccountApplicationInformation takes an address and an application index and returns the account's local state and parameters of the application
+func (c *Client) AccountApplicationInformation(account string, index uint64) (resp generatedV2.AccountApplicationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountApplicationInformation(account, index)
+	}
+	return
+}
+
 // AccountData takes an address and returns its basics.AccountData
 func (c *Client) AccountData(account string) (accountData basics.AccountData, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -729,6 +748,25 @@ func (c *Client) ApplicationInformation(index uint64) (resp generatedV2.Applicat
 	return
 }
 
+// ApplicationBoxes takes an app's index and returns the names of its boxes
+func (c *Client) ApplicationBoxes(index uint64, maxBoxNum uint64) (resp generatedV2.BoxesResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.ApplicationBoxes(index, maxBoxNum)
+	}
+	return
+}
+
+// GetApplicationBoxByName takes an app's index and a box name, in the goal
+// app call arg form "encoding:value", and returns the box's contents
+func (c *Client) GetApplicationBoxByName(index uint64, name string) (resp generatedV2.BoxResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.GetApplicationBoxByName(index, name)
+	}
+	return
+}
+
 // TransactionInformation takes an address and associated txid and return its information
 func (c *Client) TransactionInformation(addr, txid string) (resp v1.Transaction, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -776,6 +814,15 @@ func (c *Client) RawBlock(round uint64) (resp v1.RawBlock, err error) {
 	return
 }
 
+// RawLedgerStateDelta takes a round and returns the msgpack-encoded ledger state delta of that round
+func (c *Client) RawLedgerStateDelta(round uint64) (resp []byte, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.RawLedgerStateDelta(round)
+	}
+	return
+}
+
 // BookkeepingBlock takes a round and returns its block
 func (c *Client) BookkeepingBlock(round uint64) (block bookkeeping.Block, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -1003,6 +1050,30 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// TransactionPool returns the fee levels of the transaction pool and up to max of its pending
+// transaction groups, sorted by decreasing fee per byte.
+func (c *Client) TransactionPool(max uint64) (resp privateV2.TransactionPoolResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.TransactionPool(max)
+	}
+	return
+}
+
+// EvictPendingTransactions removes from the transaction pool the groups holding any of the given
+// transactions or any transaction sent by sender, and returns the number of transactions removed.
+func (c *Client) EvictPendingTransactions(txids []string, sender string) (evicted uint64, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return
+	}
+	resp, err := algod.EvictPendingTransactions(txids, sender)
+	if err != nil {
+		return
+	}
+	return resp.Evicted, nil
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
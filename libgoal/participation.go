@@ -165,7 +165,7 @@ func (c *Client) GenParticipationKeysTo(address string, firstValid, lastValid, k
 	}
 
 	// Fill the database with new participation keys
-	newPart, err := account.FillDBWithParticipationKeys(partdb, parsedAddr, firstRound, lastRound, keyDilution)
+	newPart, err := account.FillDBWithParticipationKeys(partdb, parsedAddr, firstRound, lastRound, keyDilution, proto.CompactCertRounds)
 	part = newPart.Participation
 	partdb.Close()
 	return part, partKeyPath, err
@@ -17,9 +17,23 @@
 package node
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrFollowModeEnabled is returned by operations that are not available when the node runs in follower mode
+var ErrFollowModeEnabled = errors.New("operation not available when the node is in follower mode")
+
+// ErrFollowModeDisabled is returned by operations that are only available when the node runs in follower mode
+var ErrFollowModeDisabled = errors.New("operation only available when the node is in follower mode")
+
+// ErrDevModeDisabled is returned by operations that are only available when the node runs a developer mode network
+var ErrDevModeDisabled = errors.New("operation only available when the node is in developer mode")
+
+// ErrInvalidTimeStampOffset is returned when a developer mode block timestamp offset is negative or exceeds the
+// maximum timestamp increment of the current protocol
+var ErrInvalidTimeStampOffset = errors.New("block timestamp offset must be between 0 and the protocol's maximum timestamp increment")
+
 // Catchpoint already in progress error
 
 // CatchpointAlreadyInProgressError indicates that the requested catchpoint is already running
@@ -19,15 +19,36 @@ package indexer
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
 )
 
 const (
 	dbName  = "indexer.sqlite"
 	maxRows = 100
+
+	// maxSearchRows caps the page size of SearchTransactions
+	maxSearchRows = 1000
+
+	// schemaVersion is bumped whenever the indexed columns change in a way
+	// that requires the existing rounds to be indexed again.
+	schemaVersion = 1
+)
+
+var (
+	// ErrInvalidNextToken is returned when a search is resumed with a malformed cursor
+	ErrInvalidNextToken = errors.New("invalid next token")
+	// ErrTransactionNotFound is returned when a transaction ID is not in the index
+	ErrTransactionNotFound = errors.New("transaction not found")
 )
 
 var schema = `
@@ -45,15 +66,48 @@ var schema = `
 		UNIQUE (k)
 	);
 
-	INSERT OR IGNORE INTO params (k, v) VALUES ('maxRound', 1);
+	INSERT OR IGNORE INTO params (k, v) VALUES ('maxRound', 0);
 
 	CREATE INDEX IF NOT EXISTS idx ON transactions (
 		created_at	DESC,
 		from_addr,
 		to_addr
 	);
+
+	CREATE TABLE IF NOT EXISTS txns(
+		round INTEGER NOT NULL,
+		intra INTEGER NOT NULL,
+		root INTEGER NOT NULL,
+		txid CHAR(52) NOT NULL,
+		txtype CHAR(6) NOT NULL,
+		sender CHAR(58) NOT NULL,
+		asset INTEGER DEFAULT NULL,
+		app INTEGER DEFAULT NULL,
+		note BLOB DEFAULT NULL,
+		round_time INTEGER NOT NULL,
+		PRIMARY KEY (round, intra)
+	);
+
+	CREATE INDEX IF NOT EXISTS txns_txid ON txns (txid);
+	CREATE INDEX IF NOT EXISTS txns_asset ON txns (asset, round) WHERE asset IS NOT NULL;
+	CREATE INDEX IF NOT EXISTS txns_app ON txns (app, round) WHERE app IS NOT NULL;
+	CREATE INDEX IF NOT EXISTS txns_type ON txns (txtype, round);
+	CREATE INDEX IF NOT EXISTS txns_time ON txns (round_time);
+
+	CREATE TABLE IF NOT EXISTS txn_participation(
+		addr CHAR(58) NOT NULL,
+		round INTEGER NOT NULL,
+		intra INTEGER NOT NULL,
+		PRIMARY KEY (addr, round, intra)
+	);
 `
 
+// The txns table holds one row per transaction, inner transactions included.
+// intra numbers the transactions of a round in evaluation order (an inner
+// transaction follows its parent), and root is the offset in the block payset
+// of the top-level transaction the row belongs to. txn_participation maps
+// every address a transaction touches to its (round, intra) row.
+
 // Transaction represents a transaction in the system
 type Transaction struct {
 	TXID      string
@@ -96,9 +150,44 @@ func MakeIndexerDB(dbPath string, inMemory bool) (*DB, error) {
 		return &DB{}, err
 	}
 
+	err = idb.migrate()
+	if err != nil {
+		return &DB{}, err
+	}
+
 	return idb, nil
 }
 
+// migrate resets a database written by an older schema, so that the indexer
+// starts over and fills the new tables for every round.
+func (idb *DB) migrate() error {
+	return idb.dbw.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var version uint64
+		err := tx.QueryRow("SELECT v FROM params WHERE k = 'schemaVersion'").Scan(&version)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == nil && version == schemaVersion {
+			return nil
+		}
+
+		for _, stmt := range []string{
+			"DELETE FROM transactions",
+			"DELETE FROM txns",
+			"DELETE FROM txn_participation",
+			"UPDATE params SET v = 0 WHERE k = 'maxRound'",
+		} {
+			_, err = tx.Exec(stmt)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec("INSERT OR REPLACE INTO params (k, v) VALUES ('schemaVersion', $1)", schemaVersion)
+		return err
+	})
+}
+
 // AddBlock takes an Algorand block and stores its transactions in the DB.
 func (idb *DB) AddBlock(b bookkeeping.Block) error {
 	err := idb.dbw.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -119,16 +208,47 @@ func (idb *DB) AddBlock(b bookkeeping.Block) error {
 		}
 		defer stmt.Close()
 
+		txnStmt, err := tx.Prepare("INSERT INTO txns (round, intra, root, txid, txtype, sender, asset, app, note, round_time) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);")
+		if err != nil {
+			return err
+		}
+		defer txnStmt.Close()
+
+		partStmt, err := tx.Prepare("INSERT OR IGNORE INTO txn_participation (addr, round, intra) VALUES($1, $2, $3);")
+		if err != nil {
+			return err
+		}
+		defer partStmt.Close()
+
 		payset, err := b.DecodePaysetFlat()
 		if err != nil {
 			return err
 		}
-		for _, txad := range payset {
+		w := blockWriter{
+			txnStmt:  txnStmt,
+			partStmt: partStmt,
+			round:    b.Round(),
+			time:     b.TimeStamp,
+		}
+		// Before inner transactions, the created asset and application IDs
+		// were not recorded in the ApplyData; derive them from the block
+		// transaction counter instead, which counts inner transactions too.
+		counter := b.TxnCounter
+		for i := range payset {
+			counter -= countTxns(&payset[i])
+		}
+		for i, txad := range payset {
 			txn := txad.SignedTxn
 			_, err = stmt.Exec(txn.ID().String(), txn.Txn.Sender.String(), txn.Txn.GetReceiverAddress().String(), b.Round(), b.TimeStamp)
 			if err != nil {
 				return err
 			}
+
+			err = w.write(&txad, uint64(i), counter+1)
+			if err != nil {
+				return err
+			}
+			counter += countTxns(&txad)
 		}
 
 		stmt2, err := tx.Prepare("UPDATE params SET v = $1 WHERE k = 'maxRound';")
@@ -148,6 +268,92 @@ func (idb *DB) AddBlock(b bookkeeping.Block) error {
 	return err
 }
 
+// blockWriter inserts the txns and txn_participation rows of a single block.
+type blockWriter struct {
+	txnStmt  *sql.Stmt
+	partStmt *sql.Stmt
+	round    basics.Round
+	time     int64
+	intra    uint64
+}
+
+// write indexes stxn and, recursively, its inner transactions. created is the
+// creatable ID stxn would allocate if it creates an asset or application and
+// the ApplyData does not say so; it is 0 for inner transactions.
+func (w *blockWriter) write(stxn *transactions.SignedTxnWithAD, root uint64, created uint64) error {
+	txn := &stxn.Txn
+	var asset, app uint64
+	switch txn.Type {
+	case protocol.AssetConfigTx:
+		asset = uint64(txn.ConfigAsset)
+		if asset == 0 {
+			asset = uint64(stxn.ApplyData.ConfigAsset)
+		}
+		if asset == 0 {
+			asset = created
+		}
+	case protocol.AssetTransferTx:
+		asset = uint64(txn.XferAsset)
+	case protocol.AssetFreezeTx:
+		asset = uint64(txn.FreezeAsset)
+	case protocol.ApplicationCallTx:
+		app = uint64(txn.ApplicationID)
+		if app == 0 {
+			app = uint64(stxn.ApplyData.ApplicationID)
+		}
+		if app == 0 {
+			app = created
+		}
+	}
+
+	var note []byte
+	if len(txn.Note) > 0 {
+		note = txn.Note
+	}
+
+	intra := w.intra
+	w.intra++
+	_, err := w.txnStmt.Exec(w.round, intra, root, stxn.ID().String(), string(txn.Type), txn.Sender.String(), nullIfZero(asset), nullIfZero(app), note, w.time)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range []basics.Address{txn.Sender, txn.Receiver, txn.CloseRemainderTo, txn.AssetSender, txn.AssetReceiver, txn.AssetCloseTo, txn.FreezeAccount} {
+		if addr.IsZero() {
+			continue
+		}
+		_, err = w.partStmt.Exec(addr.String(), w.round, intra)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range stxn.ApplyData.EvalDelta.InnerTxns {
+		err = w.write(&stxn.ApplyData.EvalDelta.InnerTxns[i], root, 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countTxns returns the number of transactions stxn adds to the transaction
+// counter: itself and, recursively, its inner transactions.
+func countTxns(stxn *transactions.SignedTxnWithAD) uint64 {
+	n := uint64(1)
+	for i := range stxn.ApplyData.EvalDelta.InnerTxns {
+		n += countTxns(&stxn.ApplyData.EvalDelta.InnerTxns[i])
+	}
+	return n
+}
+
+func nullIfZero(v uint64) interface{} {
+	if v == 0 {
+		return nil
+	}
+	return v
+}
+
 // GetTransactionByID takes a transaction ID and returns its transaction record
 func (idb *DB) GetTransactionByID(txid string) (Transaction, error) {
 	query := `
@@ -263,6 +469,168 @@ func (idb *DB) GetTransactionsRoundsByAddrAndDate(addr string, top uint64, from,
 	return rounds, nil
 }
 
+// TransactionFilter narrows down the transactions returned by SearchTransactions.
+// Zero-valued fields do not constrain the search.
+type TransactionFilter struct {
+	// Address matches transactions sending to, receiving from, closing to
+	// or freezing the address.
+	Address    string
+	AssetID    uint64
+	AppID      uint64
+	TxType     string
+	NotePrefix []byte
+
+	// MinRound and MaxRound are inclusive.
+	MinRound uint64
+	MaxRound uint64
+
+	// AfterTime and BeforeTime bound the block timestamp, as seconds from
+	// epoch. AfterTime is inclusive, BeforeTime exclusive.
+	AfterTime  int64
+	BeforeTime int64
+
+	// Limit is the page size; 0 means maxRows.
+	Limit uint64
+	// Next resumes a previous search from the token it returned.
+	Next string
+}
+
+// TransactionLocation points at a top-level transaction in a block.
+type TransactionLocation struct {
+	Round uint64
+	// Offset is the position of the transaction in the block payset.
+	Offset uint64
+}
+
+func encodeNextToken(loc TransactionLocation) string {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], loc.Round)
+	binary.BigEndian.PutUint64(buf[8:], loc.Offset)
+	return base64.RawURLEncoding.EncodeToString(buf[:])
+}
+
+func decodeNextToken(token string) (TransactionLocation, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) != 16 {
+		return TransactionLocation{}, ErrInvalidNextToken
+	}
+	return TransactionLocation{
+		Round:  binary.BigEndian.Uint64(buf[:8]),
+		Offset: binary.BigEndian.Uint64(buf[8:]),
+	}, nil
+}
+
+// SearchTransactions returns, in round order, the top-level transactions
+// that match the filter either themselves or through one of their inner
+// transactions. When the page is full, it also returns a token that resumes
+// the search after the last result.
+func (idb *DB) SearchTransactions(filter TransactionFilter) ([]TransactionLocation, string, error) {
+	var query strings.Builder
+	var conds []string
+	var args []interface{}
+
+	query.WriteString("SELECT t.round, t.root FROM txns t")
+	if filter.Address != "" {
+		query.WriteString(" JOIN txn_participation p ON p.round = t.round AND p.intra = t.intra")
+		conds = append(conds, "p.addr = ?")
+		args = append(args, filter.Address)
+	}
+	if filter.AssetID != 0 {
+		conds = append(conds, "t.asset = ?")
+		args = append(args, filter.AssetID)
+	}
+	if filter.AppID != 0 {
+		conds = append(conds, "t.app = ?")
+		args = append(args, filter.AppID)
+	}
+	if filter.TxType != "" {
+		conds = append(conds, "t.txtype = ?")
+		args = append(args, filter.TxType)
+	}
+	if len(filter.NotePrefix) > 0 {
+		conds = append(conds, "substr(t.note, 1, ?) = ?")
+		args = append(args, len(filter.NotePrefix), filter.NotePrefix)
+	}
+	if filter.MinRound != 0 {
+		conds = append(conds, "t.round >= ?")
+		args = append(args, filter.MinRound)
+	}
+	if filter.MaxRound != 0 {
+		conds = append(conds, "t.round <= ?")
+		args = append(args, filter.MaxRound)
+	}
+	if filter.AfterTime != 0 {
+		conds = append(conds, "t.round_time >= ?")
+		args = append(args, filter.AfterTime)
+	}
+	if filter.BeforeTime != 0 {
+		conds = append(conds, "t.round_time < ?")
+		args = append(args, filter.BeforeTime)
+	}
+	if filter.Next != "" {
+		after, err := decodeNextToken(filter.Next)
+		if err != nil {
+			return nil, "", err
+		}
+		conds = append(conds, "(t.round > ? OR (t.round = ? AND t.root > ?))")
+		args = append(args, after.Round, after.Round, after.Offset)
+	}
+	if len(conds) > 0 {
+		query.WriteString(" WHERE ")
+		query.WriteString(strings.Join(conds, " AND "))
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = maxRows
+	}
+	if limit > maxSearchRows {
+		limit = maxSearchRows
+	}
+	query.WriteString(" GROUP BY t.round, t.root ORDER BY t.round, t.root LIMIT ?")
+	args = append(args, limit)
+
+	rows, err := idb.dbr.Handle.Query(query.String(), args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var locs []TransactionLocation
+	for rows.Next() {
+		var loc TransactionLocation
+		err = rows.Scan(&loc.Round, &loc.Offset)
+		if err != nil {
+			return nil, "", err
+		}
+		locs = append(locs, loc)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if uint64(len(locs)) == limit {
+		next = encodeNextToken(locs[len(locs)-1])
+	}
+	return locs, next, nil
+}
+
+// GetTransactionLocation takes the ID of a transaction, which may be an inner
+// transaction, and returns the location of its top-level transaction.
+func (idb *DB) GetTransactionLocation(txid string) (TransactionLocation, error) {
+	var loc TransactionLocation
+	err := idb.dbr.Handle.QueryRow("SELECT round, root FROM txns WHERE txid = $1 LIMIT 1", txid).Scan(&loc.Round, &loc.Offset)
+	if err == sql.ErrNoRows {
+		return TransactionLocation{}, ErrTransactionNotFound
+	}
+	if err != nil {
+		return TransactionLocation{}, err
+	}
+	return loc, nil
+}
+
 // MaxRound returns the latest block in the DB
 func (idb *DB) MaxRound() (uint64, error) {
 	var rnd uint64
@@ -89,6 +89,18 @@ func (idx *Indexer) GetRoundsByAddress(addr string, top uint64) ([]uint64, error
 	return rounds, nil
 }
 
+// SearchTransactions returns a page of top-level transactions matching the
+// filter, along with a token for the next page if there may be one.
+func (idx *Indexer) SearchTransactions(filter TransactionFilter) ([]TransactionLocation, string, error) {
+	return idx.IDB.SearchTransactions(filter)
+}
+
+// GetTransactionLocation takes a transaction ID, inner transactions included,
+// and returns where its top-level transaction is in the chain.
+func (idx *Indexer) GetTransactionLocation(txID string) (TransactionLocation, error) {
+	return idx.IDB.GetTransactionLocation(txID)
+}
+
 // NewBlock takes a block and updates the DB
 // If the block exists, return nil.the block must be the next block
 func (idx *Indexer) NewBlock(b bookkeeping.Block) error {
@@ -66,7 +66,7 @@ func (s *IndexSuite) SetupSuite() {
 		var txnEnc []transactions.SignedTxnInBlock
 		b := bookkeeping.Block{
 			BlockHeader: bookkeeping.BlockHeader{
-				Round:       basics.Round(uint64(i + 2)),
+				Round:       basics.Round(uint64(i + 1)),
 				TimeStamp:   time.Now().Unix(),
 				GenesisID:   testGenesisID,
 				GenesisHash: genesisHash,
@@ -89,7 +89,7 @@ func (s *IndexSuite) SetupSuite() {
 
 		r, err := s.idx.LastBlock()
 		require.NoError(s.T(), err)
-		require.Equal(s.T(), basics.Round(i+2), r)
+		require.Equal(s.T(), basics.Round(i+1), r)
 	}
 }
 
@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeSearchBlock(t *testing.T, rnd basics.Round, ts int64, counter uint64, txns []transactions.SignedTxnWithAD) bookkeeping.Block {
+	b := bookkeeping.Block{
+		BlockHeader: bookkeeping.BlockHeader{
+			Round:       rnd,
+			TimeStamp:   ts,
+			TxnCounter:  counter,
+			GenesisID:   testGenesisID,
+			GenesisHash: genesisHash,
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusFuture,
+			},
+		},
+	}
+	for _, txn := range txns {
+		txib, err := b.EncodeSignedTxn(txn.SignedTxn, txn.ApplyData)
+		require.NoError(t, err)
+		b.Payset = append(b.Payset, txib)
+	}
+	return b
+}
+
+func payTxn(from, to basics.Address, note string) transactions.SignedTxnWithAD {
+	var stxn transactions.SignedTxnWithAD
+	stxn.Txn.Type = protocol.PaymentTx
+	stxn.Txn.Sender = from
+	stxn.Txn.Receiver = to
+	stxn.Txn.Amount = basics.MicroAlgos{Raw: 1}
+	stxn.Txn.GenesisHash = genesisHash
+	stxn.Txn.Note = []byte(note)
+	return stxn
+}
+
+func TestSearchTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	idx, err := MakeIndexer(t.TempDir(), &TestLedger{}, true)
+	require.NoError(t, err)
+	defer idx.Shutdown()
+
+	a := basics.Address{1}
+	b := basics.Address{2}
+	c := basics.Address{3}
+	appAddr := basics.AppIndex(7).Address()
+
+	var call transactions.SignedTxnWithAD
+	call.Txn.Type = protocol.ApplicationCallTx
+	call.Txn.Sender = a
+	call.Txn.ApplicationID = 7
+	call.Txn.GenesisHash = genesisHash
+
+	var xfer transactions.SignedTxnWithAD
+	xfer.Txn.Type = protocol.AssetTransferTx
+	xfer.Txn.Sender = appAddr
+	xfer.Txn.AssetReceiver = c
+	xfer.Txn.XferAsset = 5
+	call.ApplyData.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{xfer, payTxn(appAddr, b, "")}
+
+	var create transactions.SignedTxnWithAD
+	create.Txn.Type = protocol.AssetConfigTx
+	create.Txn.Sender = b
+	create.Txn.GenesisHash = genesisHash
+	create.Txn.AssetParams.Total = 10
+
+	blk := makeSearchBlock(t, 1, 1000, 1003, []transactions.SignedTxnWithAD{payTxn(a, b, "hello world"), call, create})
+	require.NoError(t, idx.NewBlock(blk))
+	blk = makeSearchBlock(t, 2, 2000, 1006, []transactions.SignedTxnWithAD{payTxn(a, c, "hello there"), payTxn(a, c, "hello"), payTxn(a, c, "bye")})
+	require.NoError(t, idx.NewBlock(blk))
+
+	search := func(filter TransactionFilter) ([]TransactionLocation, string) {
+		locs, next, err := idx.SearchTransactions(filter)
+		require.NoError(t, err)
+		return locs, next
+	}
+	loc := func(rnd, offset uint64) TransactionLocation {
+		return TransactionLocation{Round: rnd, Offset: offset}
+	}
+
+	// inner transactions surface their top-level transaction
+	locs, next := search(TransactionFilter{Address: c.String()})
+	require.Equal(t, []TransactionLocation{loc(1, 1), loc(2, 0), loc(2, 1), loc(2, 2)}, locs)
+	require.Empty(t, next)
+
+	locs, _ = search(TransactionFilter{AssetID: 5})
+	require.Equal(t, []TransactionLocation{loc(1, 1)}, locs)
+	locs, _ = search(TransactionFilter{AppID: 7})
+	require.Equal(t, []TransactionLocation{loc(1, 1)}, locs)
+	locs, _ = search(TransactionFilter{TxType: string(protocol.AssetTransferTx)})
+	require.Equal(t, []TransactionLocation{loc(1, 1)}, locs)
+
+	// the created asset ID is derived from the block transaction counter
+	locs, _ = search(TransactionFilter{AssetID: 1003})
+	require.Equal(t, []TransactionLocation{loc(1, 2)}, locs)
+
+	locs, _ = search(TransactionFilter{NotePrefix: []byte("hello")})
+	require.Equal(t, []TransactionLocation{loc(1, 0), loc(2, 0), loc(2, 1)}, locs)
+
+	locs, _ = search(TransactionFilter{Address: b.String(), MinRound: 1, MaxRound: 1})
+	require.Equal(t, []TransactionLocation{loc(1, 0), loc(1, 1), loc(1, 2)}, locs)
+	locs, _ = search(TransactionFilter{MinRound: 2})
+	require.Len(t, locs, 3)
+	locs, _ = search(TransactionFilter{AfterTime: 1500})
+	require.Equal(t, []TransactionLocation{loc(2, 0), loc(2, 1), loc(2, 2)}, locs)
+	locs, _ = search(TransactionFilter{BeforeTime: 1500})
+	require.Equal(t, []TransactionLocation{loc(1, 0), loc(1, 1), loc(1, 2)}, locs)
+
+	// page through the transactions of a
+	var pages [][]TransactionLocation
+	filter := TransactionFilter{Address: a.String(), Limit: 2}
+	for {
+		locs, next = search(filter)
+		pages = append(pages, locs)
+		if next == "" {
+			break
+		}
+		filter.Next = next
+	}
+	require.Equal(t, [][]TransactionLocation{
+		{loc(1, 0), loc(1, 1)},
+		{loc(2, 0), loc(2, 1)},
+		{loc(2, 2)},
+	}, pages)
+
+	_, _, err = idx.SearchTransactions(TransactionFilter{Next: "not a token"})
+	require.ErrorIs(t, err, ErrInvalidNextToken)
+
+	l, err := idx.GetTransactionLocation(xfer.ID().String())
+	require.NoError(t, err)
+	require.Equal(t, loc(1, 1), l)
+	l, err = idx.GetTransactionLocation(create.ID().String())
+	require.NoError(t, err)
+	require.Equal(t, loc(1, 2), l)
+	_, err = idx.GetTransactionLocation(payTxn(c, a, "").ID().String())
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+}
+
+func TestCreatedIDsCountInnerTxns(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	idx, err := MakeIndexer(t.TempDir(), &TestLedger{}, true)
+	require.NoError(t, err)
+	defer idx.Shutdown()
+
+	a := basics.Address{1}
+	appAddr := basics.AppIndex(7).Address()
+
+	var call transactions.SignedTxnWithAD
+	call.Txn.Type = protocol.ApplicationCallTx
+	call.Txn.Sender = a
+	call.Txn.ApplicationID = 7
+	call.Txn.GenesisHash = genesisHash
+	call.ApplyData.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{payTxn(appAddr, a, "1"), payTxn(appAddr, a, "2")}
+
+	var createAsset transactions.SignedTxnWithAD
+	createAsset.Txn.Type = protocol.AssetConfigTx
+	createAsset.Txn.Sender = a
+	createAsset.Txn.GenesisHash = genesisHash
+	createAsset.Txn.AssetParams.Total = 10
+
+	var createApp transactions.SignedTxnWithAD
+	createApp.Txn.Type = protocol.ApplicationCallTx
+	createApp.Txn.Sender = a
+	createApp.Txn.GenesisHash = genesisHash
+
+	// the counter went from 1000 to 1005: the asset is 1001, then the call
+	// and its two inner transactions take 1002 to 1004, and the app is 1005
+	blk := makeSearchBlock(t, 1, 1000, 1005, []transactions.SignedTxnWithAD{createAsset, call, createApp})
+	require.NoError(t, idx.NewBlock(blk))
+
+	locs, _, err := idx.SearchTransactions(TransactionFilter{AssetID: 1001})
+	require.NoError(t, err)
+	require.Equal(t, []TransactionLocation{{Round: 1, Offset: 0}}, locs)
+	locs, _, err = idx.SearchTransactions(TransactionFilter{AppID: 1005})
+	require.NoError(t, err)
+	require.Equal(t, []TransactionLocation{{Round: 1, Offset: 2}}, locs)
+}
+
+func TestMigrateOldSchema(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	idb, err := MakeIndexerDB(dir, false)
+	require.NoError(t, err)
+	_, err = idb.dbw.Handle.Exec("UPDATE params SET v = 5 WHERE k = 'maxRound'; DELETE FROM params WHERE k = 'schemaVersion'")
+	require.NoError(t, err)
+	idb.Close()
+
+	// a database without a schema version is indexed again from the start
+	idb, err = MakeIndexerDB(dir, false)
+	require.NoError(t, err)
+	defer idb.Close()
+	rnd, err := idb.MaxRound()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), rnd)
+}
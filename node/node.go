@@ -48,6 +48,7 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/node/indexer"
+	"github.com/algorand/go-algorand/node/txnstream"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/util/db"
@@ -111,11 +112,19 @@ type AlgorandFullNode struct {
 
 	indexer *indexer.Indexer
 
+	txnStream *txnstream.Hub
+
 	rootDir     string
 	genesisID   string
 	genesisHash crypto.Digest
 	devMode     bool // is this node operates in a developer mode ? ( benign agreement, broadcasting transaction generates a new block )
 
+	// devModePaused stops the developer mode from writing a block for each transaction group it
+	// receives, and timestampOffset, when non-zero, sets every developer mode block timestamp to the
+	// previous one plus the offset. Both are protected by mu.
+	devModePaused   bool
+	timestampOffset int64
+
 	log logging.Logger
 
 	// syncStatusMu used for locking lastRoundTimestamp and hasSyncedSinceStartup
@@ -209,10 +218,13 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log)
+	node.txnStream = txnstream.MakeHub(cfg, node.log)
+	node.transactionPool.RegisterTxnListener(node.txnStream)
 
 	blockListeners := []ledger.BlockListener{
 		node.transactionPool,
 		node,
+		node.txnStream,
 	}
 
 	if node.config.EnableTopAccountsReporting {
@@ -373,12 +385,16 @@ func (node *AlgorandFullNode) Start() {
 		node.catchpointCatchupService.Start(node.ctx)
 	} else {
 		node.catchupService.Start()
-		node.agreementService.Start()
-		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		if !node.config.EnableFollowMode {
+			node.agreementService.Start()
+			node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		}
 		node.blockService.Start()
 		node.ledgerService.Start()
-		node.txHandler.Start()
-		node.compactCert.Start()
+		if !node.config.EnableFollowMode {
+			node.txHandler.Start()
+			node.compactCert.Start()
+		}
 		startNetwork()
 		// start indexer
 		if idx, err := node.Indexer(); err == nil {
@@ -448,7 +464,9 @@ func (node *AlgorandFullNode) Stop() {
 		node.catchpointCatchupService.Stop()
 	} else {
 		node.txHandler.Stop()
-		node.agreementService.Shutdown()
+		if !node.config.EnableFollowMode {
+			node.agreementService.Shutdown()
+		}
 		node.catchupService.Stop()
 		node.txPoolSyncerService.Stop()
 		node.blockService.Stop()
@@ -488,6 +506,22 @@ func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
 		return
 	}
 
+	if node.timestampOffset != 0 {
+		blk := vb.Block()
+		var prev bookkeeping.BlockHeader
+		prev, err = node.ledger.BlockHdr(blk.Round() - 1)
+		if err != nil {
+			return
+		}
+		blk.TimeStamp = prev.TimeStamp + node.timestampOffset
+
+		// the delta keeps a pointer to the header it was evaluated with; point it at the updated one.
+		delta := vb.Delta()
+		delta.Hdr = &blk.BlockHeader
+		withOffset := ledgercore.MakeValidatedBlock(blk, delta)
+		vb = &withOffset
+	}
+
 	// add the newly generated block to the ledger
 	err = node.ledger.AddValidatedBlock(*vb, agreement.Certificate{})
 	return err
@@ -495,6 +529,10 @@ func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
 
 // BroadcastSignedTxGroup broadcasts a transaction group that has already been signed.
 func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) (err error) {
+	if node.config.EnableFollowMode {
+		return ErrFollowModeEnabled
+	}
+
 	// in developer mode, we need to take a lock, so that each new transaction group would truly
 	// render into a unique block.
 	if node.devMode {
@@ -502,7 +540,7 @@ func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.Sign
 		defer func() {
 			// if we added the transaction successfully to the transaction pool, then
 			// attempt to generate a block and write it to the ledger.
-			if err == nil {
+			if err == nil && !node.devModePaused {
 				err = node.writeDevmodeBlock()
 			}
 			node.mu.Unlock()
@@ -751,6 +789,42 @@ func (node *AlgorandFullNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn
 	return bookkeeping.SignedTxnGroupsFlatten(node.transactionPool.PendingTxGroups()), nil
 }
 
+// TxPoolStatus is a snapshot of the fee levels of the transaction pool and of
+// the transaction groups waiting in it.
+type TxPoolStatus struct {
+	FeePerByte             uint64
+	FeeThresholdMultiplier uint64
+	PendingWholeBlocks     basics.Round
+	Groups                 []pools.PendingTxGroupSummary
+}
+
+// GetTxPoolStatus returns the fee levels of the transaction pool along with its pending transaction
+// groups, sorted by decreasing fee per byte.
+func (node *AlgorandFullNode) GetTxPoolStatus() TxPoolStatus {
+	var status TxPoolStatus
+	status.FeePerByte, status.FeeThresholdMultiplier, status.PendingWholeBlocks = node.transactionPool.FeeThreshold()
+	status.Groups = node.transactionPool.PendingTxGroupsByFeePerByte()
+	return status
+}
+
+// EvictPendingTxns removes the transaction groups holding any of the given transactions from the
+// node's transaction pool, and returns the number of transactions removed.
+func (node *AlgorandFullNode) EvictPendingTxns(txids []transactions.Txid) int {
+	return node.transactionPool.EvictTxns(txids)
+}
+
+// EvictPendingTxnsFromSender removes the transaction groups holding a transaction sent by sender
+// from the node's transaction pool, and returns the number of transactions removed.
+func (node *AlgorandFullNode) EvictPendingTxnsFromSender(sender basics.Address) int {
+	return node.transactionPool.EvictSender(sender)
+}
+
+// SubscribeTxnStream subscribes client to the transaction pool admissions and
+// drops and to the committed blocks, restricted to the transactions matching filter.
+func (node *AlgorandFullNode) SubscribeTxnStream(filter txnstream.Filter, client string) (*txnstream.Subscription, error) {
+	return node.txnStream.Subscribe(filter, client)
+}
+
 // ensureParticipationDB opens or creates a participation DB.
 func ensureParticipationDB(genesisDir string, log logging.Logger) (account.ParticipationRegistry, error) {
 	accessorFile := filepath.Join(genesisDir, config.ParticipationRegistryFilename)
@@ -1165,6 +1239,92 @@ func (node *AlgorandFullNode) AbortCatchup(catchpoint string) error {
 	return nil
 }
 
+// SetSyncRound sets the last round the node's catchup service fetches from the network. It is
+// only available in follower mode, where the catchup service is the sole source of new blocks.
+func (node *AlgorandFullNode) SetSyncRound(rnd basics.Round) error {
+	if !node.config.EnableFollowMode {
+		return ErrFollowModeDisabled
+	}
+	return node.catchupService.SetSyncRound(rnd)
+}
+
+// GetSyncRound returns the current sync round, or 0 if it is not set.
+func (node *AlgorandFullNode) GetSyncRound() basics.Round {
+	return node.catchupService.GetSyncRound()
+}
+
+// UnsetSyncRound lets the node's catchup service fetch rounds without limit.
+func (node *AlgorandFullNode) UnsetSyncRound() error {
+	if !node.config.EnableFollowMode {
+		return ErrFollowModeDisabled
+	}
+	node.catchupService.UnsetSyncRound()
+	return nil
+}
+
+// SetDevModeBlockProduction pauses or resumes the developer mode block production. While paused,
+// submitted transactions stay in the transaction pool until blocks are minted with MintDevModeBlocks.
+func (node *AlgorandFullNode) SetDevModeBlockProduction(paused bool) error {
+	if !node.devMode {
+		return ErrDevModeDisabled
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.devModePaused = paused
+	return nil
+}
+
+// MintDevModeBlocks writes count developer mode blocks to the ledger, the first ones holding the
+// transactions pending in the pool, and returns the last round written.
+func (node *AlgorandFullNode) MintDevModeBlocks(count uint64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, ErrDevModeDisabled
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	for i := uint64(0); i < count; i++ {
+		err := node.writeDevmodeBlock()
+		if err != nil {
+			return node.ledger.Latest(), err
+		}
+	}
+	return node.ledger.Latest(), nil
+}
+
+// SetBlockTimeStampOffset sets the number of seconds between the timestamps of consecutive developer
+// mode blocks. An offset of 0 reverts to the wall clock. The offset may not exceed the maximum
+// timestamp increment of the current protocol, since blocks further apart would fail validation.
+func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
+	if !node.devMode {
+		return ErrDevModeDisabled
+	}
+	latest, err := node.ledger.BlockHdr(node.ledger.Latest())
+	if err != nil {
+		return err
+	}
+	proto, ok := config.Consensus[latest.CurrentProtocol]
+	if !ok {
+		return protocol.Error(latest.CurrentProtocol)
+	}
+	if offset < 0 || offset > proto.MaxTimestampIncrement {
+		return ErrInvalidTimeStampOffset
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.timestampOffset = offset
+	return nil
+}
+
+// GetBlockTimeStampOffset returns the developer mode block timestamp offset, or 0 if it is not set.
+func (node *AlgorandFullNode) GetBlockTimeStampOffset() (int64, error) {
+	if !node.devMode {
+		return 0, ErrDevModeDisabled
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.timestampOffset, nil
+}
+
 // SetCatchpointCatchupMode change the node's operational mode from catchpoint catchup mode and back, it returns a
 // channel which contains the updated node context. This function need to work asyncronisly so that the caller could
 // detect and handle the usecase where the node is being shut down while we're switching to/from catchup mode without
@@ -1192,7 +1352,9 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 			}()
 			node.net.ClearHandlers()
 			node.txHandler.Stop()
-			node.agreementService.Shutdown()
+			if !node.config.EnableFollowMode {
+				node.agreementService.Shutdown()
+			}
 			node.catchupService.Stop()
 			node.txPoolSyncerService.Stop()
 			node.blockService.Stop()
@@ -1211,11 +1373,15 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 		// start
 		node.transactionPool.Reset()
 		node.catchupService.Start()
-		node.agreementService.Start()
-		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		if !node.config.EnableFollowMode {
+			node.agreementService.Start()
+			node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		}
 		node.blockService.Start()
 		node.ledgerService.Start()
-		node.txHandler.Start()
+		if !node.config.EnableFollowMode {
+			node.txHandler.Start()
+		}
 
 		// start indexer
 		if idx, err := node.Indexer(); err == nil {
@@ -128,7 +128,7 @@ func setupFullNodes(t *testing.T, proto protocol.ConsensusVersion, verificationP
 		if err != nil {
 			panic(err)
 		}
-		part, err := account.FillDBWithParticipationKeys(access, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution)
+		part, err := account.FillDBWithParticipationKeys(access, root.Address(), firstRound, lastRound, config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 		if err != nil {
 			panic(err)
 		}
@@ -553,3 +553,57 @@ func TestAsyncRecord(t *testing.T) {
 	require.Equal(t, 10000, int(records[0].LastVote))
 	require.Equal(t, 20000, int(records[0].LastBlockProposal))
 }
+
+func TestDevModeBlockControl(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var nonDevNode AlgorandFullNode
+	require.ErrorIs(t, nonDevNode.SetDevModeBlockProduction(true), ErrDevModeDisabled)
+	_, err := nonDevNode.MintDevModeBlocks(1)
+	require.ErrorIs(t, err, ErrDevModeDisabled)
+	require.ErrorIs(t, nonDevNode.SetBlockTimeStampOffset(10), ErrDevModeDisabled)
+
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "go-test-node-devmode",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     sinkAddr.String(),
+		RewardsPool: poolAddr.String(),
+		DevMode:     true,
+		Allocation: []bookkeeping.GenesisAllocation{
+			{Address: sinkAddr.String(), State: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1e12}}},
+			{Address: poolAddr.String(), State: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1e12}}},
+		},
+	}
+	node, err := MakeFull(logging.TestingLog(t), t.TempDir(), config.GetDefaultLocal(), []string{}, genesis)
+	require.NoError(t, err)
+	defer node.ledger.Close()
+
+	require.NoError(t, node.SetDevModeBlockProduction(true))
+	require.True(t, node.devModePaused)
+
+	rnd, err := node.MintDevModeBlocks(3)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(3), rnd)
+	require.Equal(t, basics.Round(3), node.ledger.Latest())
+
+	maxIncrement := config.Consensus[protocol.ConsensusCurrentVersion].MaxTimestampIncrement
+	require.ErrorIs(t, node.SetBlockTimeStampOffset(-1), ErrInvalidTimeStampOffset)
+	require.ErrorIs(t, node.SetBlockTimeStampOffset(maxIncrement+1), ErrInvalidTimeStampOffset)
+	require.NoError(t, node.SetBlockTimeStampOffset(maxIncrement))
+	offset, err := node.GetBlockTimeStampOffset()
+	require.NoError(t, err)
+	require.Equal(t, maxIncrement, offset)
+
+	rnd, err = node.MintDevModeBlocks(2)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+	for r := basics.Round(4); r <= 5; r++ {
+		prev, err := node.ledger.BlockHdr(r - 1)
+		require.NoError(t, err)
+		hdr, err := node.ledger.BlockHdr(r)
+		require.NoError(t, err)
+		require.Equal(t, prev.TimeStamp+maxIncrement, hdr.TimeStamp)
+		require.NoError(t, hdr.PreCheck(prev))
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package txnstream
+
+import (
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Filter selects the transactions reported to a subscription. A transaction
+// matches when it references one of the addresses or one of the applications;
+// an empty filter matches every transaction.
+type Filter struct {
+	Addresses map[basics.Address]bool
+	Apps      map[basics.AppIndex]bool
+}
+
+func (f Filter) empty() bool {
+	return len(f.Addresses) == 0 && len(f.Apps) == 0
+}
+
+// matchTxn checks whether txn references one of the filtered addresses or
+// applications.
+func (f Filter) matchTxn(txn *transactions.Transaction) bool {
+	if f.empty() {
+		return true
+	}
+	if len(f.Addresses) > 0 {
+		addrs := []basics.Address{
+			txn.Sender,
+			txn.Receiver,
+			txn.CloseRemainderTo,
+			txn.AssetSender,
+			txn.AssetReceiver,
+			txn.AssetCloseTo,
+			txn.FreezeAccount,
+		}
+		addrs = append(addrs, txn.Accounts...)
+		for _, addr := range addrs {
+			if !addr.IsZero() && f.Addresses[addr] {
+				return true
+			}
+		}
+	}
+	if len(f.Apps) > 0 && txn.Type == protocol.ApplicationCallTx {
+		if f.Apps[txn.ApplicationID] {
+			return true
+		}
+		for _, app := range txn.ForeignApps {
+			if f.Apps[app] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchApplied checks a committed transaction, including the application it
+// created and the inner transactions it issued.
+func (f Filter) matchApplied(stxn *transactions.SignedTxnWithAD) bool {
+	if f.matchTxn(&stxn.Txn) {
+		return true
+	}
+	if stxn.ApplyData.ApplicationID != 0 && f.Apps[stxn.ApplyData.ApplicationID] {
+		return true
+	}
+	for i := range stxn.ApplyData.EvalDelta.InnerTxns {
+		if f.matchApplied(&stxn.ApplyData.EvalDelta.InnerTxns[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,248 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package txnstream fans the transaction pool and ledger activity of a node
+// out to the subscribers of the algod event stream.
+package txnstream
+
+import (
+	"errors"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// EventType identifies the kind of an Event.
+type EventType string
+
+const (
+	// PendingEvent reports a transaction group admitted to the transaction pool.
+	PendingEvent EventType = "pending"
+	// BlockEvent reports a committed block along with its matching transactions.
+	BlockEvent EventType = "block"
+	// DroppedEvent reports a transaction removed from the pool without being committed.
+	DroppedEvent EventType = "dropped"
+)
+
+// Event is a single message of the stream.
+type Event struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Type EventType `codec:"type"`
+
+	// Round is the round of a committed block.
+	Round basics.Round `codec:"round"`
+
+	// Txids lists the transactions of an admitted group, the matching
+	// transactions of a committed block or the dropped transaction.
+	Txids []string `codec:"txids"`
+
+	// Txns carries the admitted group or the dropped transaction.
+	Txns []transactions.SignedTxn `codec:"txns"`
+
+	// Reason is the reason a transaction was dropped from the pool.
+	Reason string `codec:"reason"`
+}
+
+// subscriptionBufferSize is the number of events a subscriber may fall
+// behind before it is disconnected.
+const subscriptionBufferSize = 1024
+
+// ErrTooManySubscriptions is returned by Subscribe when the hub already
+// serves the maximal number of subscriptions.
+var ErrTooManySubscriptions = errors.New("too many event stream subscriptions")
+
+// ErrTooManyClientSubscriptions is returned by Subscribe when the hub already
+// serves the maximal number of subscriptions of the client.
+var ErrTooManyClientSubscriptions = errors.New("too many event stream subscriptions from the client")
+
+// Subscription receives the events matching its filter.
+type Subscription struct {
+	hub    *Hub
+	filter Filter
+	client string
+	events chan Event
+}
+
+// Events returns the channel the events are delivered on. The channel is
+// closed once the subscription is closed, or when the subscriber fell too far
+// behind and was disconnected.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close stops the delivery of events to the subscription.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+// Hub dispatches the pool and ledger events to the subscriptions. It is
+// registered both as a transaction pool listener and as a ledger block
+// listener.
+type Hub struct {
+	mu      deadlock.Mutex
+	subs    map[*Subscription]bool
+	clients map[string]uint64
+	log     logging.Logger
+
+	maxSubscriptions          uint64
+	maxSubscriptionsPerClient uint64
+}
+
+// MakeHub creates a hub with no subscriptions, limited to the numbers of
+// subscriptions set by cfg.
+func MakeHub(cfg config.Local, log logging.Logger) *Hub {
+	return &Hub{
+		subs:                      make(map[*Subscription]bool),
+		clients:                   make(map[string]uint64),
+		log:                       log,
+		maxSubscriptions:          cfg.TxnStreamMaxSubscriptions,
+		maxSubscriptionsPerClient: cfg.TxnStreamMaxSubscriptionsPerClient,
+	}
+}
+
+// Subscribe registers a new subscription of client for the events matching
+// filter. The client identifies the subscriber, usually by its address, so
+// that a single client cannot take all of the subscriptions.
+func (h *Hub) Subscribe(filter Filter, client string) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if uint64(len(h.subs)) >= h.maxSubscriptions {
+		return nil, ErrTooManySubscriptions
+	}
+	if h.clients[client] >= h.maxSubscriptionsPerClient {
+		return nil, ErrTooManyClientSubscriptions
+	}
+	s := &Subscription{
+		hub:    h,
+		filter: filter,
+		client: client,
+		events: make(chan Event, subscriptionBufferSize),
+	}
+	h.subs[s] = true
+	h.clients[client]++
+	return s, nil
+}
+
+// remove unregisters s and closes its channel. The caller holds h.mu.
+func (h *Hub) remove(s *Subscription) {
+	if h.subs[s] {
+		delete(h.subs, s)
+		close(s.events)
+		h.clients[s.client]--
+		if h.clients[s.client] == 0 {
+			delete(h.clients, s.client)
+		}
+	}
+}
+
+// send delivers ev to s without blocking; a subscriber whose buffer is full
+// is disconnected rather than silently missing events. The caller holds h.mu.
+func (h *Hub) send(s *Subscription, ev Event) {
+	select {
+	case s.events <- ev:
+	default:
+		h.log.Infof("txnstream: disconnecting a subscriber that fell %d events behind", subscriptionBufferSize)
+		h.remove(s)
+	}
+}
+
+// OnTxGroupAdmitted implements pools.TxnListener.
+func (h *Hub) OnTxGroupAdmitted(txgroup []transactions.SignedTxn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs) == 0 {
+		return
+	}
+
+	txids := make([]string, len(txgroup))
+	for i := range txgroup {
+		txids[i] = txgroup[i].ID().String()
+	}
+	ev := Event{Type: PendingEvent, Txids: txids, Txns: txgroup}
+
+	for s := range h.subs {
+		for i := range txgroup {
+			if s.filter.matchTxn(&txgroup[i].Txn) {
+				h.send(s, ev)
+				break
+			}
+		}
+	}
+}
+
+// OnTxnDropped implements pools.TxnListener.
+func (h *Hub) OnTxnDropped(txn transactions.SignedTxn, reason string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs) == 0 {
+		return
+	}
+
+	ev := Event{
+		Type:   DroppedEvent,
+		Txids:  []string{txn.ID().String()},
+		Txns:   []transactions.SignedTxn{txn},
+		Reason: reason,
+	}
+	for s := range h.subs {
+		if s.filter.matchTxn(&txn.Txn) {
+			h.send(s, ev)
+		}
+	}
+}
+
+// OnNewBlock implements ledger.BlockListener. Every subscription is notified
+// of every block, along with the ids of the transactions matching its filter.
+func (h *Hub) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs) == 0 {
+		return
+	}
+
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		h.log.Warnf("txnstream: unable to decode the payset of round %d: %v", block.Round(), err)
+		return
+	}
+	txids := make([]string, len(payset))
+	for i := range payset {
+		txids[i] = payset[i].ID().String()
+	}
+
+	for s := range h.subs {
+		ev := Event{Type: BlockEvent, Round: block.Round()}
+		if s.filter.empty() {
+			ev.Txids = txids
+		} else {
+			for i := range payset {
+				if s.filter.matchApplied(&payset[i]) {
+					ev.Txids = append(ev.Txids, txids[i])
+				}
+			}
+		}
+		h.send(s, ev)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package txnstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func payment(sender, receiver basics.Address, note byte) transactions.SignedTxn {
+	return transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: sender, Note: []byte{note}},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: receiver},
+		},
+	}
+}
+
+func appCall(sender basics.Address, app basics.AppIndex) transactions.SignedTxn {
+	return transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:                     protocol.ApplicationCallTx,
+			Header:                   transactions.Header{Sender: sender},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: app},
+		},
+	}
+}
+
+func TestFilteredEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	alice := basics.Address{1}
+	bob := basics.Address{2}
+	carol := basics.Address{3}
+
+	hub := MakeHub(config.GetDefaultLocal(), logging.TestingLog(t))
+	all, err := hub.Subscribe(Filter{}, "client")
+	require.NoError(t, err)
+	byAddr, err := hub.Subscribe(Filter{Addresses: map[basics.Address]bool{bob: true}}, "client")
+	require.NoError(t, err)
+	byApp, err := hub.Subscribe(Filter{Apps: map[basics.AppIndex]bool{7: true}}, "client")
+	require.NoError(t, err)
+
+	pay := payment(alice, bob, 1)
+	pay.Txn.GenesisHash = crypto.Digest{9}
+	other := payment(alice, carol, 2)
+	hub.OnTxGroupAdmitted([]transactions.SignedTxn{pay})
+	hub.OnTxGroupAdmitted([]transactions.SignedTxn{other})
+	hub.OnTxnDropped(pay, "txn dead")
+
+	require.Len(t, all.Events(), 3)
+	require.Len(t, byAddr.Events(), 2)
+	require.Len(t, byApp.Events(), 0)
+
+	ev := <-byAddr.Events()
+	require.Equal(t, PendingEvent, ev.Type)
+	require.Equal(t, []string{pay.ID().String()}, ev.Txids)
+	require.Equal(t, []transactions.SignedTxn{pay}, ev.Txns)
+	ev = <-byAddr.Events()
+	require.Equal(t, DroppedEvent, ev.Type)
+	require.Equal(t, []string{pay.ID().String()}, ev.Txids)
+	require.Equal(t, "txn dead", ev.Reason)
+
+	// the app is only called by an inner transaction
+	outer := appCall(carol, 5)
+	outer.Txn.GenesisHash = crypto.Digest{9}
+	ad := transactions.ApplyData{
+		EvalDelta: transactions.EvalDelta{
+			InnerTxns: []transactions.SignedTxnWithAD{{SignedTxn: appCall(basics.Address{}, 7)}},
+		},
+	}
+	var blk bookkeeping.Block
+	blk.BlockHeader.Round = 10
+	blk.BlockHeader.CurrentProtocol = protocol.ConsensusCurrentVersion
+	blk.BlockHeader.GenesisHash = crypto.Digest{9}
+	for _, txad := range []transactions.SignedTxnWithAD{{SignedTxn: pay}, {SignedTxn: outer, ApplyData: ad}} {
+		stib, err := blk.EncodeSignedTxn(txad.SignedTxn, txad.ApplyData)
+		require.NoError(t, err)
+		blk.Payset = append(blk.Payset, stib)
+	}
+	hub.OnNewBlock(blk, ledgercore.StateDelta{})
+
+	ev = <-byApp.Events()
+	require.Equal(t, BlockEvent, ev.Type)
+	require.Equal(t, basics.Round(10), ev.Round)
+	require.Equal(t, []string{outer.ID().String()}, ev.Txids)
+	ev = <-byAddr.Events()
+	require.Equal(t, []string{pay.ID().String()}, ev.Txids)
+	for i := 0; i < 3; i++ {
+		<-all.Events()
+	}
+	ev = <-all.Events()
+	require.Equal(t, []string{pay.ID().String(), outer.ID().String()}, ev.Txids)
+
+	// closing a subscription closes its channel
+	byApp.Close()
+	_, ok := <-byApp.Events()
+	require.False(t, ok)
+	byApp.Close()
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	hub := MakeHub(config.GetDefaultLocal(), logging.TestingLog(t))
+	sub, err := hub.Subscribe(Filter{}, "client")
+	require.NoError(t, err)
+
+	pay := payment(basics.Address{1}, basics.Address{2}, 0)
+	for i := 0; i <= subscriptionBufferSize; i++ {
+		hub.OnTxGroupAdmitted([]transactions.SignedTxn{pay})
+	}
+
+	// the subscriber is disconnected once its buffer overflows
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+	require.Equal(t, subscriptionBufferSize, received)
+	sub.Close()
+
+}
+
+func TestSubscriptionLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxnStreamMaxSubscriptions = 5
+	cfg.TxnStreamMaxSubscriptionsPerClient = 2
+	hub := MakeHub(cfg, logging.TestingLog(t))
+
+	// a client cannot take more than its share of the subscriptions
+	first, err := hub.Subscribe(Filter{}, "a")
+	require.NoError(t, err)
+	_, err = hub.Subscribe(Filter{}, "a")
+	require.NoError(t, err)
+	_, err = hub.Subscribe(Filter{}, "a")
+	require.Equal(t, ErrTooManyClientSubscriptions, err)
+
+	// closing a subscription frees a slot of its client
+	first.Close()
+	_, err = hub.Subscribe(Filter{}, "a")
+	require.NoError(t, err)
+
+	// other clients still get subscriptions, up to the overall limit
+	for _, client := range []string{"b", "b", "c"} {
+		_, err = hub.Subscribe(Filter{}, client)
+		require.NoError(t, err)
+	}
+	_, err = hub.Subscribe(Filter{}, "d")
+	require.Equal(t, ErrTooManySubscriptions, err)
+}
@@ -48,6 +48,7 @@ const (
 	Credential        HashID = "CR"
 	Genesis           HashID = "GE"
 	MerkleArrayNode   HashID = "MA"
+	MerkleSigKey      HashID = "MSK"
 	Message           HashID = "MX"
 	NetPrioResponse   HashID = "NPR"
 	OneTimeSigKey1    HashID = "OT1"
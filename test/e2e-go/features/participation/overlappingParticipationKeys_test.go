@@ -196,7 +196,7 @@ func prepareParticipationKey(a *require.Assertions, fixture *fixtures.RestClient
 		return err
 	}
 
-	persistedParticipation, err := account.FillDBWithParticipationKeys(partkeyHandle, rootAccount.Address(), basics.Round(regStartRound), basics.Round(regEndRound), fixture.LibGoalFixture.Genesis().PartKeyDilution)
+	persistedParticipation, err := account.FillDBWithParticipationKeys(partkeyHandle, rootAccount.Address(), basics.Round(regStartRound), basics.Round(regEndRound), fixture.LibGoalFixture.Genesis().PartKeyDilution, config.Consensus[protocol.ConsensusCurrentVersion].CompactCertRounds)
 	if err != nil {
 		a.NoError(err)
 		return err